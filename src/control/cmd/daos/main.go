@@ -26,7 +26,8 @@ import (
 type cliOptions struct {
 	Debug         bool             `long:"debug" description:"Enable debug output"`
 	Verbose       bool             `long:"verbose" description:"Enable verbose output (when applicable)"`
-	JSON          bool             `long:"json" short:"j" description:"Enable JSON output"`
+	JSON          bool             `long:"json" short:"j" description:"Enable JSON output (same as --output=json)"`
+	Output        string           `long:"output" choice:"json" choice:"yaml" choice:"csv" description:"Enable machine-readable output in the given format (csv is only supported by tabular commands)"`
 	SysName       string           `long:"sys-name" short:"G" description:"DAOS system name (optional)"`
 	Container     containerCmd     `command:"container" alias:"cont" description:"Perform tasks related to DAOS containers"`
 	Pool          poolCmd          `command:"pool" description:"Perform tasks related to DAOS pools"`
@@ -40,6 +41,19 @@ type cliOptions struct {
 	faultsCmdRoot
 }
 
+// outputFormat returns the machine-readable output format selected on the
+// command line, or an empty format if the output should be human-readable.
+func (opts *cliOptions) outputFormat() (cmdutil.OutputFormat, error) {
+	format := cmdutil.OutputFormat(opts.Output)
+	if opts.JSON {
+		if format != "" && format != cmdutil.OutputFormatJSON {
+			return "", errors.Errorf("--json and --output=%s cannot be used together", format)
+		}
+		return cmdutil.OutputFormatJSON, nil
+	}
+	return format, nil
+}
+
 type versionCmd struct {
 	cmdutil.JSONOutputCmd
 }
@@ -125,8 +139,13 @@ or query/manage an object inside a container.`
 			log.Debug("debug output enabled")
 		}
 
-		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && opts.JSON {
+		format, err := opts.outputFormat()
+		if err != nil {
+			return err
+		}
+		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && format != "" {
 			jsonCmd.EnableJSONOutput(os.Stdout, &wroteJSON)
+			jsonCmd.SetOutputFormat(format)
 			// disable output on stdout other than JSON
 			log.ClearLevel(logging.LogLevelInfo)
 		}
@@ -193,8 +212,8 @@ or query/manage an object inside a container.`
 	debug.SetTraceback("crash")
 
 	_, err = p.ParseArgs(args)
-	if opts.JSON && wroteJSON.IsFalse() {
-		return cmdutil.OutputJSON(os.Stdout, nil, err)
+	if format, fmtErr := opts.outputFormat(); fmtErr == nil && format != "" && wroteJSON.IsFalse() {
+		return cmdutil.WriteOutput(os.Stdout, format, nil, err)
 	}
 	return err
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/cmd/daos/pretty"
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -752,11 +753,11 @@ func (cmd *poolListCmd) Execute(_ []string) error {
 	}
 
 	if cmd.JSONOutputEnabled() {
-		return cmd.OutputJSON(struct {
+		return cmd.OutputJSON(cmdutil.NewTabularOutput(struct {
 			Pools []*daos.PoolInfo `json:"pools"` // compatibility with dmg
 		}{
 			Pools: pools,
-		}, nil)
+		}, pretty.TabulatePoolList(pools)), nil)
 	}
 
 	var buf strings.Builder
@@ -9,10 +9,14 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
@@ -182,7 +186,7 @@ func addVerboseTierUsage(row txtfmt.TableRow, usage *daos.PoolTierUsage) txtfmt.
 	return row
 }
 
-func poolListCreateRowVerbose(pool *daos.PoolInfo, hasSpace, hasRebuild bool) txtfmt.TableRow {
+func poolListCreateRowVerbose(pool *daos.PoolInfo, hasSpace, hasRebuild, hasCreation bool) txtfmt.TableRow {
 	label := pool.Label
 	if label == "" {
 		label = "-"
@@ -211,6 +215,16 @@ func poolListCreateRowVerbose(pool *daos.PoolInfo, hasSpace, hasRebuild bool) tx
 	if hasRebuild {
 		row["Rebuild State"] = pool.RebuildState()
 	}
+	if hasCreation {
+		row["Created"] = "-"
+		if pool.Created != nil {
+			row["Created"] = common.FormatTime(*pool.Created)
+		}
+		row["Creator"] = "-"
+		if pool.Creator != "" {
+			row["Creator"] = pool.Creator
+		}
+	}
 
 	if hasSpace {
 		for _, tu := range pool.Usage() {
@@ -227,17 +241,17 @@ func printVerbosePoolList(pools []*daos.PoolInfo, out io.Writer) error {
 
 	hasSpaceQuery := false
 	hasRebuildQuery := false
+	hasCreation := false
 	for _, pool := range pools {
-		if hasSpaceQuery && hasRebuildQuery {
-			break
-		}
-
 		if pool.QueryMask.HasOption(daos.PoolQueryOptionSpace) {
 			hasSpaceQuery = true
 		}
 		if pool.QueryMask.HasOption(daos.PoolQueryOptionRebuild) {
 			hasRebuildQuery = true
 		}
+		if pool.Created != nil || pool.Creator != "" {
+			hasCreation = true
+		}
 	}
 
 	// If any of the pools was queried, then we'll need to show more fields.
@@ -256,11 +270,16 @@ func printVerbosePoolList(pools []*daos.PoolInfo, out io.Writer) error {
 		titles = append(titles, "Rebuild State")
 	}
 
+	// Creation details are only recorded by the management service.
+	if hasCreation {
+		titles = append(titles, "Created", "Creator")
+	}
+
 	formatter := txtfmt.NewTableFormatter(titles...)
 
 	var table []txtfmt.TableRow
 	for _, pool := range pools {
-		table = append(table, poolListCreateRowVerbose(pool, hasSpaceQuery, hasRebuildQuery))
+		table = append(table, poolListCreateRowVerbose(pool, hasSpaceQuery, hasRebuildQuery, hasCreation))
 	}
 
 	fmt.Fprintln(out, formatter.Format(table))
@@ -282,3 +301,62 @@ func PrintPoolList(pools []*daos.PoolInfo, out io.Writer, verbose bool) error {
 
 	return printPoolList(pools, out)
 }
+
+// TabulatePoolList returns a tabular representation of the supplied slice of
+// daos.PoolInfo structs for machine-readable output. Sizes are in bytes and
+// usage columns are only included if the pools were queried for space.
+func TabulatePoolList(pools []*daos.PoolInfo) *cmdutil.Table {
+	table := &cmdutil.Table{
+		Header: []string{"label", "uuid", "state", "svc_reps", "total_targets",
+			"disabled_targets", "rebuild_state", "pool_layout_ver", "upgrade_layout_ver"},
+	}
+
+	// Use the tiers of the first pool that was queried for space.
+	var tiers []string
+	for _, pool := range pools {
+		usage := pool.Usage()
+		for _, tu := range usage {
+			tier := strings.ToLower(tu.TierName)
+			tiers = append(tiers, tier)
+			table.Header = append(table.Header,
+				tier+"_size", tier+"_free", tier+"_imbalance")
+		}
+		if len(usage) > 0 {
+			break
+		}
+	}
+
+	for _, pool := range pools {
+		row := []string{
+			pool.Label,
+			pool.UUID.String(),
+			pool.State.String(),
+			PrintRanks(pool.ServiceReplicas),
+			strconv.FormatUint(uint64(pool.TotalTargets), 10),
+			strconv.FormatUint(uint64(pool.DisabledTargets), 10),
+			pool.RebuildState(),
+			strconv.FormatUint(uint64(pool.PoolLayoutVer), 10),
+			strconv.FormatUint(uint64(pool.UpgradeLayoutVer), 10),
+		}
+
+		usage := make(map[string]*daos.PoolTierUsage)
+		for _, tu := range pool.Usage() {
+			usage[strings.ToLower(tu.TierName)] = tu
+		}
+		for _, tier := range tiers {
+			tu, found := usage[tier]
+			if !found {
+				row = append(row, "", "", "")
+				continue
+			}
+			row = append(row,
+				strconv.FormatUint(tu.Size, 10),
+				strconv.FormatUint(tu.Free, 10),
+				strconv.FormatUint(uint64(tu.Imbalance), 10))
+		}
+
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table
+}
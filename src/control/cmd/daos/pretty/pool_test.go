@@ -11,11 +11,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -448,6 +450,7 @@ Target: type unknown, state drain
 }
 
 func TestPretty_PrintListPools(t *testing.T) {
+	createdTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
 	exampleTierStats := []*daos.StorageUsageStats{
 		{
 			MediaType: daos.StorageMediaTypeScm,
@@ -615,6 +618,32 @@ Label UUID                                 State SvcReps
 ----- ----                                 ----- ------- 
 -     00000001-0001-0001-0001-000000000001 Ready N/A     
 
+`,
+		},
+		"verbose; creation details with no query": {
+			pools: []*daos.PoolInfo{
+				{
+					Label:           "one",
+					UUID:            test.MockPoolUUID(1),
+					ServiceReplicas: []ranklist.Rank{0, 1, 2},
+					State:           daos.PoolServiceStateReady,
+					Creator:         "CN=admin,OU=alice,O=DAOS",
+					Created:         &createdTime,
+				},
+				{
+					Label:           "two",
+					UUID:            test.MockPoolUUID(2),
+					ServiceReplicas: []ranklist.Rank{3, 4, 5},
+					State:           daos.PoolServiceStateReady,
+				},
+			},
+			verbose: true,
+			expPrintStr: `
+Label UUID                                 State SvcReps Created                       Creator                  
+----- ----                                 ----- ------- -------                       -------                  
+one   00000001-0001-0001-0001-000000000001 Ready [0-2]   2024-05-01T12:00:00.000+00:00 CN=admin,OU=alice,O=DAOS 
+two   00000002-0002-0002-0002-000000000002 Ready [3-5]   -                             -                        
+
 `,
 		},
 		"verbose; two pools; one destroying": {
@@ -706,3 +735,76 @@ one   00000001-0001-0001-0001-000000000001 Degraded [0-2]   100 GB   80 GB    8%
 		})
 	}
 }
+
+func TestPretty_TabulatePoolList(t *testing.T) {
+	header := []string{"label", "uuid", "state", "svc_reps", "total_targets",
+		"disabled_targets", "rebuild_state", "pool_layout_ver", "upgrade_layout_ver"}
+
+	for name, tc := range map[string]struct {
+		pools    []*daos.PoolInfo
+		expTable *cmdutil.Table
+	}{
+		"empty list": {
+			expTable: &cmdutil.Table{Header: header},
+		},
+		"pools with and without usage": {
+			pools: []*daos.PoolInfo{
+				{
+					Label:           "two",
+					UUID:            test.MockPoolUUID(2),
+					ServiceReplicas: []ranklist.Rank{3},
+					State:           daos.PoolServiceStateReady,
+					Rebuild: &daos.PoolRebuildStatus{
+						State: daos.PoolRebuildStateIdle,
+					},
+				},
+				{
+					Label:           "one",
+					UUID:            test.MockPoolUUID(1),
+					ServiceReplicas: []ranklist.Rank{0, 1, 2},
+					TierStats: []*daos.StorageUsageStats{
+						{
+							MediaType: daos.StorageMediaTypeScm,
+							Total:     100 * humanize.GByte,
+							Free:      20 * humanize.GByte,
+							Min:       5 * humanize.GByte,
+							Max:       6 * humanize.GByte,
+						},
+						{
+							MediaType: daos.StorageMediaTypeNvme,
+							Total:     6 * humanize.TByte,
+							Free:      1 * humanize.TByte,
+							Min:       20 * humanize.GByte,
+							Max:       50 * humanize.GByte,
+						},
+					},
+					TotalTargets:     16,
+					ActiveTargets:    16,
+					State:            daos.PoolServiceStateReady,
+					PoolLayoutVer:    1,
+					UpgradeLayoutVer: 2,
+				},
+			},
+			expTable: &cmdutil.Table{
+				Header: append(header,
+					"scm_size", "scm_free", "scm_imbalance",
+					"nvme_size", "nvme_free", "nvme_imbalance"),
+				Rows: [][]string{
+					{"two", test.MockPoolUUID(2).String(), "Ready", "3", "0", "0",
+						"idle", "0", "0", "", "", "", "", "", ""},
+					{"one", test.MockPoolUUID(1).String(), "Ready", "[0-2]", "16", "0",
+						"Unknown", "1", "2", "100000000000", "20000000000", "16",
+						"6000000000000", "1000000000000", "8"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTable := TabulatePoolList(tc.pools)
+
+			if diff := cmp.Diff(tc.expTable, gotTable); diff != "" {
+				t.Fatalf("unexpected table (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
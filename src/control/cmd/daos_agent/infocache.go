@@ -310,10 +310,31 @@ func (c *InfoCache) getAttachInfo(ctx context.Context, rpcClient control.UnaryIn
 	if err != nil {
 		return nil, err
 	}
+	c.updateAccessPoints(rpcClient, resp)
 	c.addTelemetrySettings(resp)
 	return resp, nil
 }
 
+// hostListSetter is implemented by control clients whose default host list
+// may be updated.
+type hostListSetter interface {
+	SetHostList([]string)
+}
+
+// updateAccessPoints directs subsequent control requests to the MS access
+// points reported in the response, which may have changed since the agent
+// was started if the MS replica set has been reconfigured.
+func (c *InfoCache) updateAccessPoints(rpcClient control.UnaryInvoker, resp *control.GetAttachInfoResp) {
+	if c == nil || resp == nil || len(resp.AccessPoints) == 0 {
+		return
+	}
+
+	if hls, ok := rpcClient.(hostListSetter); ok {
+		c.log.Debugf("using MS access points %v", resp.AccessPoints)
+		hls.SetHostList(resp.AccessPoints)
+	}
+}
+
 // addTelemetrySettings modifies the response by adding telemetry settings
 // before returning it.
 func (c *InfoCache) addTelemetrySettings(resp *control.GetAttachInfoResp) {
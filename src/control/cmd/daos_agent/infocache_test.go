@@ -919,6 +919,47 @@ func TestAgent_InfoCache_GetAttachInfo(t *testing.T) {
 	}
 }
 
+type mockHostListInvoker struct {
+	control.UnaryInvoker
+	hostList []string
+}
+
+func (mi *mockHostListInvoker) SetHostList(hostList []string) {
+	mi.hostList = hostList
+}
+
+func TestAgent_InfoCache_updateAccessPoints(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.GetAttachInfoResp
+		expHostList []string
+	}{
+		"nil response": {},
+		"no access points": {
+			resp: &control.GetAttachInfoResp{},
+		},
+		"access points": {
+			resp: &control.GetAttachInfoResp{
+				AccessPoints: []string{"host1:10001", "host2:10001"},
+			},
+			expHostList: []string{"host1:10001", "host2:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := &mockHostListInvoker{}
+			ic := newTestInfoCache(t, log, testInfoCacheParams{})
+
+			ic.updateAccessPoints(mi, tc.resp)
+
+			if diff := cmp.Diff(tc.expHostList, mi.hostList); diff != "" {
+				t.Fatalf("want-, got+:\n%s", diff)
+			}
+		})
+	}
+}
+
 func mockGetAddrInterface(name string) (addrFI, error) {
 	return &mockNetInterface{
 		addrs: []net.Addr{
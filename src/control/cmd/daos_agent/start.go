@@ -24,6 +24,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware/hwprov"
 	"github.com/daos-stack/daos/src/control/lib/systemd"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type ctxKey string
@@ -105,6 +106,9 @@ func (cmd *startCmd) Execute(_ []string) error {
 		cmd.Debugf("telemetry exporter started: %s", time.Since(telemetryStart))
 	}
 
+	// Reload the agent certificate when it is renewed.
+	security.NewCertWatcher(cmd.Logger, cmd.cfg.TransportConfig).Start(ctx, security.CertWatchInterval)
+
 	drpcRegStart := time.Now()
 	drpcServer.RegisterRPCModule(NewSecurityModule(cmd.Logger, cmd.cfg.TransportConfig))
 	mgmtMod := &mgmtModule{
@@ -0,0 +1,177 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"crypto/rsa"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const (
+	caCertFile = "daosCA.crt"
+	caKeyFile  = "daosCA.key"
+)
+
+// certCmdRoot is the struct representing the top-level cert subcommand.
+type certCmdRoot struct {
+	CreateCA certCreateCACmd `command:"create-ca" description:"Create a certificate authority to sign the DAOS control plane certificates"`
+	Issue    certIssueCmd    `command:"issue" description:"Issue a server, agent or admin certificate signed by the DAOS CA"`
+}
+
+func daysToDuration(days uint) time.Duration {
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// checkOverwrite returns an error if any of the files exist, unless force is set.
+func checkOverwrite(force bool, paths ...string) error {
+	if force {
+		return nil
+	}
+
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			return errors.Errorf("%s already exists (use --force to overwrite)", path)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// certCreateCACmd is the struct representing the command to create a CA.
+type certCreateCACmd struct {
+	cmdutil.LogCmd
+	Dir   string `short:"d" long:"dir" default:"." description:"Directory to write the CA certificate and key to"`
+	Days  uint   `long:"days" default:"1095" description:"Number of days that the CA certificate is valid for"`
+	Force bool   `short:"f" long:"force" description:"Overwrite an existing CA; all certificates signed by it must be reissued"`
+}
+
+// Execute is run when certCreateCACmd activates.
+func (cmd *certCreateCACmd) Execute(_ []string) error {
+	if cmd.Days == 0 {
+		return errors.New("--days must be greater than 0")
+	}
+
+	certPath := filepath.Join(cmd.Dir, caCertFile)
+	keyPath := filepath.Join(cmd.Dir, caKeyFile)
+	if err := checkOverwrite(cmd.Force, certPath, keyPath); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(cmd.Dir, security.MaxDirPerm); err != nil {
+		return errors.Wrap(err, "creating CA directory")
+	}
+
+	cert, key, err := security.GenerateCA(daysToDuration(cmd.Days))
+	if err != nil {
+		return err
+	}
+
+	if err := security.WritePrivateKey(keyPath, key); err != nil {
+		return err
+	}
+	if err := security.WriteCertificate(certPath, cert); err != nil {
+		return err
+	}
+
+	cmd.Infof("Created CA certificate %s (expires %s)", certPath, cert.NotAfter.Format(time.RFC3339))
+	cmd.Infof("The CA key %s must be kept private and is only needed to issue certificates", keyPath)
+
+	return nil
+}
+
+// certIssueCmd is the struct representing the command to issue a certificate
+// for a DAOS component.
+type certIssueCmd struct {
+	cmdutil.LogCmd
+	Type   string   `short:"t" long:"type" required:"1" choice:"server" choice:"agent" choice:"admin" description:"Type of certificate to issue"`
+	CACert string   `long:"ca-cert" default:"daosCA.crt" description:"Path to the CA certificate"`
+	CAKey  string   `long:"ca-key" default:"daosCA.key" description:"Path to the CA key"`
+	Dir    string   `short:"d" long:"dir" default:"." description:"Directory to write the certificate and key to"`
+	Days   uint     `long:"days" description:"Number of days that the certificate is valid for (default: 1095, limited to the validity of the CA)"`
+	OU     []string `long:"ou" description:"Organizational unit to include in the certificate subject, e.g. to match admin role bindings; may be repeated"`
+	SANs   []string `long:"san" description:"DNS name or IP address to include as a subject alternative name; may be repeated"`
+	Force  bool     `short:"f" long:"force" description:"Overwrite an existing certificate and key, e.g. to renew them"`
+}
+
+func (cmd *certIssueCmd) loadCA() (*rsa.PrivateKey, error) {
+	key, err := security.LoadPrivateKey(cmd.CAKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading CA key %s", cmd.CAKey)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("CA key %s is not an RSA key", cmd.CAKey)
+	}
+
+	return rsaKey, nil
+}
+
+func (cmd *certIssueCmd) certRequest() *security.CertRequest {
+	req := &security.CertRequest{
+		Component:          security.CommonNameToComponent(cmd.Type),
+		OrganizationalUnit: cmd.OU,
+		Validity:           daysToDuration(cmd.Days),
+	}
+	for _, san := range cmd.SANs {
+		if ip := net.ParseIP(san); ip != nil {
+			req.IPAddresses = append(req.IPAddresses, ip)
+			continue
+		}
+		req.DNSNames = append(req.DNSNames, san)
+	}
+
+	return req
+}
+
+// Execute is run when certIssueCmd activates.
+func (cmd *certIssueCmd) Execute(_ []string) error {
+	certPath := filepath.Join(cmd.Dir, cmd.Type+".crt")
+	keyPath := filepath.Join(cmd.Dir, cmd.Type+".key")
+	if err := checkOverwrite(cmd.Force, certPath, keyPath); err != nil {
+		return err
+	}
+
+	caCert, err := security.LoadCertificate(cmd.CACert)
+	if err != nil {
+		return errors.Wrapf(err, "loading CA certificate %s", cmd.CACert)
+	}
+	caKey, err := cmd.loadCA()
+	if err != nil {
+		return err
+	}
+
+	cert, key, err := security.IssueCertificate(caCert, caKey, cmd.certRequest())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cmd.Dir, security.MaxDirPerm); err != nil {
+		return errors.Wrap(err, "creating certificate directory")
+	}
+	if err := security.WritePrivateKey(keyPath, key); err != nil {
+		return err
+	}
+	if err := security.WriteCertificate(certPath, cert); err != nil {
+		return err
+	}
+
+	cmd.Infof("Issued %s certificate %s (expires %s)", cmd.Type, certPath, cert.NotAfter.Format(time.RFC3339))
+	if cmd.Type == security.ComponentAgent.String() {
+		cmd.Infof("Copy %s to the client_cert_dir of each server", certPath)
+	}
+
+	return nil
+}
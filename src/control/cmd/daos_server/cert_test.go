@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestDaosServer_Cert_Commands_JSON(t *testing.T) {
+	log, buf := logging.NewTestCommandLineLogger()
+
+	runJSONCmdTests(t, log, buf, []jsonCmdTest{
+		{
+			"Create CA; JSON",
+			"cert create-ca -j",
+			nil,
+			nil,
+			errJSONOutputNotSupported,
+		},
+		{
+			"Issue; JSON",
+			"cert issue -t agent -j",
+			nil,
+			nil,
+			errJSONOutputNotSupported,
+		},
+	})
+}
+
+func TestDaosServer_Cert_Commands(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caDir := filepath.Join(testDir, "ca")
+	certDir := filepath.Join(testDir, "certs")
+	caArgs := fmt.Sprintf("--ca-cert %s --ca-key %s", filepath.Join(caDir, caCertFile),
+		filepath.Join(caDir, caKeyFile))
+
+	for _, tc := range []struct {
+		name    string
+		cmdLine string
+		expErr  error
+	}{
+		{
+			name:    "issue without CA",
+			cmdLine: fmt.Sprintf("cert issue -t server %s -d %s", caArgs, certDir),
+			expErr:  errors.New("loading CA certificate"),
+		},
+		{
+			name:    "create CA with zero days",
+			cmdLine: fmt.Sprintf("cert create-ca -d %s --days 0", caDir),
+			expErr:  errors.New("must be greater than 0"),
+		},
+		{
+			name:    "create CA",
+			cmdLine: fmt.Sprintf("cert create-ca -d %s", caDir),
+		},
+		{
+			name:    "create CA again",
+			cmdLine: fmt.Sprintf("cert create-ca -d %s", caDir),
+			expErr:  errors.New("already exists"),
+		},
+		{
+			name:    "issue unknown type",
+			cmdLine: fmt.Sprintf("cert issue -t client %s -d %s", caArgs, certDir),
+			expErr:  errors.New("Invalid value `client'"),
+		},
+		{
+			name:    "issue outliving CA",
+			cmdLine: fmt.Sprintf("cert issue -t server %s -d %s --days 2000", caArgs, certDir),
+			expErr:  errors.New("expire after the CA"),
+		},
+		{
+			name:    "issue server",
+			cmdLine: fmt.Sprintf("cert issue -t server %s -d %s --san 10.0.0.1", caArgs, certDir),
+		},
+		{
+			name:    "issue server again",
+			cmdLine: fmt.Sprintf("cert issue -t server %s -d %s", caArgs, certDir),
+			expErr:  errors.New("already exists"),
+		},
+		{
+			name:    "renew server",
+			cmdLine: fmt.Sprintf("cert issue -t server %s -d %s --days 30 --force", caArgs, certDir),
+		},
+		{
+			name:    "issue admin",
+			cmdLine: fmt.Sprintf("cert issue -t admin %s -d %s --ou ops", caArgs, certDir),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			// The cert commands don't need the privileged helper.
+			opts := mainOpts{
+				preExecTests: []execTestFn{
+					func() error {
+						return errors.New("pre-exec tests should be skipped")
+					},
+				},
+			}
+			err := parseOpts(strings.Split(tc.cmdLine, " "), &opts, log)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+
+	for _, comp := range []security.Component{security.ComponentServer, security.ComponentAdmin} {
+		tc := security.DefaultServerTransportConfig()
+		tc.ClientCertDir = ""
+		tc.CARootPath = filepath.Join(caDir, caCertFile)
+		tc.CertificatePath = filepath.Join(certDir, comp.String()+".crt")
+		tc.PrivateKeyPath = filepath.Join(certDir, comp.String()+".key")
+		if err := tc.PreLoadCertData(); err != nil {
+			t.Fatalf("unable to load issued %s certificate: %s", comp, err)
+		}
+		leaf := tc.CertificateChain()[0]
+		test.AssertEqual(t, comp, security.CommonNameToComponent(leaf.Subject.CommonName), "unexpected component")
+	}
+}
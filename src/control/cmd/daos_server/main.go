@@ -57,6 +57,7 @@ type mainOpts struct {
 	DumpTopo hwprov.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
 	Support  supportCmd             `command:"support" description:"Perform debug tasks to help support team"`
 	Config   configCmd              `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on the local server"`
+	Cert     certCmdRoot            `command:"cert" description:"Perform tasks related to control plane certificates"`
 
 	// Allow a set of tests to be run before executing commands.
 	preExecTests []execTestFn
@@ -118,6 +119,9 @@ func parseOpts(args []string, opts *mainOpts, log *logging.LeveledLogger) error
 			// No pre-exec tests or setup needed for these commands; just
 			// execute them directly.
 			return cmd.Execute(nil)
+		case *certCreateCACmd, *certIssueCmd:
+			// Certificates may be managed on hosts where the privileged
+			// helper isn't installed.
 		default:
 			for _, test := range opts.preExecTests {
 				if err := test(); err != nil {
@@ -26,6 +26,8 @@ type msCmdRoot struct {
 	Status  msStatusCmd   `command:"status" description:"Show status of the local management service replica"`
 	Recover msRecoveryCmd `command:"recover" description:"Recover the management service using this replica"`
 	Restore msRestoreCmd  `command:"restore" description:"Restore the management service from a snapshot"`
+	Verify  msVerifyCmd   `command:"verify" description:"Check the consistency of the management service database in a snapshot"`
+	Diff    msDiffCmd     `command:"diff" description:"Compare the management service databases in two snapshots"`
 }
 
 type dbCfgCmd struct {
@@ -211,7 +213,7 @@ type msRestoreCmd struct {
 	dbCfgCmd
 
 	Force bool   `short:"f" long:"force" description:"Don't prompt for confirmation"`
-	Path  string `short:"p" long:"path" description:"Path to snapshot file" required:"1"`
+	Path  string `short:"p" long:"path" description:"Path to snapshot directory or archive created by dmg system db snapshot" required:"1"`
 }
 
 func (cmd *msRestoreCmd) Execute([]string) error {
@@ -281,3 +283,165 @@ they join the quorum.
 
 	return nil
 }
+
+// readSnapshot reads the snapshot at the given path and returns its details
+// along with the raw snapshot data.
+func readSnapshot(path string) (*sdb.SnapshotDetails, []byte, error) {
+	meta, data, err := sdb.ReadSnapshot(path)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read snapshot %q", path)
+	}
+
+	sInfo := &sdb.SnapshotDetails{
+		Path:     path,
+		Metadata: meta,
+	}
+	if err := sInfo.DecodeSnapshot(data); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to decode snapshot data in %s", path)
+	}
+	return sInfo, data, nil
+}
+
+type msVerifyCmd struct {
+	dbCfgCmd
+
+	Path string `short:"p" long:"path" description:"Path to snapshot directory or archive; the latest local snapshot is used if unset"`
+}
+
+func (cmd *msVerifyCmd) setOptional() {
+	cmd.configIsOptional = true
+}
+
+// latestSnapshotPath returns the path of the latest snapshot held by the local
+// replica.
+func (cmd *msVerifyCmd) latestSnapshotPath() (string, error) {
+	if err := common.CheckDupeProcess(); err != nil {
+		return "", err
+	}
+	if cmd.config == nil {
+		return "", errors.New("a server config file or snapshot path is required")
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return "", err
+	}
+
+	sInfo, err := sdb.GetLatestSnapshot(cmd.Logger, dbCfg)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get latest snapshot")
+	}
+	return sInfo.Path, nil
+}
+
+func (cmd *msVerifyCmd) Execute([]string) error {
+	path := cmd.Path
+	if path == "" {
+		var err error
+		if path, err = cmd.latestSnapshotPath(); err != nil {
+			return err
+		}
+	}
+
+	sInfo, data, err := readSnapshot(path)
+	if err != nil {
+		return err
+	}
+
+	found, err := sdb.VerifySnapshot(sInfo.Metadata, data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to verify snapshot %q", path)
+	}
+
+	var buf strings.Builder
+	fmt.Fprintln(&buf, "Snapshot info:")
+	printSnapshotDetails(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), sInfo)
+	if len(found) == 0 {
+		fmt.Fprintf(&buf, "No inconsistencies found in %s DB\n", build.ManagementServiceName)
+		cmd.Info(buf.String())
+		return nil
+	}
+
+	fmt.Fprintf(&buf, "Inconsistencies found in %s DB:\n", build.ManagementServiceName)
+	for _, inc := range found {
+		fmt.Fprintf(&buf, "  %s\n", inc)
+	}
+	cmd.Info(buf.String())
+
+	return errors.Errorf("%d inconsistencies found in snapshot %q", len(found), path)
+}
+
+type msDiffCmd struct {
+	cmdutil.LogCmd
+
+	Args struct {
+		First  string `positional-arg-name:"<first snapshot>" required:"1"`
+		Second string `positional-arg-name:"<second snapshot>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+func formatFindingIDs(ids []uint64) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = fmt.Sprintf("0x%x", id)
+	}
+	return strings.Join(strs, ",")
+}
+
+func printSnapshotComparison(out io.Writer, cmp *sdb.SnapshotComparison) error {
+	ew := txtfmt.NewErrWriter(out)
+
+	for _, diff := range []struct {
+		desc  string
+		items string
+	}{
+		{"Ranks only in first", cmp.MissingRanks.String()},
+		{"Ranks only in second", cmp.ExtraRanks.String()},
+		{"Ranks changed in second", cmp.ChangedRanks.String()},
+		{"Pools only in first", strings.Join(cmp.MissingPools, ",")},
+		{"Pools only in second", strings.Join(cmp.ExtraPools, ",")},
+		{"Pools with changed service ranks", strings.Join(cmp.ChangedPools, ",")},
+		{"Findings only in first", formatFindingIDs(cmp.MissingFindings)},
+		{"Findings only in second", formatFindingIDs(cmp.ExtraFindings)},
+	} {
+		if diff.items == "" {
+			continue
+		}
+		fmt.Fprintf(ew, "%s: %s\n", diff.desc, diff.items)
+	}
+
+	return ew.Err
+}
+
+func (cmd *msDiffCmd) Execute([]string) error {
+	var infos [2]*sdb.SnapshotDetails
+	var data [2][]byte
+	for i, path := range []string{cmd.Args.First, cmd.Args.Second} {
+		var err error
+		if infos[i], data[i], err = readSnapshot(path); err != nil {
+			return err
+		}
+	}
+
+	cmp, err := sdb.DiffSnapshots(infos[0].Metadata, data[0], infos[1].Metadata, data[1])
+	if err != nil {
+		return err
+	}
+
+	var buf strings.Builder
+	for i, desc := range []string{"First", "Second"} {
+		fmt.Fprintf(&buf, "%s snapshot info:\n", desc)
+		printSnapshotDetails(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), infos[i])
+	}
+	if !cmp.HasDifferences() {
+		fmt.Fprintln(&buf, "Snapshots hold the same members, pools and checker findings")
+		cmd.Info(buf.String())
+		return nil
+	}
+
+	fmt.Fprintln(&buf, "Differences:")
+	printSnapshotComparison(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), cmp)
+	cmd.Info(buf.String())
+
+	return errors.New("snapshots differ")
+}
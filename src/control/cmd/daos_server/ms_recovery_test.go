@@ -39,5 +39,19 @@ func TestDaosServer_MS_Commands_JSON(t *testing.T) {
 			nil,
 			errJSONOutputNotSupported,
 		},
+		{
+			"MS verify",
+			"ms verify -p foo -j",
+			nil,
+			nil,
+			errJSONOutputNotSupported,
+		},
+		{
+			"MS diff",
+			"ms diff foo bar -j",
+			nil,
+			nil,
+			errJSONOutputNotSupported,
+		},
 	})
 }
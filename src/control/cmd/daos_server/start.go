@@ -7,9 +7,13 @@
 package main
 
 import (
+	"os"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -31,6 +35,55 @@ type startCmd struct {
 	SocketDir   string  `short:"d" long:"socket_dir" description:"Location for all daos_server & daos_engine sockets"`
 	Insecure    bool    `short:"i" long:"insecure" description:"Allow for insecure connections"`
 	AutoFormat  bool    `long:"auto-format" description:"Automatically format storage on server start to bring-up engines without requiring dmg storage format command"`
+	DevCluster  int     `long:"dev-cluster" description:"Ignore the config file and run a single-host developer cluster with the given number of engines backed by RAM-disk SCM and AIO file bdevs"`
+	DevDir      string  `long:"dev-cluster-dir" description:"Location of the developer cluster mount points, backing files, logs and sockets"`
+	DevKdevs    string  `long:"dev-cluster-kdevs" description:"Comma-separated kernel block devices to use instead of AIO files in the developer cluster"`
+}
+
+// loadConfig generates the developer cluster config if requested, otherwise the config is read
+// from file.
+func (cmd *startCmd) loadConfig() error {
+	if cmd.DevCluster == 0 {
+		return cmd.cfgCmd.loadConfig()
+	}
+
+	if cmd.DevDir == "" {
+		cmd.DevDir = control.DefaultDevClusterDir
+	}
+	req := control.DevClusterReq{
+		NrEngines:   cmd.DevCluster,
+		BaseDir:     cmd.DevDir,
+		ControlPort: int(cmd.Port),
+		Log:         cmd.Logger,
+	}
+	if cmd.DevKdevs != "" {
+		req.KdevList = strings.Split(cmd.DevKdevs, ",")
+	}
+
+	cfg, err := control.DevClusterConfGenerate(req)
+	if err != nil {
+		return errors.Wrap(err, "generating dev-cluster config")
+	}
+	cmd.config = cfg
+
+	return cmd.setCLIOverrides()
+}
+
+// createDevClusterDirs creates the developer cluster directories that are expected to exist on
+// server start.
+func (cmd *startCmd) createDevClusterDirs() error {
+	dirs := []string{cmd.config.SocketDir}
+	for idx := range cmd.config.Engines {
+		dirs = append(dirs, control.DevClusterEngineDir(cmd.DevDir, idx))
+	}
+
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.Wrap(err, "creating dev-cluster directory")
+		}
+	}
+
+	return nil
 }
 
 func (cmd *startCmd) setCLIOverrides() error {
@@ -96,11 +149,23 @@ func (cmd *startCmd) Execute(args []string) error {
 		cmd.start = server.Start
 	}
 
+	if cmd.DevCluster > 0 {
+		if err := cmd.createDevClusterDirs(); err != nil {
+			return err
+		}
+	}
+
 	if err := cmd.configureLogging(); err != nil {
 		return err
 	}
 
-	cmd.config.AutoFormat = cmd.AutoFormat
+	if cmd.DevCluster > 0 {
+		cmd.Noticef("starting %d engine dev-cluster in %s", len(cmd.config.Engines),
+			cmd.DevDir)
+	}
+	if cmd.AutoFormat {
+		cmd.config.AutoFormat = true
+	}
 
 	return cmd.start(cmd.Logger, cmd.config)
 }
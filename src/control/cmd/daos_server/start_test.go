@@ -8,6 +8,7 @@ package main
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"path"
 	"reflect"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
@@ -274,6 +276,76 @@ func TestStartOptions(t *testing.T) {
 	}
 }
 
+func TestStartDevCluster(t *testing.T) {
+	for desc, tc := range map[string]struct {
+		argList    []string
+		expEngines int
+		expClass   storage.Class
+		expErr     error
+	}{
+		"Three engines": {
+			argList:    []string{"--dev-cluster=3"},
+			expEngines: 3,
+			expClass:   storage.ClassFile,
+		},
+		"Kernel block devices": {
+			argList:    []string{"--dev-cluster=2", "--dev-cluster-kdevs=/dev/sdb,/dev/sdc"},
+			expEngines: 2,
+			expClass:   storage.ClassKdev,
+		},
+		"Uneven kernel block devices": {
+			argList: []string{"--dev-cluster=2", "--dev-cluster-kdevs=/dev/sdb"},
+			expErr:  errors.New("evenly assigned"),
+		},
+		"Too many engines": {
+			argList: []string{"--dev-cluster=100"},
+			expErr:  errors.New("between 1 and"),
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			devDir := t.TempDir()
+			var gotConfig *config.Server
+			var opts mainOpts
+			opts.Start.start = func(log logging.Logger, cfg *config.Server) error {
+				gotConfig = cfg
+				return nil
+			}
+			opts.Start.config = genMinimalConfig()
+
+			args := append([]string{"start", "--dev-cluster-dir", devDir, "-p", "10002"},
+				tc.argList...)
+			err := parseOpts(args, &opts, log)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expEngines, len(gotConfig.Engines), "unexpected engine count")
+			test.AssertEqual(t, 10002, gotConfig.ControlPort, "CLI override not applied")
+			test.AssertEqual(t, []string{"localhost:10002"}, gotConfig.AccessPoints,
+				"unexpected access points")
+			test.AssertTrue(t, gotConfig.AutoFormat, "expected auto-format")
+			test.AssertTrue(t, gotConfig.TransportConfig.AllowInsecure,
+				"expected insecure transport")
+			if _, err := os.Stat(gotConfig.SocketDir); err != nil {
+				t.Fatal(err)
+			}
+			for idx, ec := range gotConfig.Engines {
+				test.AssertEqual(t, tc.expClass, ec.Storage.Tiers.BdevConfigs()[0].Class,
+					"unexpected bdev class")
+				if _, err := os.Stat(path.Dir(ec.LogFile)); err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, path.Join(devDir, fmt.Sprintf("daos%d", idx)),
+					ec.Storage.Tiers.ScmConfigs()[0].Scm.MountPoint, "unexpected scm mount")
+			}
+		})
+	}
+}
+
 func TestStartLoggingOptions(t *testing.T) {
 	for desc, tc := range map[string]struct {
 		argList   []string
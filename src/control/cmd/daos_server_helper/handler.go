@@ -200,6 +200,31 @@ func (h *scmScanHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Res
 	return pbin.NewResponseWithPayload(sRes)
 }
 
+// scmHealthHandler implements the ScmQueryHealth method.
+type scmHealthHandler struct {
+	scmHandler
+}
+
+func (h *scmHealthHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var hReq storage.ScmHealthRequest
+	if err := json.Unmarshal(req.Payload, &hReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	hRes, err := h.scmProvider.QueryHealth(hReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(hRes)
+}
+
 // scmPrepHandler implements the ScmPrepare method.
 type scmPrepHandler struct {
 	scmHandler
@@ -633,6 +633,76 @@ func TestDaosAdmin_ScmScanHandler(t *testing.T) {
 	}
 }
 
+func TestDaosAdmin_ScmHealthHandler(t *testing.T) {
+	scmHealthReqPayload, err := json.Marshal(storage.ScmHealthRequest{
+		ForwardableRequest: pbin.ForwardableRequest{Forwarded: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req        *pbin.Request
+		smbc       *scm.MockBackendConfig
+		expPayload *storage.ScmHealthResponse
+		expErr     *fault.Fault
+	}{
+		"nil request": {
+			expErr: pbin.PrivilegedHelperRequestFailed("nil request"),
+		},
+		"ScmQueryHealth nil payload": {
+			req: &pbin.Request{
+				Method: "ScmQueryHealth",
+			},
+			expErr: nilPayloadErr,
+		},
+		"ScmQueryHealth success": {
+			req: &pbin.Request{
+				Method:  "ScmQueryHealth",
+				Payload: scmHealthReqPayload,
+			},
+			smbc: &scm.MockBackendConfig{
+				GetNamespaceHealthRes: []*storage.ScmNamespaceHealth{
+					{Name: "namespace0.0", BlockDevice: "pmem0", HealthState: "ok"},
+				},
+			},
+			expPayload: &storage.ScmHealthResponse{
+				Namespaces: []*storage.ScmNamespaceHealth{
+					{Name: "namespace0.0", BlockDevice: "pmem0", HealthState: "ok"},
+				},
+			},
+		},
+		"ScmQueryHealth failure": {
+			req: &pbin.Request{
+				Method:  "ScmQueryHealth",
+				Payload: scmHealthReqPayload,
+			},
+			smbc: &scm.MockBackendConfig{
+				GetNamespaceHealthErr: errors.New("query failed"),
+			},
+			expErr: pbin.PrivilegedHelperRequestFailed("query failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			sp := scm.NewMockProvider(log, tc.smbc, nil)
+			handler := &scmHealthHandler{scmHandler: scmHandler{scmProvider: sp}}
+
+			resp := handler.Handle(log, tc.req)
+
+			if diff := cmp.Diff(tc.expErr, resp.Error); diff != "" {
+				t.Errorf("got wrong fault (-want, +got)\n%s\n", diff)
+			}
+			if tc.expPayload == nil {
+				tc.expPayload = &storage.ScmHealthResponse{}
+			}
+			expectPayload(t, resp, &storage.ScmHealthResponse{}, tc.expPayload)
+		})
+	}
+}
+
 func TestDaosAdmin_BdevScanHandler(t *testing.T) {
 	bdevScanReqPayload, err := json.Marshal(storage.BdevScanRequest{
 		ForwardableRequest: pbin.ForwardableRequest{Forwarded: true},
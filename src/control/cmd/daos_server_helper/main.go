@@ -41,6 +41,7 @@ func addMethodHandlers(app *pbin.App) {
 	app.AddHandler("ScmCheckFormat", &scmFormatCheckHandler{})
 	app.AddHandler("ScmScan", &scmScanHandler{})
 	app.AddHandler("ScmPrepare", &scmPrepHandler{})
+	app.AddHandler("ScmQueryHealth", &scmHealthHandler{})
 
 	app.AddHandler("BdevPrepare", &bdevPrepHandler{})
 	app.AddHandler("BdevScan", &bdevScanHandler{})
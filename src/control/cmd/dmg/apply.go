@@ -0,0 +1,699 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// poolReadOnlyProps are pool properties reported by the pool service which
+// cannot be set by the user.
+var poolReadOnlyProps = common.NewStringSet("global_version", "upgrade_status", "svc_list")
+
+// poolSizeDriftTolerance is the fraction by which the size of an existing pool
+// may differ from the spec before it is reported. The allocation made by the
+// server is rounded per-target, so an exact match is not expected.
+const poolSizeDriftTolerance = 0.01
+
+type (
+	// clusterSpec describes the desired state of the pools and system-level
+	// settings managed by the apply, diff and export commands.
+	clusterSpec struct {
+		System *systemSpec `yaml:"system,omitempty" json:"system,omitempty"`
+		Pools  []*poolSpec `yaml:"pools,omitempty" json:"pools,omitempty"`
+	}
+
+	// systemSpec describes the desired system properties and attributes.
+	systemSpec struct {
+		Properties map[string]string `yaml:"properties,omitempty" json:"properties,omitempty"`
+		Attributes map[string]string `yaml:"attributes,omitempty" json:"attributes,omitempty"`
+	}
+
+	// poolSpec describes the desired state of a single pool, identified by
+	// its label. Size and tier ratio are only used when creating the pool.
+	// A nil ACL leaves the pool's ACL unmanaged.
+	poolSpec struct {
+		Label      string            `yaml:"label" json:"label"`
+		Size       string            `yaml:"size,omitempty" json:"size,omitempty"`
+		TierRatio  string            `yaml:"tier_ratio,omitempty" json:"tier_ratio,omitempty"`
+		Properties map[string]string `yaml:"properties,omitempty" json:"properties,omitempty"`
+		ACL        []string          `yaml:"acl,omitempty" json:"acl,omitempty"`
+
+		size      poolSizeFlag
+		tierRatio tierRatioFlag
+		props     []*daos.PoolProperty
+	}
+)
+
+func (ps *poolSpec) validate() error {
+	if !daos.LabelIsValid(ps.Label) {
+		return errors.Errorf("invalid pool label %q", ps.Label)
+	}
+
+	if ps.Size != "" {
+		if err := ps.size.UnmarshalFlag(ps.Size); err != nil {
+			return err
+		}
+	}
+	if ps.TierRatio != "" {
+		if ps.size.IsRatio() {
+			return errors.New("tier_ratio may not be set with a percentage size")
+		}
+		if err := ps.tierRatio.UnmarshalFlag(ps.TierRatio); err != nil {
+			return err
+		}
+	}
+
+	ps.props = nil
+	propHdlrs := daos.PoolProperties()
+	for _, key := range keysOf(ps.Properties) {
+		switch {
+		case key == "label":
+			return errors.New("pool label must be set with the label key, not as a property")
+		case poolReadOnlyProps.Has(key):
+			return errors.Errorf("pool property %q is read-only", key)
+		}
+
+		prop, err := propHdlrs.GetProperty(key)
+		if err != nil {
+			return err
+		}
+		if err := prop.SetValue(ps.Properties[key]); err != nil {
+			return err
+		}
+		ps.props = append(ps.props, prop)
+	}
+
+	return nil
+}
+
+func (ps *poolSpec) createReq() (*control.PoolCreateReq, error) {
+	if !ps.size.IsSet() {
+		return nil, errors.Errorf("pool %q: size must be set to create the pool", ps.Label)
+	}
+
+	label, err := daos.PoolProperties().GetProperty("label")
+	if err != nil {
+		return nil, err
+	}
+	if err := label.SetValue(ps.Label); err != nil {
+		return nil, err
+	}
+
+	req := &control.PoolCreateReq{
+		Properties: append([]*daos.PoolProperty{label}, ps.props...),
+	}
+	if ps.ACL != nil {
+		req.ACL = &control.AccessControlList{Entries: ps.ACL}
+	}
+
+	if ps.size.IsRatio() {
+		availFrac := float64(ps.size.availRatio) / 100.0
+		req.TierRatio = []float64{availFrac, availFrac}
+	} else {
+		req.TierRatio = ps.tierRatio.Ratios()
+		req.TotalBytes = ps.size.bytes
+	}
+
+	return req, nil
+}
+
+func keysOf(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (spec *clusterSpec) validate() error {
+	if spec.System != nil {
+		sysProps := daos.SystemProperties()
+		for _, key := range keysOf(spec.System.Properties) {
+			prop, ok := sysProps.Get(key)
+			if !ok {
+				return errors.Errorf("invalid system property key: %s", key)
+			}
+			if _, ok := prop.Value.(*daos.CompPropVal); ok {
+				return errors.Errorf("system property %q is read-only", key)
+			}
+			if err := prop.Value.Handler(spec.System.Properties[key]); err != nil {
+				return errors.Wrapf(err, "invalid value for system property %s", key)
+			}
+		}
+	}
+
+	labels := common.NewStringSet()
+	for _, ps := range spec.Pools {
+		if ps == nil {
+			return errors.New("empty pool entry in spec")
+		}
+		if err := labels.AddUnique(ps.Label); err != nil {
+			return errors.Errorf("pool %q is specified more than once", ps.Label)
+		}
+		if err := ps.validate(); err != nil {
+			return errors.Wrapf(err, "pool %q", ps.Label)
+		}
+	}
+
+	return nil
+}
+
+// loadClusterSpec reads and validates the cluster spec in the given file.
+func loadClusterSpec(path string) (*clusterSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading spec file")
+	}
+
+	spec := new(clusterSpec)
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, errors.Wrapf(err, "parsing spec file %s", path)
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid spec")
+	}
+
+	return spec, nil
+}
+
+type (
+	// planChange describes a single change required to converge the system
+	// on the spec.
+	planChange struct {
+		Resource string `json:"resource"`
+		Action   string `json:"action"`
+		Detail   string `json:"detail"`
+		Applied  bool   `json:"applied"`
+
+		apply func(context.Context, control.UnaryInvoker) error
+	}
+
+	// applyPlan contains the changes required to converge the system on the
+	// spec, along with any differences which will not be changed.
+	applyPlan struct {
+		Changes  []*planChange `json:"changes"`
+		Warnings []string      `json:"warnings"`
+	}
+)
+
+func (p *applyPlan) add(resource, action, detail string, apply func(context.Context, control.UnaryInvoker) error) {
+	p.Changes = append(p.Changes, &planChange{
+		Resource: resource,
+		Action:   action,
+		Detail:   detail,
+		apply:    apply,
+	})
+}
+
+func (p *applyPlan) warn(format string, args ...interface{}) {
+	p.Warnings = append(p.Warnings, fmt.Sprintf(format, args...))
+}
+
+func planSystem(ctx context.Context, rpcClient control.UnaryInvoker, spec *systemSpec, plan *applyPlan) error {
+	if len(spec.Properties) > 0 {
+		resp, err := control.SystemGetProp(ctx, rpcClient, &control.SystemGetPropReq{})
+		if err != nil {
+			return errors.Wrap(err, "system get-prop failed")
+		}
+		current := make(map[string]string)
+		for _, prop := range resp.Properties {
+			current[prop.Key.String()] = prop.Value.String()
+		}
+
+		sysProps := daos.SystemProperties()
+		for _, key := range keysOf(spec.Properties) {
+			prop, _ := sysProps.Get(key)
+			if err := prop.Value.Handler(spec.Properties[key]); err != nil {
+				return err
+			}
+			if current[key] == prop.Value.String() {
+				continue
+			}
+
+			plan.add("system", "set-prop", fmt.Sprintf("%s: %s -> %s", key, current[key], prop.Value),
+				func(ctx context.Context, rpcClient control.UnaryInvoker) error {
+					return control.SystemSetProp(ctx, rpcClient, &control.SystemSetPropReq{
+						Properties: map[daos.SystemPropertyKey]daos.SystemPropertyValue{
+							prop.Key: prop.Value,
+						},
+					})
+				})
+		}
+	}
+
+	if len(spec.Attributes) > 0 {
+		resp, err := control.SystemGetAttr(ctx, rpcClient, &control.SystemGetAttrReq{})
+		if err != nil {
+			return errors.Wrap(err, "system get-attr failed")
+		}
+
+		for _, key := range keysOf(spec.Attributes) {
+			key := key
+			curVal, found := resp.Attributes[key]
+			want := spec.Attributes[key]
+			if curVal == want && (found || want == "") {
+				continue
+			}
+
+			detail := fmt.Sprintf("%s: %q -> %q", key, curVal, want)
+			if want == "" {
+				detail = fmt.Sprintf("%s: %q -> (unset)", key, curVal)
+			}
+			plan.add("system", "set-attr", detail,
+				func(ctx context.Context, rpcClient control.UnaryInvoker) error {
+					return control.SystemSetAttr(ctx, rpcClient, &control.SystemSetAttrReq{
+						Attributes: map[string]string{key: want},
+					})
+				})
+		}
+	}
+
+	return nil
+}
+
+func poolTotalBytes(pi *daos.PoolInfo) (total uint64) {
+	for _, tier := range pi.TierStats {
+		total += tier.Total
+	}
+	return
+}
+
+func planExistingPool(ctx context.Context, rpcClient control.UnaryInvoker, ps *poolSpec, pi *daos.PoolInfo, plan *applyPlan) error {
+	resource := "pool " + ps.Label
+
+	if ps.size.IsSet() && !ps.size.IsRatio() && len(pi.TierStats) > 0 {
+		total := poolTotalBytes(pi)
+		drift := math.Abs(float64(total)-float64(ps.size.bytes)) / float64(ps.size.bytes)
+		if drift > poolSizeDriftTolerance {
+			plan.warn("%s: size is %s, spec has %s (size is only applied on creation)",
+				resource, humanize.Bytes(total), ps.size)
+		}
+	}
+
+	if len(ps.props) > 0 {
+		propHdlrs := daos.PoolProperties()
+		req := &control.PoolGetPropReq{ID: ps.Label}
+		for _, want := range ps.props {
+			req.Properties = append(req.Properties, propHdlrs[want.Name].GetProperty(want.Name))
+		}
+		current, err := control.PoolGetProp(ctx, rpcClient, req)
+		if err != nil {
+			return errors.Wrapf(err, "%s: get-prop failed", resource)
+		}
+		curVals := make(map[string]string)
+		for _, prop := range current {
+			curVals[prop.Name] = prop.StringValue()
+		}
+
+		for _, want := range ps.props {
+			curVal := curVals[want.Name]
+			if curVal == want.StringValue() {
+				continue
+			}
+			if desc, found := poolCreateOnlyProps[want.Name]; found {
+				plan.warn("%s: %s is %s, spec has %s (can't set %s on existing pool)",
+					resource, want.Name, curVal, want.StringValue(), desc)
+				continue
+			}
+
+			prop := want
+			plan.add(resource, "set-prop", fmt.Sprintf("%s: %s -> %s", prop.Name, curVal, prop.StringValue()),
+				func(ctx context.Context, rpcClient control.UnaryInvoker) error {
+					return control.PoolSetProp(ctx, rpcClient, &control.PoolSetPropReq{
+						ID:         ps.Label,
+						Properties: []*daos.PoolProperty{prop},
+					})
+				})
+		}
+	}
+
+	if ps.ACL != nil {
+		resp, err := control.PoolGetACL(ctx, rpcClient, &control.PoolGetACLReq{ID: ps.Label})
+		if err != nil {
+			return errors.Wrapf(err, "%s: get-acl failed", resource)
+		}
+
+		curEntries := common.NewStringSet(resp.ACL.Entries...)
+		wantEntries := common.NewStringSet(ps.ACL...)
+		var added, removed []string
+		for _, entry := range wantEntries.ToSlice() {
+			if !curEntries.Has(entry) {
+				added = append(added, "+"+entry)
+			}
+		}
+		for _, entry := range curEntries.ToSlice() {
+			if !wantEntries.Has(entry) {
+				removed = append(removed, "-"+entry)
+			}
+		}
+		if len(added) > 0 || len(removed) > 0 {
+			plan.add(resource, "set-acl", strings.Join(append(removed, added...), " "),
+				func(ctx context.Context, rpcClient control.UnaryInvoker) error {
+					_, err := control.PoolOverwriteACL(ctx, rpcClient, &control.PoolOverwriteACLReq{
+						ID:  ps.Label,
+						ACL: &control.AccessControlList{Entries: ps.ACL},
+					})
+					return err
+				})
+		}
+	}
+
+	return nil
+}
+
+func planPools(ctx context.Context, rpcClient control.UnaryInvoker, pools []*poolSpec, plan *applyPlan) error {
+	resp, err := control.ListPools(ctx, rpcClient, &control.ListPoolsReq{})
+	if err != nil {
+		return errors.Wrap(err, "list pools failed")
+	}
+
+	existing := make(map[string]*daos.PoolInfo)
+	for _, pi := range resp.Pools {
+		if pi.Label != "" {
+			existing[pi.Label] = pi
+		}
+	}
+
+	managed := common.NewStringSet()
+	for _, ps := range pools {
+		managed.Add(ps.Label)
+
+		if pi, found := existing[ps.Label]; found {
+			if err := planExistingPool(ctx, rpcClient, ps, pi, plan); err != nil {
+				return err
+			}
+			continue
+		}
+
+		req, err := ps.createReq()
+		if err != nil {
+			return err
+		}
+		detail := fmt.Sprintf("size %s", ps.size)
+		if !ps.size.IsRatio() {
+			detail += fmt.Sprintf(", tier ratio %s", ps.tierRatio)
+		}
+		if len(ps.props) > 0 {
+			propStrs := make([]string, 0, len(ps.props))
+			for _, prop := range ps.props {
+				propStrs = append(propStrs, prop.String())
+			}
+			detail += ", properties " + strings.Join(propStrs, ",")
+		}
+		if ps.ACL != nil {
+			detail += fmt.Sprintf(", %d ACL entries", len(ps.ACL))
+		}
+		plan.add("pool "+ps.Label, "create", detail,
+			func(ctx context.Context, rpcClient control.UnaryInvoker) error {
+				_, err := control.PoolCreate(ctx, rpcClient, req)
+				return err
+			})
+	}
+
+	for _, pi := range resp.Pools {
+		if pi.Label == "" || !managed.Has(pi.Label) {
+			id := pi.Label
+			if id == "" {
+				id = pi.UUID.String()
+			}
+			plan.warn("pool %s: not in spec, left unchanged", id)
+		}
+	}
+
+	return nil
+}
+
+// planClusterSpec compares the spec against the live system and returns the
+// changes needed to converge on it.
+func planClusterSpec(ctx context.Context, rpcClient control.UnaryInvoker, spec *clusterSpec) (*applyPlan, error) {
+	plan := new(applyPlan)
+
+	if spec.System != nil {
+		if err := planSystem(ctx, rpcClient, spec.System, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := planPools(ctx, rpcClient, spec.Pools, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func printApplyPlan(plan *applyPlan, out io.Writer) {
+	if len(plan.Changes) == 0 {
+		fmt.Fprintln(out, "No changes required, system matches spec.")
+	} else {
+		resTitle := "Resource"
+		actTitle := "Action"
+		chgTitle := "Change"
+		table := []txtfmt.TableRow{}
+		for _, chg := range plan.Changes {
+			table = append(table, txtfmt.TableRow{
+				resTitle: chg.Resource,
+				actTitle: chg.Action,
+				chgTitle: chg.Detail,
+			})
+		}
+
+		tf := txtfmt.NewTableFormatter(resTitle, actTitle, chgTitle)
+		tf.InitWriter(out)
+		tf.Format(table)
+	}
+
+	for _, warning := range plan.Warnings {
+		fmt.Fprintf(out, "Warning: %s\n", warning)
+	}
+}
+
+// clusterSpecCmd is embedded by commands which read a cluster spec file.
+type clusterSpecCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	SpecFile string `short:"f" long:"file" required:"1" description:"YAML file describing the desired pool and system state"`
+}
+
+func (cmd *clusterSpecCmd) plan(ctx context.Context) (*applyPlan, error) {
+	spec, err := loadClusterSpec(cmd.SpecFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return planClusterSpec(ctx, cmd.ctlInvoker, spec)
+}
+
+// diffCmd is the struct representing the command to show the changes needed
+// for the system to match a cluster spec.
+type diffCmd struct {
+	clusterSpecCmd
+	ExitCode bool `long:"exit-code" description:"Return an error if the system does not match the spec"`
+}
+
+// Execute is run when diffCmd activates.
+func (cmd *diffCmd) Execute(_ []string) error {
+	plan, err := cmd.plan(cmd.MustLogCtx())
+	if err == nil && cmd.ExitCode && len(plan.Changes) > 0 {
+		err = errors.Errorf("system differs from spec (%d changes)", len(plan.Changes))
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(plan, err)
+	}
+
+	if plan != nil {
+		var bld strings.Builder
+		printApplyPlan(plan, &bld)
+		cmd.Info(bld.String())
+	}
+
+	return errors.Wrap(err, "diff failed")
+}
+
+// applyCmd is the struct representing the command to converge the system on
+// a cluster spec.
+type applyCmd struct {
+	clusterSpecCmd
+}
+
+// Execute is run when applyCmd activates.
+func (cmd *applyCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+
+	plan, err := cmd.plan(ctx)
+	if err == nil {
+		if !cmd.JSONOutputEnabled() {
+			var bld strings.Builder
+			printApplyPlan(plan, &bld)
+			cmd.Info(bld.String())
+		}
+
+		for _, chg := range plan.Changes {
+			if err = chg.apply(ctx, cmd.ctlInvoker); err != nil {
+				err = errors.Wrapf(err, "%s: %s", chg.Resource, chg.Action)
+				break
+			}
+			chg.Applied = true
+		}
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(plan, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "apply failed")
+	}
+	if len(plan.Changes) > 0 {
+		cmd.Infof("Applied %d changes", len(plan.Changes))
+	}
+
+	return nil
+}
+
+// exportCmd is the struct representing the command to generate a cluster spec
+// from the current state of the system.
+type exportCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	OutFile string `short:"o" long:"outfile" description:"Write the spec to a file instead of stdout"`
+}
+
+func exportPool(ctx context.Context, rpcClient control.UnaryInvoker, pi *daos.PoolInfo) (*poolSpec, error) {
+	ps := &poolSpec{
+		Label:      pi.Label,
+		Properties: make(map[string]string),
+	}
+
+	if total := poolTotalBytes(pi); total > 0 {
+		ps.Size = humanize.Bytes(total)
+		if len(pi.TierStats) == 2 {
+			trf := tierRatioFlag{}
+			for _, tier := range pi.TierStats {
+				trf.ratios = append(trf.ratios, float64(tier.Total)/float64(total))
+			}
+			ps.TierRatio = trf.String()
+		}
+	}
+
+	props, err := control.PoolGetProp(ctx, rpcClient, &control.PoolGetPropReq{ID: pi.Label})
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool %s: get-prop failed", pi.Label)
+	}
+	for _, prop := range props {
+		if prop.Name == "label" || poolReadOnlyProps.Has(prop.Name) {
+			continue
+		}
+		ps.Properties[prop.Name] = prop.StringValue()
+	}
+
+	resp, err := control.PoolGetACL(ctx, rpcClient, &control.PoolGetACLReq{ID: pi.Label})
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool %s: get-acl failed", pi.Label)
+	}
+	ps.ACL = resp.ACL.Entries
+
+	return ps, nil
+}
+
+func exportClusterSpec(ctx context.Context, log logging.Logger, rpcClient control.UnaryInvoker) (*clusterSpec, error) {
+	spec := &clusterSpec{
+		System: &systemSpec{
+			Properties: make(map[string]string),
+		},
+	}
+
+	propResp, err := control.SystemGetProp(ctx, rpcClient, &control.SystemGetPropReq{})
+	if err != nil {
+		return nil, errors.Wrap(err, "system get-prop failed")
+	}
+	sysProps := daos.SystemProperties()
+	for _, prop := range propResp.Properties {
+		if _, ok := sysProps[prop.Key].Value.(*daos.CompPropVal); ok {
+			continue
+		}
+		spec.System.Properties[prop.Key.String()] = prop.Value.String()
+	}
+
+	attrResp, err := control.SystemGetAttr(ctx, rpcClient, &control.SystemGetAttrReq{})
+	if err != nil {
+		return nil, errors.Wrap(err, "system get-attr failed")
+	}
+	if len(attrResp.Attributes) > 0 {
+		spec.System.Attributes = attrResp.Attributes
+	}
+
+	listResp, err := control.ListPools(ctx, rpcClient, &control.ListPoolsReq{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pools failed")
+	}
+	for _, pi := range listResp.Pools {
+		if pi.Label == "" {
+			log.Noticef("skipping pool %s without a label", pi.UUID)
+			continue
+		}
+
+		ps, err := exportPool(ctx, rpcClient, pi)
+		if err != nil {
+			return nil, err
+		}
+		spec.Pools = append(spec.Pools, ps)
+	}
+
+	return spec, nil
+}
+
+// Execute is run when exportCmd activates.
+func (cmd *exportCmd) Execute(_ []string) error {
+	spec, err := exportClusterSpec(cmd.MustLogCtx(), cmd.Logger, cmd.ctlInvoker)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(spec, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "export failed")
+	}
+
+	data, err := yaml.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	if cmd.OutFile == "" {
+		cmd.Info(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(cmd.OutFile, data, 0644); err != nil {
+		return errors.Wrapf(err, "writing spec to %s", cmd.OutFile)
+	}
+	cmd.Infof("Wrote spec to %s", cmd.OutFile)
+
+	return nil
+}
@@ -0,0 +1,365 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestDmg_loadClusterSpec(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content  string
+		expPools int
+		expErr   error
+	}{
+		"valid spec": {
+			content: `
+system:
+  properties:
+    pool_scrub_mode: lazy
+  attributes:
+    site: lab
+pools:
+- label: tank
+  size: 10TB
+  tier_ratio: 3,97
+  properties:
+    reclaim: time
+  acl:
+  - A::OWNER@:rw
+- label: scratch
+  size: 50%
+`,
+			expPools: 2,
+		},
+		"unknown field": {
+			content: "pools:\n- label: tank\n  sise: 10TB\n",
+			expErr:  errors.New("field sise not found"),
+		},
+		"duplicate pool": {
+			content: "pools:\n- label: tank\n- label: tank\n",
+			expErr:  errors.New("specified more than once"),
+		},
+		"invalid label": {
+			content: "pools:\n- label: \"\"\n",
+			expErr:  errors.New("invalid pool label"),
+		},
+		"label as property": {
+			content: "pools:\n- label: tank\n  properties:\n    label: foo\n",
+			expErr:  errors.New("label key"),
+		},
+		"read-only pool property": {
+			content: "pools:\n- label: tank\n  properties:\n    svc_list: \"[0]\"\n",
+			expErr:  errors.New("read-only"),
+		},
+		"invalid pool property value": {
+			content: "pools:\n- label: tank\n  properties:\n    reclaim: sometimes\n",
+			expErr:  errors.New("invalid value"),
+		},
+		"percentage size with tier ratio": {
+			content: "pools:\n- label: tank\n  size: 50%\n  tier_ratio: 6,94\n",
+			expErr:  errors.New("percentage size"),
+		},
+		"invalid system property": {
+			content: "system:\n  properties:\n    foo: bar\n",
+			expErr:  errors.New("invalid system property key"),
+		},
+		"read-only system property": {
+			content: "system:\n  properties:\n    daos_version: \"3.0\"\n",
+			expErr:  errors.New("read-only"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+			specPath := test.CreateTestFile(t, testDir, tc.content)
+
+			spec, gotErr := loadClusterSpec(specPath)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expPools, len(spec.Pools), "unexpected number of pools")
+		})
+	}
+}
+
+func mockPoolPropResp(t *testing.T, vals map[string]string) *mgmtpb.PoolGetPropResp {
+	t.Helper()
+
+	resp := new(mgmtpb.PoolGetPropResp)
+	propHdlrs := daos.PoolProperties()
+	for _, key := range keysOf(vals) {
+		prop := propHdlrs[key].GetProperty(key)
+		if err := prop.SetValue(vals[key]); err != nil {
+			t.Fatal(err)
+		}
+		pbProp := &mgmtpb.PoolProperty{Number: prop.Number}
+		if num, err := prop.Value.GetNumber(); err == nil {
+			pbProp.SetValueNumber(num)
+		} else {
+			pbProp.SetValueString(prop.Value.String())
+		}
+		resp.Properties = append(resp.Properties, pbProp)
+	}
+
+	return resp
+}
+
+func TestDmg_planClusterSpec(t *testing.T) {
+	listPoolsResp := &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:  test.MockUUID(1),
+				Label: "other",
+				State: daos.PoolServiceStateReady.String(),
+			},
+			{
+				Uuid:  test.MockUUID(2),
+				Label: "tank",
+				State: daos.PoolServiceStateReady.String(),
+			},
+		},
+	}
+	queryResp := func(i int32, label string, total uint64) *mgmtpb.PoolQueryResp {
+		return &mgmtpb.PoolQueryResp{
+			Uuid:  test.MockUUID(i),
+			Label: label,
+			State: mgmtpb.PoolServiceState_Ready,
+			TierStats: []*mgmtpb.StorageUsageStats{
+				{Total: total / 10},
+				{Total: total - total/10},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		spec    *clusterSpec
+		mic     *control.MockInvokerConfig
+		expPlan *applyPlan
+		expErr  error
+	}{
+		"converged": {
+			spec: &clusterSpec{
+				Pools: []*poolSpec{
+					{Label: "other"},
+					{Label: "tank", Size: "1TB"},
+				},
+			},
+			mic: &control.MockInvokerConfig{
+				UnaryResponseSet: []*control.UnaryResponse{
+					control.MockMSResponse("host1", nil, listPoolsResp),
+					control.MockMSResponse("host1", nil, queryResp(1, "other", 1000000)),
+					control.MockMSResponse("host1", nil, queryResp(2, "tank", 1000000000000)),
+				},
+			},
+			expPlan: &applyPlan{},
+		},
+		"changes and drift": {
+			spec: &clusterSpec{
+				System: &systemSpec{
+					Properties: map[string]string{
+						"pool_scrub_mode":   "lazy",
+						"pool_scrub_thresh": "0",
+					},
+					Attributes: map[string]string{
+						"site":  "lab",
+						"owner": "",
+						"rack":  "",
+					},
+				},
+				Pools: []*poolSpec{
+					{
+						Label: "tank",
+						Size:  "2TB",
+						Properties: map[string]string{
+							"reclaim": "time",
+							"rd_fac":  "2",
+						},
+						ACL: []string{"A::OWNER@:rw", "A::bob@:r"},
+					},
+					{
+						Label: "scratch",
+						Size:  "1TB",
+					},
+				},
+			},
+			mic: &control.MockInvokerConfig{
+				UnaryResponseSet: []*control.UnaryResponse{
+					control.MockMSResponse("host1", nil, &mgmtpb.SystemGetPropResp{
+						Properties: map[string]string{
+							"pool_scrub_mode":   "off",
+							"pool_scrub_thresh": "0",
+						},
+					}),
+					control.MockMSResponse("host1", nil, &mgmtpb.SystemGetAttrResp{
+						Attributes: map[string]string{
+							"site":  "home",
+							"owner": "alice",
+						},
+					}),
+					control.MockMSResponse("host1", nil, listPoolsResp),
+					control.MockMSResponse("host1", nil, queryResp(1, "other", 1000000)),
+					control.MockMSResponse("host1", nil, queryResp(2, "tank", 1000000000000)),
+					control.MockMSResponse("host1", nil, mockPoolPropResp(t, map[string]string{
+						"reclaim": "lazy",
+						"rd_fac":  "1",
+					})),
+					control.MockMSResponse("host1", nil, &mgmtpb.ACLResp{
+						Acl: &mgmtpb.AccessControlList{
+							Entries: []string{"A::OWNER@:rw", "A::alice@:r"},
+						},
+					}),
+				},
+			},
+			expPlan: &applyPlan{
+				Changes: []*planChange{
+					{Resource: "system", Action: "set-prop", Detail: "pool_scrub_mode: off -> lazy"},
+					{Resource: "system", Action: "set-attr", Detail: `owner: "alice" -> (unset)`},
+					{Resource: "system", Action: "set-attr", Detail: `site: "home" -> "lab"`},
+					{Resource: "pool tank", Action: "set-prop", Detail: "reclaim: lazy -> time"},
+					{Resource: "pool tank", Action: "set-acl", Detail: "-A::alice@:r +A::bob@:r"},
+					{Resource: "pool scratch", Action: "create", Detail: "size 1.0 TB, tier ratio 6.00%,94.00%"},
+				},
+				Warnings: []string{
+					"pool tank: size is 1.0 TB, spec has 2.0 TB (size is only applied on creation)",
+					"pool tank: rd_fac is 1, spec has 2 (can't set redundancy factor on existing pool)",
+					"pool other: not in spec, left unchanged",
+				},
+			},
+		},
+		"missing size for new pool": {
+			spec: &clusterSpec{
+				Pools: []*poolSpec{{Label: "scratch"}},
+			},
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			},
+			expErr: errors.New("size must be set"),
+		},
+		"list pools fails": {
+			spec: &clusterSpec{},
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if err := tc.spec.validate(); err != nil {
+				t.Fatal(err)
+			}
+			mi := control.NewMockInvoker(log, tc.mic)
+
+			gotPlan, gotErr := planClusterSpec(test.Context(t), mi, tc.spec)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(planChange{}),
+				cmpopts.EquateEmpty(),
+			}
+			if diff := cmp.Diff(tc.expPlan, gotPlan, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected plan (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_exportClusterSpec(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponseSet: []*control.UnaryResponse{
+			control.MockMSResponse("host1", nil, &mgmtpb.SystemGetPropResp{
+				Properties: map[string]string{
+					"daos_system":       "daos_server",
+					"pool_scrub_mode":   "lazy",
+					"pool_scrub_thresh": "0",
+				},
+			}),
+			control.MockMSResponse("host1", nil, &mgmtpb.SystemGetAttrResp{
+				Attributes: map[string]string{"site": "lab"},
+			}),
+			control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+				Pools: []*mgmtpb.ListPoolsResp_Pool{
+					{
+						Uuid:  test.MockUUID(1),
+						Label: "tank",
+						State: daos.PoolServiceStateReady.String(),
+					},
+				},
+			}),
+			control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+				Uuid:  test.MockUUID(1),
+				Label: "tank",
+				State: mgmtpb.PoolServiceState_Ready,
+				TierStats: []*mgmtpb.StorageUsageStats{
+					{Total: 60000000000},
+					{Total: 940000000000},
+				},
+			}),
+			control.MockMSResponse("host1", nil, mockPoolPropResp(t, map[string]string{
+				"label":          "tank",
+				"reclaim":        "lazy",
+				"global_version": "1",
+			})),
+			control.MockMSResponse("host1", nil, &mgmtpb.ACLResp{
+				Acl: &mgmtpb.AccessControlList{
+					Entries: []string{"A::OWNER@:rw"},
+				},
+			}),
+		},
+	})
+
+	gotSpec, err := exportClusterSpec(test.Context(t), log, mi)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expSpec := &clusterSpec{
+		System: &systemSpec{
+			Properties: map[string]string{
+				"pool_scrub_mode":   "lazy",
+				"pool_scrub_thresh": "0",
+			},
+			Attributes: map[string]string{"site": "lab"},
+		},
+		Pools: []*poolSpec{
+			{
+				Label:      "tank",
+				Size:       "1.0 TB",
+				TierRatio:  "6.00%,94.00%",
+				Properties: map[string]string{"reclaim": "lazy"},
+				ACL:        []string{"A::OWNER@:rw"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expSpec, gotSpec, cmpopts.IgnoreUnexported(poolSpec{})); diff != "" {
+		t.Fatalf("unexpected spec (-want, +got):\n%s\n", diff)
+	}
+
+	// The exported spec should be accepted as input.
+	test.CmpErr(t, nil, gotSpec.validate())
+}
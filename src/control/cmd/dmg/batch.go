@@ -0,0 +1,380 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/desertbit/go-shlex"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+const (
+	batchStatusOK      = "ok"
+	batchStatusFailed  = "failed"
+	batchStatusSkipped = "skipped"
+)
+
+// batchRunFn runs a single dmg command from a batch. If out is set, the
+// command's machine-readable output is written to it. If validate is set, it
+// is called with the parsed command instead of running it.
+type batchRunFn func(args []string, out io.Writer, validate func(flags.Commander) error) error
+
+type (
+	// batchLine is a command read from a batch file.
+	batchLine struct {
+		num  int
+		args []string
+	}
+
+	// batchResult is the outcome of a single command in a batch.
+	batchResult struct {
+		Line     int             `json:"line"`
+		Command  string          `json:"command"`
+		Status   string          `json:"status"`
+		Error    string          `json:"error,omitempty"`
+		Response json.RawMessage `json:"response,omitempty"`
+	}
+
+	// batchReport is the aggregated outcome of the commands in a batch.
+	batchReport struct {
+		DryRun    bool           `json:"dry_run"`
+		Results   []*batchResult `json:"results"`
+		Succeeded int            `json:"succeeded"`
+		Failed    int            `json:"failed"`
+		Skipped   int            `json:"skipped"`
+	}
+
+	// batchPool is a pool known to a dry run.
+	batchPool struct {
+		label string
+		uuid  string
+	}
+)
+
+// batchCmd is the struct representing the command to run a file of dmg
+// commands. All commands in the batch share a single control client and the
+// control config that was loaded when the batch was started.
+type batchCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+
+	File        string `short:"f" long:"file" required:"1" description:"File of dmg commands to run, one per line"`
+	DryRun      bool   `long:"dry-run" description:"Validate the commands without running them"`
+	StopOnError bool   `long:"stop-on-error" description:"Stop at the first command that fails (default)"`
+	Continue    bool   `long:"continue" description:"Run the remaining commands after a command fails"`
+
+	runCmd batchRunFn
+	pools  map[string]*batchPool
+	ranks  map[ranklist.Rank]bool
+}
+
+// setRunner sets the function used to run the commands in the batch.
+func (cmd *batchCmd) setRunner(fn batchRunFn) {
+	cmd.runCmd = fn
+}
+
+// parseBatch reads dmg commands from the supplied reader. Blank lines and
+// lines starting with # are skipped, and a leading "dmg" is optional.
+func parseBatch(r io.Reader) ([]*batchLine, error) {
+	var lines []*batchLine
+
+	scanner := bufio.NewScanner(r)
+	for num := 1; scanner.Scan(); num++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		args, err := shlex.Split(text, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", num)
+		}
+		if len(args) > 0 && args[0] == "dmg" {
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			return nil, errors.Errorf("line %d: no command given", num)
+		}
+
+		lines = append(lines, &batchLine{num: num, args: args})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+func readBatchFile(path string) ([]*batchLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading batch file")
+	}
+	defer f.Close()
+
+	lines, err := parseBatch(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing batch file %s", path)
+	}
+	if len(lines) == 0 {
+		return nil, errors.Errorf("no commands in batch file %s", path)
+	}
+
+	return lines, nil
+}
+
+// envelopeResponse returns the response from the JSON output of a command, or
+// nil if the output doesn't hold a single response.
+func envelopeResponse(data []byte) json.RawMessage {
+	var envelope struct {
+		Response json.RawMessage `json:"response"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil
+	}
+	if bytes.Equal(envelope.Response, []byte("null")) {
+		return nil
+	}
+
+	return envelope.Response
+}
+
+// Execute is run when batchCmd activates.
+func (cmd *batchCmd) Execute(_ []string) error {
+	if cmd.runCmd == nil {
+		return errors.New("batch command runner not set")
+	}
+	if cmd.StopOnError && cmd.Continue {
+		return errIncompatFlags("stop-on-error", "continue")
+	}
+
+	lines, err := readBatchFile(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	var validate func(flags.Commander) error
+	if cmd.DryRun {
+		validate = cmd.validate
+	}
+
+	report := &batchReport{DryRun: cmd.DryRun}
+	for _, line := range lines {
+		result := &batchResult{
+			Line:    line.num,
+			Command: strings.Join(line.args, " "),
+		}
+		report.Results = append(report.Results, result)
+
+		if report.Failed > 0 && !cmd.Continue {
+			result.Status = batchStatusSkipped
+			report.Skipped++
+			continue
+		}
+
+		var out io.Writer
+		var buf bytes.Buffer
+		if cmd.JSONOutputEnabled() {
+			out = &buf
+		} else {
+			cmd.Infof("[line %d] dmg %s", line.num, result.Command)
+		}
+
+		if err := cmd.runCmd(line.args, out, validate); err != nil {
+			result.Status = batchStatusFailed
+			result.Error = err.Error()
+			report.Failed++
+			if !cmd.JSONOutputEnabled() {
+				cmd.Errorf("line %d: %s", line.num, err)
+			}
+		} else {
+			result.Status = batchStatusOK
+			report.Succeeded++
+		}
+		result.Response = envelopeResponse(buf.Bytes())
+	}
+
+	var batchErr error
+	if report.Failed > 0 {
+		batchErr = errors.Errorf("%d of %d batch commands failed", report.Failed, len(lines))
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(report, batchErr)
+	}
+
+	verb := "ran"
+	if cmd.DryRun {
+		verb = "validated"
+	}
+	cmd.Infof("Batch %s: %d succeeded, %d failed, %d skipped", verb,
+		report.Succeeded, report.Failed, report.Skipped)
+
+	return batchErr
+}
+
+// loadPools fetches the pools in the system for a dry run.
+func (cmd *batchCmd) loadPools() error {
+	if cmd.pools != nil {
+		return nil
+	}
+
+	resp, err := control.ListPools(cmd.MustLogCtx(), cmd.ctlInvoker, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return errors.Wrap(err, "listing pools")
+	}
+
+	cmd.pools = make(map[string]*batchPool)
+	for _, p := range resp.Pools {
+		cmd.addPool(&batchPool{label: p.Label, uuid: p.UUID.String()})
+	}
+
+	return nil
+}
+
+func (cmd *batchCmd) addPool(pool *batchPool) {
+	if pool.label != "" {
+		cmd.pools[pool.label] = pool
+	}
+	if pool.uuid != "" {
+		cmd.pools[pool.uuid] = pool
+	}
+}
+
+func (cmd *batchCmd) removePool(pool *batchPool) {
+	delete(cmd.pools, pool.label)
+	delete(cmd.pools, pool.uuid)
+}
+
+// loadRanks fetches the ranks of the system members for a dry run.
+func (cmd *batchCmd) loadRanks() error {
+	if cmd.ranks != nil {
+		return nil
+	}
+
+	resp, err := control.SystemQuery(cmd.MustLogCtx(), cmd.ctlInvoker, &control.SystemQueryReq{})
+	if err != nil {
+		return errors.Wrap(err, "querying system members")
+	}
+
+	cmd.ranks = make(map[ranklist.Rank]bool)
+	for _, m := range resp.Members {
+		cmd.ranks[m.Rank] = true
+	}
+
+	return nil
+}
+
+var rankSetFlagType = reflect.TypeOf(ui.RankSetFlag{})
+
+// collectRanks appends the ranks given in the rank and rank set options of a
+// command struct to the supplied list.
+func collectRanks(v reflect.Value, ranks []ranklist.Rank) []ranklist.Rank {
+	if v.Kind() != reflect.Struct {
+		return ranks
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+		fv := v.Field(i)
+
+		switch {
+		case sf.Type == rankSetFlagType:
+			rs := fv.Addr().Interface().(*ui.RankSetFlag)
+			ranks = append(ranks, rs.Ranks()...)
+		case sf.Anonymous:
+			ranks = collectRanks(fv, ranks)
+		case sf.Tag.Get("long") == "rank":
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Uint32 {
+				ranks = append(ranks, ranklist.Rank(fv.Uint()))
+			}
+		}
+	}
+
+	return ranks
+}
+
+func (cmd *batchCmd) checkRanks(ranks []ranklist.Rank) error {
+	if len(ranks) == 0 {
+		return nil
+	}
+	if err := cmd.loadRanks(); err != nil {
+		return err
+	}
+
+	unknown := ranklist.NewRankSet()
+	for _, r := range ranks {
+		if !cmd.ranks[r] {
+			unknown.Add(r)
+		}
+	}
+	if unknown.Count() > 0 {
+		return errors.Errorf("rank(s) %s not found in the system", unknown)
+	}
+
+	return nil
+}
+
+// validate checks a command from the batch without running it. The ranks
+// given to the command must be system members and any pool it refers to must
+// exist, taking into account the pools created and destroyed by earlier
+// commands in the batch.
+func (cmd *batchCmd) validate(c flags.Commander) error {
+	if err := cmd.checkRanks(collectRanks(reflect.Indirect(reflect.ValueOf(c)), nil)); err != nil {
+		return err
+	}
+
+	var pool *batchPool
+	if pc, ok := c.(interface{ PoolID() *PoolID }); ok {
+		if err := cmd.loadPools(); err != nil {
+			return err
+		}
+		id := pc.PoolID().String()
+		if pool = cmd.pools[id]; pool == nil {
+			return errors.Errorf("pool %s not found", id)
+		}
+	}
+
+	switch c := c.(type) {
+	case *PoolCreateCmd:
+		if err := cmd.loadPools(); err != nil {
+			return err
+		}
+		if cmd.pools[c.Args.PoolLabel] != nil {
+			return errors.Errorf("pool %s already exists", c.Args.PoolLabel)
+		}
+		cmd.addPool(&batchPool{label: c.Args.PoolLabel})
+	case *PoolDestroyCmd:
+		cmd.removePool(pool)
+	}
+
+	return nil
+}
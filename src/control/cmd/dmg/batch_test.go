@@ -0,0 +1,221 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestDmg_parseBatch(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content  string
+		expLines []*batchLine
+		expErr   error
+	}{
+		"empty": {},
+		"comments and blank lines": {
+			content: "# setup\n\n  system query\n\t# done\n",
+			expLines: []*batchLine{
+				{num: 3, args: []string{"system", "query"}},
+			},
+		},
+		"leading dmg and quoting": {
+			content: "dmg pool create -s 1TB tank\npool set-prop tank 'label:new tank'\n",
+			expLines: []*batchLine{
+				{num: 1, args: []string{"pool", "create", "-s", "1TB", "tank"}},
+				{num: 2, args: []string{"pool", "set-prop", "tank", "label:new tank"}},
+			},
+		},
+		"dmg without command": {
+			content: "system query\ndmg\n",
+			expErr:  errors.New("line 2: no command given"),
+		},
+		"unterminated quote": {
+			content: "pool query \"tank\n",
+			expErr:  errors.New("line 1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			lines, err := parseBatch(strings.NewReader(tc.content))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expLines, lines, cmp.AllowUnexported(batchLine{})); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_batchCmd(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		content   string
+		args      []string
+		expStatus []string
+		expErrors []string
+		expCalls  []string
+		expErr    error
+	}{
+		"commands run in order": {
+			content:   "system query\npool list\n",
+			expStatus: []string{"ok", "ok"},
+			expErrors: []string{"", ""},
+			expCalls: []string{
+				printRequest(t, &control.SystemQueryReq{}),
+				printRequest(t, &control.ListPoolsReq{}),
+			},
+		},
+		"stop on error": {
+			content:   "pool query\nsystem query\n",
+			expStatus: []string{"failed", "skipped"},
+			expErrors: []string{"required argument", ""},
+			expErr:    errors.New("1 of 2 batch commands failed"),
+		},
+		"continue after error": {
+			content:   "pool query\nsystem query\n",
+			args:      []string{"--continue"},
+			expStatus: []string{"failed", "ok"},
+			expErrors: []string{"required argument", ""},
+			expCalls: []string{
+				printRequest(t, &control.SystemQueryReq{}),
+			},
+			expErr: errors.New("1 of 2 batch commands failed"),
+		},
+		"continue and stop on error": {
+			content: "system query\n",
+			args:    []string{"--continue", "--stop-on-error"},
+			expErr:  errors.New("may not be mixed"),
+		},
+		"nested batch": {
+			content:   "batch -f other.txt\n",
+			expStatus: []string{"failed"},
+			expErrors: []string{"can't be run from a batch"},
+			expErr:    errors.New("1 of 1 batch commands failed"),
+		},
+		"dry run": {
+			content: "pool create -s 1TB tank\npool query tank\npool destroy tank\n",
+			args:    []string{"--dry-run"},
+			expCalls: []string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			},
+			expStatus: []string{"ok", "ok", "ok"},
+			expErrors: []string{"", "", ""},
+		},
+		"dry run unknown pool": {
+			content: "pool query tank\n",
+			args:    []string{"--dry-run"},
+			expCalls: []string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			},
+			expStatus: []string{"failed"},
+			expErrors: []string{"pool tank not found"},
+			expErr:    errors.New("1 of 1 batch commands failed"),
+		},
+		"dry run destroyed pool": {
+			content: "pool create -s 1TB tank\npool destroy tank\npool query tank\n",
+			args:    []string{"--dry-run"},
+			expCalls: []string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			},
+			expStatus: []string{"ok", "ok", "failed"},
+			expErrors: []string{"", "", "pool tank not found"},
+			expErr:    errors.New("1 of 3 batch commands failed"),
+		},
+		"dry run existing pool": {
+			content: "pool create -s 1TB tank\npool create -s 1TB tank\n",
+			args:    []string{"--dry-run"},
+			expCalls: []string{
+				printRequest(t, &control.ListPoolsReq{NoQuery: true}),
+			},
+			expStatus: []string{"ok", "failed"},
+			expErrors: []string{"", "pool tank already exists"},
+			expErr:    errors.New("1 of 2 batch commands failed"),
+		},
+		"dry run unknown rank": {
+			content: "system stop --ranks 0-1\n",
+			args:    []string{"--dry-run"},
+			expCalls: []string{
+				printRequest(t, &control.SystemQueryReq{}),
+			},
+			expStatus: []string{"failed"},
+			expErrors: []string{"rank(s) 0-1 not found in the system"},
+			expErr:    errors.New("1 of 1 batch commands failed"),
+		},
+		"dry run bad arguments": {
+			content:   "system stop --bogus\n",
+			args:      []string{"--dry-run"},
+			expStatus: []string{"failed"},
+			expErrors: []string{"unknown flag"},
+			expErr:    errors.New("1 of 1 batch commands failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			path := test.CreateTestFile(t, testDir, tc.content)
+			conn := newTestConn(t)
+			bridge := &bridgeConnInvoker{
+				MockInvoker: *control.DefaultMockInvoker(log),
+				t:           t,
+				conn:        conn,
+			}
+
+			var out bytes.Buffer
+			opts := cliOptions{output: &out}
+			args := append([]string{"-i", "--json", "batch", "-f", path}, tc.args...)
+			gotErr := parseOpts(args, &opts, bridge, log)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(strings.Join(tc.expCalls, " "), strings.Join(conn.called, " ")); diff != "" {
+				t.Fatalf("unexpected calls (-want, +got):\n%s\n", diff)
+			}
+
+			var report struct {
+				Response *batchReport `json:"response"`
+			}
+			if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+				t.Fatalf("invalid output: %s\n%s", err, out.String())
+			}
+			if report.Response == nil {
+				if len(tc.expStatus) != 0 {
+					t.Fatalf("no batch report in output: %s", out.String())
+				}
+				return
+			}
+
+			var gotStatus, gotErrors []string
+			for _, result := range report.Response.Results {
+				gotStatus = append(gotStatus, result.Status)
+				gotErrors = append(gotErrors, result.Error)
+			}
+			if diff := cmp.Diff(tc.expStatus, gotStatus); diff != "" {
+				t.Fatalf("unexpected status (-want, +got):\n%s\n", diff)
+			}
+			for i, expErr := range tc.expErrors {
+				if (expErr == "" && gotErrors[i] != "") || !strings.Contains(gotErrors[i], expErr) {
+					t.Errorf("result %d: expected error %q, got %q", i, expErr, gotErrors[i])
+				}
+			}
+		})
+	}
+}
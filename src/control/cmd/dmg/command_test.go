@@ -18,6 +18,7 @@ import (
 
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
@@ -142,6 +143,32 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemQueryResp{})
 	case *control.SystemCleanupReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemCleanupResp{})
+	case *control.SystemGetEventsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetEventsResp{})
+	case *control.SystemStreamEventsReq:
+		resp = control.MockMSResponse("", nil, &sharedpb.RASEvent{})
+	case *control.SystemSetEventPolicyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.SystemGetEventPolicyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetEventPolicyResp{})
+	case *control.SystemDBSnapshotReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDBSnapshotResp{})
+	case *control.SystemDBRestoreReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDBRestoreResp{})
+	case *control.SystemDBVerifyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDBVerifyResp{})
+	case *control.SystemDBDiffReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDBDiffResp{})
+	case *control.SystemSetQuotaReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.SystemGetQuotaReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetQuotaResp{})
+	case *control.SystemGetAccessReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetAccessResp{})
+	case *control.SystemGetAuditReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetAuditResp{})
+	case *control.SystemReplicaReq, *control.SystemLeaderTransferReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{})
 	case *control.LeaderQueryReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{})
 	case *control.ListPoolsReq:
@@ -163,6 +190,12 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolDrainResp{})
 	case *control.PoolExtendReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolExtendResp{})
+	case *control.PoolOpScheduleReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolOpScheduleResp{})
+	case *control.PoolOpListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolOpListResp{})
+	case *control.PoolOpCancelReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.PoolReintegrateReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolReintegrateResp{})
 	case *control.SystemCheckEnableReq:
@@ -238,6 +271,12 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 	return resp, nil
 }
 
+// InvokeStreamRPC records stream requests in the same way as unary requests;
+// no intermediate messages are passed to the handler.
+func (bci *bridgeConnInvoker) InvokeStreamRPC(ctx context.Context, sReq control.StreamRequest, _ control.HostStreamHandler) (*control.UnaryResponse, error) {
+	return bci.InvokeUnaryRPC(ctx, sReq)
+}
+
 func runCmdTest(t *testing.T, cmd, expectedCalls string, expectedErr error) {
 	t.Helper()
 	log, buf := logging.NewTestLogger(t.Name())
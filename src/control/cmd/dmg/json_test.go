@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -61,13 +62,17 @@ func TestDmg_JsonOutput(t *testing.T) {
 	defer cleanup()
 	aclContent := "A::OWNER@:rw\nA::user1@:rw\nA:g:group1@:r\n"
 	aclPath := test.CreateTestFile(t, testDir, aclContent)
+	specContent := "system:\n  attributes:\n    foo: bar\n"
+	specPath := test.CreateTestFile(t, testDir, specContent)
+	batchPath := test.CreateTestFile(t, testDir, "system query\n")
 
 	for _, args := range cmdArgs {
 		t.Run(strings.Join(args, " "), func(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
 			switch strings.Join(args, " ") {
 			case "version", "telemetry config", "telemetry run", "config generate",
-				"manpage", "system set-prop", "support collect-log", "check repair":
+				"manpage", "system set-prop", "support collect-log", "check repair",
+				"shell":
 				return
 			case "storage nvme-rebind":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
@@ -100,6 +105,8 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, test.MockUUID(), "--rank", "0")
 			case "pool query-targets":
 				testArgs = append(testArgs, test.MockUUID(), "--rank", "0", "--target-idx", "1,3,5,7")
+			case "pool ops cancel":
+				testArgs = append(testArgs, test.MockUUID(), test.MockUUID(1))
 			case "container set-owner":
 				testArgs = append(testArgs, "--user", "foo", test.MockUUID(), test.MockUUID())
 			case "telemetry metrics list", "telemetry metrics query":
@@ -116,6 +123,22 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, "--ranks", "0")
 			case "system clear-exclude":
 				testArgs = append(testArgs, "--ranks", "0")
+			case "system event-policy set":
+				testArgs = append(testArgs, "--disable", "engine_format_required")
+			case "system db snapshot":
+				testArgs = append(testArgs, "-o", filepath.Join(testDir, "snapshot.tar.gz"))
+			case "system db restore":
+				testArgs = append(testArgs, "--force", aclPath)
+			case "system replicas add", "system replicas remove", "system db diff":
+				testArgs = append(testArgs, "host1:10001")
+			case "system quota set", "system quota get":
+				testArgs = append(testArgs, "--user", "bob@")
+			case "apply", "diff":
+				testArgs = append(testArgs, "-f", specPath)
+			case "schema":
+				testArgs = append(testArgs, "system", "query")
+			case "batch":
+				testArgs = append(testArgs, "-f", batchPath)
 			}
 
 			// replace os.Stdout so that we can verify the generated output
@@ -9,6 +9,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 
@@ -114,7 +115,8 @@ type cliOptions struct {
 	Insecure       bool             `short:"i" long:"insecure" description:"Have dmg attempt to connect without certificates"`
 	Debug          bool             `short:"d" long:"debug" description:"Enable debug output"`
 	LogFile        string           `long:"log-file" description:"Log command output to the specified file"`
-	JSON           bool             `short:"j" long:"json" description:"Enable JSON output"`
+	JSON           bool             `short:"j" long:"json" description:"Enable JSON output (same as --output=json)"`
+	Output         string           `long:"output" choice:"json" choice:"yaml" choice:"csv" description:"Enable machine-readable output in the given format (csv is only supported by tabular commands)"`
 	JSONLogs       bool             `short:"J" long:"json-logging" description:"Enable JSON-formatted log output"`
 	ConfigPath     string           `short:"o" long:"config-path" description:"Client config file path"`
 	Server         serverCmd        `command:"server" alias:"srv" description:"Perform tasks related to remote servers"`
@@ -129,9 +131,40 @@ type cliOptions struct {
 	ServerVersion  serverVersionCmd `command:"server-version" description:"Print server version"`
 	Telemetry      telemCmd         `command:"telemetry" alias:"telem" description:"Perform telemetry operations"`
 	Check          checkCmdRoot     `command:"check" description:"Check system health"`
+	Shell          shellCmd         `command:"shell" description:"Start an interactive dmg shell"`
+	Apply          applyCmd         `command:"apply" description:"Converge pools and system settings on a YAML spec"`
+	Diff           diffCmd          `command:"diff" description:"Show the changes needed to converge on a YAML spec"`
+	Export         exportCmd        `command:"export" description:"Export pools and system settings as a YAML spec"`
+	Schema         schemaCmd        `command:"schema" description:"Print the JSON Schema of a command's machine-readable output"`
+	Batch          batchCmd         `command:"batch" description:"Run a file of dmg commands"`
 	ManPage        cmdutil.ManCmd   `command:"manpage" hidden:"true"`
 	faultsCmdRoot                   // compiled out for release builds
 	firmwareOption                  // build with tag "firmware" to enable
+
+	sharedCfg *control.Config             // control config shared by commands run from a shell or batch
+	output    io.Writer                   // machine-readable output writer, stdout if not set
+	validate  func(flags.Commander) error // if set, called instead of running the command
+	batch     bool                        // command is run from a batch
+}
+
+// outputFormat returns the machine-readable output format selected on the
+// command line, or an empty format if the output should be human-readable.
+func (opts *cliOptions) outputFormat() (cmdutil.OutputFormat, error) {
+	format := cmdutil.OutputFormat(opts.Output)
+	if opts.JSON {
+		if format != "" && format != cmdutil.OutputFormatJSON {
+			return "", errors.Errorf("--json and --output=%s cannot be used together", format)
+		}
+		return cmdutil.OutputFormatJSON, nil
+	}
+	return format, nil
+}
+
+func (opts *cliOptions) outputWriter() io.Writer {
+	if opts.output != nil {
+		return opts.output
+	}
+	return os.Stdout
 }
 
 type versionCmd struct {
@@ -210,6 +243,13 @@ and access control settings, along with system wide operations.`
 			return nil
 		}
 
+		if opts.batch {
+			switch cmd.(type) {
+			case *shellCmd, *batchCmd, *versionCmd:
+				return errors.New("command can't be run from a batch")
+			}
+		}
+
 		if manCmd, ok := cmd.(cmdutil.ManPageWriter); ok {
 			manCmd.SetWriteFunc(p.WriteManPage)
 			// Just execute now without any more setup.
@@ -249,8 +289,13 @@ and access control settings, along with system wide operations.`
 			log.WithJSONOutput()
 		}
 
-		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && opts.JSON {
-			jsonCmd.EnableJSONOutput(os.Stdout, &wroteJSON)
+		format, err := opts.outputFormat()
+		if err != nil {
+			return err
+		}
+		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && format != "" {
+			jsonCmd.EnableJSONOutput(opts.outputWriter(), &wroteJSON)
+			jsonCmd.SetOutputFormat(format)
 			// disable output on stdout other than JSON
 			log.ClearLevel(logging.LogLevelInfo)
 		}
@@ -265,23 +310,32 @@ and access control settings, along with system wide operations.`
 			return cmd.Execute(args)
 		}
 
-		ctlCfg, err := control.LoadConfig(opts.ConfigPath)
-		if err != nil {
-			if errors.Cause(err) != control.ErrNoConfigFile {
-				return errors.Wrap(err, "failed to load control configuration")
+		var ctlCfg *control.Config
+		if opts.sharedCfg != nil {
+			// Commands run from a shell or batch reuse the config that
+			// was loaded when the shell or batch was started.
+			cfg := *opts.sharedCfg
+			ctlCfg = &cfg
+		} else {
+			var err error
+			ctlCfg, err = control.LoadConfig(opts.ConfigPath)
+			if err != nil {
+				if errors.Cause(err) != control.ErrNoConfigFile {
+					return errors.Wrap(err, "failed to load control configuration")
+				}
+				// Use the default config if no config file was found.
+				ctlCfg = control.DefaultConfig()
+			}
+			if ctlCfg.Path != "" {
+				log.Debugf("control config loaded from %s", ctlCfg.Path)
 			}
-			// Use the default config if no config file was found.
-			ctlCfg = control.DefaultConfig()
-		}
-		if ctlCfg.Path != "" {
-			log.Debugf("control config loaded from %s", ctlCfg.Path)
-		}
 
-		if opts.Insecure {
-			ctlCfg.TransportConfig.AllowInsecure = true
-		}
-		if err := ctlCfg.TransportConfig.PreLoadCertData(); err != nil {
-			return errors.Wrap(err, "Unable to load Certificate Data")
+			if opts.Insecure {
+				ctlCfg.TransportConfig.AllowInsecure = true
+			}
+			if err := ctlCfg.TransportConfig.PreLoadCertData(); err != nil {
+				return errors.Wrap(err, "Unable to load Certificate Data")
+			}
 		}
 
 		invoker.SetConfig(ctlCfg)
@@ -312,12 +366,51 @@ and access control settings, along with system wide operations.`
 			cfgCmd.setConfig(ctlCfg)
 		}
 
+		if shell, ok := cmd.(*shellCmd); ok {
+			if opts.sharedCfg != nil {
+				return errors.New("already running in a dmg shell")
+			}
+			shell.setRunner(p, func(args []string) error {
+				// Each command gets a fresh set of options and logger
+				// so that per-command flags don't leak between commands.
+				shellOpts := cliOptions{
+					AllowProxy: opts.AllowProxy,
+					Debug:      opts.Debug,
+					LogFile:    opts.LogFile,
+					JSONLogs:   opts.JSONLogs,
+					sharedCfg:  ctlCfg,
+				}
+				return parseOpts(args, &shellOpts, invoker, logging.NewCommandLineLogger())
+			})
+		}
+
+		if batch, ok := cmd.(*batchCmd); ok {
+			batch.setRunner(func(args []string, out io.Writer, validate func(flags.Commander) error) error {
+				batchOpts := cliOptions{
+					AllowProxy: opts.AllowProxy,
+					Debug:      opts.Debug,
+					LogFile:    opts.LogFile,
+					JSONLogs:   opts.JSONLogs,
+					JSON:       out != nil,
+					sharedCfg:  ctlCfg,
+					output:     out,
+					validate:   validate,
+					batch:      true,
+				}
+				return parseOpts(args, &batchOpts, invoker, logging.NewCommandLineLogger())
+			})
+		}
+
 		if argsCmd, ok := cmd.(cmdutil.ArgsHandler); ok {
 			if err := argsCmd.CheckArgs(args); err != nil {
 				return err
 			}
 		}
 
+		if opts.validate != nil {
+			return opts.validate(cmd)
+		}
+
 		if err := cmd.Execute(args); err != nil {
 			return err
 		}
@@ -326,8 +419,8 @@ and access control settings, along with system wide operations.`
 	}
 
 	_, err := p.ParseArgs(args)
-	if opts.JSON && wroteJSON.IsFalse() {
-		return cmdutil.OutputJSON(os.Stdout, nil, err)
+	if format, fmtErr := opts.outputFormat(); fmtErr == nil && format != "" && wroteJSON.IsFalse() {
+		return cmdutil.WriteOutput(opts.outputWriter(), format, nil, err)
 	}
 	return err
 }
@@ -339,6 +432,7 @@ func main() {
 	ctlInvoker := control.NewClient(
 		control.WithClientLogger(log),
 		control.WithClientComponent(build.ComponentAdmin),
+		control.WithClientMSCache(),
 	)
 
 	if err := parseOpts(os.Args[1:], &opts, ctlInvoker, log); err != nil {
@@ -31,6 +31,7 @@ import (
 // PoolCmd is the struct representing the top-level pool subcommand.
 type PoolCmd struct {
 	Create       PoolCreateCmd       `command:"create" description:"Create a DAOS pool"`
+	Plan         PoolPlanCmd         `command:"plan" description:"Simulate pool creation and report placement and capacity headroom"`
 	Destroy      PoolDestroyCmd      `command:"destroy" description:"Destroy a DAOS pool"`
 	Evict        PoolEvictCmd        `command:"evict" description:"Evict all pool connections to a DAOS pool"`
 	List         PoolListCmd         `command:"list" alias:"ls" description:"List DAOS pools"`
@@ -47,6 +48,7 @@ type PoolCmd struct {
 	SetProp      PoolSetPropCmd      `command:"set-prop" description:"Set pool property"`
 	GetProp      PoolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
 	Upgrade      PoolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
+	Ops          poolOpsCmd          `command:"ops" description:"Manage queued pool operations"`
 }
 
 var (
@@ -191,8 +193,8 @@ type PoolCreateCmd struct {
 	cfgCmd
 	ctlInvokerCmd
 	cmdutil.JSONOutputCmd
-	GroupName  ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
-	UserName   ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
+	GroupName  ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain (default: group mapped from admin certificate or current group)"`
+	UserName   ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain (default: user mapped from admin certificate or current user)"`
 	Properties PoolSetPropsFlag    `short:"P" long:"properties" description:"Pool properties to be set"`
 	ACLFile    string              `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
 	Size       poolSizeFlag        `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
@@ -427,7 +429,8 @@ func (cmd *PoolListCmd) Execute(_ []string) (errOut error) {
 	}
 
 	if cmd.JSONOutputEnabled() {
-		return cmd.OutputJSON(resp, nil)
+		return cmd.OutputJSON(cmdutil.NewTabularOutput(resp,
+			pretty.TabulateListPoolsResponse(resp)), nil)
 	}
 
 	var out, outErr strings.Builder
@@ -516,6 +519,7 @@ func (cmd *PoolEvictCmd) Execute(args []string) error {
 // PoolExcludeCmd is the struct representing the command to exclude a DAOS target.
 type PoolExcludeCmd struct {
 	poolCmd
+	poolOpScheduleFlags
 	Rank      uint32 `long:"rank" required:"1" description:"Engine rank of the targets to be excluded"`
 	Targetidx string `long:"target-idx" description:"Comma-separated list of target idx(s) to be excluded from the rank"`
 }
@@ -530,6 +534,9 @@ func (cmd *PoolExcludeCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolExcludeReq{ID: cmd.PoolID().String(), Rank: ranklist.Rank(cmd.Rank), Targetidx: idxlist}
+	if cmd.isSet() {
+		return cmd.schedule(&cmd.poolCmd, "Exclude", &control.PoolOpScheduleReq{Exclude: req})
+	}
 
 	err := control.PoolExclude(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -544,6 +551,7 @@ func (cmd *PoolExcludeCmd) Execute(args []string) error {
 // PoolDrainCmd is the struct representing the command to Drain a DAOS target.
 type PoolDrainCmd struct {
 	poolCmd
+	poolOpScheduleFlags
 	Rank      uint32 `long:"rank" required:"1" description:"Engine rank of the targets to be drained"`
 	Targetidx string `long:"target-idx" description:"Comma-separated list of target idx(s) to be drained on the rank"`
 }
@@ -559,6 +567,9 @@ func (cmd *PoolDrainCmd) Execute(args []string) error {
 	}
 
 	req := &control.PoolDrainReq{ID: cmd.PoolID().String(), Rank: ranklist.Rank(cmd.Rank), Targetidx: idxlist}
+	if cmd.isSet() {
+		return cmd.schedule(&cmd.poolCmd, "Drain", &control.PoolOpScheduleReq{Drain: req})
+	}
 
 	err := control.PoolDrain(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -573,6 +584,7 @@ func (cmd *PoolDrainCmd) Execute(args []string) error {
 // PoolExtendCmd is the struct representing the command to Extend a DAOS pool.
 type PoolExtendCmd struct {
 	poolCmd
+	poolOpScheduleFlags
 	RankList ui.RankSetFlag `long:"ranks" required:"1" description:"Comma-separated list of ranks to add to the pool"`
 }
 
@@ -584,6 +596,9 @@ func (cmd *PoolExtendCmd) Execute(args []string) error {
 		ID:    cmd.PoolID().String(),
 		Ranks: cmd.RankList.Ranks(),
 	}
+	if cmd.isSet() {
+		return cmd.schedule(&cmd.poolCmd, "Extend", &control.PoolOpScheduleReq{Extend: req})
+	}
 
 	err := control.PoolExtend(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -598,6 +613,7 @@ func (cmd *PoolExtendCmd) Execute(args []string) error {
 // PoolReintegrateCmd is the struct representing the command to Add a DAOS target.
 type PoolReintegrateCmd struct {
 	poolCmd
+	poolOpScheduleFlags
 	Rank      uint32 `long:"rank" required:"1" description:"Engine rank of the targets to be reintegrated"`
 	Targetidx string `long:"target-idx" description:"Comma-separated list of target idx(s) to be reintegrated into the rank"`
 }
@@ -617,6 +633,9 @@ func (cmd *PoolReintegrateCmd) Execute(args []string) error {
 		Rank:      ranklist.Rank(cmd.Rank),
 		Targetidx: idxlist,
 	}
+	if cmd.isSet() {
+		return cmd.schedule(&cmd.poolCmd, "Reintegration", &control.PoolOpScheduleReq{Reintegrate: req})
+	}
 
 	err := control.PoolReintegrate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -758,6 +777,15 @@ func (cmd *PoolUpgradeCmd) Execute(args []string) error {
 	return nil
 }
 
+// poolCreateOnlyProps maps the pool properties which can only be set when the
+// pool is created to their descriptions.
+var poolCreateOnlyProps = map[string]string{
+	"perf_domain": "perf_domain",
+	"rd_fac":      "redundancy factor",
+	"ec_pda":      "EC performance domain affinity",
+	"rp_pda":      "RP performance domain affinity",
+}
+
 // PoolSetPropCmd represents the command to set a property on a pool.
 type PoolSetPropCmd struct {
 	poolCmd
@@ -770,17 +798,8 @@ type PoolSetPropCmd struct {
 // Execute is run when PoolSetPropCmd subcommand is activatecmd.
 func (cmd *PoolSetPropCmd) Execute(_ []string) error {
 	for _, prop := range cmd.Args.Props.ToSet {
-		if prop.Name == "perf_domain" {
-			return errors.New("can't set perf_domain on existing pool.")
-		}
-		if prop.Name == "rd_fac" {
-			return errors.New("can't set redundancy factor on existing pool.")
-		}
-		if prop.Name == "ec_pda" {
-			return errors.New("can't set EC performance domain affinity on existing pool.")
-		}
-		if prop.Name == "rp_pda" {
-			return errors.New("can't set RP performance domain affinity on existing pool.")
+		if desc, found := poolCreateOnlyProps[prop.Name]; found {
+			return errors.Errorf("can't set %s on existing pool.", desc)
 		}
 	}
 
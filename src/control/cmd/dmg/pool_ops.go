@@ -0,0 +1,157 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// poolOpsCmd is the struct representing the commands to manage the
+// rebuild-triggering pool operations queued by the MS.
+type poolOpsCmd struct {
+	List   poolOpsListCmd   `command:"list" alias:"ls" description:"List queued pool operations"`
+	Cancel poolOpsCancelCmd `command:"cancel" description:"Cancel a queued pool operation"`
+}
+
+// poolOpScheduleFlags are used by the rebuild-triggering pool commands to
+// defer the operation to a time window rather than running it immediately.
+type poolOpScheduleFlags struct {
+	StartAfter  string `long:"start-after" description:"Queue the operation to be started after the given time (RFC3339 timestamp or duration from now)"`
+	StartBefore string `long:"start-before" description:"Queue the operation and cancel it if not started before the given time (RFC3339 timestamp or duration from now)"`
+}
+
+func (f *poolOpScheduleFlags) isSet() bool {
+	return f.StartAfter != "" || f.StartBefore != ""
+}
+
+// parseScheduleTime parses either an absolute timestamp or a duration that
+// is relative to now.
+func parseScheduleTime(in string, now time.Time) (time.Time, error) {
+	if in == "" {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(in); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.Errorf("negative duration %q", in)
+		}
+		return now.Add(d), nil
+	}
+
+	ts, err := common.ParseTime(in)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q (expected RFC3339 timestamp or duration)", in)
+	}
+
+	return ts, nil
+}
+
+// schedule queues the supplied operation to be run by the MS within the
+// requested time window.
+func (f *poolOpScheduleFlags) schedule(cmd *poolCmd, name string, req *control.PoolOpScheduleReq) error {
+	now := time.Now()
+
+	var err error
+	if req.NotBefore, err = parseScheduleTime(f.StartAfter, now); err != nil {
+		return errors.Wrap(err, "--start-after")
+	}
+	if req.NotAfter, err = parseScheduleTime(f.StartBefore, now); err != nil {
+		return errors.Wrap(err, "--start-before")
+	}
+	req.ID = cmd.PoolID().String()
+
+	resp, err := control.PoolOpSchedule(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		cmd.Infof("%s command failed: %s\n", name, err)
+		return err
+	}
+	cmd.Infof("%s command scheduled as operation %s\n", name, resp.OpID)
+
+	return nil
+}
+
+// poolOpsListCmd is the struct representing the command to list the queued
+// operations on a pool, or on all pools.
+type poolOpsListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+
+	Args struct {
+		Pool PoolID `positional-arg-name:"<pool label or UUID>"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolOpsListCmd subcommand is activated.
+func (cmd *poolOpsListCmd) Execute(_ []string) error {
+	req := new(control.PoolOpListReq)
+	if !cmd.Args.Pool.Empty() {
+		req.ID = cmd.Args.Pool.String()
+	}
+
+	resp, err := control.PoolOpList(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool ops list failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolOps(&bld, resp.Ops)
+	cmd.Infof("%s", bld.String())
+
+	return nil
+}
+
+// poolOpsCancelCmd is the struct representing the command to cancel a queued
+// pool operation.
+type poolOpsCancelCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+
+	Args struct {
+		Pool PoolID `positional-arg-name:"<pool label or UUID>" required:"1"`
+		OpID string `positional-arg-name:"<operation ID>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolOpsCancelCmd subcommand is activated.
+func (cmd *poolOpsCancelCmd) Execute(_ []string) error {
+	req := &control.PoolOpCancelReq{
+		ID:   cmd.Args.Pool.String(),
+		OpID: cmd.Args.OpID,
+	}
+
+	err := control.PoolOpCancel(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool ops cancel failed")
+	}
+	cmd.Infof("pool operation %s canceled", req.OpID)
+
+	return nil
+}
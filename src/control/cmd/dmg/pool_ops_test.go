@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestDmg_parseScheduleTime(t *testing.T) {
+	now := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		in      string
+		expTime time.Time
+		expErr  error
+	}{
+		"empty": {},
+		"duration": {
+			in:      "90m",
+			expTime: now.Add(90 * time.Minute),
+		},
+		"negative duration": {
+			in:     "-1h",
+			expErr: errors.New("negative duration"),
+		},
+		"timestamp": {
+			in:      "2024-03-02T00:00:00Z",
+			expTime: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		"garbage": {
+			in:     "tomorrow",
+			expErr: errors.New("invalid time"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTime, gotErr := parseScheduleTime(tc.in, now)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertTrue(t, tc.expTime.Equal(gotTime),
+				"expected "+tc.expTime.String()+", got "+gotTime.String())
+		})
+	}
+}
+
+func TestDmg_PoolOpsCommands(t *testing.T) {
+	notBefore := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2024, 3, 1, 6, 0, 0, 0, time.UTC)
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Drain scheduled within a window",
+			"pool drain " + test.MockUUID() + " --rank 1 --start-after 2024-03-01T02:00:00Z --start-before 2024-03-01T06:00:00Z",
+			strings.Join([]string{
+				printRequest(t, &control.PoolOpScheduleReq{
+					ID: test.MockUUID(),
+					Drain: &control.PoolDrainReq{
+						ID:        test.MockUUID(),
+						Rank:      1,
+						Targetidx: []uint32{},
+					},
+					NotBefore: notBefore,
+					NotAfter:  notAfter,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Exclude scheduled to start later",
+			"pool exclude " + test.MockUUID() + " --rank 2 --target-idx 1 --start-after 2024-03-01T02:00:00Z",
+			strings.Join([]string{
+				printRequest(t, &control.PoolOpScheduleReq{
+					ID: test.MockUUID(),
+					Exclude: &control.PoolExcludeReq{
+						ID:        test.MockUUID(),
+						Rank:      2,
+						Targetidx: []uint32{1},
+					},
+					NotBefore: notBefore,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Extend scheduled with a deadline",
+			"pool extend " + test.MockUUID() + " --ranks 3,4 --start-before 2024-03-01T06:00:00Z",
+			strings.Join([]string{
+				printRequest(t, &control.PoolOpScheduleReq{
+					ID: test.MockUUID(),
+					Extend: &control.PoolExtendReq{
+						ID:    test.MockUUID(),
+						Ranks: []ranklist.Rank{3, 4},
+					},
+					NotAfter: notAfter,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Reintegrate with bad schedule time",
+			"pool reintegrate " + test.MockUUID() + " --rank 1 --start-after soon",
+			"",
+			errors.New("--start-after"),
+		},
+		{
+			"List ops on all pools",
+			"pool ops list",
+			strings.Join([]string{
+				printRequest(t, &control.PoolOpListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"List ops on a pool",
+			"pool ops list " + test.MockUUID(),
+			strings.Join([]string{
+				printRequest(t, &control.PoolOpListReq{ID: test.MockUUID()}),
+			}, " "),
+			nil,
+		},
+		{
+			"Cancel op without op ID",
+			"pool ops cancel " + test.MockUUID(),
+			"",
+			errors.New("the required argument `<operation ID>` was not provided"),
+		},
+		{
+			"Cancel op",
+			"pool ops cancel " + test.MockUUID() + " " + test.MockUUID(1),
+			strings.Join([]string{
+				printRequest(t, &control.PoolOpCancelReq{
+					ID:   test.MockUUID(),
+					OpID: test.MockUUID(1),
+				}),
+			}, " "),
+			nil,
+		},
+	})
+}
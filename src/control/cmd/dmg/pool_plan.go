@@ -0,0 +1,99 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+// PoolPlanCmd is the struct representing the command to simulate the creation
+// of a DAOS pool without allocating any storage.
+type PoolPlanCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Size       poolSizeFlag   `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
+	TierRatio  tierRatioFlag  `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage (auto; default: 6,94)"`
+	NumRanks   uint32         `short:"k" long:"nranks" description:"Number of ranks to use, spread across fault domains (auto)"`
+	ScmSize    sizeFlag       `short:"s" long:"scm-size" description:"Per-engine SCM allocation for DAOS pool (manual)"`
+	NVMeSize   sizeFlag       `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
+	RankList   ui.RankSetFlag `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	RedunFac   uint32         `long:"rd-fac" description:"Redundancy factor of the pool (number of fault domain failures to tolerate)"`
+	RedunLevel string         `long:"rd-lvl" choice:"node" choice:"rank" default:"node" description:"Fault domain level that the redundancy factor applies to"`
+}
+
+func (cmd *PoolPlanCmd) setSizeArgs(req *control.PoolPlanReq) error {
+	switch {
+	case cmd.Size.IsSet() && (cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet()):
+		return errIncompatFlags("size", "scm-size", "nvme-size")
+	case cmd.Size.IsRatio():
+		if cmd.NumRanks > 0 {
+			return errIncompatFlags("size", "nranks")
+		}
+		if cmd.TierRatio.IsSet() {
+			return errIncompatFlags("size=%", "tier-ratio")
+		}
+		availFrac := float64(cmd.Size.availRatio) / 100.0
+		req.TierRatio = []float64{availFrac, availFrac}
+	case cmd.Size.IsSet():
+		if cmd.NumRanks > 0 && !cmd.RankList.Empty() {
+			return errIncompatFlags("nranks", "ranks")
+		}
+		req.NumRanks = cmd.NumRanks
+		req.TierRatio = cmd.TierRatio.Ratios()
+		req.TotalBytes = cmd.Size.bytes
+	case cmd.ScmSize.IsSet():
+		if cmd.NumRanks > 0 {
+			return errIncompatFlags("nranks", "scm-size")
+		}
+		if cmd.TierRatio.IsSet() {
+			return errIncompatFlags("tier-ratio", "scm-size")
+		}
+		req.TierBytes = []uint64{cmd.ScmSize.bytes, cmd.NVMeSize.bytes}
+	default:
+		return errors.New("either --size or --scm-size must be set")
+	}
+
+	return nil
+}
+
+// Execute is run when PoolPlanCmd subcommand is activated
+func (cmd *PoolPlanCmd) Execute(_ []string) error {
+	req := &control.PoolPlanReq{
+		Ranks:      cmd.RankList.Ranks(),
+		RedunFac:   cmd.RedunFac,
+		RedunLevel: cmd.RedunLevel,
+	}
+	if err := cmd.setSizeArgs(req); err != nil {
+		return err
+	}
+
+	resp, err := control.PoolPlan(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolPlanResponse(resp, &bld); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
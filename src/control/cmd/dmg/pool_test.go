@@ -1085,6 +1085,51 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Plan pool with total size",
+			fmt.Sprintf("pool plan --size %s --nranks 2 --rd-fac 1", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.SystemQueryReq{}),
+				printRequest(t, &control.StorageScanReq{Usage: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"Plan pool with missing size",
+			"pool plan",
+			"",
+			errors.New("must be set"),
+		},
+		{
+			"Plan pool with both size and scm-size",
+			fmt.Sprintf("pool plan --size %s --scm-size %s", testSizeStr, testSizeStr),
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Plan pool with percentage size and nranks",
+			"pool plan --size 50% --nranks 2",
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Plan pool with scm-size and tier-ratio",
+			fmt.Sprintf("pool plan --scm-size %s --tier-ratio 10", testSizeStr),
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Plan pool with invalid rd-fac",
+			fmt.Sprintf("pool plan --size %s --rd-fac 5", testSizeStr),
+			"",
+			errors.New("invalid rd_fac"),
+		},
+		{
+			"Plan pool with invalid rd-lvl",
+			fmt.Sprintf("pool plan --size %s --rd-lvl rack", testSizeStr),
+			"",
+			errors.New("Invalid value"),
+		},
 		{
 			"Nonexistent subcommand",
 			"pool quack",
@@ -0,0 +1,100 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// auditResult returns a short description of the result of an audited
+// operation.
+func auditResult(rec *control.AuditRecord) string {
+	if rec.Denied {
+		return "denied"
+	}
+	if rec.Error != "" {
+		return "failed"
+	}
+	return "ok"
+}
+
+// printAuditDropped notes any records discarded from the audit log due to the
+// retention limit.
+func printAuditDropped(out io.Writer, dropped uint64) {
+	if dropped > 0 {
+		fmt.Fprintf(out, "%d older audit record(s) discarded due to the retention limit\n", dropped)
+	}
+}
+
+// PrintSystemGetAuditResponse generates a human-readable representation of
+// the supplied SystemGetAuditResp struct and writes it to the supplied
+// io.Writer.
+func PrintSystemGetAuditResponse(out io.Writer, resp *control.SystemGetAuditResp, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Records) == 0 {
+		fmt.Fprintln(out, "No audit records found")
+		printAuditDropped(out, resp.Dropped)
+		return nil
+	}
+
+	if getPrintConfig(opts...).Verbose {
+		for _, rec := range resp.Records {
+			rows := []txtfmt.TableRow{
+				{"Time": common.FormatTime(rec.Time)},
+				{"Operation": rec.Operation},
+				{"Component": rec.Component},
+				{"Role": rec.Role},
+				{"Subject": rec.Subject},
+				{"Address": rec.Address},
+				{"Request": rec.Request},
+				{"Result": auditResult(rec)},
+			}
+			if rec.Error != "" {
+				rows = append(rows, txtfmt.TableRow{"Error": rec.Error})
+			}
+			fmt.Fprintln(out, txtfmt.FormatEntity(fmt.Sprintf("Audit record %d", rec.ID), rows))
+		}
+		printAuditDropped(out, resp.Dropped)
+		return nil
+	}
+
+	tsTitle := "Timestamp"
+	opTitle := "Operation"
+	subjectTitle := "Subject"
+	roleTitle := "Role"
+	addrTitle := "Address"
+	resultTitle := "Result"
+
+	formatter := txtfmt.NewTableFormatter(tsTitle, opTitle, subjectTitle, roleTitle, addrTitle, resultTitle)
+	var table []txtfmt.TableRow
+
+	for _, rec := range resp.Records {
+		table = append(table, txtfmt.TableRow{
+			tsTitle:      common.FormatTime(rec.Time),
+			opTitle:      rec.Operation,
+			subjectTitle: rec.Subject,
+			roleTitle:    rec.Role,
+			addrTitle:    rec.Address,
+			resultTitle:  auditResult(rec),
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+	printAuditDropped(out, resp.Dropped)
+
+	return nil
+}
@@ -0,0 +1,134 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintSystemGetAuditResponse(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	records := []*control.AuditRecord{
+		{
+			ID:        1,
+			Time:      start,
+			Operation: "PoolCreate",
+			Component: "admin",
+			Role:      "admin",
+			Subject:   "CN=admin,OU=storage admins",
+			Address:   "10.0.0.1:4321",
+		},
+		{
+			ID:        2,
+			Time:      start.Add(time.Hour),
+			Operation: "SystemStop",
+			Component: "admin",
+			Role:      "operator",
+			Subject:   "CN=admin,OU=ops",
+			Address:   "10.0.0.2:4321",
+			Request:   `{"ranks":"0-3"}`,
+			Error:     "rank 3 busy",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemGetAuditResp
+		verbose     bool
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no records": {
+			resp: &control.SystemGetAuditResp{},
+			expPrintStr: `
+No audit records found
+`,
+		},
+		"records": {
+			resp: &control.SystemGetAuditResp{Records: records},
+			expPrintStr: `
+Timestamp                     Operation  Subject                    Role     Address       Result 
+---------                     ---------  -------                    ----     -------       ------ 
+2024-05-01T12:00:00.000+00:00 PoolCreate CN=admin,OU=storage admins admin    10.0.0.1:4321 ok     
+2024-05-01T13:00:00.000+00:00 SystemStop CN=admin,OU=ops            operator 10.0.0.2:4321 failed 
+
+`,
+		},
+		"records dropped": {
+			resp: &control.SystemGetAuditResp{Records: records[:1], Dropped: 3},
+			expPrintStr: `
+Timestamp                     Operation  Subject                    Role  Address       Result 
+---------                     ---------  -------                    ----  -------       ------ 
+2024-05-01T12:00:00.000+00:00 PoolCreate CN=admin,OU=storage admins admin 10.0.0.1:4321 ok     
+
+3 older audit record(s) discarded due to the retention limit
+`,
+		},
+		"denied": {
+			resp: &control.SystemGetAuditResp{Records: []*control.AuditRecord{
+				{
+					ID:        3,
+					Time:      start.Add(2 * time.Hour),
+					Operation: "PoolDestroy",
+					Component: "admin",
+					Role:      "viewer",
+					Subject:   "CN=admin",
+					Address:   "10.0.0.3:4321",
+					Error:     "role viewer does not have permission to call /mgmt.MgmtSvc/PoolDestroy",
+					Denied:    true,
+				},
+			}},
+			expPrintStr: `
+Timestamp                     Operation   Subject  Role   Address       Result 
+---------                     ---------   -------  ----   -------       ------ 
+2024-05-01T14:00:00.000+00:00 PoolDestroy CN=admin viewer 10.0.0.3:4321 denied 
+
+`,
+		},
+		"records verbose": {
+			resp:    &control.SystemGetAuditResp{Records: records[1:]},
+			verbose: true,
+			expPrintStr: `
+Audit record 2
+--------------
+  Time      : 2024-05-01T13:00:00.000+00:00
+  Operation : SystemStop                   
+  Component : admin                        
+  Role      : operator                     
+  Subject   : CN=admin,OU=ops              
+  Address   : 10.0.0.2:4321                
+  Request   : {"ranks":"0-3"}              
+  Result    : failed                       
+  Error     : rank 3 busy                  
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintSystemGetAuditResponse(&bld, tc.resp, PrintWithVerboseOutput(tc.verbose))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
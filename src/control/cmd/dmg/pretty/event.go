@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// eventRank returns the rank associated with an event, or "-" if there is
+// none.
+func eventRank(evt *events.RASEvent) string {
+	if ranklist.Rank(evt.Rank) == ranklist.NilRank {
+		return "-"
+	}
+	return fmt.Sprintf("%d", evt.Rank)
+}
+
+// PrintSystemGetEventsResponse generates a human-readable representation of
+// the supplied SystemGetEventsResp struct and writes it to the supplied
+// io.Writer.
+func PrintSystemGetEventsResponse(out io.Writer, resp *control.SystemGetEventsResp, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Events) == 0 {
+		fmt.Fprintln(out, "No events found")
+		return nil
+	}
+
+	if getPrintConfig(opts...).Verbose {
+		for _, evt := range resp.Events {
+			fmt.Fprintln(out, evt.PrintRAS())
+		}
+		return nil
+	}
+
+	tsTitle := "Timestamp"
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	sevTitle := "Severity"
+	idTitle := "Event"
+	msgTitle := "Message"
+
+	formatter := txtfmt.NewTableFormatter(tsTitle, hostTitle, rankTitle, sevTitle, idTitle, msgTitle)
+	var table []txtfmt.TableRow
+
+	for _, evt := range resp.Events {
+		table = append(table, txtfmt.TableRow{
+			tsTitle:   evt.Timestamp,
+			hostTitle: evt.Hostname,
+			rankTitle: eventRank(evt),
+			sevTitle:  evt.Severity.String(),
+			idTitle:   evt.ID.String(),
+			msgTitle:  evt.Msg,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
+
+// PrintRASEvent writes a human-readable representation of a single RAS event
+// to the supplied io.Writer, on one line unless verbose output is requested.
+func PrintRASEvent(out io.Writer, evt *events.RASEvent, opts ...PrintConfigOption) error {
+	if evt == nil {
+		return errors.Errorf("nil %T", evt)
+	}
+
+	if getPrintConfig(opts...).Verbose {
+		fmt.Fprintln(out, evt.PrintRAS())
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s %s rank %s %s %s: %s\n", evt.Timestamp, evt.Hostname, eventRank(evt),
+		evt.Severity, evt.ID, evt.Msg)
+
+	return nil
+}
+
+// PrintEventPolicies writes a table of RAS event policies to the supplied
+// io.Writer.
+func PrintEventPolicies(out io.Writer, policies []*control.EventPolicy) {
+	if len(policies) == 0 {
+		fmt.Fprintln(out, "No event policies set")
+		return
+	}
+
+	idTitle := "Event"
+	numTitle := "ID"
+	disabledTitle := "Disabled"
+	debounceTitle := "Debounce"
+
+	formatter := txtfmt.NewTableFormatter(idTitle, numTitle, disabledTitle, debounceTitle)
+	var table []txtfmt.TableRow
+
+	for _, p := range policies {
+		debounce := "-"
+		switch {
+		case p.Debounce && p.Cooldown == 0:
+			debounce = "once"
+		case p.Debounce:
+			debounce = p.Cooldown.String()
+		}
+
+		table = append(table, txtfmt.TableRow{
+			idTitle:       p.ID.String(),
+			numTitle:      fmt.Sprintf("%d", p.ID),
+			disabledTitle: fmt.Sprintf("%t", p.Disabled),
+			debounceTitle: debounce,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
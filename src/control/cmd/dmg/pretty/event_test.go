@@ -0,0 +1,180 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestPretty_PrintSystemGetEventsResponse(t *testing.T) {
+	evtDied := &events.RASEvent{
+		ID:        events.RASEngineDied,
+		Timestamp: "2024-01-02T03:04:05.678+00:00",
+		Type:      events.RASTypeStateChange,
+		Severity:  events.RASSeverityError,
+		Msg:       "DAOS engine 0 exited unexpectedly: test",
+		Hostname:  "foo-1",
+		Rank:      1,
+	}
+	evtNoRank := &events.RASEvent{
+		ID:        events.RASSystemFabricProvChanged,
+		Timestamp: "2024-01-02T03:04:06.000+00:00",
+		Type:      events.RASTypeInfoOnly,
+		Severity:  events.RASSeverityNotice,
+		Msg:       "system fabric provider has changed",
+		Hostname:  "foo-2",
+		Rank:      uint32(ranklist.NilRank),
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemGetEventsResp
+		verbose     bool
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no events": {
+			resp: &control.SystemGetEventsResp{},
+			expPrintStr: `
+No events found
+`,
+		},
+		"events": {
+			resp: &control.SystemGetEventsResp{
+				Events: []*events.RASEvent{evtDied, evtNoRank},
+			},
+			expPrintStr: `
+Timestamp                     Host  Rank Severity Event                          Message                                 
+---------                     ----  ---- -------- -----                          -------                                 
+2024-01-02T03:04:05.678+00:00 foo-1 1    ERROR    engine_died                    DAOS engine 0 exited unexpectedly: test 
+2024-01-02T03:04:06.000+00:00 foo-2 -    NOTICE   system_fabric_provider_changed system fabric provider has changed      
+
+`,
+		},
+		"events verbose": {
+			resp: &control.SystemGetEventsResp{
+				Events: []*events.RASEvent{evtDied, evtNoRank},
+			},
+			verbose: true,
+			expPrintStr: `
+` + evtDied.PrintRAS() + `
+` + evtNoRank.PrintRAS() + `
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintSystemGetEventsResponse(&bld, tc.resp, PrintWithVerboseOutput(tc.verbose))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintRASEvent(t *testing.T) {
+	evt := &events.RASEvent{
+		ID:        events.RASSwimRankDead,
+		Timestamp: "2024-01-02T03:04:05.678+00:00",
+		Type:      events.RASTypeStateChange,
+		Severity:  events.RASSeverityNotice,
+		Msg:       "SWIM marked rank as dead.",
+		Hostname:  "foo-1",
+		Rank:      3,
+	}
+
+	for name, tc := range map[string]struct {
+		evt         *events.RASEvent
+		verbose     bool
+		expPrintStr string
+		expErr      error
+	}{
+		"nil event": {
+			expErr: errors.New("nil"),
+		},
+		"event": {
+			evt: evt,
+			expPrintStr: `
+2024-01-02T03:04:05.678+00:00 foo-1 rank 3 NOTICE swim_rank_dead: SWIM marked rank as dead.
+`,
+		},
+		"event verbose": {
+			evt:     evt,
+			verbose: true,
+			expPrintStr: `
+` + evt.PrintRAS() + `
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintRASEvent(&bld, tc.evt, PrintWithVerboseOutput(tc.verbose))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintEventPolicies(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policies    []*control.EventPolicy
+		expPrintStr string
+	}{
+		"no policies": {
+			expPrintStr: `
+No event policies set
+`,
+		},
+		"policies": {
+			policies: []*control.EventPolicy{
+				{ID: events.RASEngineFormatRequired, Disabled: true},
+				{ID: events.RASNVMeLinkSpeedChanged, Debounce: true, Cooldown: 5 * time.Minute},
+				{ID: events.RASNVMeLinkWidthChanged, Debounce: true},
+			},
+			expPrintStr: `
+Event                     ID Disabled Debounce 
+-----                     -- -------- -------- 
+engine_format_required    1  true     -        
+device_link_speed_changed 25 false    5m0s     
+device_link_width_changed 26 false    once     
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintEventPolicies(&bld, tc.policies)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
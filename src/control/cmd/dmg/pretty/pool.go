@@ -9,13 +9,17 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	pretty "github.com/daos-stack/daos/src/control/cmd/daos/pretty"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
 
@@ -137,6 +141,15 @@ func PrintListPoolsResponse(out, outErr io.Writer, resp *control.ListPoolsResp,
 	return pretty.PrintPoolList(queriedPools, out, verbose)
 }
 
+// TabulateListPoolsResponse returns a tabular representation of the pools in
+// the supplied ListPoolsResp struct for machine-readable output.
+func TabulateListPoolsResponse(resp *control.ListPoolsResp) *cmdutil.Table {
+	if resp == nil {
+		return pretty.TabulatePoolList(nil)
+	}
+	return pretty.TabulatePoolList(resp.Pools)
+}
+
 // PrintPoolProperties displays a two-column table of pool property names and values.
 func PrintPoolProperties(poolID string, out io.Writer, properties ...*daos.PoolProperty) {
 	fmt.Fprintf(out, "Pool %s properties:\n", poolID)
@@ -158,3 +171,50 @@ func PrintPoolProperties(poolID string, out io.Writer, properties ...*daos.PoolP
 	tf.InitWriter(out)
 	tf.Format(table)
 }
+
+func formatPoolOpTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return common.FormatTime(t)
+}
+
+// PrintPoolOps generates a human-readable representation of the supplied
+// queued pool operations and writes it to the supplied io.Writer.
+func PrintPoolOps(out io.Writer, ops []*control.PoolOp) {
+	if len(ops) == 0 {
+		fmt.Fprintln(out, "No pool operations queued.")
+		return
+	}
+
+	idTitle := "ID"
+	poolTitle := "Pool"
+	typeTitle := "Operation"
+	ranksTitle := "Ranks"
+	stateTitle := "State"
+	startTitle := "Not Before"
+	endTitle := "Not After"
+	errTitle := "Error"
+	formatter := txtfmt.NewTableFormatter(idTitle, poolTitle, typeTitle, ranksTitle, stateTitle,
+		startTitle, endTitle, errTitle)
+
+	var table []txtfmt.TableRow
+	for _, op := range ops {
+		errStr := op.Error
+		if errStr == "" {
+			errStr = "-"
+		}
+		table = append(table, txtfmt.TableRow{
+			idTitle:    op.ID,
+			poolTitle:  op.PoolUUID,
+			typeTitle:  op.Type,
+			ranksTitle: ranklist.RankSetFromRanks(op.Ranks).RangedString(),
+			stateTitle: op.State,
+			startTitle: formatPoolOpTime(op.NotBefore),
+			endTitle:   formatPoolOpTime(op.NotAfter),
+			errTitle:   errStr,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
@@ -0,0 +1,118 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func printPoolPlanRanks(ranks []*control.PoolPlanRank, out io.Writer) {
+	rankTitle := "Rank"
+	domainTitle := "Fault Domain"
+	scmFreeTitle := "SCM Free"
+	scmUsedTitle := "SCM Used"
+	scmLeftTitle := "SCM Left"
+	nvmeFreeTitle := "NVMe Free"
+	nvmeUsedTitle := "NVMe Used"
+	nvmeLeftTitle := "NVMe Left"
+
+	tablePrint := txtfmt.NewTableFormatter(rankTitle, domainTitle, scmFreeTitle, scmUsedTitle,
+		scmLeftTitle, nvmeFreeTitle, nvmeUsedTitle, nvmeLeftTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	for _, r := range ranks {
+		table = append(table, txtfmt.TableRow{
+			rankTitle:     r.Rank.String(),
+			domainTitle:   r.FaultDomain,
+			scmFreeTitle:  humanize.Bytes(r.ScmFree),
+			scmUsedTitle:  humanize.Bytes(r.ScmBytes),
+			scmLeftTitle:  humanize.Bytes(r.ScmHeadroom()),
+			nvmeFreeTitle: humanize.Bytes(r.NvmeFree),
+			nvmeUsedTitle: humanize.Bytes(r.NvmeBytes),
+			nvmeLeftTitle: humanize.Bytes(r.NvmeHeadroom()),
+		})
+	}
+
+	tablePrint.Format(table)
+}
+
+// PrintPoolPlanResponse generates a human-readable representation of the
+// supplied PoolPlanResp struct and writes it to the supplied io.Writer. The
+// summary of the simulated pool is followed by the reasons it would not fit, if
+// any, and the space it would consume on each rank.
+func PrintPoolPlanResponse(ppr *control.PoolPlanResp, out io.Writer) error {
+	if ppr == nil {
+		return errors.New("nil response")
+	}
+
+	title := "Pool plan is feasible"
+	if !ppr.Feasible {
+		title = "Pool plan is NOT feasible"
+	}
+
+	numRanks := uint64(len(ppr.Ranks))
+	if numRanks == 0 || len(ppr.TierBytes) == 0 {
+		fmt.Fprintln(out, title)
+		for _, reason := range ppr.Reasons {
+			fmt.Fprintf(out, "  - %s\n", reason)
+		}
+		return nil
+	}
+
+	var rankBytes uint64
+	for _, tierBytes := range ppr.TierBytes {
+		rankBytes += tierBytes
+	}
+
+	fmtArgs := make([]txtfmt.TableRow, 0, 7)
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{
+		"Storage Ranks": ranklist.RankSetFromRanks(ppr.RankList()).RangedString(),
+	})
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Total Size": humanize.Bytes(rankBytes * numRanks)})
+	tierName := "SCM"
+	for tierIdx, tierBytes := range ppr.TierBytes {
+		if tierIdx > 0 {
+			tierName = "NVMe"
+		}
+		fmtName := fmt.Sprintf("Storage tier %d (%s)", tierIdx, tierName)
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{
+			fmtName: fmt.Sprintf("%s (%s / rank)", humanize.Bytes(tierBytes*numRanks),
+				humanize.Bytes(tierBytes)),
+		})
+	}
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{
+		"Redundancy": fmt.Sprintf("rd_fac:%d across %d %s fault domain(s)", ppr.RedunFac,
+			ppr.FaultDomains, ppr.RedunLevel),
+	})
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{
+		"Rebuild Headroom": fmt.Sprintf("%d rank(s) lost on rd_fac failures, fill below %.0f%%",
+			ppr.RebuildRanks, ppr.RebuildFillLimit*100),
+	})
+
+	fmt.Fprintln(out, txtfmt.FormatEntity(title, fmtArgs))
+
+	if len(ppr.Reasons) > 0 {
+		fmt.Fprintln(out, "Reasons:")
+		for _, reason := range ppr.Reasons {
+			fmt.Fprintf(out, "  - %s\n", reason)
+		}
+		fmt.Fprintln(out)
+	}
+
+	printPoolPlanRanks(ppr.Ranks, out)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestPretty_PrintPoolPlanResponse(t *testing.T) {
+	planRank := func(rank ranklist.Rank, domain string) *control.PoolPlanRank {
+		return &control.PoolPlanRank{
+			Rank:        rank,
+			FaultDomain: domain,
+			ScmFree:     100 * humanize.GByte,
+			NvmeFree:    1 * humanize.TByte,
+			ScmBytes:    25 * humanize.GByte,
+			NvmeBytes:   375 * humanize.GByte,
+		}
+	}
+	planResp := func(reasons ...string) *control.PoolPlanResp {
+		return &control.PoolPlanResp{
+			Feasible: len(reasons) == 0,
+			Reasons:  reasons,
+			Ranks: []*control.PoolPlanRank{
+				planRank(0, "/host1"),
+				planRank(1, "/host1"),
+				planRank(2, "/host2"),
+				planRank(3, "/host3"),
+			},
+			TierBytes:        []uint64{25 * humanize.GByte, 375 * humanize.GByte},
+			RedunFac:         1,
+			RedunLevel:       control.PoolRedunLevelNode,
+			FaultDomains:     3,
+			RebuildRanks:     2,
+			RebuildFillLimit: 0.5,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		resp     *control.PoolPlanResp
+		expErr   error
+		expPrint string
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"no ranks": {
+			resp: &control.PoolPlanResp{
+				Reasons: []string{"no ranks available to create pool on"},
+			},
+			expPrint: `
+Pool plan is NOT feasible
+  - no ranks available to create pool on
+`,
+		},
+		"feasible": {
+			resp: planResp(),
+			expPrint: `
+Pool plan is feasible
+---------------------
+  Storage Ranks        : [0-3]                                            
+  Total Size           : 1.6 TB                                           
+  Storage tier 0 (SCM) : 100 GB (25 GB / rank)                            
+  Storage tier 1 (NVMe): 1.5 TB (375 GB / rank)                           
+  Redundancy           : rd_fac:1 across 3 node fault domain(s)           
+  Rebuild Headroom     : 2 rank(s) lost on rd_fac failures, fill below 50%
+
+Rank Fault Domain SCM Free SCM Used SCM Left NVMe Free NVMe Used NVMe Left 
+---- ------------ -------- -------- -------- --------- --------- --------- 
+0    /host1       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+1    /host1       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+2    /host2       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+3    /host3       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+`,
+		},
+		"not feasible": {
+			resp: planResp("rank 3: 600 GB SCM required but 100 GB free"),
+			expPrint: `
+Pool plan is NOT feasible
+-------------------------
+  Storage Ranks        : [0-3]                                            
+  Total Size           : 1.6 TB                                           
+  Storage tier 0 (SCM) : 100 GB (25 GB / rank)                            
+  Storage tier 1 (NVMe): 1.5 TB (375 GB / rank)                           
+  Redundancy           : rd_fac:1 across 3 node fault domain(s)           
+  Rebuild Headroom     : 2 rank(s) lost on rd_fac failures, fill below 50%
+
+Reasons:
+  - rank 3: 600 GB SCM required but 100 GB free
+
+Rank Fault Domain SCM Free SCM Used SCM Left NVMe Free NVMe Used NVMe Left 
+---- ------------ -------- -------- -------- --------- --------- --------- 
+0    /host1       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+1    /host1       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+2    /host2       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+3    /host3       100 GB   25 GB    75 GB    1.0 TB    375 GB    625 GB    
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolPlanResponse(tc.resp, &bld)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrint, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -699,3 +700,52 @@ one  6.0 TB Ready 83%%  16%%       0/16
 		})
 	}
 }
+
+func TestPretty_PrintPoolOps(t *testing.T) {
+	notBefore := time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		ops    []*control.PoolOp
+		expOut string
+	}{
+		"no ops": {
+			expOut: "No pool operations queued.\n",
+		},
+		"ops": {
+			ops: []*control.PoolOp{
+				{
+					ID:        test.MockUUID(1),
+					PoolUUID:  test.MockUUID(0),
+					Type:      "drain",
+					State:     "queued",
+					Ranks:     []ranklist.Rank{1, 2, 3},
+					NotBefore: notBefore,
+				},
+				{
+					ID:       test.MockUUID(2),
+					PoolUUID: test.MockUUID(0),
+					Type:     "exclude",
+					State:    "failed",
+					Ranks:    []ranklist.Rank{5},
+					Error:    "bad things",
+				},
+			},
+			expOut: `
+ID                                   Pool                                 Operation Ranks State  Not Before                    Not After Error      
+--                                   ----                                 --------- ----- -----  ----------                    --------- -----      
+00000001-0001-0001-0001-000000000001 00000000-0000-0000-0000-000000000000 drain     [1-3] queued 2024-03-01T02:00:00.000+00:00 -         -          
+00000002-0002-0002-0002-000000000002 00000000-0000-0000-0000-000000000000 exclude   5     failed -                             -         bad things 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolOps(&bld, tc.ops)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
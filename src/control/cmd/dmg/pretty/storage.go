@@ -9,11 +9,13 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -296,6 +298,49 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 	return w.Err
 }
 
+// TabulateSmdDevices returns a tabular representation of the SMD devices in
+// the supplied HostStorageMap for machine-readable output. Each row includes
+// the set of hosts that reported the device.
+func TabulateSmdDevices(hsm control.HostStorageMap) *cmdutil.Table {
+	table := &cmdutil.Table{
+		Header: []string{"hosts", "uuid", "rank", "tgt_ids", "roles", "has_sys_xs",
+			"pci_addr", "ctrlr_namespace_id", "dev_state", "led_state",
+			"total_bytes", "avail_bytes", "usable_bytes"},
+	}
+
+	for _, key := range hsm.Keys() {
+		hss := hsm[key]
+		if hss.HostStorage == nil || hss.HostStorage.SmdInfo == nil {
+			continue
+		}
+
+		for _, dev := range hss.HostStorage.SmdInfo.Devices {
+			tgtIDs := make([]string, 0, len(dev.TargetIDs))
+			for _, id := range dev.TargetIDs {
+				tgtIDs = append(tgtIDs, strconv.Itoa(int(id)))
+			}
+
+			table.Rows = append(table.Rows, []string{
+				hss.HostSet.RangedString(),
+				dev.UUID,
+				dev.Rank.String(),
+				strings.Join(tgtIDs, " "),
+				dev.Roles.String(),
+				strconv.FormatBool(dev.HasSysXS),
+				dev.Ctrlr.PciAddr,
+				strconv.FormatUint(uint64(dev.CtrlrNamespaceID), 10),
+				dev.Ctrlr.NvmeState.String(),
+				dev.Ctrlr.LedState.String(),
+				strconv.FormatUint(dev.TotalBytes, 10),
+				strconv.FormatUint(dev.AvailBytes, 10),
+				strconv.FormatUint(dev.UsableBytes, 10),
+			})
+		}
+	}
+
+	return table
+}
+
 // PrintSmdManageResp generates a human-readable representation of the supplied response.
 func PrintSmdManageResp(op control.SmdManageOpcode, resp *control.SmdResp, out, outErr io.Writer, opts ...PrintConfigOption) error {
 	switch op {
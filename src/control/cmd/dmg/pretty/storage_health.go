@@ -0,0 +1,120 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func wearString(wear float64) string {
+	if wear < 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.1f%%", wear*100)
+}
+
+func daysRemainingString(days float64) string {
+	if days < 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.1f", days)
+}
+
+func printNvmeHealthSamples(samples []*storage.NvmeHealthSample, out io.Writer) {
+	timeTitle := "Time"
+	wearTitle := "Wear"
+	nandTitle := "NAND Written"
+	hostTitle := "Host Written"
+	errsTitle := "Media Errors"
+	tempTitle := "Temperature"
+
+	tablePrint := txtfmt.NewTableFormatter(timeTitle, wearTitle, nandTitle, hostTitle,
+		errsTitle, tempTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	for _, s := range samples {
+		table = append(table, txtfmt.TableRow{
+			timeTitle: common.FormatTime(s.Time),
+			wearTitle: wearString(s.Wear()),
+			nandTitle: humanize.IBytes(s.NandBytesWritten),
+			hostTitle: humanize.IBytes(s.HostBytesWritten),
+			errsTitle: fmt.Sprintf("%d", s.MediaErrors),
+			tempTitle: fmt.Sprintf("%dK", s.Temperature),
+		})
+	}
+
+	tablePrint.Format(table)
+}
+
+// PrintNvmeHealthTrendMap generates a human-readable representation of the
+// supplied HostNvmeHealthTrendMap and writes it to the supplied io.Writer.
+// Devices estimated to reach their rated endurance within warnPeriod are
+// flagged. Sampled health history is printed for each device if present.
+func PrintNvmeHealthTrendMap(htm control.HostNvmeHealthTrendMap, warnPeriod time.Duration, out io.Writer, opts ...PrintConfigOption) error {
+	if len(htm) == 0 {
+		return nil
+	}
+
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	addrTitle := "TrAddr"
+	uuidTitle := "UUID"
+	wearTitle := "Wear"
+	rateTitle := "Write Rate"
+	daysTitle := "Days Left"
+	errsTitle := "New Media Errors"
+	statusTitle := "Status"
+
+	tablePrint := txtfmt.NewTableFormatter(hostTitle, rankTitle, addrTitle, uuidTitle,
+		wearTitle, rateTitle, daysTitle, errsTitle, statusTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	var withSamples []*control.NvmeHealthTrend
+	for _, host := range htm.Keys() {
+		for _, trend := range htm[host] {
+			status := "OK"
+			if trend.WearsOutWithin(warnPeriod) {
+				status = "WEAR-OUT"
+			}
+			table = append(table, txtfmt.TableRow{
+				hostTitle:   getPrintHosts(host, opts...),
+				rankTitle:   trend.Rank.String(),
+				addrTitle:   trend.TrAddr,
+				uuidTitle:   trend.UUID,
+				wearTitle:   wearString(trend.Wear),
+				rateTitle:   humanize.IBytes(uint64(trend.WriteRate*24*60*60)) + "/day",
+				daysTitle:   daysRemainingString(trend.DaysRemaining),
+				errsTitle:   fmt.Sprintf("%d", trend.NewMediaErrors),
+				statusTitle: status,
+			})
+			if len(trend.Samples) > 0 {
+				withSamples = append(withSamples, trend)
+			}
+		}
+	}
+
+	tablePrint.Format(table)
+
+	for _, trend := range withSamples {
+		fmt.Fprintf(out, "\nHealth samples for %s (rank %d, %s):\n", trend.UUID, trend.Rank,
+			trend.TrAddr)
+		printNvmeHealthSamples(trend.Samples, out)
+	}
+
+	return nil
+}
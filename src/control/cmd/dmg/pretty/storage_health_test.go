@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestPretty_PrintNvmeHealthTrendMap(t *testing.T) {
+	gib := float64(1 << 30)
+	sampleTime := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		htm         control.HostNvmeHealthTrendMap
+		expPrintStr string
+	}{
+		"empty": {},
+		"multiple hosts": {
+			htm: control.HostNvmeHealthTrendMap{
+				"host1": {
+					{
+						NvmeWearEstimate: storage.NvmeWearEstimate{
+							Wear:          0.1,
+							WriteRate:     gib / (24 * 60 * 60),
+							DaysRemaining: 900,
+						},
+						UUID:   test.MockUUID(0),
+						TrAddr: "0000:01:00.0",
+						Rank:   0,
+					},
+					{
+						NvmeWearEstimate: storage.NvmeWearEstimate{
+							Wear:           0.95,
+							WriteRate:      gib / (24 * 60 * 60),
+							DaysRemaining:  5.5,
+							NewMediaErrors: 3,
+						},
+						UUID:   test.MockUUID(1),
+						TrAddr: "0000:81:00.0",
+						Rank:   1,
+						Samples: []*storage.NvmeHealthSample{
+							{
+								Time:             sampleTime,
+								MediaWearRaw:     95 * 1024,
+								NandBytesWritten: 1 << 40,
+								HostBytesWritten: 1 << 39,
+								MediaErrors:      3,
+								Temperature:      310,
+							},
+						},
+					},
+				},
+				"host2": {
+					{
+						NvmeWearEstimate: storage.NvmeWearEstimate{
+							Wear:          -1,
+							DaysRemaining: -1,
+						},
+						UUID:   test.MockUUID(2),
+						TrAddr: "0000:02:00.0",
+						Rank:   2,
+					},
+				},
+			},
+			expPrintStr: `
+Host  Rank TrAddr       UUID                                 Wear  Write Rate  Days Left New Media Errors Status   
+----  ---- ------       ----                                 ----  ----------  --------- ---------------- ------   
+host1 0    0000:01:00.0 00000000-0000-0000-0000-000000000000 10.0% 1.0 GiB/day 900.0     0                OK       
+host1 1    0000:81:00.0 00000001-0001-0001-0001-000000000001 95.0% 1.0 GiB/day 5.5       3                WEAR-OUT 
+host2 2    0000:02:00.0 00000002-0002-0002-0002-000000000002 N/A   0 B/day     N/A       0                OK       
+
+Health samples for 00000001-0001-0001-0001-000000000001 (rank 1, 0000:81:00.0):
+Time                          Wear  NAND Written Host Written Media Errors Temperature 
+----                          ----  ------------ ------------ ------------ ----------- 
+2024-03-01T12:00:00.000+00:00 95.0% 1.0 TiB      512 GiB      3            310K        
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintNvmeHealthTrendMap(tc.htm, 30*24*time.Hour, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
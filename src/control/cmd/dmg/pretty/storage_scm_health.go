@@ -0,0 +1,152 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func scmUsageString(esh *control.EngineScmHealth) string {
+	if !esh.Mounted() {
+		return "not mounted"
+	}
+	used := esh.TotalBytes - esh.AvailBytes
+	return fmt.Sprintf("%s / %s (%s)", humanize.IBytes(used), humanize.IBytes(esh.TotalBytes),
+		common.PercentageString(used, esh.TotalBytes))
+}
+
+func scmSizeString(esh *control.EngineScmHealth) string {
+	if esh.ScmSize == 0 {
+		return "N/A"
+	}
+	return humanize.IBytes(esh.ScmSize)
+}
+
+type scmNamespaceOwner struct {
+	host string
+	esh  *control.EngineScmHealth
+	ns   *storage.ScmNamespaceHealth
+}
+
+func printScmBadBlocks(owner *scmNamespaceOwner, out io.Writer) {
+	fmt.Fprintf(out, "\nBad blocks on %s (rank %s, %s):\n", owner.ns.BlockDevice,
+		owner.esh.Rank.String(), owner.host)
+
+	offsetTitle := "Offset"
+	lengthTitle := "Length"
+	dimmsTitle := "Modules"
+
+	tablePrint := txtfmt.NewTableFormatter(offsetTitle, lengthTitle, dimmsTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	for _, bb := range owner.ns.BadBlocks {
+		table = append(table, txtfmt.TableRow{
+			offsetTitle: fmt.Sprintf("%d", bb.Offset),
+			lengthTitle: fmt.Sprintf("%d", bb.Length),
+			dimmsTitle:  strings.Join(bb.Dimms, ","),
+		})
+	}
+
+	tablePrint.Format(table)
+}
+
+// PrintScmHealthMap generates a human-readable representation of the supplied
+// HostScmHealthMap and writes it to the supplied io.Writer. Usage of the SCM
+// mounted by each engine is printed first, followed by the health of any PMem
+// namespaces and the list of bad blocks on each namespace that has any.
+func PrintScmHealthMap(hsm control.HostScmHealthMap, out io.Writer, opts ...PrintConfigOption) error {
+	if len(hsm) == 0 {
+		return nil
+	}
+
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	classTitle := "Class"
+	mountTitle := "Mount"
+	sizeTitle := "Configured Size"
+	usageTitle := "Used"
+
+	tablePrint := txtfmt.NewTableFormatter(hostTitle, rankTitle, classTitle, mountTitle,
+		sizeTitle, usageTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	var namespaces []*scmNamespaceOwner
+	for _, host := range hsm.Keys() {
+		for _, esh := range hsm[host] {
+			table = append(table, txtfmt.TableRow{
+				hostTitle:  getPrintHosts(host, opts...),
+				rankTitle:  esh.Rank.String(),
+				classTitle: esh.Class.String(),
+				mountTitle: esh.Path,
+				sizeTitle:  scmSizeString(esh),
+				usageTitle: scmUsageString(esh),
+			})
+			for _, ns := range esh.Namespaces {
+				namespaces = append(namespaces, &scmNamespaceOwner{
+					host: getPrintHosts(host, opts...),
+					esh:  esh,
+					ns:   ns,
+				})
+			}
+		}
+	}
+
+	tablePrint.Format(table)
+
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	devTitle := "Block Device"
+	numaTitle := "Socket"
+	capTitle := "Capacity"
+	healthTitle := "Health"
+	shutdownsTitle := "Dirty Shutdowns"
+	badTitle := "Bad Sectors"
+
+	fmt.Fprintln(out)
+	tablePrint = txtfmt.NewTableFormatter(hostTitle, rankTitle, devTitle, numaTitle, capTitle,
+		healthTitle, shutdownsTitle, badTitle)
+	tablePrint.InitWriter(out)
+	table = []txtfmt.TableRow{}
+
+	var withBadBlocks []*scmNamespaceOwner
+	for _, owner := range namespaces {
+		table = append(table, txtfmt.TableRow{
+			hostTitle:      owner.host,
+			rankTitle:      owner.esh.Rank.String(),
+			devTitle:       owner.ns.BlockDevice,
+			numaTitle:      fmt.Sprintf("%d", owner.ns.NumaNode),
+			capTitle:       humanize.IBytes(owner.ns.Size),
+			healthTitle:    owner.ns.HealthState,
+			shutdownsTitle: fmt.Sprintf("%d", owner.ns.DirtyShutdowns),
+			badTitle:       fmt.Sprintf("%d", owner.ns.BadBlockCount()),
+		})
+		if len(owner.ns.BadBlocks) > 0 {
+			withBadBlocks = append(withBadBlocks, owner)
+		}
+	}
+
+	tablePrint.Format(table)
+
+	for _, owner := range withBadBlocks {
+		printScmBadBlocks(owner, out)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestPretty_PrintScmHealthMap(t *testing.T) {
+	ramEngine := &control.EngineScmHealth{
+		Rank:       ranklist.NilRank,
+		Class:      storage.ClassRam,
+		Path:       "/mnt/daos0",
+		ScmSize:    16 << 30,
+		TotalBytes: 16 << 30,
+		AvailBytes: 12 << 30,
+	}
+
+	for name, tc := range map[string]struct {
+		hsm         control.HostScmHealthMap
+		expPrintStr string
+	}{
+		"empty": {},
+		"ram only": {
+			hsm: control.HostScmHealthMap{
+				"host2": {ramEngine},
+			},
+			expPrintStr: `
+Host  Rank    Class Mount      Configured Size Used                    
+----  ----    ----- -----      --------------- ----                    
+host2 NilRank ram   /mnt/daos0 16 GiB          4.0 GiB / 16 GiB (25 %) 
+`,
+		},
+		"pmem with bad blocks": {
+			hsm: control.HostScmHealthMap{
+				"host1": {
+					{
+						Rank:       0,
+						Class:      storage.ClassDcpm,
+						Path:       "/mnt/daos0",
+						TotalBytes: 3 << 40,
+						AvailBytes: 2 << 40,
+						Namespaces: []*storage.ScmNamespaceHealth{
+							{
+								BlockDevice:    "pmem0",
+								Size:           3 << 40,
+								HealthState:    "non-critical",
+								DirtyShutdowns: 2,
+								BadBlocks: []*storage.ScmBadBlock{
+									{Offset: 8, Length: 2, Dimms: []string{"nmem0", "nmem2"}},
+									{Offset: 64, Length: 1, Dimms: []string{"nmem0"}},
+								},
+							},
+						},
+					},
+					{
+						InstanceIdx: 1,
+						Rank:        1,
+						Class:       storage.ClassDcpm,
+						Path:        "/mnt/daos1",
+						Namespaces: []*storage.ScmNamespaceHealth{
+							{
+								BlockDevice: "pmem1",
+								NumaNode:    1,
+								Size:        3 << 40,
+								HealthState: "ok",
+							},
+						},
+					},
+				},
+				"host2": {ramEngine},
+			},
+			expPrintStr: `
+Host  Rank    Class Mount      Configured Size Used                     
+----  ----    ----- -----      --------------- ----                     
+host1 0       dcpm  /mnt/daos0 N/A             1.0 TiB / 3.0 TiB (33 %) 
+host1 1       dcpm  /mnt/daos1 N/A             not mounted              
+host2 NilRank ram   /mnt/daos0 16 GiB          4.0 GiB / 16 GiB (25 %)  
+
+Host  Rank Block Device Socket Capacity Health       Dirty Shutdowns Bad Sectors 
+----  ---- ------------ ------ -------- ------       --------------- ----------- 
+host1 0    pmem0        0      3.0 TiB  non-critical 2               3           
+host1 1    pmem1        1      3.0 TiB  ok           0               0           
+
+Bad blocks on pmem0 (rank 0, host1):
+Offset Length Modules     
+------ ------ -------     
+8      2      nmem0,nmem2 
+64     1      nmem0       
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintScmHealthMap(tc.hsm, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -1892,3 +1893,79 @@ host[1-2]
 		})
 	}
 }
+
+func TestPretty_TabulateSmdDevices(t *testing.T) {
+	header := []string{"hosts", "uuid", "rank", "tgt_ids", "roles", "has_sys_xs",
+		"pci_addr", "ctrlr_namespace_id", "dev_state", "led_state",
+		"total_bytes", "avail_bytes", "usable_bytes"}
+
+	for name, tc := range map[string]struct {
+		hsm      control.HostStorageMap
+		expTable *cmdutil.Table
+	}{
+		"no hosts": {
+			hsm:      control.HostStorageMap{},
+			expTable: &cmdutil.Table{Header: header},
+		},
+		"devices": {
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						SmdInfo: &control.SmdInfo{
+							Devices: []*storage.SmdDevice{
+								{
+									UUID:             test.MockUUID(0),
+									TargetIDs:        []int32{0, 1, 2},
+									HasSysXS:         true,
+									Roles:            storage.BdevRoles{storage.BdevRoleWAL},
+									TotalBytes:       1000,
+									AvailBytes:       800,
+									UsableBytes:      700,
+									CtrlrNamespaceID: 1,
+									Ctrlr: storage.NvmeController{
+										PciAddr:   "0000:8a:00.0",
+										NvmeState: storage.NvmeStateNew,
+										LedState:  storage.LedStateNormal,
+									},
+								},
+								{
+									UUID:      test.MockUUID(1),
+									TargetIDs: []int32{3, 4, 5},
+									Rank:      1,
+									Roles:     storage.BdevRoles{storage.BdevRoleMeta | storage.BdevRoleData},
+									Ctrlr: storage.NvmeController{
+										PciAddr:   "0000:8b:00.0",
+										NvmeState: storage.NvmeStateFaulty,
+										LedState:  storage.LedStateFaulty,
+									},
+								},
+							},
+						},
+					},
+				},
+				&mockHostStorage{
+					"host2",
+					&control.HostStorage{},
+				},
+			),
+			expTable: &cmdutil.Table{
+				Header: header,
+				Rows: [][]string{
+					{"host1", test.MockUUID(0), "0", "0 1 2", "wal", "true",
+						"0000:8a:00.0", "1", "NEW", "OFF", "1000", "800", "700"},
+					{"host1", test.MockUUID(1), "1", "3 4 5", "data,meta", "false",
+						"0000:8b:00.0", "0", "EVICTED", "ON", "0", "0", "0"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTable := TabulateSmdDevices(tc.hsm)
+
+			if diff := cmp.Diff(tc.expTable, gotTable); diff != "" {
+				t.Fatalf("unexpected table (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -9,11 +9,15 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -112,6 +116,39 @@ func printSystemQueryVerbose(out io.Writer, members system.Members) {
 	fmt.Fprintln(out, formatter.Format(table))
 }
 
+// TabulateSystemQueryResponse returns a tabular representation of the members
+// in the supplied SystemQueryResp struct for machine-readable output.
+func TabulateSystemQueryResponse(resp *control.SystemQueryResp) *cmdutil.Table {
+	table := &cmdutil.Table{
+		Header: []string{"rank", "uuid", "addr", "fabric_uri", "fault_domain",
+			"state", "info", "incarnation", "last_update"},
+	}
+	if resp == nil {
+		return table
+	}
+
+	for _, m := range resp.Members {
+		var lastUpdate string
+		if !m.LastUpdate.IsZero() {
+			lastUpdate = m.LastUpdate.Format(time.RFC3339)
+		}
+
+		table.Rows = append(table.Rows, []string{
+			m.Rank.String(),
+			m.UUID.String(),
+			m.Addr.String(),
+			m.PrimaryFabricURI,
+			m.FaultDomain.String(),
+			strings.ToLower(m.State.String()),
+			m.Info,
+			strconv.FormatUint(m.Incarnation, 10),
+			lastUpdate,
+		})
+	}
+
+	return table
+}
+
 // PrintSystemQueryResponse generates a human-readable representation of the supplied
 // SystemQueryResp struct and writes it to the supplied io.Writer.
 func PrintSystemQueryResponse(out, outErr io.Writer, resp *control.SystemQueryResp, opts ...PrintConfigOption) error {
@@ -229,3 +266,219 @@ func PrintSystemCleanupResponse(out, outErr io.Writer, resp *control.SystemClean
 	fmt.Fprintln(out, "System Cleanup Success")
 	return nil
 }
+
+func formatFindingIDs(ids []uint64) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = fmt.Sprintf("0x%x", id)
+	}
+	return strings.Join(strs, ",")
+}
+
+// PrintSystemDBRestoreResp generates a human-readable representation of the
+// supplied SystemDBRestoreResp struct, describing how the snapshot differs
+// from the running system, and writes it to the supplied io.Writer.
+func PrintSystemDBRestoreResp(out io.Writer, resp *control.SystemDBRestoreResp) {
+	if resp == nil {
+		return
+	}
+
+	fmt.Fprintln(out, txtfmt.FormatEntity("System database snapshot", []txtfmt.TableRow{
+		{"Index": fmt.Sprintf("%d", resp.Index)},
+		{"Term": fmt.Sprintf("%d", resp.Term)},
+		{"Database Version": fmt.Sprintf("%d", resp.Version)},
+		{"Map Version": fmt.Sprintf("%d", resp.MapVersion)},
+	}))
+
+	if !resp.HasDifferences() {
+		fmt.Fprintln(out, "Snapshot matches the running system")
+		return
+	}
+
+	diffTitle := "Difference"
+	itemsTitle := "Items"
+	formatter := txtfmt.NewTableFormatter(diffTitle, itemsTitle)
+
+	var table []txtfmt.TableRow
+	for _, diff := range []struct {
+		desc  string
+		items string
+	}{
+		{"Ranks missing from snapshot", resp.MissingRanks.String()},
+		{"Ranks only in snapshot", resp.ExtraRanks.String()},
+		{"Ranks changed in snapshot", resp.ChangedRanks.String()},
+		{"Pools missing from snapshot", strings.Join(resp.MissingPools, ",")},
+		{"Pools only in snapshot", strings.Join(resp.ExtraPools, ",")},
+		{"Pools with changed service ranks", strings.Join(resp.ChangedPools, ",")},
+		{"Findings missing from snapshot", formatFindingIDs(resp.MissingFindings)},
+		{"Findings only in snapshot", formatFindingIDs(resp.ExtraFindings)},
+	} {
+		if diff.items == "" {
+			continue
+		}
+		table = append(table, txtfmt.TableRow{
+			diffTitle:  diff.desc,
+			itemsTitle: diff.items,
+		})
+	}
+
+	fmt.Fprintln(out, "Snapshot differs from the running system:")
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemDBVerifyResp generates a human-readable representation of the
+// supplied SystemDBVerifyResp struct and writes it to the supplied io.Writer.
+func PrintSystemDBVerifyResp(out io.Writer, resp *control.SystemDBVerifyResp) {
+	if resp == nil {
+		return
+	}
+
+	if len(resp.Inconsistencies) == 0 {
+		fmt.Fprintf(out, "System database (version %d) is consistent\n", resp.Version)
+		return
+	}
+
+	checkTitle := "Check"
+	descTitle := "Inconsistency"
+	formatter := txtfmt.NewTableFormatter(checkTitle, descTitle)
+
+	var table []txtfmt.TableRow
+	for _, inc := range resp.Inconsistencies {
+		table = append(table, txtfmt.TableRow{
+			checkTitle: inc.Check,
+			descTitle:  inc.Description,
+		})
+	}
+
+	fmt.Fprintf(out, "System database (version %d) has %d inconsistencies:\n",
+		resp.Version, len(resp.Inconsistencies))
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemDBDiffResp generates a human-readable representation of the
+// supplied SystemDBDiffResp struct, describing how the copies of the system
+// database held by two MS replicas differ, and writes it to the supplied
+// io.Writer.
+func PrintSystemDBDiffResp(out io.Writer, resp *control.SystemDBDiffResp) {
+	if resp == nil {
+		return
+	}
+
+	fmt.Fprintln(out, txtfmt.FormatEntity("System database replicas", []txtfmt.TableRow{
+		{"First": fmt.Sprintf("%s (version %d)", resp.First, resp.FirstVersion)},
+		{"Second": fmt.Sprintf("%s (version %d)", resp.Second, resp.SecondVersion)},
+	}))
+
+	if !resp.HasDifferences() {
+		fmt.Fprintln(out, "Replicas hold the same members, pools and checker findings")
+		return
+	}
+
+	diffTitle := "Difference"
+	itemsTitle := "Items"
+	formatter := txtfmt.NewTableFormatter(diffTitle, itemsTitle)
+
+	var table []txtfmt.TableRow
+	for _, diff := range []struct {
+		desc  string
+		items string
+	}{
+		{"Ranks only on first", resp.MissingRanks.String()},
+		{"Ranks only on second", resp.ExtraRanks.String()},
+		{"Ranks changed on second", resp.ChangedRanks.String()},
+		{"Pools only on first", strings.Join(resp.MissingPools, ",")},
+		{"Pools only on second", strings.Join(resp.ExtraPools, ",")},
+		{"Pools with changed service ranks", strings.Join(resp.ChangedPools, ",")},
+		{"Findings only on first", formatFindingIDs(resp.MissingFindings)},
+		{"Findings only on second", formatFindingIDs(resp.ExtraFindings)},
+	} {
+		if diff.items == "" {
+			continue
+		}
+		table = append(table, txtfmt.TableRow{
+			diffTitle:  diff.desc,
+			itemsTitle: diff.items,
+		})
+	}
+
+	fmt.Fprintln(out, "Replicas differ:")
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+func formatQuotaLimit(used, limit uint64, format func(uint64) string) string {
+	if limit == 0 {
+		return format(used) + " / unlimited"
+	}
+	return format(used) + " / " + format(limit)
+}
+
+// PrintSystemQuotas generates a human-readable representation of the supplied
+// quotas and their usage and writes it to the supplied io.Writer.
+func PrintSystemQuotas(out io.Writer, quotas []*control.SystemQuota) {
+	if len(quotas) == 0 {
+		fmt.Fprintln(out, "No quotas set.")
+		return
+	}
+
+	typeTitle := "Type"
+	principalTitle := "Principal"
+	poolsTitle := "Pools"
+	scmTitle := system.QuotaTierName(0)
+	nvmeTitle := system.QuotaTierName(1)
+	formatter := txtfmt.NewTableFormatter(typeTitle, principalTitle, poolsTitle, scmTitle, nvmeTitle)
+
+	formatCount := func(n uint64) string { return fmt.Sprintf("%d", n) }
+	tierValue := func(vals []uint64, tier int) uint64 {
+		if tier < len(vals) {
+			return vals[tier]
+		}
+		return 0
+	}
+
+	var table []txtfmt.TableRow
+	for _, q := range quotas {
+		qType, principal := "user", q.User
+		if q.User == "" {
+			qType, principal = "group", q.Group
+		}
+		table = append(table, txtfmt.TableRow{
+			typeTitle:      qType,
+			principalTitle: principal,
+			poolsTitle:     formatQuotaLimit(uint64(q.UsedPools), uint64(q.MaxPools), formatCount),
+			scmTitle: formatQuotaLimit(tierValue(q.UsedTierBytes, 0), tierValue(q.TierBytes, 0),
+				humanize.Bytes),
+			nvmeTitle: formatQuotaLimit(tierValue(q.UsedTierBytes, 1), tierValue(q.TierBytes, 1),
+				humanize.Bytes),
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemAccess generates a human-readable representation of the access
+// granted to the caller and writes it to the supplied io.Writer.
+func PrintSystemAccess(out io.Writer, resp *control.SystemGetAccessResp) {
+	if resp == nil {
+		return
+	}
+
+	if resp.Insecure {
+		fmt.Fprintln(out, "Transport security is disabled; access is not checked.")
+		return
+	}
+
+	role := resp.Role
+	if role == "" {
+		role = "n/a"
+	}
+	rows := []txtfmt.TableRow{
+		{"Role": role},
+		{"Component": resp.Component},
+		{"Subject": resp.Subject},
+	}
+	if len(resp.SANs) > 0 {
+		rows = append(rows, txtfmt.TableRow{"SANs": strings.Join(resp.SANs, ", ")})
+	}
+
+	fmt.Fprintln(out, txtfmt.FormatEntity("Access", rows))
+}
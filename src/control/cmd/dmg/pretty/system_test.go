@@ -11,9 +11,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
@@ -611,3 +613,318 @@ Unknown 3 hosts: foo[7-9]
 		})
 	}
 }
+
+func TestPretty_PrintSystemDBRestoreResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemDBRestoreResp
+		expPrintStr string
+	}{
+		"nil response": {},
+		"no differences": {
+			resp: &control.SystemDBRestoreResp{
+				Index:      12,
+				Term:       2,
+				Version:    30,
+				MapVersion: 5,
+			},
+			expPrintStr: `
+System database snapshot
+------------------------
+  Index            : 12               
+  Term             : 2                
+  Database Version : 30               
+  Map Version      : 5                
+
+Snapshot matches the running system
+`,
+		},
+		"differences": {
+			resp: &control.SystemDBRestoreResp{
+				Index:           12,
+				Term:            2,
+				Version:         30,
+				MapVersion:      5,
+				MissingRanks:    MustCreateRankSet("3-4"),
+				ChangedRanks:    MustCreateRankSet("1"),
+				ExtraPools:      []string{"pool1", "pool2"},
+				MissingFindings: []uint64{10, 11},
+			},
+			expPrintStr: `
+System database snapshot
+------------------------
+  Index            : 12               
+  Term             : 2                
+  Database Version : 30               
+  Map Version      : 5                
+
+Snapshot differs from the running system:
+Difference                     Items       
+----------                     -----       
+Ranks missing from snapshot    3-4         
+Ranks changed in snapshot      1           
+Pools only in snapshot         pool1,pool2 
+Findings missing from snapshot 0xa,0xb     
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemDBRestoreResp(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemDBVerifyResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemDBVerifyResp
+		expPrintStr string
+	}{
+		"nil response": {},
+		"consistent": {
+			resp: &control.SystemDBVerifyResp{Version: 12},
+			expPrintStr: `
+System database (version 12) is consistent
+`,
+		},
+		"inconsistencies": {
+			resp: &control.SystemDBVerifyResp{
+				Version: 12,
+				Inconsistencies: []*control.SystemDBInconsistency{
+					{Check: "fabric-uris", Description: "rank 0 has no fabric URI"},
+					{Check: "map-version", Description: "map version 1 is lower than the member count 3"},
+				},
+			},
+			expPrintStr: `
+System database (version 12) has 2 inconsistencies:
+Check       Inconsistency                                  
+-----       -------------                                  
+fabric-uris rank 0 has no fabric URI                       
+map-version map version 1 is lower than the member count 3 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemDBVerifyResp(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemDBDiffResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemDBDiffResp
+		expPrintStr string
+	}{
+		"nil response": {},
+		"no differences": {
+			resp: &control.SystemDBDiffResp{
+				First:         "host1:10001",
+				FirstVersion:  10,
+				Second:        "host2:10001",
+				SecondVersion: 9,
+			},
+			expPrintStr: `
+System database replicas
+------------------------
+  First : host1:10001 (version 10)
+  Second: host2:10001 (version 9) 
+
+Replicas hold the same members, pools and checker findings
+`,
+		},
+		"differences": {
+			resp: &control.SystemDBDiffResp{
+				First:         "host1:10001",
+				FirstVersion:  10,
+				Second:        "host2:10001",
+				SecondVersion: 9,
+				MissingRanks:  MustCreateRankSet("3-4"),
+				ChangedPools:  []string{"pool1"},
+				ExtraFindings: []uint64{10},
+			},
+			expPrintStr: `
+System database replicas
+------------------------
+  First : host1:10001 (version 10)
+  Second: host2:10001 (version 9) 
+
+Replicas differ:
+Difference                       Items 
+----------                       ----- 
+Ranks only on first              3-4   
+Pools with changed service ranks pool1 
+Findings only on second          0xa   
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemDBDiffResp(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemQuotas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quotas      []*control.SystemQuota
+		expPrintStr string
+	}{
+		"no quotas": {
+			expPrintStr: `
+No quotas set.
+`,
+		},
+		"quotas": {
+			quotas: []*control.SystemQuota{
+				{
+					User:      "bob@",
+					MaxPools:  2,
+					UsedPools: 1,
+				},
+				{
+					Group:         "builders@",
+					TierBytes:     []uint64{1000000000},
+					UsedPools:     2,
+					UsedTierBytes: []uint64{60, 600},
+				},
+			},
+			expPrintStr: `
+Type  Principal Pools         SCM             NVMe              
+----  --------- -----         ---             ----              
+user  bob@      1 / 2         0 B / unlimited 0 B / unlimited   
+group builders@ 2 / unlimited 60 B / 1.0 GB   600 B / unlimited 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemQuotas(&bld, tc.quotas)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemAccess(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemGetAccessResp
+		expPrintStr string
+	}{
+		"nil response": {},
+		"insecure": {
+			resp: &control.SystemGetAccessResp{
+				Component: "admin",
+				Role:      "admin",
+				Insecure:  true,
+			},
+			expPrintStr: `
+Transport security is disabled; access is not checked.
+`,
+		},
+		"operator": {
+			resp: &control.SystemGetAccessResp{
+				Component: "admin",
+				Role:      "operator",
+				Subject:   "CN=admin,OU=ops",
+				SANs:      []string{"ops.example.com", "10.0.0.1"},
+			},
+			expPrintStr: `
+Access
+------
+  Role      : operator                 
+  Component : admin                    
+  Subject   : CN=admin,OU=ops          
+  SANs      : ops.example.com, 10.0.0.1
+
+`,
+		},
+		"no role": {
+			resp: &control.SystemGetAccessResp{
+				Component: "agent",
+				Subject:   "CN=agent",
+			},
+			expPrintStr: `
+Access
+------
+  Role      : n/a       
+  Component : agent     
+  Subject   : CN=agent  
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemAccess(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_TabulateSystemQueryResponse(t *testing.T) {
+	header := []string{"rank", "uuid", "addr", "fabric_uri", "fault_domain",
+		"state", "info", "incarnation", "last_update"}
+
+	joined := MockMember(t, 1, MemberStateJoined, "ok")
+	joined.Incarnation = 7
+	joined.LastUpdate = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	stopped := MockMember(t, 2, MemberStateStopped)
+	stopped.FaultDomain = MustCreateFaultDomain("rack0", "host2")
+	stopped.LastUpdate = time.Time{}
+
+	for name, tc := range map[string]struct {
+		resp     *control.SystemQueryResp
+		expTable *cmdutil.Table
+	}{
+		"nil response": {
+			expTable: &cmdutil.Table{Header: header},
+		},
+		"no members": {
+			resp:     &control.SystemQueryResp{},
+			expTable: &cmdutil.Table{Header: header},
+		},
+		"members": {
+			resp: &control.SystemQueryResp{
+				Members: Members{joined, stopped},
+			},
+			expTable: &cmdutil.Table{
+				Header: header,
+				Rows: [][]string{
+					{"1", test.MockUUID(1), "127.0.0.1:10001", "127.0.0.1:10001", "/",
+						"joined", "ok", "7", "2024-01-02T03:04:05Z"},
+					{"2", test.MockUUID(2), "127.0.0.2:10001", "127.0.0.2:10001", "/rack0/host2",
+						"stopped", "", "0", ""},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTable := TabulateSystemQueryResponse(tc.resp)
+
+			if diff := cmp.Diff(tc.expTable, gotTable); diff != "" {
+				t.Fatalf("unexpected table (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// outputSchemas maps commands to the type of the response written by the
+// command in machine-readable output. The JSON Schema for each command is
+// derived from the response type.
+var outputSchemas = map[string]interface{}{
+	"network scan":               &control.NetworkScanResp{},
+	"pool create":                &control.PoolCreateResp{},
+	"pool get-acl":               &control.PoolGetACLResp{},
+	"pool get-prop":              []*daos.PoolProperty{},
+	"pool list":                  &control.ListPoolsResp{},
+	"pool plan":                  &control.PoolPlanResp{},
+	"pool query":                 &daos.PoolInfo{},
+	"pool query-targets":         &control.PoolQueryTargetResp{},
+	"storage format":             &control.StorageFormatResp{},
+	"storage query health-trend": &control.NvmeHealthTrendResp{},
+	"storage query list-devices": &control.SmdResp{},
+	"storage query list-pools":   &control.SmdResp{},
+	"storage query scm-health":   &control.ScmHealthResp{},
+	"storage query usage":        &control.StorageScanResp{},
+	"storage scan":               &control.StorageScanResp{},
+	"system audit":               &control.SystemGetAuditResp{},
+	"system cleanup":             &control.SystemCleanupResp{},
+	"system exclude":             &control.SystemExcludeResp{},
+	"system get-attr":            &control.SystemGetAttrResp{},
+	"system get-prop":            []*daos.SystemProperty{},
+	"system leader-query":        &control.LeaderQueryResp{},
+	"system query":               &control.SystemQueryResp{},
+	"system start":               &control.SystemStartResp{},
+	"system stop":                &control.SystemStopResp{},
+	"system whoami":              &control.SystemGetAccessResp{},
+}
+
+// schemaCmd is the struct representing the command to print the JSON Schema
+// of the machine-readable output of a dmg command.
+type schemaCmd struct {
+	baseCmd
+	cmdutil.JSONOutputCmd
+	Args struct {
+		Command []string `positional-arg-name:"command" description:"Command to print the output schema for, e.g. \"pool list\""`
+	} `positional-args:"yes"`
+}
+
+func schemaCommands() []string {
+	cmds := make([]string, 0, len(outputSchemas))
+	for name := range outputSchemas {
+		cmds = append(cmds, name)
+	}
+	sort.Strings(cmds)
+	return cmds
+}
+
+// Execute is run when schemaCmd activates.
+func (cmd *schemaCmd) Execute(_ []string) error {
+	if len(cmd.Args.Command) == 0 {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(schemaCommands(), nil)
+		}
+
+		var bld strings.Builder
+		fmt.Fprintln(&bld, "Commands with an output schema:")
+		for _, name := range schemaCommands() {
+			fmt.Fprintf(&bld, "  %s\n", name)
+		}
+		cmd.Info(bld.String())
+		return nil
+	}
+
+	name := strings.Join(cmd.Args.Command, " ")
+	resp, found := outputSchemas[name]
+	if !found {
+		return errors.Errorf("no output schema for command %q (run \"dmg schema\" to list commands)", name)
+	}
+
+	schema := cmdutil.OutputSchema("dmg "+name, resp)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(schema, nil)
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Info(string(data))
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestDmg_outputSchemas(t *testing.T) {
+	cmds := make(map[string]bool)
+	walkStruct(reflect.ValueOf(cliOptions{}), nil, func(cmd []string) {
+		cmds[strings.Join(cmd, " ")] = true
+	})
+
+	for name := range outputSchemas {
+		t.Run(name, func(t *testing.T) {
+			if !cmds[name] {
+				t.Fatalf("schema registered for unknown command %q", name)
+			}
+
+			var buf strings.Builder
+			cmd := &schemaCmd{}
+			cmd.EnableJSONOutput(&buf, nil)
+			cmd.Args.Command = strings.Fields(name)
+			if err := cmd.Execute(nil); err != nil {
+				t.Fatal(err)
+			}
+
+			var out struct {
+				Response map[string]interface{} `json:"response"`
+			}
+			if err := json.Unmarshal([]byte(buf.String()), &out); err != nil {
+				t.Fatalf("invalid output: %s\n%s", err, buf.String())
+			}
+			if out.Response["title"] != "dmg "+name {
+				t.Fatalf("unexpected schema title %v", out.Response["title"])
+			}
+		})
+	}
+}
+
+func TestDmg_SchemaCmd(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"list commands",
+			"schema",
+			"",
+			nil,
+		},
+		{
+			"known command",
+			"schema pool list",
+			"",
+			nil,
+		},
+		{
+			"unknown command",
+			"schema pool destroy",
+			"",
+			errors.New("no output schema for command \"pool destroy\""),
+		},
+		{
+			"json and other output format",
+			"--json --output=yaml schema",
+			"",
+			errors.New("cannot be used together"),
+		},
+	})
+}
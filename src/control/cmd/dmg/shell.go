@@ -0,0 +1,549 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/desertbit/grumble"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+const (
+	// shellPoolVar is the session variable holding the default pool, which
+	// is used by commands run in the shell when the pool argument is omitted.
+	shellPoolVar = "pool"
+
+	poolArgName = "<pool label or UUID>"
+	contArgName = "<container label or UUID>"
+
+	// shellQueryTimeout bounds the live queries used for completion so that
+	// an unresponsive system doesn't block the shell.
+	shellQueryTimeout = 5 * time.Second
+)
+
+type (
+	// shellRunFn runs a single dmg command line within the shell.
+	shellRunFn func(args []string) error
+
+	// shellCmdLine describes the position reached in a (partial) dmg
+	// command line.
+	shellCmdLine struct {
+		path       []*flags.Command // commands from the root to the deepest subcommand
+		pathEnd    int              // index of the first word after the command path
+		positional []string         // positional arguments after the command path
+		optArg     *flags.Option    // option awaiting a value, if any
+	}
+)
+
+// shellCompleters provide completion for positional arguments that take a
+// fixed set of values.
+var shellCompleters = map[string]func() flags.Completer{
+	"<key:val[,key:val...]>": func() flags.Completer { return new(PoolSetPropsFlag) },
+	"[key[,key...]]":         func() flags.Completer { return new(PoolGetPropsFlag) },
+}
+
+// shellCmd is the struct representing the command to start an interactive dmg
+// shell. Commands run in the shell share a single control client and the
+// control config that was loaded when the shell was started.
+type shellCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+
+	parser *flags.Parser
+	runCmd shellRunFn
+	vars   map[string]string
+	app    *grumble.App
+}
+
+// setRunner sets the parser used to inspect dmg commands and the function used
+// to run them.
+func (cmd *shellCmd) setRunner(p *flags.Parser, fn shellRunFn) {
+	cmd.parser = p
+	cmd.runCmd = fn
+}
+
+// Execute is run when shellCmd activates.
+func (cmd *shellCmd) Execute(_ []string) error {
+	if cmd.parser == nil || cmd.runCmd == nil {
+		return errors.New("shell command runner not set")
+	}
+
+	cmd.vars = make(map[string]string)
+	cmd.app = cmd.newApp()
+
+	// grumble parses os.Args itself, so clear them in order to start the
+	// interactive shell.
+	os.Args = os.Args[:1]
+	return cmd.app.Run()
+}
+
+func (cmd *shellCmd) newApp() *grumble.App {
+	homedir, err := os.UserHomeDir()
+	if err != nil {
+		homedir = "/tmp"
+	}
+	app := grumble.New(&grumble.Config{
+		Name:        "dmg",
+		Description: "Interactive shell for managing DAOS clusters",
+		HistoryFile: filepath.Join(homedir, ".dmg_history"),
+		Prompt:      "dmg> ",
+	})
+
+	for _, c := range cmd.parser.Commands() {
+		if c.Hidden || c.Name == "shell" {
+			continue
+		}
+
+		name := c.Name
+		app.AddCommand(&grumble.Command{
+			Name:     name,
+			Aliases:  c.Aliases,
+			Help:     c.ShortDescription,
+			LongHelp: fmt.Sprintf("Run '%s <subcommand> --help' for subcommand usage.", name),
+			Args: func(a *grumble.Args) {
+				a.StringList("args", "command arguments", grumble.Default([]string{}))
+			},
+			Run: func(c *grumble.Context) error {
+				return cmd.run(append([]string{name}, c.Args.StringList("args")...))
+			},
+			Completer: func(prefix string, args []string) []string {
+				return cmd.complete(append([]string{name}, args...), prefix)
+			},
+		})
+	}
+
+	app.AddCommand(&grumble.Command{
+		Name:     "set",
+		Help:     "set a session variable",
+		LongHelp: "Session variables are expanded in commands as $name or ${name}. The \"pool\" variable sets the default pool for commands run without one.",
+		Args: func(a *grumble.Args) {
+			a.String("name", "variable name")
+			a.String("value", "variable value")
+		},
+		Run: func(c *grumble.Context) error {
+			cmd.setVar(c.Args.String("name"), c.Args.String("value"))
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			if len(args) == 1 && args[0] == shellPoolVar {
+				return filterPrefix(prefix, cmd.poolNames())
+			}
+			return nil
+		},
+	})
+	app.AddCommand(&grumble.Command{
+		Name: "unset",
+		Help: "unset a session variable",
+		Args: func(a *grumble.Args) {
+			a.String("name", "variable name")
+		},
+		Run: func(c *grumble.Context) error {
+			cmd.unsetVar(c.Args.String("name"))
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return filterPrefix(prefix, cmd.varNames())
+		},
+	})
+	app.AddCommand(&grumble.Command{
+		Name: "vars",
+		Help: "list session variables",
+		Run: func(c *grumble.Context) error {
+			for _, name := range cmd.varNames() {
+				c.App.Printf("%s=%s\n", name, cmd.vars[name])
+			}
+			return nil
+		},
+	})
+
+	return app
+}
+
+func (cmd *shellCmd) setVar(name, value string) {
+	cmd.vars[name] = value
+	if name == shellPoolVar {
+		cmd.updatePrompt()
+	}
+}
+
+func (cmd *shellCmd) unsetVar(name string) {
+	delete(cmd.vars, name)
+	if name == shellPoolVar {
+		cmd.updatePrompt()
+	}
+}
+
+func (cmd *shellCmd) varNames() []string {
+	names := make([]string, 0, len(cmd.vars))
+	for name := range cmd.vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (cmd *shellCmd) updatePrompt() {
+	if cmd.app == nil {
+		return
+	}
+
+	if pool, ok := cmd.vars[shellPoolVar]; ok {
+		cmd.app.SetPrompt(fmt.Sprintf("dmg[%s]> ", pool))
+		return
+	}
+	cmd.app.SetDefaultPrompt()
+}
+
+// run expands session variables in the supplied command line, adds the
+// default pool if the command requires a pool that wasn't given, then runs the
+// command.
+func (cmd *shellCmd) run(args []string) error {
+	args, err := cmd.expandVars(args)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.runCmd(cmd.withDefaultPool(args))
+	if fe, ok := errors.Cause(err).(*flags.Error); ok && fe.Type == flags.ErrHelp {
+		cmd.Info(fe.Error())
+		return nil
+	}
+
+	return err
+}
+
+// expandVars replaces references to session variables in the command line with
+// their values. A literal $ may be given as $$.
+func (cmd *shellCmd) expandVars(args []string) ([]string, error) {
+	var unknown []string
+	mapping := func(name string) string {
+		if name == "$" {
+			return name
+		}
+		val, ok := cmd.vars[name]
+		if !ok {
+			unknown = append(unknown, name)
+		}
+		return val
+	}
+
+	out := make([]string, 0, len(args))
+	for _, arg := range args {
+		out = append(out, os.Expand(arg, mapping))
+	}
+	if len(unknown) > 0 {
+		return nil, errors.Errorf("unknown session variable(s): %s", strings.Join(unknown, ", "))
+	}
+
+	return out, nil
+}
+
+// withDefaultPool inserts the default pool into the command line if the command
+// takes a required pool argument that was not supplied.
+func (cmd *shellCmd) withDefaultPool(args []string) []string {
+	pool, ok := cmd.vars[shellPoolVar]
+	if !ok || pool == "" {
+		return args
+	}
+
+	cl := parseShellLine(cmd.parser, args)
+	cmdArgs := cl.cmd().Args()
+	if len(cmdArgs) == 0 || cmdArgs[0].Name != poolArgName || cmdArgs[0].Required == 0 {
+		return args
+	}
+
+	required := 0
+	for _, a := range cmdArgs {
+		if a.Required > 0 {
+			required++
+		}
+	}
+	if len(cl.positional) >= required {
+		return args
+	}
+
+	out := make([]string, 0, len(args)+1)
+	out = append(out, args[:cl.pathEnd]...)
+	out = append(out, pool)
+	return append(out, args[cl.pathEnd:]...)
+}
+
+func (cl *shellCmdLine) cmd() *flags.Command {
+	return cl.path[len(cl.path)-1]
+}
+
+// findOption looks up an option on the command line by its short or long name
+// in the commands on the current command path.
+func (cl *shellCmdLine) findOption(word string) *flags.Option {
+	if long := strings.TrimPrefix(word, "--"); long != word {
+		return cl.cmd().FindOptionByLongName(long)
+	}
+	if short := strings.TrimPrefix(word, "-"); len(short) == 1 {
+		return cl.cmd().FindOptionByShortName(rune(short[0]))
+	}
+	return nil
+}
+
+// optTakesValue returns true if the option requires a value to be supplied as
+// the next word on the command line.
+func optTakesValue(opt *flags.Option) bool {
+	return !opt.OptionalArgument && opt.Field().Type.Kind() != reflect.Bool
+}
+
+// parseShellLine walks the supplied words to find the subcommand they invoke,
+// along with any positional arguments given so far.
+func parseShellLine(p *flags.Parser, words []string) *shellCmdLine {
+	cl := &shellCmdLine{path: []*flags.Command{p.Command}}
+
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		cl.optArg = nil
+
+		switch {
+		case word == "--" || strings.Contains(word, "="):
+			continue
+		case strings.HasPrefix(word, "-"):
+			opt := cl.findOption(word)
+			if opt == nil || !optTakesValue(opt) {
+				continue
+			}
+			if i+1 < len(words) {
+				i++
+				continue
+			}
+			cl.optArg = opt
+		default:
+			if len(cl.positional) == 0 {
+				if sub := cl.cmd().Find(word); sub != nil {
+					cl.path = append(cl.path, sub)
+					cl.pathEnd = i + 1
+					continue
+				}
+			}
+			cl.positional = append(cl.positional, word)
+		}
+	}
+
+	return cl
+}
+
+// optionNames returns the names of the visible options of the commands on the
+// current command path.
+func (cl *shellCmdLine) optionNames() []string {
+	var names []string
+	var addGroup func(g *flags.Group)
+	addGroup = func(g *flags.Group) {
+		for _, opt := range g.Options() {
+			if opt.Hidden {
+				continue
+			}
+			if opt.LongName != "" {
+				names = append(names, "--"+opt.LongName)
+			}
+			if opt.ShortName != 0 {
+				names = append(names, "-"+string(opt.ShortName))
+			}
+		}
+		for _, sub := range g.Groups() {
+			addGroup(sub)
+		}
+	}
+	for _, c := range cl.path {
+		addGroup(c.Group)
+	}
+
+	return names
+}
+
+// complete returns completion candidates for the word being typed, based on
+// the words which precede it on the command line.
+func (cmd *shellCmd) complete(words []string, prefix string) []string {
+	cl := parseShellLine(cmd.parser, words)
+
+	var candidates []string
+	switch {
+	case cl.optArg != nil:
+		candidates = cmd.completeOption(cl.optArg)
+	case strings.HasPrefix(prefix, "-"):
+		candidates = cl.optionNames()
+	case len(cl.positional) == 0 && len(cl.cmd().Commands()) > 0:
+		for _, sub := range cl.cmd().Commands() {
+			if !sub.Hidden {
+				candidates = append(candidates, sub.Name)
+			}
+		}
+	default:
+		cmdArgs := cl.cmd().Args()
+		if idx := len(cl.positional); idx < len(cmdArgs) {
+			candidates = cmd.completeArg(cl, cmdArgs[idx].Name, prefix)
+		}
+	}
+
+	return filterPrefix(prefix, candidates)
+}
+
+func (cmd *shellCmd) completeOption(opt *flags.Option) []string {
+	switch opt.LongName {
+	case "pool":
+		return cmd.poolNames()
+	case "rank", "ranks":
+		return cmd.rankNames()
+	case "host-list", "rank-hosts":
+		return cmd.hostNames()
+	}
+
+	return nil
+}
+
+func (cmd *shellCmd) completeArg(cl *shellCmdLine, name, prefix string) []string {
+	switch name {
+	case poolArgName, "<pool label>":
+		return cmd.poolNames()
+	case contArgName:
+		pool := cmd.vars[shellPoolVar]
+		if len(cl.positional) > 0 {
+			pool = cl.positional[0]
+		}
+		if pool == "" {
+			return nil
+		}
+		return cmd.contNames(pool)
+	}
+
+	if newCompleter, found := shellCompleters[name]; found {
+		var items []string
+		for _, comp := range newCompleter().Complete(prefix) {
+			items = append(items, comp.Item)
+		}
+		return items
+	}
+
+	return nil
+}
+
+func (cmd *shellCmd) queryCtx() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(cmd.MustLogCtx(), shellQueryTimeout)
+}
+
+// poolNames returns the labels of the pools in the system, or the UUIDs of
+// any pools without labels.
+func (cmd *shellCmd) poolNames() []string {
+	ctx, cancel := cmd.queryCtx()
+	defer cancel()
+
+	resp, err := control.ListPools(ctx, cmd.ctlInvoker, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		cmd.Debugf("pool completion failed: %s", err)
+		return nil
+	}
+
+	names := make([]string, 0, len(resp.Pools))
+	for _, p := range resp.Pools {
+		if p.Label != "" {
+			names = append(names, p.Label)
+			continue
+		}
+		names = append(names, p.UUID.String())
+	}
+
+	return names
+}
+
+// contNames returns the UUIDs of the containers in the given pool.
+func (cmd *shellCmd) contNames(pool string) []string {
+	ctx, cancel := cmd.queryCtx()
+	defer cancel()
+
+	resp, err := control.ListContainers(ctx, cmd.ctlInvoker, &control.ListContReq{PoolID: pool})
+	if err != nil {
+		cmd.Debugf("container completion failed: %s", err)
+		return nil
+	}
+
+	return resp.Containers
+}
+
+func (cmd *shellCmd) systemMembers() *control.SystemQueryResp {
+	ctx, cancel := cmd.queryCtx()
+	defer cancel()
+
+	resp, err := control.SystemQuery(ctx, cmd.ctlInvoker, &control.SystemQueryReq{})
+	if err != nil {
+		cmd.Debugf("system query for completion failed: %s", err)
+		return nil
+	}
+
+	return resp
+}
+
+// rankNames returns the ranks of the members of the system.
+func (cmd *shellCmd) rankNames() []string {
+	resp := cmd.systemMembers()
+	if resp == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		names = append(names, m.Rank.String())
+	}
+
+	return names
+}
+
+// hostNames returns the hosts in the control config host list along with the
+// addresses of the members of the system.
+func (cmd *shellCmd) hostNames() []string {
+	var names []string
+	if cmd.config != nil {
+		for _, addr := range cmd.config.HostList {
+			if host, _, err := net.SplitHostPort(addr); err == nil {
+				addr = host
+			}
+			names = append(names, addr)
+		}
+	}
+
+	if resp := cmd.systemMembers(); resp != nil {
+		for _, m := range resp.Members {
+			if m.Addr != nil {
+				names = append(names, m.Addr.IP.String())
+			}
+		}
+	}
+
+	return common.DedupeStringSlice(names)
+}
+
+// filterPrefix returns the sorted candidates which start with the prefix.
+func filterPrefix(prefix string, candidates []string) []string {
+	var out []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+
+	return out
+}
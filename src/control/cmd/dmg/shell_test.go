@@ -0,0 +1,249 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func newTestShell(t *testing.T, log logging.Logger, mic *control.MockInvokerConfig, vars map[string]string) *shellCmd {
+	t.Helper()
+
+	if mic == nil {
+		mic = control.DefaultMockInvokerConfig()
+	}
+	if vars == nil {
+		vars = make(map[string]string)
+	}
+
+	cmd := &shellCmd{
+		parser: flags.NewParser(&cliOptions{}, flags.Default),
+		vars:   vars,
+	}
+	cmd.SetLog(log)
+	cmd.setInvoker(control.NewMockInvoker(log, mic))
+
+	return cmd
+}
+
+func TestDmg_shellCmd_withDefaultPool(t *testing.T) {
+	for name, tc := range map[string]struct {
+		vars    map[string]string
+		args    []string
+		expArgs []string
+	}{
+		"no default pool": {
+			args:    []string{"pool", "query"},
+			expArgs: []string{"pool", "query"},
+		},
+		"pool missing": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"pool", "query"},
+			expArgs: []string{"pool", "query", "tank"},
+		},
+		"pool missing before flags": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"pool", "exclude", "--rank", "1"},
+			expArgs: []string{"pool", "exclude", "tank", "--rank", "1"},
+		},
+		"pool given": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"pool", "query", "other"},
+			expArgs: []string{"pool", "query", "other"},
+		},
+		"pool given after option with value": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"pool", "exclude", "--rank", "1", "other"},
+			expArgs: []string{"pool", "exclude", "--rank", "1", "other"},
+		},
+		"container given but not pool": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"cont", "set-owner", "-u", "bob@", "c1"},
+			expArgs: []string{"cont", "set-owner", "tank", "-u", "bob@", "c1"},
+		},
+		"command without pool argument": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"system", "query"},
+			expArgs: []string{"system", "query"},
+		},
+		"optional pool argument": {
+			vars:    map[string]string{shellPoolVar: "tank"},
+			args:    []string{"pool", "ops", "list"},
+			expArgs: []string{"pool", "ops", "list"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := newTestShell(t, log, nil, tc.vars)
+
+			if diff := cmp.Diff(tc.expArgs, cmd.withDefaultPool(tc.args)); diff != "" {
+				t.Fatalf("unexpected args (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_shellCmd_expandVars(t *testing.T) {
+	vars := map[string]string{
+		"pool":  "tank",
+		"ranks": "0-3",
+	}
+
+	for name, tc := range map[string]struct {
+		args    []string
+		expArgs []string
+		expErr  error
+	}{
+		"no variables": {
+			args:    []string{"pool", "list"},
+			expArgs: []string{"pool", "list"},
+		},
+		"variables": {
+			args:    []string{"pool", "query", "$pool", "--ranks=${ranks}"},
+			expArgs: []string{"pool", "query", "tank", "--ranks=0-3"},
+		},
+		"escaped dollar": {
+			args:    []string{"system", "set-attr", "foo:$$bar"},
+			expArgs: []string{"system", "set-attr", "foo:$bar"},
+		},
+		"unknown variable": {
+			args:   []string{"pool", "query", "$cont"},
+			expErr: errors.New("unknown session variable(s): cont"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := newTestShell(t, log, nil, vars)
+
+			gotArgs, gotErr := cmd.expandVars(tc.args)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expArgs, gotArgs); diff != "" {
+				t.Fatalf("unexpected args (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_shellCmd_complete(t *testing.T) {
+	listPoolsResp := control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:  test.MockUUID(1),
+				Label: "tank",
+				State: daos.PoolServiceStateReady.String(),
+			},
+			{
+				Uuid:  test.MockUUID(2),
+				State: daos.PoolServiceStateReady.String(),
+			},
+		},
+	})
+	sysQueryResp := control.MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			{
+				Rank:  0,
+				Uuid:  test.MockUUID(0),
+				State: system.MemberStateJoined.String(),
+				Addr:  "10.0.0.1:10001",
+			},
+			{
+				Rank:  1,
+				Uuid:  test.MockUUID(1),
+				State: system.MemberStateJoined.String(),
+				Addr:  "10.0.0.2:10001",
+			},
+		},
+	})
+
+	for name, tc := range map[string]struct {
+		mic      *control.MockInvokerConfig
+		words    []string
+		prefix   string
+		expComps []string
+		expHas   []string
+	}{
+		"subcommands": {
+			words:    []string{"pool"},
+			prefix:   "query",
+			expComps: []string{"query", "query-targets"},
+		},
+		"options": {
+			words:  []string{"pool", "query"},
+			prefix: "-",
+			expHas: []string{"--json", "--health-only", "-j"},
+		},
+		"pool argument": {
+			mic:      &control.MockInvokerConfig{UnaryResponse: listPoolsResp},
+			words:    []string{"pool", "query"},
+			expComps: []string{test.MockUUID(2), "tank"},
+		},
+		"pool argument with prefix": {
+			mic:      &control.MockInvokerConfig{UnaryResponse: listPoolsResp},
+			words:    []string{"pool", "query"},
+			prefix:   "ta",
+			expComps: []string{"tank"},
+		},
+		"pool argument already given": {
+			mic:   &control.MockInvokerConfig{UnaryResponse: listPoolsResp},
+			words: []string{"pool", "query", "tank"},
+		},
+		"rank option": {
+			mic:      &control.MockInvokerConfig{UnaryResponse: sysQueryResp},
+			words:    []string{"system", "stop", "--ranks"},
+			expComps: []string{"0", "1"},
+		},
+		"rank-hosts option": {
+			mic:      &control.MockInvokerConfig{UnaryResponse: sysQueryResp},
+			words:    []string{"system", "stop", "--rank-hosts"},
+			expComps: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		"property names": {
+			words:    []string{"pool", "get-prop", "tank"},
+			prefix:   "lab",
+			expComps: []string{"label"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := newTestShell(t, log, tc.mic, nil)
+
+			gotComps := cmd.complete(tc.words, tc.prefix)
+			if tc.expHas != nil {
+				for _, exp := range tc.expHas {
+					test.AssertTrue(t, common.Includes(gotComps, exp), exp+" not in completions")
+				}
+				return
+			}
+
+			if diff := cmp.Diff(tc.expComps, gotComps); diff != "" {
+				t.Fatalf("unexpected completions (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2019-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -108,6 +108,9 @@ func (cmd *storageFormatCmd) Execute(args []string) (err error) {
 
 	req := &control.StorageFormatReq{Reformat: cmd.Force}
 	req.SetHostList(cmd.getHostList())
+	if !cmd.JSONOutputEnabled() {
+		req.SetProgressCb(cmd.printFormatProgress)
+	}
 
 	resp, err := control.StorageFormat(ctx, cmd.ctlInvoker, req)
 	if err != nil {
@@ -121,6 +124,16 @@ func (cmd *storageFormatCmd) Execute(args []string) (err error) {
 	return cmd.printFormatResp(resp)
 }
 
+// printFormatProgress displays a line for each format step completed on an
+// engine while the format is in progress.
+func (cmd *storageFormatCmd) printFormatProgress(prog *control.StorageFormatProgress) {
+	if prog.Error != "" {
+		cmd.Errorf("%s engine %d: %s: %s\n", prog.Addr, prog.EngineIdx, prog.Message, prog.Error)
+		return
+	}
+	cmd.Infof("%s engine %d: %s\n", prog.Addr, prog.EngineIdx, prog.Message)
+}
+
 func (cmd *storageFormatCmd) printFormatResp(resp *control.StorageFormatResp) error {
 	var outErr strings.Builder
 	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
@@ -9,6 +9,7 @@ package main
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -46,7 +47,12 @@ func (cmd *smdQueryCmd) makeRequest(ctx context.Context, req *control.SmdQueryRe
 	}
 
 	if cmd.JSONOutputEnabled() {
-		return cmd.OutputJSON(resp, resp.Errors())
+		var out interface{} = resp
+		if req.OmitPools {
+			// device listings can also be written as CSV
+			out = cmdutil.NewTabularOutput(resp, pretty.TabulateSmdDevices(resp.HostStorage))
+		}
+		return cmd.OutputJSON(out, resp.Errors())
 	}
 
 	var outErr strings.Builder
@@ -73,6 +79,8 @@ type storageQueryCmd struct {
 	ListPools   listPoolsQueryCmd   `command:"list-pools" description:"List pools with NVMe on the server"`
 	ListDevices listDevicesQueryCmd `command:"list-devices" description:"List storage devices on the server"`
 	Usage       usageQueryCmd       `command:"usage" description:"Show SCM & NVMe storage space utilization per storage server"`
+	HealthTrend healthTrendQueryCmd `command:"health-trend" description:"Show NVMe device wear trends and estimated remaining endurance"`
+	ScmHealth   scmHealthQueryCmd   `command:"scm-health" description:"Show SCM usage and PMem namespace media health, bad blocks and dirty shutdowns"`
 }
 
 type listDevicesQueryCmd struct {
@@ -152,6 +160,81 @@ func (cmd *usageQueryCmd) Execute(_ []string) error {
 	return resp.Errors()
 }
 
+type healthTrendQueryCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	Days    uint `short:"d" long:"days" default:"30" description:"Flag devices estimated to wear out within this many days"`
+	Samples bool `short:"s" long:"samples" description:"Include sampled health history in results"`
+}
+
+// Execute is run when healthTrendQueryCmd activates.
+//
+// Queries NVMe device health history and wear-out estimates on hosts.
+func (cmd *healthTrendQueryCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+	req := &control.NvmeHealthTrendReq{IncludeSamples: cmd.Samples}
+	req.SetHostList(cmd.getHostList())
+	resp, err := control.StorageHealthTrend(ctx, cmd.ctlInvoker, req)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	warnPeriod := time.Duration(cmd.Days) * 24 * time.Hour
+	if err := pretty.PrintNvmeHealthTrendMap(resp.HostTrends, warnPeriod, &bld); err != nil {
+		return err
+	}
+	cmd.Infof("%s", bld.String())
+
+	return resp.Errors()
+}
+
+type scmHealthQueryCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when scmHealthQueryCmd activates.
+//
+// Queries SCM usage and PMem namespace health on hosts.
+func (cmd *scmHealthQueryCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+	req := &control.ScmHealthReq{}
+	req.SetHostList(cmd.getHostList())
+	resp, err := control.StorageScmHealth(ctx, cmd.ctlInvoker, req)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	if err := pretty.PrintScmHealthMap(resp.HostScmHealth, &bld); err != nil {
+		return err
+	}
+	cmd.Infof("%s", bld.String())
+
+	return resp.Errors()
+}
+
 type smdManageCmd struct {
 	baseCmd
 	ctlInvokerCmd
@@ -121,6 +121,24 @@ func TestStorageQueryCommands(t *testing.T) {
 			printRequest(t, &control.StorageScanReq{Usage: true}),
 			nil,
 		},
+		{
+			"NVMe health trend query",
+			"storage query health-trend",
+			printRequest(t, &control.NvmeHealthTrendReq{}),
+			nil,
+		},
+		{
+			"NVMe health trend query with samples",
+			"storage query health-trend --days 7 --samples",
+			printRequest(t, &control.NvmeHealthTrendReq{IncludeSamples: true}),
+			nil,
+		},
+		{
+			"SCM health query",
+			"storage query scm-health",
+			printRequest(t, &control.ScmHealthReq{}),
+			nil,
+		},
 		{
 			"Set FAULTY device status (force)",
 			"storage set nvme-faulty --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d -f",
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2019-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -39,6 +39,13 @@ type SystemCmd struct {
 	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
 	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
 	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	Events       systemEventsCmd       `command:"events" description:"Query RAS events recorded by the Management Service"`
+	EventPolicy  systemEventPolicyCmd  `command:"event-policy" description:"Manage the runtime RAS event policy"`
+	DB           systemDBCmd           `command:"db" description:"Manage the system database held by the Management Service"`
+	Replicas     systemReplicasCmd     `command:"replicas" description:"Reconfigure the Management Service replica set"`
+	Quota        systemQuotaCmd        `command:"quota" description:"Manage resource quotas for users and groups"`
+	WhoAmI       systemWhoAmICmd       `command:"whoami" description:"Show the role granted to this client's certificate"`
+	Audit        systemAuditCmd        `command:"audit" description:"Query the audit log of mutating control-plane operations"`
 }
 
 type leaderQueryCmd struct {
@@ -134,7 +141,8 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 	}
 
 	if cmd.JSONOutputEnabled() {
-		return cmd.OutputJSON(resp, resp.Errors())
+		return cmd.OutputJSON(cmdutil.NewTabularOutput(resp,
+			pretty.TabulateSystemQueryResponse(resp)), resp.Errors())
 	}
 
 	var out, outErr strings.Builder
@@ -188,6 +196,9 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	req := &control.SystemStopReq{Force: cmd.Force}
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
+	if !cmd.JSONOutputEnabled() {
+		req.SetProgressCb(cmd.printStopProgress)
+	}
 
 	resp, err := control.SystemStop(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -210,6 +221,18 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	return resp.Errors()
 }
 
+// printStopProgress displays a line for each rank as its result is received
+// while the system stop is in progress.
+func (cmd *systemStopCmd) printStopProgress(prog *control.SystemStopProgress) {
+	for _, mr := range prog.Results {
+		if mr.Errored {
+			cmd.Errorf("%s: rank %d: %s\n", prog.Action, mr.Rank, mr.Msg)
+			continue
+		}
+		cmd.Infof("%s: rank %d: %s\n", prog.Action, mr.Rank, mr.State)
+	}
+}
+
 type baseExcludeCmd struct {
 	baseCmd
 	cfgCmd
@@ -0,0 +1,44 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemWhoAmICmd is the struct representing the command to show the access
+// granted to the caller by the management service.
+type systemWhoAmICmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemWhoAmICmd subcommand is activated.
+func (cmd *systemWhoAmICmd) Execute(_ []string) error {
+	resp, err := control.SystemGetAccess(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemGetAccessReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system whoami failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintSystemAccess(&bld, resp)
+	cmd.Infof("%s", bld.String())
+
+	return nil
+}
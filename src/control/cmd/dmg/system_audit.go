@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemAuditCmd is the struct representing the command to query the audit
+// log of mutating control-plane operations.
+type systemAuditCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Operation string `long:"operation" description:"Only show records of the given operation, e.g. PoolCreate"`
+	Subject   string `long:"subject" description:"Only show records of callers whose certificate subject contains the given string"`
+	Since     string `long:"since" description:"Only show records made at or after the given time (RFC3339 timestamp or duration ago, e.g. 2h)"`
+	Until     string `long:"until" description:"Only show records made at or before the given time (RFC3339 timestamp or duration ago, e.g. 30m)"`
+	Limit     int    `long:"limit" short:"n" description:"Maximum number of most recent records to show (0 for all)"`
+	Verbose   bool   `long:"verbose" short:"v" description:"Display the request parameters and errors of each record"`
+}
+
+func (cmd *systemAuditCmd) getRequest() (*control.SystemGetAuditReq, error) {
+	req := &control.SystemGetAuditReq{
+		Operation: cmd.Operation,
+		Subject:   cmd.Subject,
+		Limit:     cmd.Limit,
+	}
+
+	now := time.Now()
+	if cmd.Since != "" {
+		ts, err := parseEventTime(cmd.Since, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "--since")
+		}
+		req.Since = ts
+	}
+	if cmd.Until != "" {
+		ts, err := parseEventTime(cmd.Until, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "--until")
+		}
+		req.Until = ts
+	}
+
+	return req, nil
+}
+
+// Execute is run when systemAuditCmd subcommand is activated.
+func (cmd *systemAuditCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system audit failed")
+	}()
+
+	req, err := cmd.getRequest()
+	if err != nil {
+		return err
+	}
+
+	resp, err := control.SystemGetAudit(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemGetAuditResponse(&out, resp,
+		pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return nil
+}
@@ -0,0 +1,69 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_SystemAuditCommand(t *testing.T) {
+	since, err := time.Parse(time.RFC3339, "2024-01-02T03:04:05Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	until := since.Add(time.Hour)
+
+	runCmdTests(t, []cmdTest{
+		{
+			"system audit with no arguments",
+			"system audit",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAuditReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system audit with all filters",
+			"system audit --operation PoolCreate --subject OU=ops " +
+				"--since 2024-01-02T03:04:05Z --until 2024-01-02T04:04:05Z --limit 5 --verbose",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAuditReq{
+					Operation: "PoolCreate",
+					Subject:   "OU=ops",
+					Since:     since,
+					Until:     until,
+					Limit:     5,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system audit with bad since time",
+			"system audit --since yesterday",
+			"",
+			errors.New("--since: invalid time"),
+		},
+		{
+			"system audit with until before since",
+			"system audit --since 2024-01-02T04:04:05Z --until 2024-01-02T03:04:05Z",
+			"",
+			errors.New("until time must not be before since time"),
+		},
+		{
+			"system audit with negative limit",
+			"system audit --limit -1",
+			"",
+			errors.New("limit must not be negative"),
+		},
+	})
+}
@@ -0,0 +1,233 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemDBCmd is the struct representing the commands to manage the system
+// database held by the Management Service.
+type systemDBCmd struct {
+	Snapshot systemDBSnapshotCmd `command:"snapshot" description:"Save a snapshot of the system database to a file"`
+	Restore  systemDBRestoreCmd  `command:"restore" description:"Restore the system database from a snapshot file"`
+	Verify   systemDBVerifyCmd   `command:"verify" description:"Check the consistency of the system database"`
+	Diff     systemDBDiffCmd     `command:"diff" description:"Compare the copies of the system database held by MS replicas"`
+}
+
+// systemDBSnapshotCmd is the struct representing the command to save a
+// snapshot of the system database.
+type systemDBSnapshotCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Output string `short:"o" long:"output" required:"1" description:"Path of the snapshot file to write"`
+}
+
+// Execute is run when systemDBSnapshotCmd subcommand is activated.
+func (cmd *systemDBSnapshotCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system db snapshot failed")
+	}()
+
+	resp, err := control.SystemDBSnapshot(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.SystemDBSnapshotReq))
+	if err == nil {
+		err = errors.Wrapf(os.WriteFile(cmd.Output, resp.Data, 0600),
+			"failed to write snapshot to %q", cmd.Output)
+	}
+
+	if cmd.JSONOutputEnabled() {
+		if err != nil {
+			return cmd.OutputJSON(nil, err)
+		}
+		return cmd.OutputJSON(&struct {
+			Index uint64 `json:"index"`
+			Term  uint64 `json:"term"`
+			Size  int    `json:"size"`
+			Path  string `json:"path"`
+		}{
+			Index: resp.Index,
+			Term:  resp.Term,
+			Size:  len(resp.Data),
+			Path:  cmd.Output,
+		}, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Saved system database snapshot (index %d, term %d) to %s (%s)",
+		resp.Index, resp.Term, cmd.Output, humanize.Bytes(uint64(len(resp.Data))))
+
+	return nil
+}
+
+// systemDBRestoreCmd is the struct representing the command to restore the
+// system database from a snapshot file.
+type systemDBRestoreCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	DryRun bool `short:"n" long:"dry-run" description:"Compare the snapshot with the running system without restoring it"`
+	Force  bool `short:"f" long:"force" description:"Restore the snapshot without prompting for confirmation"`
+	Args   struct {
+		Path string `positional-arg-name:"<snapshot file>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemDBRestoreCmd subcommand is activated.
+func (cmd *systemDBRestoreCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system db restore failed")
+	}()
+
+	data, err := os.ReadFile(cmd.Args.Path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read snapshot from %q", cmd.Args.Path)
+	}
+
+	// Always validate the snapshot against the running system before
+	// anything is restored.
+	resp, err := control.SystemDBRestore(cmd.MustLogCtx(), cmd.ctlInvoker,
+		&control.SystemDBRestoreReq{Data: data})
+	if cmd.JSONOutputEnabled() && (err != nil || cmd.DryRun) {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !cmd.JSONOutputEnabled() {
+		var out strings.Builder
+		pretty.PrintSystemDBRestoreResp(&out, resp)
+		cmd.Info(out.String())
+	}
+	if cmd.DryRun {
+		return nil
+	}
+
+	if !cmd.Force {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(nil, errors.New("--force is required with JSON output"))
+		}
+		cmd.Notice("This command will replace the system database on all MS replicas!")
+		if !common.GetConsent(cmd.Logger) {
+			return errors.New("consent not given")
+		}
+	}
+
+	// Only allow the restore to proceed with differences that have already
+	// been reported, a snapshot that matched the running system is refused
+	// if the system has changed in the meantime.
+	resp, err = control.SystemDBRestore(cmd.MustLogCtx(), cmd.ctlInvoker,
+		&control.SystemDBRestoreReq{
+			Data:  data,
+			Apply: true,
+			Force: resp.HasDifferences(),
+		})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+	cmd.Info("system db restore succeeded")
+
+	return nil
+}
+
+// systemDBVerifyCmd is the struct representing the command to check the
+// consistency of the system database.
+type systemDBVerifyCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemDBVerifyCmd subcommand is activated.
+func (cmd *systemDBVerifyCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system db verify failed")
+	}()
+
+	resp, err := control.SystemDBVerify(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.SystemDBVerifyReq))
+	if err == nil && len(resp.Inconsistencies) > 0 {
+		err = errors.Errorf("%d system database inconsistencies found",
+			len(resp.Inconsistencies))
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if resp == nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemDBVerifyResp(&out, resp)
+	cmd.Info(out.String())
+
+	return err
+}
+
+// systemDBDiffCmd is the struct representing the command to compare the
+// copies of the system database held by two MS replicas.
+type systemDBDiffCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Args struct {
+		First  string `positional-arg-name:"<host:port>" required:"1" description:"MS replica to compare"`
+		Second string `positional-arg-name:"<host:port>" description:"MS replica to compare with the first; the MS leader is compared with the first replica if unset"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemDBDiffCmd subcommand is activated.
+func (cmd *systemDBDiffCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system db diff failed")
+	}()
+
+	req := &control.SystemDBDiffReq{
+		Replicas: []string{cmd.Args.First},
+	}
+	if cmd.Args.Second != "" {
+		req.Replicas = append(req.Replicas, cmd.Args.Second)
+	}
+
+	resp, err := control.SystemDBDiff(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err == nil && resp.HasDifferences() {
+		err = errors.Errorf("system database differs between %s and %s",
+			resp.First, resp.Second)
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if resp == nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemDBDiffResp(&out, resp)
+	cmd.Info(out.String())
+
+	return err
+}
@@ -0,0 +1,120 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_SystemDBCommands(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	snapPath := test.CreateTestFile(t, testDir, "snapshot data")
+
+	runCmdTests(t, []cmdTest{
+		{
+			"db snapshot without output",
+			"system db snapshot",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"db snapshot",
+			fmt.Sprintf("system db snapshot -o %s", filepath.Join(testDir, "out.tar.gz")),
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBSnapshotReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"db snapshot unwritable output",
+			"system db snapshot -o /bad/dir/out.tar.gz",
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBSnapshotReq{}),
+			}, " "),
+			errors.New("failed to write snapshot"),
+		},
+		{
+			"db restore without file",
+			"system db restore",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"db restore missing file",
+			"system db restore /bad/snapshot.tar.gz",
+			"",
+			errors.New("failed to read snapshot"),
+		},
+		{
+			"db restore dry run",
+			fmt.Sprintf("system db restore --dry-run %s", snapPath),
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBRestoreReq{
+					Data: []byte("snapshot data"),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"db restore forced",
+			fmt.Sprintf("system db restore --force %s", snapPath),
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBRestoreReq{
+					Data: []byte("snapshot data"),
+				}),
+				printRequest(t, &control.SystemDBRestoreReq{
+					Data:  []byte("snapshot data"),
+					Apply: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"db verify",
+			"system db verify",
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBVerifyReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"db diff without replica",
+			"system db diff",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"db diff with leader",
+			"system db diff host1:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBDiffReq{
+					Replicas: []string{"host1:10001"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"db diff two replicas",
+			"system db diff host1:10001 host2:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemDBDiffReq{
+					Replicas: []string{"host1:10001", "host2:10001"},
+				}),
+			}, " "),
+			nil,
+		},
+	})
+}
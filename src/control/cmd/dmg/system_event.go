@@ -0,0 +1,406 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+// parseEventTime parses a time filter value which can either be an absolute
+// (RFC3339) timestamp or a duration relative to now, e.g. "90m" means 90
+// minutes ago.
+func parseEventTime(in string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(in); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.Errorf("negative duration %q", in)
+		}
+		return now.Add(-d), nil
+	}
+
+	ts, err := common.ParseTime(in)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q (expected RFC3339 timestamp or duration)", in)
+	}
+
+	return ts, nil
+}
+
+// parseEventIDs parses a comma-separated list of RAS event identifiers,
+// given either by name or by number.
+func parseEventIDs(in string) ([]events.RASID, error) {
+	var ids []events.RASID
+	for _, field := range strings.Split(in, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		if n, err := strconv.ParseUint(field, 10, 32); err == nil {
+			ids = append(ids, events.RASID(n))
+			continue
+		}
+
+		id, err := events.RASIDFromString(field)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// systemEventsCmd is the struct representing the command to retrieve RAS
+// events from the management service event history.
+type systemEventsCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Ranks    ui.RankSetFlag `long:"ranks" short:"r" description:"Only show events involving the given ranks"`
+	Severity string         `long:"severity" short:"s" choice:"error" choice:"warning" choice:"notice" description:"Only show events of at least the given severity"`
+	IDs      string         `long:"id" description:"Only show events with the given comma-separated RAS event names or numbers"`
+	PoolUUID string         `long:"pool" short:"p" description:"Only show events involving the pool with the given UUID"`
+	Since    string         `long:"since" description:"Only show events raised at or after the given time (RFC3339 timestamp or duration ago, e.g. 2h)"`
+	Until    string         `long:"until" description:"Only show events raised at or before the given time (RFC3339 timestamp or duration ago, e.g. 30m)"`
+	Limit    int            `long:"limit" short:"n" description:"Maximum number of most recent events to show (0 for all)"`
+	Verbose  bool           `long:"verbose" short:"v" description:"Display all event details"`
+	Follow   bool           `long:"follow" short:"f" description:"Display events as they are raised until interrupted"`
+	Type     string         `long:"type" short:"t" choice:"state_change" choice:"info" description:"Only show events of the given type (--follow only)"`
+}
+
+// getStreamRequest builds a request to subscribe to live events. Filters
+// which only apply to the event history are rejected.
+func (cmd *systemEventsCmd) getStreamRequest() (*control.SystemStreamEventsReq, error) {
+	for _, opt := range []struct {
+		flag  string
+		isSet bool
+	}{
+		{"ranks", cmd.Ranks.Count() > 0},
+		{"pool", cmd.PoolUUID != ""},
+		{"since", cmd.Since != ""},
+		{"until", cmd.Until != ""},
+		{"limit", cmd.Limit != 0},
+	} {
+		if opt.isSet {
+			return nil, errors.Errorf("--%s may not be used with --follow", opt.flag)
+		}
+	}
+
+	req := new(control.SystemStreamEventsReq)
+	if cmd.Type != "" {
+		typ, err := events.RASTypeFromString(cmd.Type)
+		if err != nil {
+			return nil, err
+		}
+		req.Type = typ
+	}
+	if cmd.Severity != "" {
+		sev, err := events.RASSeverityFromString(cmd.Severity)
+		if err != nil {
+			return nil, err
+		}
+		req.Severity = sev
+	}
+	if cmd.IDs != "" {
+		ids, err := parseEventIDs(cmd.IDs)
+		if err != nil {
+			return nil, err
+		}
+		req.IDs = ids
+	}
+
+	return req, nil
+}
+
+// follow displays events as they are received from the MS leader.
+func (cmd *systemEventsCmd) follow() error {
+	req, err := cmd.getStreamRequest()
+	if err != nil {
+		return err
+	}
+
+	var outErr error
+	ctx, cancel := context.WithCancel(cmd.MustLogCtx())
+	defer cancel()
+	handler := events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+		if cmd.JSONOutputEnabled() {
+			outErr = cmd.OutputJSONLine(evt)
+		} else {
+			var out strings.Builder
+			outErr = pretty.PrintRASEvent(&out, evt, pretty.PrintWithVerboseOutput(cmd.Verbose))
+			cmd.Info(strings.TrimSuffix(out.String(), "\n"))
+		}
+		if outErr != nil {
+			cancel()
+		}
+	})
+
+	if err := control.SystemStreamEvents(ctx, cmd.ctlInvoker, req, handler); err != nil {
+		return err
+	}
+	return outErr
+}
+
+func (cmd *systemEventsCmd) getRequest() (*control.SystemGetEventsReq, error) {
+	req := &control.SystemGetEventsReq{
+		Limit: cmd.Limit,
+	}
+
+	if cmd.Ranks.Count() > 0 {
+		req.Ranks = &cmd.Ranks.RankSet
+	}
+	if cmd.Severity != "" {
+		sev, err := events.RASSeverityFromString(cmd.Severity)
+		if err != nil {
+			return nil, err
+		}
+		req.Severity = sev
+	}
+	if cmd.IDs != "" {
+		ids, err := parseEventIDs(cmd.IDs)
+		if err != nil {
+			return nil, err
+		}
+		req.IDs = ids
+	}
+	if cmd.PoolUUID != "" {
+		poolUUID, err := uuid.Parse(cmd.PoolUUID)
+		if err != nil {
+			return nil, errors.Errorf("invalid pool UUID %q", cmd.PoolUUID)
+		}
+		req.PoolUUID = poolUUID.String()
+	}
+
+	now := time.Now()
+	if cmd.Since != "" {
+		ts, err := parseEventTime(cmd.Since, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "--since")
+		}
+		req.Since = ts
+	}
+	if cmd.Until != "" {
+		ts, err := parseEventTime(cmd.Until, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "--until")
+		}
+		req.Until = ts
+	}
+
+	return req, nil
+}
+
+// Execute is run when systemEventsCmd subcommand is activated.
+func (cmd *systemEventsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events failed")
+	}()
+
+	if cmd.Follow {
+		return cmd.follow()
+	}
+	if cmd.Type != "" {
+		return errors.New("--type may only be used with --follow")
+	}
+
+	req, err := cmd.getRequest()
+	if err != nil {
+		return err
+	}
+
+	resp, err := control.SystemGetEvents(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemGetEventsResponse(&out, resp,
+		pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// systemEventPolicyCmd is the struct representing the commands to manage the
+// runtime RAS event policy.
+type systemEventPolicyCmd struct {
+	Set systemEventPolicySetCmd `command:"set" description:"Mute, unmute or debounce RAS event IDs across the system"`
+	Get systemEventPolicyGetCmd `command:"get" description:"Display the RAS event policy"`
+}
+
+// systemEventPolicySetCmd is the struct representing the command to update
+// the policy of one or more RAS event IDs.
+type systemEventPolicySetCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Disable    bool   `long:"disable" description:"Do not publish events with the given IDs"`
+	Enable     bool   `long:"enable" description:"Publish events with the given IDs"`
+	Debounce   bool   `long:"debounce" description:"Publish duplicate events only once (or once per --cooldown)"`
+	Cooldown   string `long:"cooldown" description:"Minimum time between publication of duplicate events, e.g. 5m (implies --debounce)"`
+	NoDebounce bool   `long:"no-debounce" description:"Publish all duplicate events"`
+	Reset      bool   `long:"reset" description:"Remove any policy for the given IDs"`
+	Args       struct {
+		IDs string `positional-arg-name:"<comma-separated RAS event names or numbers>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// updatePolicy applies the requested changes to an event ID's policy.
+func (cmd *systemEventPolicySetCmd) updatePolicy(p *control.EventPolicy, cooldown time.Duration) {
+	if cmd.Reset {
+		*p = control.EventPolicy{ID: p.ID}
+		return
+	}
+
+	switch {
+	case cmd.Disable:
+		p.Disabled = true
+	case cmd.Enable:
+		p.Disabled = false
+	}
+
+	switch {
+	case cmd.Debounce || cmd.Cooldown != "":
+		p.Debounce = true
+		p.Cooldown = cooldown
+	case cmd.NoDebounce:
+		p.Debounce = false
+		p.Cooldown = 0
+	}
+}
+
+func (cmd *systemEventPolicySetCmd) getPolicies(cur []*control.EventPolicy) ([]*control.EventPolicy, error) {
+	switch {
+	case cmd.Disable && cmd.Enable:
+		return nil, errors.New("--disable and --enable are mutually exclusive")
+	case cmd.NoDebounce && (cmd.Debounce || cmd.Cooldown != ""):
+		return nil, errors.New("--no-debounce may not be used with --debounce or --cooldown")
+	case cmd.Reset && (cmd.Disable || cmd.Enable || cmd.Debounce || cmd.Cooldown != "" || cmd.NoDebounce):
+		return nil, errors.New("--reset may not be used with other policy options")
+	case !(cmd.Reset || cmd.Disable || cmd.Enable || cmd.Debounce || cmd.Cooldown != "" || cmd.NoDebounce):
+		return nil, errors.New("no policy options specified")
+	}
+
+	var cooldown time.Duration
+	if cmd.Cooldown != "" {
+		var err error
+		cooldown, err = time.ParseDuration(cmd.Cooldown)
+		if err != nil || cooldown < 0 {
+			return nil, errors.Errorf("invalid cooldown %q", cmd.Cooldown)
+		}
+	}
+
+	ids, err := parseEventIDs(cmd.Args.IDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no event IDs specified")
+	}
+
+	curByID := make(map[events.RASID]*control.EventPolicy)
+	for _, p := range cur {
+		curByID[p.ID] = p
+	}
+
+	policies := make([]*control.EventPolicy, 0, len(ids))
+	for _, id := range ids {
+		p := &control.EventPolicy{ID: id}
+		if cp, found := curByID[id]; found {
+			*p = *cp
+		}
+		cmd.updatePolicy(p, cooldown)
+		policies = append(policies, p)
+	}
+
+	return policies, nil
+}
+
+// Execute is run when systemEventPolicySetCmd subcommand is activated.
+func (cmd *systemEventPolicySetCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system event-policy set failed")
+	}()
+
+	// Options not supplied leave the existing policy for an ID unchanged,
+	// so fetch the current policy to merge the changes into.
+	cur, err := control.SystemGetEventPolicy(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.SystemGetEventPolicyReq))
+	if err != nil {
+		return err
+	}
+
+	policies, err := cmd.getPolicies(cur.Policies)
+	if err != nil {
+		return err
+	}
+
+	req := &control.SystemSetEventPolicyReq{
+		Policies: policies,
+	}
+	err = control.SystemSetEventPolicy(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+	cmd.Info("system event-policy set succeeded")
+
+	return nil
+}
+
+// systemEventPolicyGetCmd is the struct representing the command to display
+// the RAS event policy.
+type systemEventPolicyGetCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemEventPolicyGetCmd subcommand is activated.
+func (cmd *systemEventPolicyGetCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system event-policy get failed")
+	}()
+
+	resp, err := control.SystemGetEventPolicy(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.SystemGetEventPolicyReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintEventPolicies(&out, resp.Policies)
+	cmd.Info(out.String())
+
+	return nil
+}
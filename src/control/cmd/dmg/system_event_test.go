@@ -0,0 +1,340 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestDmg_SystemEventsCommand(t *testing.T) {
+	since, err := time.Parse(time.RFC3339, "2024-01-02T03:04:05Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	until := since.Add(time.Hour)
+
+	runCmdTests(t, []cmdTest{
+		{
+			"system events with no arguments",
+			"system events",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetEventsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events with all filters",
+			"system events --ranks 0-2 --severity warning --id engine_died,15 " +
+				"--pool 00000001-0001-0001-0001-000000000001 " +
+				"--since 2024-01-02T03:04:05Z --until 2024-01-02T04:04:05Z --limit 5",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetEventsReq{
+					Ranks:    ranklist.MustCreateRankSet("0-2"),
+					Severity: events.RASSeverityWarning,
+					IDs:      []events.RASID{events.RASEngineDied, events.RASSwimRankDead},
+					PoolUUID: test.MockUUID(1),
+					Since:    since,
+					Until:    until,
+					Limit:    5,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events with bad severity",
+			"system events --severity critical",
+			"",
+			errors.New("Invalid value"),
+		},
+		{
+			"system events with bad event id",
+			"system events --id engine_died,foo",
+			"",
+			errors.New(`unknown RAS event "foo"`),
+		},
+		{
+			"system events with bad pool uuid",
+			"system events --pool foo",
+			"",
+			errors.New("invalid pool UUID"),
+		},
+		{
+			"system events with bad since time",
+			"system events --since yesterday",
+			"",
+			errors.New("--since: invalid time"),
+		},
+		{
+			"system events with until before since",
+			"system events --since 2024-01-02T04:04:05Z --until 2024-01-02T03:04:05Z",
+			"",
+			errors.New("until time must not be before since time"),
+		},
+		{
+			"system events with global insecure option",
+			"system events -i --id engine_died",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetEventsReq{
+					IDs: []events.RASID{events.RASEngineDied},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events with type but no follow",
+			"system events --type info",
+			"",
+			errors.New("--type may only be used with --follow"),
+		},
+		{
+			"system events follow",
+			"system events --follow",
+			strings.Join([]string{
+				printRequest(t, &control.SystemStreamEventsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events follow with filters",
+			"system events -f --type state_change --severity error --id engine_died,swim_rank_dead",
+			strings.Join([]string{
+				printRequest(t, &control.SystemStreamEventsReq{
+					Type:     events.RASTypeStateChange,
+					Severity: events.RASSeverityError,
+					IDs:      []events.RASID{events.RASEngineDied, events.RASSwimRankDead},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events follow with history filter",
+			"system events --follow --since 1h",
+			"",
+			errors.New("--since may not be used with --follow"),
+		},
+		{
+			"system events follow with bad type",
+			"system events --follow --type any",
+			"",
+			errors.New("Invalid value"),
+		},
+	})
+}
+
+func TestDmg_SystemEventPolicyCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"event-policy get",
+			"system event-policy get",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetEventPolicyReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"event-policy set disable",
+			"system event-policy set --disable engine_format_required,device_link_speed_changed",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetEventPolicyReq{}),
+				printRequest(t, &control.SystemSetEventPolicyReq{
+					Policies: []*control.EventPolicy{
+						{ID: events.RASEngineFormatRequired, Disabled: true},
+						{ID: events.RASNVMeLinkSpeedChanged, Disabled: true},
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"event-policy set cooldown",
+			"system event-policy set --cooldown 5m device_link_speed_changed",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetEventPolicyReq{}),
+				printRequest(t, &control.SystemSetEventPolicyReq{
+					Policies: []*control.EventPolicy{
+						{ID: events.RASNVMeLinkSpeedChanged, Debounce: true, Cooldown: 5 * time.Minute},
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"event-policy set without ids",
+			"system event-policy set --disable",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"event-policy set without options",
+			"system event-policy set engine_format_required",
+			"",
+			errors.New("no policy options specified"),
+		},
+		{
+			"event-policy set bad id",
+			"system event-policy set --disable foo",
+			"",
+			errors.New(`unknown RAS event "foo"`),
+		},
+	})
+}
+
+func TestDmg_systemEventPolicySetCmd_getPolicies(t *testing.T) {
+	fmtReq := events.RASEngineFormatRequired
+	linkSpeed := events.RASNVMeLinkSpeedChanged
+	cur := []*control.EventPolicy{
+		{ID: fmtReq, Disabled: true, Debounce: true, Cooldown: time.Minute},
+	}
+
+	for name, tc := range map[string]struct {
+		cmd         *systemEventPolicySetCmd
+		expPolicies []*control.EventPolicy
+		expErr      error
+	}{
+		"enable and disable": {
+			cmd:    &systemEventPolicySetCmd{Enable: true, Disable: true},
+			expErr: errors.New("mutually exclusive"),
+		},
+		"debounce and no-debounce": {
+			cmd:    &systemEventPolicySetCmd{Debounce: true, NoDebounce: true},
+			expErr: errors.New("--no-debounce may not be used"),
+		},
+		"reset with other options": {
+			cmd:    &systemEventPolicySetCmd{Reset: true, Disable: true},
+			expErr: errors.New("--reset may not be used"),
+		},
+		"bad cooldown": {
+			cmd:    &systemEventPolicySetCmd{Cooldown: "-5m"},
+			expErr: errors.New("invalid cooldown"),
+		},
+		"merge with existing": {
+			cmd: &systemEventPolicySetCmd{Enable: true},
+			expPolicies: []*control.EventPolicy{
+				{ID: fmtReq, Debounce: true, Cooldown: time.Minute},
+				{ID: linkSpeed},
+			},
+		},
+		"debounce once": {
+			cmd: &systemEventPolicySetCmd{Debounce: true},
+			expPolicies: []*control.EventPolicy{
+				{ID: fmtReq, Disabled: true, Debounce: true},
+				{ID: linkSpeed, Debounce: true},
+			},
+		},
+		"no debounce": {
+			cmd: &systemEventPolicySetCmd{NoDebounce: true},
+			expPolicies: []*control.EventPolicy{
+				{ID: fmtReq, Disabled: true},
+				{ID: linkSpeed},
+			},
+		},
+		"reset": {
+			cmd: &systemEventPolicySetCmd{Reset: true},
+			expPolicies: []*control.EventPolicy{
+				{ID: fmtReq},
+				{ID: linkSpeed},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.cmd.Args.IDs = "engine_format_required,device_link_speed_changed"
+
+			gotPolicies, gotErr := tc.cmd.getPolicies(cur)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expPolicies, gotPolicies); diff != "" {
+				t.Fatalf("unexpected policies (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_parseEventTime(t *testing.T) {
+	now := time.Now()
+	abs, err := time.Parse(time.RFC3339, "2024-01-02T03:04:05Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		in     string
+		expTS  time.Time
+		expErr error
+	}{
+		"duration": {
+			in:    "90m",
+			expTS: now.Add(-90 * time.Minute),
+		},
+		"negative duration": {
+			in:     "-1h",
+			expErr: errors.New("negative duration"),
+		},
+		"timestamp": {
+			in:    "2024-01-02T03:04:05Z",
+			expTS: abs,
+		},
+		"garbage": {
+			in:     "last week",
+			expErr: errors.New("invalid time"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ts, err := parseEventTime(tc.in, now)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if !tc.expTS.Equal(ts) {
+				t.Fatalf("expected %s, got %s", tc.expTS, ts)
+			}
+		})
+	}
+}
+
+func TestDmg_parseEventIDs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expIDs []events.RASID
+		expErr error
+	}{
+		"empty": {},
+		"names and numbers": {
+			in:     "engine_died, 15,swim_rank_alive",
+			expIDs: []events.RASID{events.RASEngineDied, events.RASSwimRankDead, events.RASSwimRankAlive},
+		},
+		"unknown name": {
+			in:     "engine_died,foo",
+			expErr: errors.New("unknown RAS event"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ids, err := parseEventIDs(tc.in)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expIDs, ids); diff != "" {
+				t.Fatalf("unexpected ids (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+// systemQuotaCmd is the struct representing the commands to manage the
+// resource quotas for users and groups.
+type systemQuotaCmd struct {
+	Set  systemQuotaSetCmd  `command:"set" description:"Set the resource quota for a user or group"`
+	Get  systemQuotaGetCmd  `command:"get" description:"Show the resource quota and usage for a user or group"`
+	List systemQuotaListCmd `command:"list" description:"List all resource quotas and their usage"`
+}
+
+// quotaPrincipalCmd selects the user or group that a quota applies to.
+type quotaPrincipalCmd struct {
+	User  ui.ACLPrincipalFlag `short:"u" long:"user" description:"User that the quota applies to, format name@domain"`
+	Group ui.ACLPrincipalFlag `short:"g" long:"group" description:"Group that the quota applies to, format name@domain"`
+}
+
+func (cmd *quotaPrincipalCmd) checkPrincipal() error {
+	switch {
+	case cmd.User != "" && cmd.Group != "":
+		return errors.New("only one of --user or --group may be supplied")
+	case cmd.User == "" && cmd.Group == "":
+		return errors.New("one of --user or --group must be supplied")
+	default:
+		return nil
+	}
+}
+
+func (cmd *quotaPrincipalCmd) String() string {
+	if cmd.User != "" {
+		return "user " + cmd.User.String()
+	}
+	return "group " + cmd.Group.String()
+}
+
+// systemQuotaSetCmd is the struct representing the command to set the
+// resource quota for a user or group.
+type systemQuotaSetCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	quotaPrincipalCmd
+	MaxPools uint32   `short:"p" long:"max-pools" description:"Maximum number of pools (0 for unlimited)"`
+	ScmSize  sizeFlag `short:"s" long:"scm-size" description:"Maximum SCM allocated across all pools (unlimited if unset)"`
+	NVMeSize sizeFlag `short:"n" long:"nvme-size" description:"Maximum NVMe allocated across all pools (unlimited if unset)"`
+}
+
+// Execute is run when systemQuotaSetCmd subcommand is activated.
+func (cmd *systemQuotaSetCmd) Execute(_ []string) error {
+	if err := cmd.checkPrincipal(); err != nil {
+		return errors.Wrap(err, "system quota set failed")
+	}
+
+	req := &control.SystemSetQuotaReq{
+		User:     cmd.User.String(),
+		Group:    cmd.Group.String(),
+		MaxPools: cmd.MaxPools,
+	}
+	if cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet() {
+		req.TierBytes = []uint64{cmd.ScmSize.bytes, cmd.NVMeSize.bytes}
+	}
+
+	err := control.SystemSetQuota(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system quota set failed")
+	}
+	if req.MaxPools == 0 && req.TierBytes == nil {
+		cmd.Infof("system quota for %s removed", &cmd.quotaPrincipalCmd)
+		return nil
+	}
+	cmd.Info("system quota set succeeded")
+
+	return nil
+}
+
+// systemQuotaGetCmd is the struct representing the command to show the
+// resource quota for a user or group.
+type systemQuotaGetCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	quotaPrincipalCmd
+}
+
+// Execute is run when systemQuotaGetCmd subcommand is activated.
+func (cmd *systemQuotaGetCmd) Execute(_ []string) error {
+	if err := cmd.checkPrincipal(); err != nil {
+		return errors.Wrap(err, "system quota get failed")
+	}
+
+	req := new(control.SystemGetQuotaReq)
+	if cmd.User != "" {
+		req.Users = []string{cmd.User.String()}
+	} else {
+		req.Groups = []string{cmd.Group.String()}
+	}
+
+	resp, err := control.SystemGetQuota(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system quota get failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintSystemQuotas(&bld, resp.Quotas)
+	cmd.Infof("%s", bld.String())
+
+	return nil
+}
+
+// systemQuotaListCmd is the struct representing the command to list all
+// resource quotas.
+type systemQuotaListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemQuotaListCmd subcommand is activated.
+func (cmd *systemQuotaListCmd) Execute(_ []string) error {
+	resp, err := control.SystemGetQuota(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemGetQuotaReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system quota list failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintSystemQuotas(&bld, resp.Quotas)
+	cmd.Infof("%s", bld.String())
+
+	return nil
+}
@@ -0,0 +1,103 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_SystemQuotaCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"quota set without principal",
+			"system quota set --max-pools 2",
+			"",
+			errors.New("one of --user or --group"),
+		},
+		{
+			"quota set with user and group",
+			"system quota set -u bob -g builders --max-pools 2",
+			"",
+			errors.New("only one of --user or --group"),
+		},
+		{
+			"quota set invalid principal",
+			"system quota set -u bob@@ --max-pools 2",
+			"",
+			errors.New("invalid ACL principal"),
+		},
+		{
+			"quota set pool count",
+			"system quota set -u bob --max-pools 2",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetQuotaReq{
+					User:     "bob@",
+					MaxPools: 2,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"quota set storage",
+			"system quota set --group builders@ --nvme-size 1TB",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetQuotaReq{
+					Group:     "builders@",
+					TierBytes: []uint64{0, 1000000000000},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"quota set all limits",
+			"system quota set -u bob -p 4 -s 10GB -n 1TB",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetQuotaReq{
+					User:      "bob@",
+					MaxPools:  4,
+					TierBytes: []uint64{10000000000, 1000000000000},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"quota set bad size",
+			"system quota set -u bob --scm-size foo",
+			"",
+			errors.New("invalid"),
+		},
+		{
+			"quota get without principal",
+			"system quota get",
+			"",
+			errors.New("one of --user or --group"),
+		},
+		{
+			"quota get",
+			"system quota get --group builders",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetQuotaReq{
+					Groups: []string{"builders@"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"quota list",
+			"system quota list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetQuotaReq{}),
+			}, " "),
+			nil,
+		},
+	})
+}
@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemReplicasCmd is the struct representing the commands to reconfigure
+// the Management Service replica set at runtime.
+type systemReplicasCmd struct {
+	Add                systemReplicaAddCmd     `command:"add" description:"Add a server to the Management Service replica set"`
+	Remove             systemReplicaRemoveCmd  `command:"remove" description:"Remove a server from the Management Service replica set"`
+	TransferLeadership systemLeaderTransferCmd `command:"transfer-leadership" description:"Transfer Management Service leadership to another replica"`
+}
+
+// replicaAddrArg is embedded by commands that operate on the MS replica at the
+// supplied control address.
+type replicaAddrArg struct {
+	Args struct {
+		Addr string `positional-arg-name:"<host:port>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// printReplicaResp displays the MS replica set after a change.
+func printReplicaResp(cmd *baseCmd, resp *control.SystemReplicaResp) {
+	cmd.Infof("Current Leader: %s\n   Replica Set: %s\n", resp.Leader,
+		strings.Join(resp.Replicas, ", "))
+}
+
+// systemReplicaAddCmd is the struct representing the command to add a server
+// to the MS replica set.
+type systemReplicaAddCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	replicaAddrArg
+	Force bool `short:"f" long:"force" description:"Add the replica even if quorum could not be maintained"`
+}
+
+// Execute is run when systemReplicaAddCmd subcommand is activated.
+func (cmd *systemReplicaAddCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system replicas add failed")
+	}()
+
+	resp, err := control.SystemReplicaAdd(cmd.MustLogCtx(), cmd.ctlInvoker,
+		&control.SystemReplicaReq{
+			Addr:  cmd.Args.Addr,
+			Force: cmd.Force,
+		})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	printReplicaResp(&cmd.baseCmd, resp)
+
+	return nil
+}
+
+// systemReplicaRemoveCmd is the struct representing the command to remove a
+// server from the MS replica set.
+type systemReplicaRemoveCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	replicaAddrArg
+	Force bool `short:"f" long:"force" description:"Remove the replica even if quorum could not be maintained"`
+}
+
+// Execute is run when systemReplicaRemoveCmd subcommand is activated.
+func (cmd *systemReplicaRemoveCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system replicas remove failed")
+	}()
+
+	resp, err := control.SystemReplicaRemove(cmd.MustLogCtx(), cmd.ctlInvoker,
+		&control.SystemReplicaReq{
+			Addr:  cmd.Args.Addr,
+			Force: cmd.Force,
+		})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	printReplicaResp(&cmd.baseCmd, resp)
+
+	return nil
+}
+
+// systemLeaderTransferCmd is the struct representing the command to transfer
+// MS leadership to another replica.
+type systemLeaderTransferCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Args struct {
+		Addr string `positional-arg-name:"<host:port>" description:"Replica to transfer leadership to; any replica if unset"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemLeaderTransferCmd subcommand is activated.
+func (cmd *systemLeaderTransferCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system replicas transfer-leadership failed")
+	}()
+
+	resp, err := control.SystemLeaderTransfer(cmd.MustLogCtx(), cmd.ctlInvoker,
+		&control.SystemLeaderTransferReq{
+			Addr: cmd.Args.Addr,
+		})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	printReplicaResp(&cmd.baseCmd, resp)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_SystemReplicasCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"replicas add without address",
+			"system replicas add",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"replicas add",
+			"system replicas add host1:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicaReq{
+					Addr: "host1:10001",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"replicas add forced",
+			"system replicas add --force host1:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicaReq{
+					Addr:  "host1:10001",
+					Force: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"replicas remove without address",
+			"system replicas remove",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"replicas remove",
+			"system replicas remove host1:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicaReq{
+					Addr: "host1:10001",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"transfer leadership to any replica",
+			"system replicas transfer-leadership",
+			strings.Join([]string{
+				printRequest(t, &control.SystemLeaderTransferReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"transfer leadership to replica",
+			"system replicas transfer-leadership host2:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemLeaderTransferReq{
+					Addr: "host2:10001",
+				}),
+			}, " "),
+			nil,
+		},
+	})
+}
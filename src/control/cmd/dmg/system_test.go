@@ -245,6 +245,14 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"whoami",
+			"system whoami",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAccessReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"system list-pools with default config",
 			"system list-pools",
@@ -16,35 +16,55 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
+// OutputSchemaVersion is the version of the machine-readable output schema.
+// It is included in every JSON or YAML response and must be incremented
+// whenever the output of any command changes in a way that is not backwards
+// compatible, e.g. when a field is removed, renamed or changes type. Adding
+// fields does not require a new version.
+const OutputSchemaVersion = 1
+
 var _ JSONOutputter = (*JSONOutputCmd)(nil)
 
 type (
 	// JSONOutputter is an interface for commands that can output JSON.
 	JSONOutputter interface {
 		EnableJSONOutput(io.Writer, *atm.Bool)
+		SetOutputFormat(OutputFormat)
 		JSONOutputEnabled() bool
 		OutputJSON(interface{}, error) error
 	}
+
+	// outputEnvelope wraps the response or error of a command in
+	// machine-readable output.
+	outputEnvelope struct {
+		Response      interface{} `json:"response"`
+		Error         *string     `json:"error"`
+		Status        int         `json:"status"`
+		SchemaVersion int         `json:"schema_version"`
+	}
 )
 
-// OutputJSON writes the given data or error to the given writer as JSON.
-func OutputJSON(writer io.Writer, in interface{}, inErr error) error {
-	status := 0
-	var errStr *string
+func newOutputEnvelope(in interface{}, inErr error) *outputEnvelope {
+	env := &outputEnvelope{
+		Response:      in,
+		SchemaVersion: OutputSchemaVersion,
+	}
 	if inErr != nil {
-		errStr = func() *string { str := inErr.Error(); return &str }()
+		errStr := inErr.Error()
+		env.Error = &errStr
 		if s, ok := errors.Cause(inErr).(daos.Status); ok {
-			status = int(s)
+			env.Status = int(s)
 		} else {
-			status = int(daos.MiscError)
+			env.Status = int(daos.MiscError)
 		}
 	}
 
-	data, err := json.MarshalIndent(struct {
-		Response interface{} `json:"response"`
-		Error    *string     `json:"error"`
-		Status   int         `json:"status"`
-	}{in, errStr, status}, "", "  ")
+	return env
+}
+
+// OutputJSON writes the given data or error to the given writer as JSON.
+func OutputJSON(writer io.Writer, in interface{}, inErr error) error {
+	data, err := json.MarshalIndent(newOutputEnvelope(in, inErr), "", "  ")
 	if err != nil {
 		return err
 	}
@@ -60,6 +80,7 @@ func OutputJSON(writer io.Writer, in interface{}, inErr error) error {
 // can be embedded in a command struct to provide JSON output.
 type JSONOutputCmd struct {
 	writer      io.Writer
+	format      OutputFormat
 	jsonEnabled atm.Bool
 	wroteJSON   *atm.Bool
 }
@@ -76,17 +97,56 @@ func (cmd *JSONOutputCmd) EnableJSONOutput(writer io.Writer, wroteJSON *atm.Bool
 	cmd.jsonEnabled.SetTrue()
 }
 
-// JSONOutputEnabled returns true if JSON output is enabled.
+// SetOutputFormat selects the format used once machine-readable output has
+// been enabled. JSON is used if no format is set.
+func (cmd *JSONOutputCmd) SetOutputFormat(format OutputFormat) {
+	cmd.format = format
+}
+
+// JSONOutputEnabled returns true if JSON output is enabled. It is also true
+// if output has been enabled in one of the other machine-readable formats.
 func (cmd *JSONOutputCmd) JSONOutputEnabled() bool {
 	return cmd.jsonEnabled.IsTrue()
 }
 
-// OutputJSON writes the given data or error to the command's writer as JSON.
+// OutputJSON writes the given data or error to the command's writer as JSON,
+// or in the output format selected with SetOutputFormat.
 func (cmd *JSONOutputCmd) OutputJSON(in interface{}, err error) error {
 	if cmd.JSONOutputEnabled() && cmd.wroteJSON.IsFalse() {
 		cmd.wroteJSON.SetTrue()
-		return OutputJSON(cmd.writer, in, err)
+		return WriteOutput(cmd.writer, cmd.format, in, err)
 	}
 
 	return nil
 }
+
+// OutputJSONLine writes the given data to the command's writer as a single
+// line of compact JSON. Unlike OutputJSON, it may be called repeatedly in
+// order to emit a stream of JSON objects. In YAML mode each object is written
+// as a separate YAML document.
+func (cmd *JSONOutputCmd) OutputJSONLine(in interface{}) error {
+	if !cmd.JSONOutputEnabled() {
+		return nil
+	}
+	cmd.wroteJSON.SetTrue()
+
+	switch cmd.format {
+	case OutputFormatYAML:
+		data, err := marshalYAML(in)
+		if err != nil {
+			return err
+		}
+		_, err = cmd.writer.Write(append([]byte("---\n"), data...))
+		return err
+	case OutputFormatCSV:
+		return errNoCSVOutput
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.writer.Write(append(data, []byte("\n")...))
+	return err
+}
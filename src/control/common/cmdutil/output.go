@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFormat identifies a machine-readable output format.
+type OutputFormat string
+
+const (
+	// OutputFormatJSON writes the response envelope as indented JSON.
+	OutputFormatJSON OutputFormat = "json"
+	// OutputFormatYAML writes the response envelope as YAML, using the
+	// same keys as the JSON output.
+	OutputFormatYAML OutputFormat = "yaml"
+	// OutputFormatCSV writes tabular responses as CSV, with a header row.
+	OutputFormatCSV OutputFormat = "csv"
+)
+
+var errNoCSVOutput = errors.New("CSV output is not supported by this command")
+
+type (
+	// Table is a tabular representation of a command response.
+	Table struct {
+		Header []string
+		Rows   [][]string
+	}
+
+	// Tabulator is implemented by command responses that can be written
+	// as CSV.
+	Tabulator interface {
+		Table() *Table
+	}
+
+	// TabularOutput pairs a command response with its tabular
+	// representation. It marshals to the same JSON as the response alone,
+	// so the JSON and YAML output are not affected by the wrapping.
+	TabularOutput struct {
+		Response interface{}
+		table    *Table
+	}
+)
+
+// NewTabularOutput returns a TabularOutput for the given response and table.
+func NewTabularOutput(resp interface{}, table *Table) *TabularOutput {
+	return &TabularOutput{
+		Response: resp,
+		table:    table,
+	}
+}
+
+// Table returns the tabular representation of the response.
+func (to *TabularOutput) Table() *Table {
+	return to.table
+}
+
+// MarshalJSON marshals the wrapped response.
+func (to *TabularOutput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(to.Response)
+}
+
+// marshalYAML converts the JSON representation of the input to YAML so that
+// the keys and custom encodings match the JSON output. The order of object
+// keys is preserved.
+func marshalYAML(in interface{}) ([]byte, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if bytes.HasPrefix(data, []byte("{")) {
+		out = &yaml.MapSlice{}
+	} else {
+		out = new(interface{})
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return nil, errors.Wrap(err, "converting JSON to YAML")
+	}
+
+	return yaml.Marshal(out)
+}
+
+// OutputYAML writes the given data or error to the given writer as YAML.
+func OutputYAML(writer io.Writer, in interface{}, inErr error) error {
+	data, err := marshalYAML(newOutputEnvelope(in, inErr))
+	if err != nil {
+		return err
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		return err
+	}
+
+	return inErr
+}
+
+func isNil(in interface{}) bool {
+	if in == nil {
+		return true
+	}
+	val := reflect.ValueOf(in)
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return val.IsNil()
+	}
+	return false
+}
+
+// OutputCSV writes the given data to the given writer as CSV. The data must
+// implement Tabulator. Errors are not written to the output, so that the
+// output only contains rows of the table.
+func OutputCSV(writer io.Writer, in interface{}, inErr error) error {
+	tab, ok := in.(Tabulator)
+	if !ok {
+		if isNil(in) || inErr != nil {
+			return inErr
+		}
+		return errNoCSVOutput
+	}
+
+	if table := tab.Table(); table != nil {
+		cw := csv.NewWriter(writer)
+		if err := cw.Write(table.Header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(table.Rows); err != nil {
+			return err
+		}
+	}
+
+	return inErr
+}
+
+// WriteOutput writes the given data or error to the given writer in the
+// given format. JSON is used if no format is specified.
+func WriteOutput(writer io.Writer, format OutputFormat, in interface{}, inErr error) error {
+	switch format {
+	case "", OutputFormatJSON:
+		return OutputJSON(writer, in, inErr)
+	case OutputFormatYAML:
+		return OutputYAML(writer, in, inErr)
+	case OutputFormatCSV:
+		return OutputCSV(writer, in, inErr)
+	default:
+		return errors.Errorf("unknown output format %q", format)
+	}
+}
@@ -0,0 +1,199 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+type testOutput struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+	Info  *struct {
+		Zed   string `json:"zed"`
+		Alpha string `json:"alpha"`
+	} `json:"info,omitempty"`
+}
+
+func TestCmdutil_WriteOutput(t *testing.T) {
+	testTable := &Table{
+		Header: []string{"name", "count"},
+		Rows: [][]string{
+			{"a", "1"},
+			{"b, c", "2"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		format OutputFormat
+		in     interface{}
+		inErr  error
+		expOut string
+		expErr error
+	}{
+		"json": {
+			format: OutputFormatJSON,
+			in:     &testOutput{Name: "a", Count: 1},
+			expOut: `{
+  "response": {
+    "name": "a",
+    "count": 1
+  },
+  "error": null,
+  "status": 0,
+  "schema_version": 1
+}
+`,
+		},
+		"default is json": {
+			in:     []string{"a"},
+			inErr:  daos.Nonexistent,
+			expErr: daos.Nonexistent,
+			expOut: `{
+  "response": [
+    "a"
+  ],
+  "error": "` + daos.Nonexistent.Error() + `",
+  "status": -1005,
+  "schema_version": 1
+}
+`,
+		},
+		"yaml keeps key order": {
+			format: OutputFormatYAML,
+			in: &testOutput{
+				Name:  "a",
+				Count: 1,
+				Info: &struct {
+					Zed   string `json:"zed"`
+					Alpha string `json:"alpha"`
+				}{"z", "a"},
+			},
+			expOut: `response:
+  name: a
+  count: 1
+  info:
+    zed: z
+    alpha: a
+error: null
+status: 0
+schema_version: 1
+`,
+		},
+		"yaml error": {
+			format: OutputFormatYAML,
+			inErr:  errors.New("whoops"),
+			expErr: errors.New("whoops"),
+			expOut: `response: null
+error: whoops
+status: -1025
+schema_version: 1
+`,
+		},
+		"yaml tabular output marshals response": {
+			format: OutputFormatYAML,
+			in:     NewTabularOutput(&testOutput{Name: "a"}, testTable),
+			expOut: `response:
+  name: a
+  count: 0
+error: null
+status: 0
+schema_version: 1
+`,
+		},
+		"csv": {
+			format: OutputFormatCSV,
+			in:     NewTabularOutput(&testOutput{Name: "a"}, testTable),
+			expOut: "name,count\na,1\n\"b, c\",2\n",
+		},
+		"csv with partial error": {
+			format: OutputFormatCSV,
+			in:     NewTabularOutput(&testOutput{Name: "a"}, testTable),
+			inErr:  errors.New("whoops"),
+			expOut: "name,count\na,1\n\"b, c\",2\n",
+			expErr: errors.New("whoops"),
+		},
+		"csv nil table": {
+			format: OutputFormatCSV,
+			in:     NewTabularOutput(nil, nil),
+		},
+		"csv error only": {
+			format: OutputFormatCSV,
+			inErr:  errors.New("whoops"),
+			expErr: errors.New("whoops"),
+		},
+		"csv not tabular": {
+			format: OutputFormatCSV,
+			in:     &testOutput{Name: "a"},
+			expErr: errNoCSVOutput,
+		},
+		"unknown format": {
+			format: "xml",
+			expErr: errors.New("unknown output format"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf strings.Builder
+			gotErr := WriteOutput(&buf, tc.format, tc.in, tc.inErr)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expOut, buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCmdutil_JSONOutputCmd(t *testing.T) {
+	for name, tc := range map[string]struct {
+		format OutputFormat
+		lines  []interface{}
+		expOut string
+		expErr error
+	}{
+		"json lines": {
+			lines:  []interface{}{&testOutput{Name: "a"}, &testOutput{Name: "b"}},
+			expOut: "{\"name\":\"a\",\"count\":0}\n{\"name\":\"b\",\"count\":0}\n",
+		},
+		"yaml documents": {
+			format: OutputFormatYAML,
+			lines:  []interface{}{&testOutput{Name: "a"}, &testOutput{Name: "b"}},
+			expOut: "---\nname: a\ncount: 0\n---\nname: b\ncount: 0\n",
+		},
+		"csv": {
+			format: OutputFormatCSV,
+			lines:  []interface{}{&testOutput{Name: "a"}},
+			expErr: errNoCSVOutput,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf strings.Builder
+			cmd := &JSONOutputCmd{}
+			cmd.EnableJSONOutput(&buf, nil)
+			cmd.SetOutputFormat(tc.format)
+
+			var gotErr error
+			for _, line := range tc.lines {
+				if gotErr = cmd.OutputJSONLine(line); gotErr != nil {
+					break
+				}
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expOut, buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDialect is the JSON Schema dialect used for generated schemas.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// schema is a JSON Schema document or subschema.
+type schema map[string]interface{}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+)
+
+// OutputSchema returns a JSON Schema describing the JSON output of a command
+// whose response has the same type as resp. The schema covers the whole
+// output envelope, including the error, status and schema version.
+//
+// The schema is derived from the response type by reflection, following the
+// rules used by encoding/json. Types with a custom JSON encoding are
+// described by marshaling their zero value, so fields that are omitted or
+// null in the zero value are described less precisely.
+func OutputSchema(title string, resp interface{}) map[string]interface{} {
+	gen := &schemaGenerator{
+		visiting: make(map[reflect.Type]bool),
+	}
+
+	return schema{
+		"$schema": jsonSchemaDialect,
+		"title":   title,
+		"type":    "object",
+		"properties": schema{
+			"response":       nullable(gen.typeSchema(reflect.TypeOf(resp))),
+			"error":          schema{"type": []string{"string", "null"}},
+			"status":         schema{"type": "integer"},
+			"schema_version": schema{"const": OutputSchemaVersion},
+		},
+		"required": []string{"response", "error", "status", "schema_version"},
+	}
+}
+
+type schemaGenerator struct {
+	visiting map[reflect.Type]bool
+}
+
+// nullable allows the given schema to also match null.
+func nullable(s schema) schema {
+	switch t := s["type"].(type) {
+	case string:
+		s["type"] = []string{t, "null"}
+	case []string:
+		for _, elem := range t {
+			if elem == "null" {
+				return s
+			}
+		}
+		s["type"] = append(t, "null")
+	}
+	return s
+}
+
+// primaryType returns the first non-null type of the given schema, if any.
+// Integers are reported as numbers, as the two can't be told apart in a
+// decoded JSON value.
+func primaryType(s schema) string {
+	var types []string
+	switch t := s["type"].(type) {
+	case string:
+		types = []string{t}
+	case []string:
+		types = t
+	}
+
+	for _, elem := range types {
+		switch elem {
+		case "null":
+			continue
+		case "integer":
+			return "number"
+		}
+		return elem
+	}
+	return ""
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
+
+func (g *schemaGenerator) typeSchema(t reflect.Type) schema {
+	if t == nil {
+		return schema{}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return nullable(g.typeSchema(t.Elem()))
+	}
+
+	switch {
+	case t == timeType:
+		return schema{"type": "string", "format": "date-time"}
+	case t == rawMessageType:
+		return schema{}
+	case implements(t, jsonMarshalerType):
+		return g.marshalerSchema(t)
+	case implements(t, textMarshalerType):
+		return schema{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json writes byte slices as base64 strings
+			return schema{"type": []string{"string", "null"}, "contentEncoding": "base64"}
+		}
+		return nullable(schema{"type": "array", "items": g.typeSchema(t.Elem())})
+	case reflect.Array:
+		return schema{
+			"type":     "array",
+			"items":    g.typeSchema(t.Elem()),
+			"minItems": t.Len(),
+			"maxItems": t.Len(),
+		}
+	case reflect.Map:
+		return nullable(schema{
+			"type":                 "object",
+			"additionalProperties": g.typeSchema(t.Elem()),
+		})
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		// interfaces may hold any value
+		return schema{}
+	}
+}
+
+type schemaField struct {
+	name     string
+	schema   schema
+	required bool
+}
+
+// structFields returns the fields of the given struct type in the order in
+// which encoding/json writes them. Fields of embedded structs are promoted
+// unless a field with the same name exists at a shallower depth.
+func (g *schemaGenerator) structFields(t reflect.Type, seen map[string]bool) []*schemaField {
+	var fields []*schemaField
+	var embedded []reflect.Type
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		ft := sf.Type
+		if sf.Anonymous && name == "" {
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		switch ft.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Complex64, reflect.Complex128:
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		fs := g.typeSchema(sf.Type)
+		if strings.Contains(","+opts+",", ",string,") {
+			fs = schema{"type": "string"}
+		}
+		fields = append(fields, &schemaField{
+			name:     name,
+			schema:   fs,
+			required: !strings.Contains(","+opts+",", ",omitempty,"),
+		})
+	}
+
+	for _, et := range embedded {
+		fields = append(fields, g.structFields(et, seen)...)
+	}
+
+	return fields
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) schema {
+	if g.visiting[t] {
+		// recursive types are not described further
+		return schema{}
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	props := schema{}
+	required := []string{}
+	for _, field := range g.structFields(t, make(map[string]bool)) {
+		props[field.name] = field.schema
+		if field.required {
+			required = append(required, field.name)
+		}
+	}
+
+	s := schema{
+		"type":       "object",
+		"properties": props,
+	}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+// marshalerSchema describes a type with a custom JSON encoding by marshaling
+// its zero value. For structs, the schema derived from the fields is used as a
+// starting point and updated with the keys found in the encoded value.
+func (g *schemaGenerator) marshalerSchema(t reflect.Type) (s schema) {
+	sample, ok := sampleJSON(t)
+	if !ok {
+		return schema{}
+	}
+
+	sampleSchema := inferSchema(sample)
+	sampleObj, isObj := sample.(map[string]interface{})
+	if t.Kind() != reflect.Struct || !isObj {
+		return sampleSchema
+	}
+
+	s = g.structSchema(t)
+	props, ok := s["properties"].(schema)
+	if !ok {
+		return sampleSchema
+	}
+	sampleProps := sampleSchema["properties"].(schema)
+
+	required := []string{}
+	for name, val := range sampleObj {
+		required = append(required, name)
+
+		fs, found := props[name].(schema)
+		if !found || (val != nil && primaryType(fs) != primaryType(sampleProps[name].(schema))) {
+			props[name] = sampleProps[name]
+		}
+	}
+	// The encoded value defines which keys are always present.
+	delete(s, "required")
+	if len(required) > 0 {
+		sort.Strings(required)
+		s["required"] = required
+	}
+
+	return s
+}
+
+// sampleJSON returns the decoded JSON encoding of the zero value of the given
+// type, or false if the zero value could not be encoded.
+func sampleJSON(t reflect.Type) (sample interface{}, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	data, err := json.Marshal(reflect.New(t).Interface())
+	if err != nil {
+		return nil, false
+	}
+	if err := json.Unmarshal(data, &sample); err != nil {
+		return nil, false
+	}
+	return sample, true
+}
+
+// inferSchema returns a schema describing the given decoded JSON value.
+func inferSchema(val interface{}) schema {
+	switch v := val.(type) {
+	case bool:
+		return schema{"type": "boolean"}
+	case float64:
+		return schema{"type": "number"}
+	case string:
+		return schema{"type": "string"}
+	case []interface{}:
+		items := schema{}
+		if len(v) > 0 {
+			items = inferSchema(v[0])
+		}
+		return schema{"type": []string{"array", "null"}, "items": items}
+	case map[string]interface{}:
+		props := schema{}
+		for key, elem := range v {
+			props[key] = inferSchema(elem)
+		}
+		return schema{"type": "object", "properties": props}
+	default:
+		// null carries no type information
+		return schema{}
+	}
+}
@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+type (
+	schemaTestEmbedded struct {
+		Shadowed string `json:"name"`
+		Promoted bool   `json:"promoted"`
+	}
+
+	schemaTestText struct{}
+
+	schemaTestFailing struct{}
+
+	schemaTestCustom struct {
+		Addr  *net.TCPAddr `json:"addr"`
+		State int          `json:"-"`
+		Count uint32       `json:"count"`
+	}
+
+	schemaTestResp struct {
+		schemaTestEmbedded
+		Name     string                 `json:"name"`
+		Sizes    []uint64               `json:"sizes"`
+		Opt      *float64               `json:"opt,omitempty"`
+		Stamp    time.Time              `json:"stamp"`
+		Labels   map[string]string      `json:"labels"`
+		Text     schemaTestText         `json:"text"`
+		Custom   *schemaTestCustom      `json:"custom"`
+		Ignored  string                 `json:"-"`
+		NumStr   int                    `json:"num_str,string"`
+		Any      interface{}            `json:"any"`
+		Children []*schemaTestRecursive `json:"children"`
+		hidden   string
+	}
+
+	schemaTestRecursive struct {
+		Next *schemaTestRecursive `json:"next"`
+	}
+)
+
+func (schemaTestText) MarshalText() ([]byte, error) {
+	return []byte("text"), nil
+}
+
+func (schemaTestFailing) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("can't marshal")
+}
+
+func (c *schemaTestCustom) MarshalJSON() ([]byte, error) {
+	type toJSON schemaTestCustom
+	return json.Marshal(&struct {
+		Addr  string `json:"addr"`
+		State string `json:"state"`
+		*toJSON
+	}{
+		Addr:   c.Addr.String(),
+		State:  "ok",
+		toJSON: (*toJSON)(c),
+	})
+}
+
+func TestCmdutil_OutputSchema(t *testing.T) {
+	expSchema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "test cmd",
+  "type": "object",
+  "properties": {
+    "response": {
+      "type": ["object", "null"],
+      "properties": {
+        "name": {"type": "string"},
+        "promoted": {"type": "boolean"},
+        "sizes": {"type": ["array", "null"], "items": {"type": "integer"}},
+        "opt": {"type": ["number", "null"]},
+        "stamp": {"type": "string", "format": "date-time"},
+        "labels": {
+          "type": ["object", "null"],
+          "additionalProperties": {"type": "string"}
+        },
+        "text": {"type": "string"},
+        "custom": {
+          "type": ["object", "null"],
+          "properties": {
+            "addr": {"type": "string"},
+            "state": {"type": "string"},
+            "count": {"type": "integer"}
+          },
+          "required": ["addr", "count", "state"]
+        },
+        "num_str": {"type": "string"},
+        "any": {},
+        "children": {
+          "type": ["array", "null"],
+          "items": {
+            "type": ["object", "null"],
+            "properties": {
+              "next": {}
+            },
+            "required": ["next"]
+          }
+        }
+      },
+      "required": ["name", "sizes", "stamp", "labels", "text", "custom", "num_str", "any", "children", "promoted"]
+    },
+    "error": {"type": ["string", "null"]},
+    "status": {"type": "integer"},
+    "schema_version": {"const": 1}
+  },
+  "required": ["response", "error", "status", "schema_version"]
+}`
+
+	var expected, got interface{}
+	if err := json.Unmarshal([]byte(expSchema), &expected); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(OutputSchema("test cmd", &schemaTestResp{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("unexpected schema (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestCmdutil_OutputSchema_MarshalerError(t *testing.T) {
+	// If the zero value of a type with a custom encoding can't be
+	// marshaled, any value is allowed.
+	data, err := json.Marshal(OutputSchema("test", schemaTestFailing{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"response":{}`) {
+		t.Fatalf("unexpected schema: %s", data)
+	}
+}
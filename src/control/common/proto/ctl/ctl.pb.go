@@ -30,135 +30,162 @@ var File_ctl_ctl_proto protoreflect.FileDescriptor
 var file_ctl_ctl_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x74, 0x6c, 0x2f, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x03, 0x63, 0x74, 0x6c, 0x1a, 0x11, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x63, 0x74, 0x6c, 0x2f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x63, 0x74, 0x6c, 0x2f,
-	0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d,
-	0x63, 0x74, 0x6c, 0x2f, 0x73, 0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63,
-	0x74, 0x6c, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
-	0x63, 0x74, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x11, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xfe, 0x06, 0x0a, 0x06, 0x43, 0x74, 0x6c, 0x53, 0x76, 0x63, 0x12, 0x3a,
-	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52,
-	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e,
-	0x64, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65,
-	0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41,
-	0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d,
-	0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x6d, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53,
-	0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x6f,
-	0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74,
-	0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x75, 0x74, 0x64,
-	0x6f, 0x77, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52,
-	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61,
-	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x53, 0x74, 0x6f,
-	0x70, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0a,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61,
-	0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x76, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x15, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x63, 0x74, 0x6c, 0x2f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x63, 0x74, 0x6c, 0x2f, 0x66,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x63,
+	0x74, 0x6c, 0x2f, 0x73, 0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63, 0x74,
+	0x6c, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x63,
+	0x74, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x11, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x32, 0xd6, 0x08, 0x0a, 0x06, 0x43, 0x74, 0x6c, 0x53, 0x76, 0x63, 0x12, 0x3a, 0x0a,
+	0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x13, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x11, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52,
+	0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x6d, 0x64, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x6d,
+	0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d,
+	0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x50, 0x72,
+	0x65, 0x70, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12,
+	0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2c, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x61, 0x6e,
+	0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_ctl_ctl_proto_goTypes = []interface{}{
-	(*StorageScanReq)(nil),     // 0: ctl.StorageScanReq
-	(*StorageFormatReq)(nil),   // 1: ctl.StorageFormatReq
-	(*NvmeRebindReq)(nil),      // 2: ctl.NvmeRebindReq
-	(*NvmeAddDeviceReq)(nil),   // 3: ctl.NvmeAddDeviceReq
-	(*NetworkScanReq)(nil),     // 4: ctl.NetworkScanReq
-	(*FirmwareQueryReq)(nil),   // 5: ctl.FirmwareQueryReq
-	(*FirmwareUpdateReq)(nil),  // 6: ctl.FirmwareUpdateReq
-	(*SmdQueryReq)(nil),        // 7: ctl.SmdQueryReq
-	(*SmdManageReq)(nil),       // 8: ctl.SmdManageReq
-	(*SetLogMasksReq)(nil),     // 9: ctl.SetLogMasksReq
-	(*RanksReq)(nil),           // 10: ctl.RanksReq
-	(*CollectLogReq)(nil),      // 11: ctl.CollectLogReq
-	(*StorageScanResp)(nil),    // 12: ctl.StorageScanResp
-	(*StorageFormatResp)(nil),  // 13: ctl.StorageFormatResp
-	(*NvmeRebindResp)(nil),     // 14: ctl.NvmeRebindResp
-	(*NvmeAddDeviceResp)(nil),  // 15: ctl.NvmeAddDeviceResp
-	(*NetworkScanResp)(nil),    // 16: ctl.NetworkScanResp
-	(*FirmwareQueryResp)(nil),  // 17: ctl.FirmwareQueryResp
-	(*FirmwareUpdateResp)(nil), // 18: ctl.FirmwareUpdateResp
-	(*SmdQueryResp)(nil),       // 19: ctl.SmdQueryResp
-	(*SmdManageResp)(nil),      // 20: ctl.SmdManageResp
-	(*SetLogMasksResp)(nil),    // 21: ctl.SetLogMasksResp
-	(*RanksResp)(nil),          // 22: ctl.RanksResp
-	(*CollectLogResp)(nil),     // 23: ctl.CollectLogResp
+	(*StorageScanReq)(nil),          // 0: ctl.StorageScanReq
+	(*StorageFormatReq)(nil),        // 1: ctl.StorageFormatReq
+	(*NvmeRebindReq)(nil),           // 2: ctl.NvmeRebindReq
+	(*NvmeAddDeviceReq)(nil),        // 3: ctl.NvmeAddDeviceReq
+	(*NetworkScanReq)(nil),          // 4: ctl.NetworkScanReq
+	(*FirmwareQueryReq)(nil),        // 5: ctl.FirmwareQueryReq
+	(*FirmwareUpdateReq)(nil),       // 6: ctl.FirmwareUpdateReq
+	(*SmdQueryReq)(nil),             // 7: ctl.SmdQueryReq
+	(*SmdManageReq)(nil),            // 8: ctl.SmdManageReq
+	(*NvmeHealthTrendReq)(nil),      // 9: ctl.NvmeHealthTrendReq
+	(*ScmHealthReq)(nil),            // 10: ctl.ScmHealthReq
+	(*SetLogMasksReq)(nil),          // 11: ctl.SetLogMasksReq
+	(*RanksReq)(nil),                // 12: ctl.RanksReq
+	(*CollectLogReq)(nil),           // 13: ctl.CollectLogReq
+	(*StorageScanResp)(nil),         // 14: ctl.StorageScanResp
+	(*StorageFormatResp)(nil),       // 15: ctl.StorageFormatResp
+	(*StorageFormatStreamResp)(nil), // 16: ctl.StorageFormatStreamResp
+	(*NvmeRebindResp)(nil),          // 17: ctl.NvmeRebindResp
+	(*NvmeAddDeviceResp)(nil),       // 18: ctl.NvmeAddDeviceResp
+	(*NetworkScanResp)(nil),         // 19: ctl.NetworkScanResp
+	(*FirmwareQueryResp)(nil),       // 20: ctl.FirmwareQueryResp
+	(*FirmwareUpdateResp)(nil),      // 21: ctl.FirmwareUpdateResp
+	(*SmdQueryResp)(nil),            // 22: ctl.SmdQueryResp
+	(*SmdManageResp)(nil),           // 23: ctl.SmdManageResp
+	(*NvmeHealthTrendResp)(nil),     // 24: ctl.NvmeHealthTrendResp
+	(*ScmHealthResp)(nil),           // 25: ctl.ScmHealthResp
+	(*SetLogMasksResp)(nil),         // 26: ctl.SetLogMasksResp
+	(*RanksResp)(nil),               // 27: ctl.RanksResp
+	(*CollectLogResp)(nil),          // 28: ctl.CollectLogResp
 }
 var file_ctl_ctl_proto_depIdxs = []int32{
 	0,  // 0: ctl.CtlSvc.StorageScan:input_type -> ctl.StorageScanReq
 	1,  // 1: ctl.CtlSvc.StorageFormat:input_type -> ctl.StorageFormatReq
-	2,  // 2: ctl.CtlSvc.StorageNvmeRebind:input_type -> ctl.NvmeRebindReq
-	3,  // 3: ctl.CtlSvc.StorageNvmeAddDevice:input_type -> ctl.NvmeAddDeviceReq
-	4,  // 4: ctl.CtlSvc.NetworkScan:input_type -> ctl.NetworkScanReq
-	5,  // 5: ctl.CtlSvc.FirmwareQuery:input_type -> ctl.FirmwareQueryReq
-	6,  // 6: ctl.CtlSvc.FirmwareUpdate:input_type -> ctl.FirmwareUpdateReq
-	7,  // 7: ctl.CtlSvc.SmdQuery:input_type -> ctl.SmdQueryReq
-	8,  // 8: ctl.CtlSvc.SmdManage:input_type -> ctl.SmdManageReq
-	9,  // 9: ctl.CtlSvc.SetEngineLogMasks:input_type -> ctl.SetLogMasksReq
-	10, // 10: ctl.CtlSvc.PrepShutdownRanks:input_type -> ctl.RanksReq
-	10, // 11: ctl.CtlSvc.StopRanks:input_type -> ctl.RanksReq
-	10, // 12: ctl.CtlSvc.ResetFormatRanks:input_type -> ctl.RanksReq
-	10, // 13: ctl.CtlSvc.StartRanks:input_type -> ctl.RanksReq
-	11, // 14: ctl.CtlSvc.CollectLog:input_type -> ctl.CollectLogReq
-	12, // 15: ctl.CtlSvc.StorageScan:output_type -> ctl.StorageScanResp
-	13, // 16: ctl.CtlSvc.StorageFormat:output_type -> ctl.StorageFormatResp
-	14, // 17: ctl.CtlSvc.StorageNvmeRebind:output_type -> ctl.NvmeRebindResp
-	15, // 18: ctl.CtlSvc.StorageNvmeAddDevice:output_type -> ctl.NvmeAddDeviceResp
-	16, // 19: ctl.CtlSvc.NetworkScan:output_type -> ctl.NetworkScanResp
-	17, // 20: ctl.CtlSvc.FirmwareQuery:output_type -> ctl.FirmwareQueryResp
-	18, // 21: ctl.CtlSvc.FirmwareUpdate:output_type -> ctl.FirmwareUpdateResp
-	19, // 22: ctl.CtlSvc.SmdQuery:output_type -> ctl.SmdQueryResp
-	20, // 23: ctl.CtlSvc.SmdManage:output_type -> ctl.SmdManageResp
-	21, // 24: ctl.CtlSvc.SetEngineLogMasks:output_type -> ctl.SetLogMasksResp
-	22, // 25: ctl.CtlSvc.PrepShutdownRanks:output_type -> ctl.RanksResp
-	22, // 26: ctl.CtlSvc.StopRanks:output_type -> ctl.RanksResp
-	22, // 27: ctl.CtlSvc.ResetFormatRanks:output_type -> ctl.RanksResp
-	22, // 28: ctl.CtlSvc.StartRanks:output_type -> ctl.RanksResp
-	23, // 29: ctl.CtlSvc.CollectLog:output_type -> ctl.CollectLogResp
-	15, // [15:30] is the sub-list for method output_type
-	0,  // [0:15] is the sub-list for method input_type
+	1,  // 2: ctl.CtlSvc.StorageFormatStream:input_type -> ctl.StorageFormatReq
+	2,  // 3: ctl.CtlSvc.StorageNvmeRebind:input_type -> ctl.NvmeRebindReq
+	3,  // 4: ctl.CtlSvc.StorageNvmeAddDevice:input_type -> ctl.NvmeAddDeviceReq
+	4,  // 5: ctl.CtlSvc.NetworkScan:input_type -> ctl.NetworkScanReq
+	5,  // 6: ctl.CtlSvc.FirmwareQuery:input_type -> ctl.FirmwareQueryReq
+	6,  // 7: ctl.CtlSvc.FirmwareUpdate:input_type -> ctl.FirmwareUpdateReq
+	7,  // 8: ctl.CtlSvc.SmdQuery:input_type -> ctl.SmdQueryReq
+	8,  // 9: ctl.CtlSvc.SmdManage:input_type -> ctl.SmdManageReq
+	9,  // 10: ctl.CtlSvc.StorageHealthTrend:input_type -> ctl.NvmeHealthTrendReq
+	10, // 11: ctl.CtlSvc.StorageScmHealth:input_type -> ctl.ScmHealthReq
+	11, // 12: ctl.CtlSvc.SetEngineLogMasks:input_type -> ctl.SetLogMasksReq
+	12, // 13: ctl.CtlSvc.PrepShutdownRanks:input_type -> ctl.RanksReq
+	12, // 14: ctl.CtlSvc.StopRanks:input_type -> ctl.RanksReq
+	12, // 15: ctl.CtlSvc.ResetFormatRanks:input_type -> ctl.RanksReq
+	12, // 16: ctl.CtlSvc.StartRanks:input_type -> ctl.RanksReq
+	13, // 17: ctl.CtlSvc.CollectLog:input_type -> ctl.CollectLogReq
+	14, // 18: ctl.CtlSvc.StorageScan:output_type -> ctl.StorageScanResp
+	15, // 19: ctl.CtlSvc.StorageFormat:output_type -> ctl.StorageFormatResp
+	16, // 20: ctl.CtlSvc.StorageFormatStream:output_type -> ctl.StorageFormatStreamResp
+	17, // 21: ctl.CtlSvc.StorageNvmeRebind:output_type -> ctl.NvmeRebindResp
+	18, // 22: ctl.CtlSvc.StorageNvmeAddDevice:output_type -> ctl.NvmeAddDeviceResp
+	19, // 23: ctl.CtlSvc.NetworkScan:output_type -> ctl.NetworkScanResp
+	20, // 24: ctl.CtlSvc.FirmwareQuery:output_type -> ctl.FirmwareQueryResp
+	21, // 25: ctl.CtlSvc.FirmwareUpdate:output_type -> ctl.FirmwareUpdateResp
+	22, // 26: ctl.CtlSvc.SmdQuery:output_type -> ctl.SmdQueryResp
+	23, // 27: ctl.CtlSvc.SmdManage:output_type -> ctl.SmdManageResp
+	24, // 28: ctl.CtlSvc.StorageHealthTrend:output_type -> ctl.NvmeHealthTrendResp
+	25, // 29: ctl.CtlSvc.StorageScmHealth:output_type -> ctl.ScmHealthResp
+	26, // 30: ctl.CtlSvc.SetEngineLogMasks:output_type -> ctl.SetLogMasksResp
+	27, // 31: ctl.CtlSvc.PrepShutdownRanks:output_type -> ctl.RanksResp
+	27, // 32: ctl.CtlSvc.StopRanks:output_type -> ctl.RanksResp
+	27, // 33: ctl.CtlSvc.ResetFormatRanks:output_type -> ctl.RanksResp
+	27, // 34: ctl.CtlSvc.StartRanks:output_type -> ctl.RanksResp
+	28, // 35: ctl.CtlSvc.CollectLog:output_type -> ctl.CollectLogResp
+	18, // [18:36] is the sub-list for method output_type
+	0,  // [0:18] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -170,6 +197,8 @@ func file_ctl_ctl_proto_init() {
 		return
 	}
 	file_ctl_storage_proto_init()
+	file_ctl_storage_nvme_proto_init()
+	file_ctl_storage_scm_proto_init()
 	file_ctl_network_proto_init()
 	file_ctl_firmware_proto_init()
 	file_ctl_smd_proto_init()
@@ -1,9 +1,15 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.3.0
 // - protoc             v3.5.0
 // source: ctl/ctl.proto
 
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: ctl/ctl.proto
+
 package ctl
 
 import (
@@ -18,6 +24,27 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	CtlSvc_StorageScan_FullMethodName          = "/ctl.CtlSvc/StorageScan"
+	CtlSvc_StorageFormat_FullMethodName        = "/ctl.CtlSvc/StorageFormat"
+	CtlSvc_StorageFormatStream_FullMethodName  = "/ctl.CtlSvc/StorageFormatStream"
+	CtlSvc_StorageNvmeRebind_FullMethodName    = "/ctl.CtlSvc/StorageNvmeRebind"
+	CtlSvc_StorageNvmeAddDevice_FullMethodName = "/ctl.CtlSvc/StorageNvmeAddDevice"
+	CtlSvc_NetworkScan_FullMethodName          = "/ctl.CtlSvc/NetworkScan"
+	CtlSvc_FirmwareQuery_FullMethodName        = "/ctl.CtlSvc/FirmwareQuery"
+	CtlSvc_FirmwareUpdate_FullMethodName       = "/ctl.CtlSvc/FirmwareUpdate"
+	CtlSvc_SmdQuery_FullMethodName             = "/ctl.CtlSvc/SmdQuery"
+	CtlSvc_SmdManage_FullMethodName            = "/ctl.CtlSvc/SmdManage"
+	CtlSvc_StorageHealthTrend_FullMethodName   = "/ctl.CtlSvc/StorageHealthTrend"
+	CtlSvc_StorageScmHealth_FullMethodName     = "/ctl.CtlSvc/StorageScmHealth"
+	CtlSvc_SetEngineLogMasks_FullMethodName    = "/ctl.CtlSvc/SetEngineLogMasks"
+	CtlSvc_PrepShutdownRanks_FullMethodName    = "/ctl.CtlSvc/PrepShutdownRanks"
+	CtlSvc_StopRanks_FullMethodName            = "/ctl.CtlSvc/StopRanks"
+	CtlSvc_ResetFormatRanks_FullMethodName     = "/ctl.CtlSvc/ResetFormatRanks"
+	CtlSvc_StartRanks_FullMethodName           = "/ctl.CtlSvc/StartRanks"
+	CtlSvc_CollectLog_FullMethodName           = "/ctl.CtlSvc/CollectLog"
+)
+
 // CtlSvcClient is the client API for CtlSvc service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -26,6 +53,8 @@ type CtlSvcClient interface {
 	StorageScan(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (*StorageScanResp, error)
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error)
+	// Format nonvolatile storage devices, streaming progress before the final result
+	StorageFormatStream(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (CtlSvc_StorageFormatStreamClient, error)
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
@@ -40,6 +69,10 @@ type CtlSvcClient interface {
 	SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...grpc.CallOption) (*SmdQueryResp, error)
 	// Manage devices (per-server) identified in SMD table
 	SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error)
+	// Retrieve NVMe device health history and wear-out estimates
+	StorageHealthTrend(ctx context.Context, in *NvmeHealthTrendReq, opts ...grpc.CallOption) (*NvmeHealthTrendResp, error)
+	// Retrieve PMem namespace media health and SCM usage
+	StorageScmHealth(ctx context.Context, in *ScmHealthReq, opts ...grpc.CallOption) (*ScmHealthResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
@@ -64,7 +97,7 @@ func NewCtlSvcClient(cc grpc.ClientConnInterface) CtlSvcClient {
 
 func (c *ctlSvcClient) StorageScan(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (*StorageScanResp, error) {
 	out := new(StorageScanResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageScan", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageScan_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,16 +106,48 @@ func (c *ctlSvcClient) StorageScan(ctx context.Context, in *StorageScanReq, opts
 
 func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error) {
 	out := new(StorageFormatResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageFormat", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageFormat_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageFormatStream(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (CtlSvc_StorageFormatStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[0], CtlSvc_StorageFormatStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ctlSvcStorageFormatStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CtlSvc_StorageFormatStreamClient interface {
+	Recv() (*StorageFormatStreamResp, error)
+	grpc.ClientStream
+}
+
+type ctlSvcStorageFormatStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ctlSvcStorageFormatStreamClient) Recv() (*StorageFormatStreamResp, error) {
+	m := new(StorageFormatStreamResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ctlSvcClient) StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error) {
 	out := new(NvmeRebindResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageNvmeRebind", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeRebind_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +156,7 @@ func (c *ctlSvcClient) StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq,
 
 func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDeviceReq, opts ...grpc.CallOption) (*NvmeAddDeviceResp, error) {
 	out := new(NvmeAddDeviceResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageNvmeAddDevice", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeAddDevice_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +165,7 @@ func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDevi
 
 func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error) {
 	out := new(NetworkScanResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/NetworkScan", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_NetworkScan_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +174,7 @@ func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts
 
 func (c *ctlSvcClient) FirmwareQuery(ctx context.Context, in *FirmwareQueryReq, opts ...grpc.CallOption) (*FirmwareQueryResp, error) {
 	out := new(FirmwareQueryResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/FirmwareQuery", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_FirmwareQuery_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +183,7 @@ func (c *ctlSvcClient) FirmwareQuery(ctx context.Context, in *FirmwareQueryReq,
 
 func (c *ctlSvcClient) FirmwareUpdate(ctx context.Context, in *FirmwareUpdateReq, opts ...grpc.CallOption) (*FirmwareUpdateResp, error) {
 	out := new(FirmwareUpdateResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/FirmwareUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_FirmwareUpdate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +192,7 @@ func (c *ctlSvcClient) FirmwareUpdate(ctx context.Context, in *FirmwareUpdateReq
 
 func (c *ctlSvcClient) SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...grpc.CallOption) (*SmdQueryResp, error) {
 	out := new(SmdQueryResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SmdQuery", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_SmdQuery_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +201,25 @@ func (c *ctlSvcClient) SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...gr
 
 func (c *ctlSvcClient) SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error) {
 	out := new(SmdManageResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SmdManage", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_SmdManage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) StorageHealthTrend(ctx context.Context, in *NvmeHealthTrendReq, opts ...grpc.CallOption) (*NvmeHealthTrendResp, error) {
+	out := new(NvmeHealthTrendResp)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageHealthTrend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) StorageScmHealth(ctx context.Context, in *ScmHealthReq, opts ...grpc.CallOption) (*ScmHealthResp, error) {
+	out := new(ScmHealthResp)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageScmHealth_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +228,7 @@ func (c *ctlSvcClient) SmdManage(ctx context.Context, in *SmdManageReq, opts ...
 
 func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error) {
 	out := new(SetLogMasksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SetEngineLogMasks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_SetEngineLogMasks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +237,7 @@ func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq
 
 func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/PrepShutdownRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_PrepShutdownRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +246,7 @@ func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts
 
 func (c *ctlSvcClient) StopRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StopRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StopRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +255,7 @@ func (c *ctlSvcClient) StopRanks(ctx context.Context, in *RanksReq, opts ...grpc
 
 func (c *ctlSvcClient) ResetFormatRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/ResetFormatRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_ResetFormatRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +264,7 @@ func (c *ctlSvcClient) ResetFormatRanks(ctx context.Context, in *RanksReq, opts
 
 func (c *ctlSvcClient) StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StartRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StartRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +273,7 @@ func (c *ctlSvcClient) StartRanks(ctx context.Context, in *RanksReq, opts ...grp
 
 func (c *ctlSvcClient) CollectLog(ctx context.Context, in *CollectLogReq, opts ...grpc.CallOption) (*CollectLogResp, error) {
 	out := new(CollectLogResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/CollectLog", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_CollectLog_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +288,8 @@ type CtlSvcServer interface {
 	StorageScan(context.Context, *StorageScanReq) (*StorageScanResp, error)
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error)
+	// Format nonvolatile storage devices, streaming progress before the final result
+	StorageFormatStream(*StorageFormatReq, CtlSvc_StorageFormatStreamServer) error
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
@@ -219,6 +304,10 @@ type CtlSvcServer interface {
 	SmdQuery(context.Context, *SmdQueryReq) (*SmdQueryResp, error)
 	// Manage devices (per-server) identified in SMD table
 	SmdManage(context.Context, *SmdManageReq) (*SmdManageResp, error)
+	// Retrieve NVMe device health history and wear-out estimates
+	StorageHealthTrend(context.Context, *NvmeHealthTrendReq) (*NvmeHealthTrendResp, error)
+	// Retrieve PMem namespace media health and SCM usage
+	StorageScmHealth(context.Context, *ScmHealthReq) (*ScmHealthResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
@@ -244,6 +333,9 @@ func (UnimplementedCtlSvcServer) StorageScan(context.Context, *StorageScanReq) (
 func (UnimplementedCtlSvcServer) StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageFormat not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageFormatStream(*StorageFormatReq, CtlSvc_StorageFormatStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method StorageFormatStream not implemented")
+}
 func (UnimplementedCtlSvcServer) StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeRebind not implemented")
 }
@@ -265,6 +357,12 @@ func (UnimplementedCtlSvcServer) SmdQuery(context.Context, *SmdQueryReq) (*SmdQu
 func (UnimplementedCtlSvcServer) SmdManage(context.Context, *SmdManageReq) (*SmdManageResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SmdManage not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageHealthTrend(context.Context, *NvmeHealthTrendReq) (*NvmeHealthTrendResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageHealthTrend not implemented")
+}
+func (UnimplementedCtlSvcServer) StorageScmHealth(context.Context, *ScmHealthReq) (*ScmHealthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageScmHealth not implemented")
+}
 func (UnimplementedCtlSvcServer) SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEngineLogMasks not implemented")
 }
@@ -306,7 +404,7 @@ func _CtlSvc_StorageScan_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageScan",
+		FullMethod: CtlSvc_StorageScan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageScan(ctx, req.(*StorageScanReq))
@@ -324,7 +422,7 @@ func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageFormat",
+		FullMethod: CtlSvc_StorageFormat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageFormat(ctx, req.(*StorageFormatReq))
@@ -332,6 +430,27 @@ func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageFormatStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StorageFormatReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).StorageFormatStream(m, &ctlSvcStorageFormatStreamServer{stream})
+}
+
+type CtlSvc_StorageFormatStreamServer interface {
+	Send(*StorageFormatStreamResp) error
+	grpc.ServerStream
+}
+
+type ctlSvcStorageFormatStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ctlSvcStorageFormatStreamServer) Send(m *StorageFormatStreamResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CtlSvc_StorageNvmeRebind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NvmeRebindReq)
 	if err := dec(in); err != nil {
@@ -342,7 +461,7 @@ func _CtlSvc_StorageNvmeRebind_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageNvmeRebind",
+		FullMethod: CtlSvc_StorageNvmeRebind_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageNvmeRebind(ctx, req.(*NvmeRebindReq))
@@ -360,7 +479,7 @@ func _CtlSvc_StorageNvmeAddDevice_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageNvmeAddDevice",
+		FullMethod: CtlSvc_StorageNvmeAddDevice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageNvmeAddDevice(ctx, req.(*NvmeAddDeviceReq))
@@ -378,7 +497,7 @@ func _CtlSvc_NetworkScan_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/NetworkScan",
+		FullMethod: CtlSvc_NetworkScan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).NetworkScan(ctx, req.(*NetworkScanReq))
@@ -396,7 +515,7 @@ func _CtlSvc_FirmwareQuery_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/FirmwareQuery",
+		FullMethod: CtlSvc_FirmwareQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).FirmwareQuery(ctx, req.(*FirmwareQueryReq))
@@ -414,7 +533,7 @@ func _CtlSvc_FirmwareUpdate_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/FirmwareUpdate",
+		FullMethod: CtlSvc_FirmwareUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).FirmwareUpdate(ctx, req.(*FirmwareUpdateReq))
@@ -432,7 +551,7 @@ func _CtlSvc_SmdQuery_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/SmdQuery",
+		FullMethod: CtlSvc_SmdQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).SmdQuery(ctx, req.(*SmdQueryReq))
@@ -450,7 +569,7 @@ func _CtlSvc_SmdManage_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/SmdManage",
+		FullMethod: CtlSvc_SmdManage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).SmdManage(ctx, req.(*SmdManageReq))
@@ -458,6 +577,42 @@ func _CtlSvc_SmdManage_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageHealthTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NvmeHealthTrendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageHealthTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_StorageHealthTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageHealthTrend(ctx, req.(*NvmeHealthTrendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_StorageScmHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScmHealthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageScmHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_StorageScmHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageScmHealth(ctx, req.(*ScmHealthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetLogMasksReq)
 	if err := dec(in); err != nil {
@@ -468,7 +623,7 @@ func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/SetEngineLogMasks",
+		FullMethod: CtlSvc_SetEngineLogMasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).SetEngineLogMasks(ctx, req.(*SetLogMasksReq))
@@ -486,7 +641,7 @@ func _CtlSvc_PrepShutdownRanks_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/PrepShutdownRanks",
+		FullMethod: CtlSvc_PrepShutdownRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).PrepShutdownRanks(ctx, req.(*RanksReq))
@@ -504,7 +659,7 @@ func _CtlSvc_StopRanks_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StopRanks",
+		FullMethod: CtlSvc_StopRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StopRanks(ctx, req.(*RanksReq))
@@ -522,7 +677,7 @@ func _CtlSvc_ResetFormatRanks_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/ResetFormatRanks",
+		FullMethod: CtlSvc_ResetFormatRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).ResetFormatRanks(ctx, req.(*RanksReq))
@@ -540,7 +695,7 @@ func _CtlSvc_StartRanks_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StartRanks",
+		FullMethod: CtlSvc_StartRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StartRanks(ctx, req.(*RanksReq))
@@ -558,7 +713,7 @@ func _CtlSvc_CollectLog_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/CollectLog",
+		FullMethod: CtlSvc_CollectLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).CollectLog(ctx, req.(*CollectLogReq))
@@ -609,6 +764,14 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SmdManage",
 			Handler:    _CtlSvc_SmdManage_Handler,
 		},
+		{
+			MethodName: "StorageHealthTrend",
+			Handler:    _CtlSvc_StorageHealthTrend_Handler,
+		},
+		{
+			MethodName: "StorageScmHealth",
+			Handler:    _CtlSvc_StorageScmHealth_Handler,
+		},
 		{
 			MethodName: "SetEngineLogMasks",
 			Handler:    _CtlSvc_SetEngineLogMasks_Handler,
@@ -634,6 +797,12 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CtlSvc_CollectLog_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StorageFormatStream",
+			Handler:       _CtlSvc_StorageFormatStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ctl/ctl.proto",
 }
@@ -262,6 +262,324 @@ func (*FormatNvmeReq) Descriptor() ([]byte, []int) {
 	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{3}
 }
 
+type NvmeHealthTrendReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeSamples bool `protobuf:"varint,1,opt,name=include_samples,json=includeSamples,proto3" json:"include_samples,omitempty"` // Include sampled health history in response
+}
+
+func (x *NvmeHealthTrendReq) Reset() {
+	*x = NvmeHealthTrendReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthTrendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthTrendReq) ProtoMessage() {}
+
+func (x *NvmeHealthTrendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthTrendReq.ProtoReflect.Descriptor instead.
+func (*NvmeHealthTrendReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NvmeHealthTrendReq) GetIncludeSamples() bool {
+	if x != nil {
+		return x.IncludeSamples
+	}
+	return false
+}
+
+// NvmeHealthSample is a point-in-time record of NVMe device health attributes.
+type NvmeHealthSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time                    string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"` // Time sample was taken
+	MediaErrs               uint64 `protobuf:"varint,2,opt,name=media_errs,json=mediaErrs,proto3" json:"media_errs,omitempty"`
+	MediaWearRaw            uint64 `protobuf:"varint,3,opt,name=media_wear_raw,json=mediaWearRaw,proto3" json:"media_wear_raw,omitempty"`
+	WearLevelingCntNorm     uint32 `protobuf:"varint,4,opt,name=wear_leveling_cnt_norm,json=wearLevelingCntNorm,proto3" json:"wear_leveling_cnt_norm,omitempty"`
+	NandBytesWritten        uint64 `protobuf:"varint,5,opt,name=nand_bytes_written,json=nandBytesWritten,proto3" json:"nand_bytes_written,omitempty"`
+	HostBytesWritten        uint64 `protobuf:"varint,6,opt,name=host_bytes_written,json=hostBytesWritten,proto3" json:"host_bytes_written,omitempty"`
+	ThermalThrottleEventCnt uint64 `protobuf:"varint,7,opt,name=thermal_throttle_event_cnt,json=thermalThrottleEventCnt,proto3" json:"thermal_throttle_event_cnt,omitempty"`
+	Temperature             uint32 `protobuf:"varint,8,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	AvailSpareWarn          bool   `protobuf:"varint,9,opt,name=avail_spare_warn,json=availSpareWarn,proto3" json:"avail_spare_warn,omitempty"`
+}
+
+func (x *NvmeHealthSample) Reset() {
+	*x = NvmeHealthSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthSample) ProtoMessage() {}
+
+func (x *NvmeHealthSample) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthSample.ProtoReflect.Descriptor instead.
+func (*NvmeHealthSample) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NvmeHealthSample) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *NvmeHealthSample) GetMediaErrs() uint64 {
+	if x != nil {
+		return x.MediaErrs
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetMediaWearRaw() uint64 {
+	if x != nil {
+		return x.MediaWearRaw
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetWearLevelingCntNorm() uint32 {
+	if x != nil {
+		return x.WearLevelingCntNorm
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetNandBytesWritten() uint64 {
+	if x != nil {
+		return x.NandBytesWritten
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetHostBytesWritten() uint64 {
+	if x != nil {
+		return x.HostBytesWritten
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetThermalThrottleEventCnt() uint64 {
+	if x != nil {
+		return x.ThermalThrottleEventCnt
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetTemperature() uint32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *NvmeHealthSample) GetAvailSpareWarn() bool {
+	if x != nil {
+		return x.AvailSpareWarn
+	}
+	return false
+}
+
+// NvmeHealthTrend describes wear of an NVMe device over its sampled history.
+type NvmeHealthTrend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid                     string              `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                              // UUID of blobstore
+	TrAddr                   string              `protobuf:"bytes,2,opt,name=tr_addr,json=trAddr,proto3" json:"tr_addr,omitempty"`                                                            // Transport address of controller
+	Rank                     uint32              `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                                                             // Rank to which device is assigned
+	Wear                     float64             `protobuf:"fixed64,4,opt,name=wear,proto3" json:"wear,omitempty"`                                                                            // Fraction of rated endurance used, negative if unknown
+	WriteRate                float64             `protobuf:"fixed64,5,opt,name=write_rate,json=writeRate,proto3" json:"write_rate,omitempty"`                                                 // NAND write rate in bytes/second
+	DaysRemaining            float64             `protobuf:"fixed64,6,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`                                     // Estimated days until endurance reached, negative if unknown
+	NewMediaErrs             uint64              `protobuf:"varint,7,opt,name=new_media_errs,json=newMediaErrs,proto3" json:"new_media_errs,omitempty"`                                       // Media errors seen over history
+	NewThermalThrottleEvents uint64              `protobuf:"varint,8,opt,name=new_thermal_throttle_events,json=newThermalThrottleEvents,proto3" json:"new_thermal_throttle_events,omitempty"` // Thermal throttle events seen over history
+	Samples                  []*NvmeHealthSample `protobuf:"bytes,9,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *NvmeHealthTrend) Reset() {
+	*x = NvmeHealthTrend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthTrend) ProtoMessage() {}
+
+func (x *NvmeHealthTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthTrend.ProtoReflect.Descriptor instead.
+func (*NvmeHealthTrend) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NvmeHealthTrend) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NvmeHealthTrend) GetTrAddr() string {
+	if x != nil {
+		return x.TrAddr
+	}
+	return ""
+}
+
+func (x *NvmeHealthTrend) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetWear() float64 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetWriteRate() float64 {
+	if x != nil {
+		return x.WriteRate
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetDaysRemaining() float64 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetNewMediaErrs() uint64 {
+	if x != nil {
+		return x.NewMediaErrs
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetNewThermalThrottleEvents() uint64 {
+	if x != nil {
+		return x.NewThermalThrottleEvents
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetSamples() []*NvmeHealthSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type NvmeHealthTrendResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*NvmeHealthTrend `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *NvmeHealthTrendResp) Reset() {
+	*x = NvmeHealthTrendResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_nvme_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthTrendResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthTrendResp) ProtoMessage() {}
+
+func (x *NvmeHealthTrendResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_nvme_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthTrendResp.ProtoReflect.Descriptor instead.
+func (*NvmeHealthTrendResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_nvme_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NvmeHealthTrendResp) GetDevices() []*NvmeHealthTrend {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
 var File_ctl_storage_nvme_proto protoreflect.FileDescriptor
 
 var file_ctl_storage_nvme_proto_rawDesc = []byte{
@@ -292,11 +610,64 @@ var file_ctl_storage_nvme_proto_rawDesc = []byte{
 	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
 	0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63,
-	0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x22, 0x3d, 0x0a, 0x12, 0x4e, 0x76, 0x6d, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x85, 0x03, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x72, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x61,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x57, 0x65,
+	0x61, 0x72, 0x52, 0x61, 0x77, 0x12, 0x33, 0x0a, 0x16, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61,
+	0x6e, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x6c, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x74, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x73,
+	0x70, 0x61, 0x72, 0x65, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x53, 0x70, 0x61, 0x72, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x22,
+	0xc2, 0x02, 0x0a, 0x0f, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x04, 0x77, 0x65, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x5f,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x24,
+	0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x65, 0x72, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x45, 0x72, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6e, 0x65, 0x77, 0x54, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -311,24 +682,30 @@ func file_ctl_storage_nvme_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_nvme_proto_rawDescData
 }
 
-var file_ctl_storage_nvme_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ctl_storage_nvme_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_ctl_storage_nvme_proto_goTypes = []interface{}{
 	(*NvmeControllerResult)(nil), // 0: ctl.NvmeControllerResult
 	(*ScanNvmeReq)(nil),          // 1: ctl.ScanNvmeReq
 	(*ScanNvmeResp)(nil),         // 2: ctl.ScanNvmeResp
 	(*FormatNvmeReq)(nil),        // 3: ctl.FormatNvmeReq
-	(*ResponseState)(nil),        // 4: ctl.ResponseState
-	(*NvmeController)(nil),       // 5: ctl.NvmeController
+	(*NvmeHealthTrendReq)(nil),   // 4: ctl.NvmeHealthTrendReq
+	(*NvmeHealthSample)(nil),     // 5: ctl.NvmeHealthSample
+	(*NvmeHealthTrend)(nil),      // 6: ctl.NvmeHealthTrend
+	(*NvmeHealthTrendResp)(nil),  // 7: ctl.NvmeHealthTrendResp
+	(*ResponseState)(nil),        // 8: ctl.ResponseState
+	(*NvmeController)(nil),       // 9: ctl.NvmeController
 }
 var file_ctl_storage_nvme_proto_depIdxs = []int32{
-	4, // 0: ctl.NvmeControllerResult.state:type_name -> ctl.ResponseState
-	5, // 1: ctl.ScanNvmeResp.ctrlrs:type_name -> ctl.NvmeController
-	4, // 2: ctl.ScanNvmeResp.state:type_name -> ctl.ResponseState
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 0: ctl.NvmeControllerResult.state:type_name -> ctl.ResponseState
+	9, // 1: ctl.ScanNvmeResp.ctrlrs:type_name -> ctl.NvmeController
+	8, // 2: ctl.ScanNvmeResp.state:type_name -> ctl.ResponseState
+	5, // 3: ctl.NvmeHealthTrend.samples:type_name -> ctl.NvmeHealthSample
+	6, // 4: ctl.NvmeHealthTrendResp.devices:type_name -> ctl.NvmeHealthTrend
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_nvme_proto_init() }
@@ -387,6 +764,54 @@ func file_ctl_storage_nvme_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_storage_nvme_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthTrendReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_nvme_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_nvme_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthTrend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_nvme_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeHealthTrendResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -394,7 +819,7 @@ func file_ctl_storage_nvme_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_nvme_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -614,6 +614,363 @@ func (*FormatScmReq) Descriptor() ([]byte, []int) {
 	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{8}
 }
 
+// ScmBadBlock is a range of PMem media reported as bad, in units of 512-byte sectors.
+type ScmBadBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint64   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Dimms  []string `protobuf:"bytes,3,rep,name=dimms,proto3" json:"dimms,omitempty"` // PMem modules reporting the range
+}
+
+func (x *ScmBadBlock) Reset() {
+	*x = ScmBadBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmBadBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmBadBlock) ProtoMessage() {}
+
+func (x *ScmBadBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmBadBlock.ProtoReflect.Descriptor instead.
+func (*ScmBadBlock) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScmBadBlock) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ScmBadBlock) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ScmBadBlock) GetDimms() []string {
+	if x != nil {
+		return x.Dimms
+	}
+	return nil
+}
+
+// ScmNamespaceHealth describes the media health of a PMem namespace.
+type ScmNamespaceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid           string         `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Blockdev       string         `protobuf:"bytes,2,opt,name=blockdev,proto3" json:"blockdev,omitempty"`
+	Dev            string         `protobuf:"bytes,3,opt,name=dev,proto3" json:"dev,omitempty"` // ndctl specific device identifier
+	NumaNode       uint32         `protobuf:"varint,4,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
+	Size           uint64         `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                                           // pmem block device capacity in bytes
+	HealthState    string         `protobuf:"bytes,6,opt,name=health_state,json=healthState,proto3" json:"health_state,omitempty"`           // Worst health state of backing modules
+	DirtyShutdowns uint64         `protobuf:"varint,7,opt,name=dirty_shutdowns,json=dirtyShutdowns,proto3" json:"dirty_shutdowns,omitempty"` // Dirty shutdown count of backing modules
+	Badblocks      []*ScmBadBlock `protobuf:"bytes,8,rep,name=badblocks,proto3" json:"badblocks,omitempty"`
+}
+
+func (x *ScmNamespaceHealth) Reset() {
+	*x = ScmNamespaceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmNamespaceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmNamespaceHealth) ProtoMessage() {}
+
+func (x *ScmNamespaceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmNamespaceHealth.ProtoReflect.Descriptor instead.
+func (*ScmNamespaceHealth) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScmNamespaceHealth) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ScmNamespaceHealth) GetBlockdev() string {
+	if x != nil {
+		return x.Blockdev
+	}
+	return ""
+}
+
+func (x *ScmNamespaceHealth) GetDev() string {
+	if x != nil {
+		return x.Dev
+	}
+	return ""
+}
+
+func (x *ScmNamespaceHealth) GetNumaNode() uint32 {
+	if x != nil {
+		return x.NumaNode
+	}
+	return 0
+}
+
+func (x *ScmNamespaceHealth) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ScmNamespaceHealth) GetHealthState() string {
+	if x != nil {
+		return x.HealthState
+	}
+	return ""
+}
+
+func (x *ScmNamespaceHealth) GetDirtyShutdowns() uint64 {
+	if x != nil {
+		return x.DirtyShutdowns
+	}
+	return 0
+}
+
+func (x *ScmNamespaceHealth) GetBadblocks() []*ScmBadBlock {
+	if x != nil {
+		return x.Badblocks
+	}
+	return nil
+}
+
+// EngineScmHealth describes the health and usage of the SCM used by an engine.
+type EngineScmHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceIdx uint32                `protobuf:"varint,1,opt,name=instance_idx,json=instanceIdx,proto3" json:"instance_idx,omitempty"` // Index of I/O Engine instance
+	Rank        uint32                `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`                                  // Rank of I/O Engine, nil rank if not joined
+	Class       string                `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`                                 // SCM class (dcpm or ram)
+	Path        string                `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                                   // SCM mount point
+	TotalBytes  uint64                `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`    // Mount size, zero if not mounted
+	AvailBytes  uint64                `protobuf:"varint,6,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`    // Mount free space, zero if not mounted
+	ScmSize     uint64                `protobuf:"varint,7,opt,name=scm_size,json=scmSize,proto3" json:"scm_size,omitempty"`             // Configured RAM-disk size in bytes
+	Namespaces  []*ScmNamespaceHealth `protobuf:"bytes,8,rep,name=namespaces,proto3" json:"namespaces,omitempty"`                       // PMem namespaces backing engine
+}
+
+func (x *EngineScmHealth) Reset() {
+	*x = EngineScmHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineScmHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineScmHealth) ProtoMessage() {}
+
+func (x *EngineScmHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineScmHealth.ProtoReflect.Descriptor instead.
+func (*EngineScmHealth) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EngineScmHealth) GetInstanceIdx() uint32 {
+	if x != nil {
+		return x.InstanceIdx
+	}
+	return 0
+}
+
+func (x *EngineScmHealth) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *EngineScmHealth) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *EngineScmHealth) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EngineScmHealth) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *EngineScmHealth) GetAvailBytes() uint64 {
+	if x != nil {
+		return x.AvailBytes
+	}
+	return 0
+}
+
+func (x *EngineScmHealth) GetScmSize() uint64 {
+	if x != nil {
+		return x.ScmSize
+	}
+	return 0
+}
+
+func (x *EngineScmHealth) GetNamespaces() []*ScmNamespaceHealth {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type ScmHealthReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ScmHealthReq) Reset() {
+	*x = ScmHealthReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmHealthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmHealthReq) ProtoMessage() {}
+
+func (x *ScmHealthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmHealthReq.ProtoReflect.Descriptor instead.
+func (*ScmHealthReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{12}
+}
+
+type ScmHealthResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Engines []*EngineScmHealth `protobuf:"bytes,1,rep,name=engines,proto3" json:"engines,omitempty"`
+}
+
+func (x *ScmHealthResp) Reset() {
+	*x = ScmHealthResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmHealthResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmHealthResp) ProtoMessage() {}
+
+func (x *ScmHealthResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmHealthResp.ProtoReflect.Descriptor instead.
+func (*ScmHealthResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ScmHealthResp) GetEngines() []*EngineScmHealth {
+	if x != nil {
+		return x.Engines
+	}
+	return nil
+}
+
 // Mount represents a mounted pmem block device.
 type ScmNamespace_Mount struct {
 	state         protoimpl.MessageState
@@ -632,7 +989,7 @@ type ScmNamespace_Mount struct {
 func (x *ScmNamespace_Mount) Reset() {
 	*x = ScmNamespace_Mount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[9]
+		mi := &file_ctl_storage_scm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +1002,7 @@ func (x *ScmNamespace_Mount) String() string {
 func (*ScmNamespace_Mount) ProtoMessage() {}
 
 func (x *ScmNamespace_Mount) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[9]
+	mi := &file_ctl_storage_scm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,11 +1156,54 @@ var file_ctl_storage_scm_proto_rawDesc = []byte{
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
 	0x74, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x63, 0x6d, 0x52,
-	0x65, 0x71, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
-	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x22, 0x53, 0x0a, 0x0b, 0x53, 0x63, 0x6d, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x6d, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x69, 0x6d, 0x6d, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x12, 0x53, 0x63, 0x6d, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x65, 0x76,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x68,
+	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64,
+	0x69, 0x72, 0x74, 0x79, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x12, 0x2e, 0x0a,
+	0x09, 0x62, 0x61, 0x64, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x42, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x09, 0x62, 0x61, 0x64, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x88, 0x02,
+	0x0a, 0x0f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x63, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x37, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x63, 0x6d, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x22, 0x3f, 0x0a, 0x0d, 0x53, 0x63, 0x6d, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x63, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x07, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -818,7 +1218,7 @@ func file_ctl_storage_scm_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_scm_proto_rawDescData
 }
 
-var file_ctl_storage_scm_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ctl_storage_scm_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_ctl_storage_scm_proto_goTypes = []interface{}{
 	(*ScmModule)(nil),          // 0: ctl.ScmModule
 	(*ScmNamespace)(nil),       // 1: ctl.ScmNamespace
@@ -829,23 +1229,31 @@ var file_ctl_storage_scm_proto_goTypes = []interface{}{
 	(*ScanScmReq)(nil),         // 6: ctl.ScanScmReq
 	(*ScanScmResp)(nil),        // 7: ctl.ScanScmResp
 	(*FormatScmReq)(nil),       // 8: ctl.FormatScmReq
-	(*ScmNamespace_Mount)(nil), // 9: ctl.ScmNamespace.Mount
-	(*ResponseState)(nil),      // 10: ctl.ResponseState
+	(*ScmBadBlock)(nil),        // 9: ctl.ScmBadBlock
+	(*ScmNamespaceHealth)(nil), // 10: ctl.ScmNamespaceHealth
+	(*EngineScmHealth)(nil),    // 11: ctl.EngineScmHealth
+	(*ScmHealthReq)(nil),       // 12: ctl.ScmHealthReq
+	(*ScmHealthResp)(nil),      // 13: ctl.ScmHealthResp
+	(*ScmNamespace_Mount)(nil), // 14: ctl.ScmNamespace.Mount
+	(*ResponseState)(nil),      // 15: ctl.ResponseState
 }
 var file_ctl_storage_scm_proto_depIdxs = []int32{
-	9,  // 0: ctl.ScmNamespace.mount:type_name -> ctl.ScmNamespace.Mount
-	10, // 1: ctl.ScmModuleResult.state:type_name -> ctl.ResponseState
-	10, // 2: ctl.ScmMountResult.state:type_name -> ctl.ResponseState
+	14, // 0: ctl.ScmNamespace.mount:type_name -> ctl.ScmNamespace.Mount
+	15, // 1: ctl.ScmModuleResult.state:type_name -> ctl.ResponseState
+	15, // 2: ctl.ScmMountResult.state:type_name -> ctl.ResponseState
 	1,  // 3: ctl.PrepareScmResp.namespaces:type_name -> ctl.ScmNamespace
-	10, // 4: ctl.PrepareScmResp.state:type_name -> ctl.ResponseState
+	15, // 4: ctl.PrepareScmResp.state:type_name -> ctl.ResponseState
 	0,  // 5: ctl.ScanScmResp.modules:type_name -> ctl.ScmModule
 	1,  // 6: ctl.ScanScmResp.namespaces:type_name -> ctl.ScmNamespace
-	10, // 7: ctl.ScanScmResp.state:type_name -> ctl.ResponseState
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	15, // 7: ctl.ScanScmResp.state:type_name -> ctl.ResponseState
+	9,  // 8: ctl.ScmNamespaceHealth.badblocks:type_name -> ctl.ScmBadBlock
+	10, // 9: ctl.EngineScmHealth.namespaces:type_name -> ctl.ScmNamespaceHealth
+	11, // 10: ctl.ScmHealthResp.engines:type_name -> ctl.EngineScmHealth
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_scm_proto_init() }
@@ -964,6 +1372,66 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScmBadBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScmNamespaceHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineScmHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScmHealthReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScmHealthResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScmNamespace_Mount); i {
 			case 0:
 				return &v.state
@@ -982,7 +1450,7 @@ func file_ctl_storage_scm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_scm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -51,6 +51,7 @@ const (
 	MgmtSvc_ContSetOwner_FullMethodName             = "/mgmt.MgmtSvc/ContSetOwner"
 	MgmtSvc_SystemQuery_FullMethodName              = "/mgmt.MgmtSvc/SystemQuery"
 	MgmtSvc_SystemStop_FullMethodName               = "/mgmt.MgmtSvc/SystemStop"
+	MgmtSvc_SystemStopStream_FullMethodName         = "/mgmt.MgmtSvc/SystemStopStream"
 	MgmtSvc_SystemStart_FullMethodName              = "/mgmt.MgmtSvc/SystemStart"
 	MgmtSvc_SystemExclude_FullMethodName            = "/mgmt.MgmtSvc/SystemExclude"
 	MgmtSvc_SystemErase_FullMethodName              = "/mgmt.MgmtSvc/SystemErase"
@@ -64,10 +65,31 @@ const (
 	MgmtSvc_SystemCheckGetPolicy_FullMethodName     = "/mgmt.MgmtSvc/SystemCheckGetPolicy"
 	MgmtSvc_SystemCheckRepair_FullMethodName        = "/mgmt.MgmtSvc/SystemCheckRepair"
 	MgmtSvc_PoolUpgrade_FullMethodName              = "/mgmt.MgmtSvc/PoolUpgrade"
+	MgmtSvc_PoolOpSchedule_FullMethodName           = "/mgmt.MgmtSvc/PoolOpSchedule"
+	MgmtSvc_PoolOpList_FullMethodName               = "/mgmt.MgmtSvc/PoolOpList"
+	MgmtSvc_PoolOpCancel_FullMethodName             = "/mgmt.MgmtSvc/PoolOpCancel"
 	MgmtSvc_SystemSetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemSetAttr"
 	MgmtSvc_SystemGetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemGetAttr"
 	MgmtSvc_SystemSetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemSetProp"
 	MgmtSvc_SystemGetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemGetProp"
+	MgmtSvc_SystemGetEvents_FullMethodName          = "/mgmt.MgmtSvc/SystemGetEvents"
+	MgmtSvc_SystemStreamEvents_FullMethodName       = "/mgmt.MgmtSvc/SystemStreamEvents"
+	MgmtSvc_SystemSetEventPolicy_FullMethodName     = "/mgmt.MgmtSvc/SystemSetEventPolicy"
+	MgmtSvc_SystemGetEventPolicy_FullMethodName     = "/mgmt.MgmtSvc/SystemGetEventPolicy"
+	MgmtSvc_SystemDBSnapshot_FullMethodName         = "/mgmt.MgmtSvc/SystemDBSnapshot"
+	MgmtSvc_SystemDBRestore_FullMethodName          = "/mgmt.MgmtSvc/SystemDBRestore"
+	MgmtSvc_SystemReplicaAdd_FullMethodName         = "/mgmt.MgmtSvc/SystemReplicaAdd"
+	MgmtSvc_SystemReplicaRemove_FullMethodName      = "/mgmt.MgmtSvc/SystemReplicaRemove"
+	MgmtSvc_SystemLeaderTransfer_FullMethodName     = "/mgmt.MgmtSvc/SystemLeaderTransfer"
+	MgmtSvc_SetAccessPoints_FullMethodName          = "/mgmt.MgmtSvc/SetAccessPoints"
+	MgmtSvc_SystemDBVerify_FullMethodName           = "/mgmt.MgmtSvc/SystemDBVerify"
+	MgmtSvc_SystemDBReplica_FullMethodName          = "/mgmt.MgmtSvc/SystemDBReplica"
+	MgmtSvc_SystemDBDiff_FullMethodName             = "/mgmt.MgmtSvc/SystemDBDiff"
+	MgmtSvc_SystemSetQuota_FullMethodName           = "/mgmt.MgmtSvc/SystemSetQuota"
+	MgmtSvc_SystemGetQuota_FullMethodName           = "/mgmt.MgmtSvc/SystemGetQuota"
+	MgmtSvc_SystemGetAccess_FullMethodName          = "/mgmt.MgmtSvc/SystemGetAccess"
+	MgmtSvc_SystemGetAudit_FullMethodName           = "/mgmt.MgmtSvc/SystemGetAudit"
+	MgmtSvc_SystemAddAudit_FullMethodName           = "/mgmt.MgmtSvc/SystemAddAudit"
 	MgmtSvc_FaultInjectReport_FullMethodName        = "/mgmt.MgmtSvc/FaultInjectReport"
 	MgmtSvc_FaultInjectPoolFault_FullMethodName     = "/mgmt.MgmtSvc/FaultInjectPoolFault"
 	MgmtSvc_FaultInjectMgmtPoolFault_FullMethodName = "/mgmt.MgmtSvc/FaultInjectMgmtPoolFault"
@@ -126,6 +148,8 @@ type MgmtSvcClient interface {
 	SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
 	SystemStop(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (*SystemStopResp, error)
+	// Stop DAOS system, streaming per-rank results as each host responds
+	SystemStopStream(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (MgmtSvc_SystemStopStreamClient, error)
 	// Start DAOS system (restart data-plane instances)
 	SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error)
 	// Exclude DAOS ranks
@@ -152,6 +176,12 @@ type MgmtSvcClient interface {
 	SystemCheckRepair(ctx context.Context, in *CheckActReq, opts ...grpc.CallOption) (*CheckActResp, error)
 	// PoolUpgrade queries a DAOS pool.
 	PoolUpgrade(ctx context.Context, in *PoolUpgradeReq, opts ...grpc.CallOption) (*PoolUpgradeResp, error)
+	// Schedule a rebuild-triggering pool operation to run within a time window.
+	PoolOpSchedule(ctx context.Context, in *PoolOpScheduleReq, opts ...grpc.CallOption) (*PoolOpScheduleResp, error)
+	// List the rebuild-triggering operations queued for a pool.
+	PoolOpList(ctx context.Context, in *PoolOpListReq, opts ...grpc.CallOption) (*PoolOpListResp, error)
+	// Cancel a queued pool operation.
+	PoolOpCancel(ctx context.Context, in *PoolOpCancelReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Set a system attribute or attributes.
 	SystemSetAttr(ctx context.Context, in *SystemSetAttrReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system attribute or attributes.
@@ -160,6 +190,43 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// Get RAS events from the management service event history.
+	SystemGetEvents(ctx context.Context, in *SystemGetEventsReq, opts ...grpc.CallOption) (*SystemGetEventsResp, error)
+	// Stream RAS events as they are raised on the management service leader.
+	SystemStreamEvents(ctx context.Context, in *SystemStreamEventsReq, opts ...grpc.CallOption) (MgmtSvc_SystemStreamEventsClient, error)
+	// Set the runtime publication policy for RAS event IDs.
+	SystemSetEventPolicy(ctx context.Context, in *SystemSetEventPolicyReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Get the runtime publication policy for RAS event IDs.
+	SystemGetEventPolicy(ctx context.Context, in *SystemGetEventPolicyReq, opts ...grpc.CallOption) (*SystemGetEventPolicyResp, error)
+	// Snapshot the system database on the management service leader.
+	SystemDBSnapshot(ctx context.Context, in *SystemDBSnapshotReq, opts ...grpc.CallOption) (MgmtSvc_SystemDBSnapshotClient, error)
+	// Validate and optionally restore a system database snapshot, streamed
+	// to the management service leader in chunks.
+	SystemDBRestore(ctx context.Context, opts ...grpc.CallOption) (MgmtSvc_SystemDBRestoreClient, error)
+	// Add a server to the management service replica set.
+	SystemReplicaAdd(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Remove a server from the management service replica set.
+	SystemReplicaRemove(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Transfer management service leadership to another replica.
+	SystemLeaderTransfer(ctx context.Context, in *SystemLeaderTransferReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Update the management service access points on a server.
+	SetAccessPoints(ctx context.Context, in *SetAccessPointsReq, opts ...grpc.CallOption) (*SetAccessPointsResp, error)
+	// Check the consistency of the system database on the management service leader.
+	SystemDBVerify(ctx context.Context, in *SystemDBVerifyReq, opts ...grpc.CallOption) (*SystemDBVerifyResp, error)
+	// Get the local copy of the system database held by a management service replica.
+	SystemDBReplica(ctx context.Context, in *SystemDBReplicaReq, opts ...grpc.CallOption) (MgmtSvc_SystemDBReplicaClient, error)
+	// Compare the copies of the system database held by two management service replicas.
+	SystemDBDiff(ctx context.Context, in *SystemDBDiffReq, opts ...grpc.CallOption) (*SystemDBDiffResp, error)
+	// Set the resource quota for a user or group.
+	SystemSetQuota(ctx context.Context, in *SystemSetQuotaReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Get the resource quotas for one or more users or groups.
+	SystemGetQuota(ctx context.Context, in *SystemGetQuotaReq, opts ...grpc.CallOption) (*SystemGetQuotaResp, error)
+	// Get the access role granted to the caller.
+	SystemGetAccess(ctx context.Context, in *SystemGetAccessReq, opts ...grpc.CallOption) (*SystemGetAccessResp, error)
+	// Query the audit log of mutating control-plane operations.
+	SystemGetAudit(ctx context.Context, in *SystemGetAuditReq, opts ...grpc.CallOption) (*SystemGetAuditResp, error)
+	// Add a record forwarded by another server to the audit log.
+	SystemAddAudit(ctx context.Context, in *SystemAddAuditReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error)
@@ -392,6 +459,38 @@ func (c *mgmtSvcClient) SystemStop(ctx context.Context, in *SystemStopReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemStopStream(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (MgmtSvc_SystemStopStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[0], MgmtSvc_SystemStopStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemStopStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemStopStreamClient interface {
+	Recv() (*SystemStopStreamResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemStopStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemStopStreamClient) Recv() (*SystemStopStreamResp, error) {
+	m := new(SystemStopStreamResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *mgmtSvcClient) SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error) {
 	out := new(SystemStartResp)
 	err := c.cc.Invoke(ctx, MgmtSvc_SystemStart_FullMethodName, in, out, opts...)
@@ -509,6 +608,33 @@ func (c *mgmtSvcClient) PoolUpgrade(ctx context.Context, in *PoolUpgradeReq, opt
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolOpSchedule(ctx context.Context, in *PoolOpScheduleReq, opts ...grpc.CallOption) (*PoolOpScheduleResp, error) {
+	out := new(PoolOpScheduleResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolOpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolOpList(ctx context.Context, in *PoolOpListReq, opts ...grpc.CallOption) (*PoolOpListResp, error) {
+	out := new(PoolOpListResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolOpList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolOpCancel(ctx context.Context, in *PoolOpCancelReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolOpCancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) SystemSetAttr(ctx context.Context, in *SystemSetAttrReq, opts ...grpc.CallOption) (*DaosResp, error) {
 	out := new(DaosResp)
 	err := c.cc.Invoke(ctx, MgmtSvc_SystemSetAttr_FullMethodName, in, out, opts...)
@@ -545,6 +671,262 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemGetEvents(ctx context.Context, in *SystemGetEventsReq, opts ...grpc.CallOption) (*SystemGetEventsResp, error) {
+	out := new(SystemGetEventsResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemGetEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemStreamEvents(ctx context.Context, in *SystemStreamEventsReq, opts ...grpc.CallOption) (MgmtSvc_SystemStreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[1], MgmtSvc_SystemStreamEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemStreamEventsClient interface {
+	Recv() (*shared.RASEvent, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemStreamEventsClient) Recv() (*shared.RASEvent, error) {
+	m := new(shared.RASEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemSetEventPolicy(ctx context.Context, in *SystemSetEventPolicyReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemSetEventPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemGetEventPolicy(ctx context.Context, in *SystemGetEventPolicyReq, opts ...grpc.CallOption) (*SystemGetEventPolicyResp, error) {
+	out := new(SystemGetEventPolicyResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemGetEventPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDBSnapshot(ctx context.Context, in *SystemDBSnapshotReq, opts ...grpc.CallOption) (MgmtSvc_SystemDBSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[2], MgmtSvc_SystemDBSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemDBSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemDBSnapshotClient interface {
+	Recv() (*SystemDBSnapshotResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemDBSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemDBSnapshotClient) Recv() (*SystemDBSnapshotResp, error) {
+	m := new(SystemDBSnapshotResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemDBRestore(ctx context.Context, opts ...grpc.CallOption) (MgmtSvc_SystemDBRestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[3], MgmtSvc_SystemDBRestore_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemDBRestoreClient{stream}
+	return x, nil
+}
+
+type MgmtSvc_SystemDBRestoreClient interface {
+	Send(*SystemDBRestoreReq) error
+	CloseAndRecv() (*SystemDBRestoreResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemDBRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemDBRestoreClient) Send(m *SystemDBRestoreReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mgmtSvcSystemDBRestoreClient) CloseAndRecv() (*SystemDBRestoreResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SystemDBRestoreResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicaAdd(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemReplicaAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemReplicaRemove(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemReplicaRemove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemLeaderTransfer(ctx context.Context, in *SystemLeaderTransferReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemLeaderTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SetAccessPoints(ctx context.Context, in *SetAccessPointsReq, opts ...grpc.CallOption) (*SetAccessPointsResp, error) {
+	out := new(SetAccessPointsResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SetAccessPoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDBVerify(ctx context.Context, in *SystemDBVerifyReq, opts ...grpc.CallOption) (*SystemDBVerifyResp, error) {
+	out := new(SystemDBVerifyResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemDBVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDBReplica(ctx context.Context, in *SystemDBReplicaReq, opts ...grpc.CallOption) (MgmtSvc_SystemDBReplicaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[4], MgmtSvc_SystemDBReplica_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemDBReplicaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemDBReplicaClient interface {
+	Recv() (*SystemDBReplicaResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemDBReplicaClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemDBReplicaClient) Recv() (*SystemDBReplicaResp, error) {
+	m := new(SystemDBReplicaResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemDBDiff(ctx context.Context, in *SystemDBDiffReq, opts ...grpc.CallOption) (*SystemDBDiffResp, error) {
+	out := new(SystemDBDiffResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemDBDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemSetQuota(ctx context.Context, in *SystemSetQuotaReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemSetQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemGetQuota(ctx context.Context, in *SystemGetQuotaReq, opts ...grpc.CallOption) (*SystemGetQuotaResp, error) {
+	out := new(SystemGetQuotaResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemGetQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemGetAccess(ctx context.Context, in *SystemGetAccessReq, opts ...grpc.CallOption) (*SystemGetAccessResp, error) {
+	out := new(SystemGetAccessResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemGetAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemGetAudit(ctx context.Context, in *SystemGetAuditReq, opts ...grpc.CallOption) (*SystemGetAuditResp, error) {
+	out := new(SystemGetAuditResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemGetAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemAddAudit(ctx context.Context, in *SystemAddAuditReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemAddAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error) {
 	out := new(DaosResp)
 	err := c.cc.Invoke(ctx, MgmtSvc_FaultInjectReport_FullMethodName, in, out, opts...)
@@ -625,6 +1007,8 @@ type MgmtSvcServer interface {
 	SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
 	SystemStop(context.Context, *SystemStopReq) (*SystemStopResp, error)
+	// Stop DAOS system, streaming per-rank results as each host responds
+	SystemStopStream(*SystemStopReq, MgmtSvc_SystemStopStreamServer) error
 	// Start DAOS system (restart data-plane instances)
 	SystemStart(context.Context, *SystemStartReq) (*SystemStartResp, error)
 	// Exclude DAOS ranks
@@ -651,6 +1035,12 @@ type MgmtSvcServer interface {
 	SystemCheckRepair(context.Context, *CheckActReq) (*CheckActResp, error)
 	// PoolUpgrade queries a DAOS pool.
 	PoolUpgrade(context.Context, *PoolUpgradeReq) (*PoolUpgradeResp, error)
+	// Schedule a rebuild-triggering pool operation to run within a time window.
+	PoolOpSchedule(context.Context, *PoolOpScheduleReq) (*PoolOpScheduleResp, error)
+	// List the rebuild-triggering operations queued for a pool.
+	PoolOpList(context.Context, *PoolOpListReq) (*PoolOpListResp, error)
+	// Cancel a queued pool operation.
+	PoolOpCancel(context.Context, *PoolOpCancelReq) (*DaosResp, error)
 	// Set a system attribute or attributes.
 	SystemSetAttr(context.Context, *SystemSetAttrReq) (*DaosResp, error)
 	// Get a system attribute or attributes.
@@ -659,6 +1049,43 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// Get RAS events from the management service event history.
+	SystemGetEvents(context.Context, *SystemGetEventsReq) (*SystemGetEventsResp, error)
+	// Stream RAS events as they are raised on the management service leader.
+	SystemStreamEvents(*SystemStreamEventsReq, MgmtSvc_SystemStreamEventsServer) error
+	// Set the runtime publication policy for RAS event IDs.
+	SystemSetEventPolicy(context.Context, *SystemSetEventPolicyReq) (*DaosResp, error)
+	// Get the runtime publication policy for RAS event IDs.
+	SystemGetEventPolicy(context.Context, *SystemGetEventPolicyReq) (*SystemGetEventPolicyResp, error)
+	// Snapshot the system database on the management service leader.
+	SystemDBSnapshot(*SystemDBSnapshotReq, MgmtSvc_SystemDBSnapshotServer) error
+	// Validate and optionally restore a system database snapshot, streamed
+	// to the management service leader in chunks.
+	SystemDBRestore(MgmtSvc_SystemDBRestoreServer) error
+	// Add a server to the management service replica set.
+	SystemReplicaAdd(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error)
+	// Remove a server from the management service replica set.
+	SystemReplicaRemove(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error)
+	// Transfer management service leadership to another replica.
+	SystemLeaderTransfer(context.Context, *SystemLeaderTransferReq) (*SystemReplicaResp, error)
+	// Update the management service access points on a server.
+	SetAccessPoints(context.Context, *SetAccessPointsReq) (*SetAccessPointsResp, error)
+	// Check the consistency of the system database on the management service leader.
+	SystemDBVerify(context.Context, *SystemDBVerifyReq) (*SystemDBVerifyResp, error)
+	// Get the local copy of the system database held by a management service replica.
+	SystemDBReplica(*SystemDBReplicaReq, MgmtSvc_SystemDBReplicaServer) error
+	// Compare the copies of the system database held by two management service replicas.
+	SystemDBDiff(context.Context, *SystemDBDiffReq) (*SystemDBDiffResp, error)
+	// Set the resource quota for a user or group.
+	SystemSetQuota(context.Context, *SystemSetQuotaReq) (*DaosResp, error)
+	// Get the resource quotas for one or more users or groups.
+	SystemGetQuota(context.Context, *SystemGetQuotaReq) (*SystemGetQuotaResp, error)
+	// Get the access role granted to the caller.
+	SystemGetAccess(context.Context, *SystemGetAccessReq) (*SystemGetAccessResp, error)
+	// Query the audit log of mutating control-plane operations.
+	SystemGetAudit(context.Context, *SystemGetAuditReq) (*SystemGetAuditResp, error)
+	// Add a record forwarded by another server to the audit log.
+	SystemAddAudit(context.Context, *SystemAddAuditReq) (*DaosResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error)
@@ -744,6 +1171,9 @@ func (UnimplementedMgmtSvcServer) SystemQuery(context.Context, *SystemQueryReq)
 func (UnimplementedMgmtSvcServer) SystemStop(context.Context, *SystemStopReq) (*SystemStopResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemStop not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemStopStream(*SystemStopReq, MgmtSvc_SystemStopStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemStopStream not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemStart(context.Context, *SystemStartReq) (*SystemStartResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemStart not implemented")
 }
@@ -783,6 +1213,15 @@ func (UnimplementedMgmtSvcServer) SystemCheckRepair(context.Context, *CheckActRe
 func (UnimplementedMgmtSvcServer) PoolUpgrade(context.Context, *PoolUpgradeReq) (*PoolUpgradeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolUpgrade not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolOpSchedule(context.Context, *PoolOpScheduleReq) (*PoolOpScheduleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolOpSchedule not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolOpList(context.Context, *PoolOpListReq) (*PoolOpListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolOpList not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolOpCancel(context.Context, *PoolOpCancelReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolOpCancel not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemSetAttr(context.Context, *SystemSetAttrReq) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemSetAttr not implemented")
 }
@@ -795,6 +1234,60 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemGetEvents(context.Context, *SystemGetEventsReq) (*SystemGetEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGetEvents not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemStreamEvents(*SystemStreamEventsReq, MgmtSvc_SystemStreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemStreamEvents not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemSetEventPolicy(context.Context, *SystemSetEventPolicyReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSetEventPolicy not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemGetEventPolicy(context.Context, *SystemGetEventPolicyReq) (*SystemGetEventPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGetEventPolicy not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDBSnapshot(*SystemDBSnapshotReq, MgmtSvc_SystemDBSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemDBSnapshot not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDBRestore(MgmtSvc_SystemDBRestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemDBRestore not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemReplicaAdd(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicaAdd not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemReplicaRemove(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplicaRemove not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemLeaderTransfer(context.Context, *SystemLeaderTransferReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemLeaderTransfer not implemented")
+}
+func (UnimplementedMgmtSvcServer) SetAccessPoints(context.Context, *SetAccessPointsReq) (*SetAccessPointsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccessPoints not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDBVerify(context.Context, *SystemDBVerifyReq) (*SystemDBVerifyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDBVerify not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDBReplica(*SystemDBReplicaReq, MgmtSvc_SystemDBReplicaServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemDBReplica not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDBDiff(context.Context, *SystemDBDiffReq) (*SystemDBDiffResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDBDiff not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemSetQuota(context.Context, *SystemSetQuotaReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSetQuota not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemGetQuota(context.Context, *SystemGetQuotaReq) (*SystemGetQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGetQuota not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemGetAccess(context.Context, *SystemGetAccessReq) (*SystemGetAccessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGetAccess not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemGetAudit(context.Context, *SystemGetAuditReq) (*SystemGetAuditResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemGetAudit not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemAddAudit(context.Context, *SystemAddAuditReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemAddAudit not implemented")
+}
 func (UnimplementedMgmtSvcServer) FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectReport not implemented")
 }
@@ -1249,6 +1742,27 @@ func _MgmtSvc_SystemStop_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemStopStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemStopReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemStopStream(m, &mgmtSvcSystemStopStreamServer{stream})
+}
+
+type MgmtSvc_SystemStopStreamServer interface {
+	Send(*SystemStopStreamResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemStopStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemStopStreamServer) Send(m *SystemStopStreamResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MgmtSvc_SystemStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SystemStartReq)
 	if err := dec(in); err != nil {
@@ -1483,92 +1997,487 @@ func _MgmtSvc_PoolUpgrade_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemSetAttr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemSetAttrReq)
+func _MgmtSvc_PoolOpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolOpScheduleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemSetAttr(ctx, in)
+		return srv.(MgmtSvcServer).PoolOpSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemSetAttr_FullMethodName,
+		FullMethod: MgmtSvc_PoolOpSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemSetAttr(ctx, req.(*SystemSetAttrReq))
+		return srv.(MgmtSvcServer).PoolOpSchedule(ctx, req.(*PoolOpScheduleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemGetAttr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemGetAttrReq)
+func _MgmtSvc_PoolOpList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolOpListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemGetAttr(ctx, in)
+		return srv.(MgmtSvcServer).PoolOpList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemGetAttr_FullMethodName,
+		FullMethod: MgmtSvc_PoolOpList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemGetAttr(ctx, req.(*SystemGetAttrReq))
+		return srv.(MgmtSvcServer).PoolOpList(ctx, req.(*PoolOpListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemSetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemSetPropReq)
+func _MgmtSvc_PoolOpCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolOpCancelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemSetProp(ctx, in)
+		return srv.(MgmtSvcServer).PoolOpCancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemSetProp_FullMethodName,
+		FullMethod: MgmtSvc_PoolOpCancel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemSetProp(ctx, req.(*SystemSetPropReq))
+		return srv.(MgmtSvcServer).PoolOpCancel(ctx, req.(*PoolOpCancelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemGetPropReq)
+func _MgmtSvc_SystemSetAttr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetAttrReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemGetProp(ctx, in)
+		return srv.(MgmtSvcServer).SystemSetAttr(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemGetProp_FullMethodName,
+		FullMethod: MgmtSvc_SystemSetAttr_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemGetProp(ctx, req.(*SystemGetPropReq))
+		return srv.(MgmtSvcServer).SystemSetAttr(ctx, req.(*SystemSetAttrReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_FaultInjectReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(chk.CheckReport)
+func _MgmtSvc_SystemGetAttr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetAttrReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).FaultInjectReport(ctx, in)
+		return srv.(MgmtSvcServer).SystemGetAttr(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_FaultInjectReport_FullMethodName,
+		FullMethod: MgmtSvc_SystemGetAttr_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).FaultInjectReport(ctx, req.(*chk.CheckReport))
+		return srv.(MgmtSvcServer).SystemGetAttr(ctx, req.(*SystemGetAttrReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemSetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetPropReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetProp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemSetProp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetProp(ctx, req.(*SystemSetPropReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetPropReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetProp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetProp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetProp(ctx, req.(*SystemGetPropReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetEvents(ctx, req.(*SystemGetEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemStreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemStreamEventsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemStreamEvents(m, &mgmtSvcSystemStreamEventsServer{stream})
+}
+
+type MgmtSvc_SystemStreamEventsServer interface {
+	Send(*shared.RASEvent) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemStreamEventsServer) Send(m *shared.RASEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemSetEventPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetEventPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetEventPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemSetEventPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetEventPolicy(ctx, req.(*SystemSetEventPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetEventPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetEventPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetEventPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetEventPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetEventPolicy(ctx, req.(*SystemGetEventPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDBSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemDBSnapshotReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemDBSnapshot(m, &mgmtSvcSystemDBSnapshotServer{stream})
+}
+
+type MgmtSvc_SystemDBSnapshotServer interface {
+	Send(*SystemDBSnapshotResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemDBSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemDBSnapshotServer) Send(m *SystemDBSnapshotResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemDBRestore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MgmtSvcServer).SystemDBRestore(&mgmtSvcSystemDBRestoreServer{stream})
+}
+
+type MgmtSvc_SystemDBRestoreServer interface {
+	SendAndClose(*SystemDBRestoreResp) error
+	Recv() (*SystemDBRestoreReq, error)
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemDBRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemDBRestoreServer) SendAndClose(m *SystemDBRestoreResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mgmtSvcSystemDBRestoreServer) Recv() (*SystemDBRestoreReq, error) {
+	m := new(SystemDBRestoreReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MgmtSvc_SystemReplicaAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicaAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemReplicaAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicaAdd(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemReplicaRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplicaRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemReplicaRemove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplicaRemove(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemLeaderTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemLeaderTransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemLeaderTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemLeaderTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemLeaderTransfer(ctx, req.(*SystemLeaderTransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SetAccessPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccessPointsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SetAccessPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SetAccessPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SetAccessPoints(ctx, req.(*SetAccessPointsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDBVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDBVerifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDBVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemDBVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDBVerify(ctx, req.(*SystemDBVerifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDBReplica_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemDBReplicaReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemDBReplica(m, &mgmtSvcSystemDBReplicaServer{stream})
+}
+
+type MgmtSvc_SystemDBReplicaServer interface {
+	Send(*SystemDBReplicaResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemDBReplicaServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemDBReplicaServer) Send(m *SystemDBReplicaResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemDBDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDBDiffReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDBDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemDBDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDBDiff(ctx, req.(*SystemDBDiffReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemSetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemSetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetQuota(ctx, req.(*SystemSetQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetQuota(ctx, req.(*SystemGetQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetAccessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetAccess(ctx, req.(*SystemGetAccessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemGetAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemGetAuditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemGetAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemGetAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemGetAudit(ctx, req.(*SystemGetAuditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemAddAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemAddAuditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemAddAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemAddAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemAddAudit(ctx, req.(*SystemAddAuditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_FaultInjectReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(chk.CheckReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).FaultInjectReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_FaultInjectReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).FaultInjectReport(ctx, req.(*chk.CheckReport))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1764,6 +2673,18 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolUpgrade",
 			Handler:    _MgmtSvc_PoolUpgrade_Handler,
 		},
+		{
+			MethodName: "PoolOpSchedule",
+			Handler:    _MgmtSvc_PoolOpSchedule_Handler,
+		},
+		{
+			MethodName: "PoolOpList",
+			Handler:    _MgmtSvc_PoolOpList_Handler,
+		},
+		{
+			MethodName: "PoolOpCancel",
+			Handler:    _MgmtSvc_PoolOpCancel_Handler,
+		},
 		{
 			MethodName: "SystemSetAttr",
 			Handler:    _MgmtSvc_SystemSetAttr_Handler,
@@ -1780,6 +2701,62 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "SystemGetEvents",
+			Handler:    _MgmtSvc_SystemGetEvents_Handler,
+		},
+		{
+			MethodName: "SystemSetEventPolicy",
+			Handler:    _MgmtSvc_SystemSetEventPolicy_Handler,
+		},
+		{
+			MethodName: "SystemGetEventPolicy",
+			Handler:    _MgmtSvc_SystemGetEventPolicy_Handler,
+		},
+		{
+			MethodName: "SystemReplicaAdd",
+			Handler:    _MgmtSvc_SystemReplicaAdd_Handler,
+		},
+		{
+			MethodName: "SystemReplicaRemove",
+			Handler:    _MgmtSvc_SystemReplicaRemove_Handler,
+		},
+		{
+			MethodName: "SystemLeaderTransfer",
+			Handler:    _MgmtSvc_SystemLeaderTransfer_Handler,
+		},
+		{
+			MethodName: "SetAccessPoints",
+			Handler:    _MgmtSvc_SetAccessPoints_Handler,
+		},
+		{
+			MethodName: "SystemDBVerify",
+			Handler:    _MgmtSvc_SystemDBVerify_Handler,
+		},
+		{
+			MethodName: "SystemDBDiff",
+			Handler:    _MgmtSvc_SystemDBDiff_Handler,
+		},
+		{
+			MethodName: "SystemSetQuota",
+			Handler:    _MgmtSvc_SystemSetQuota_Handler,
+		},
+		{
+			MethodName: "SystemGetQuota",
+			Handler:    _MgmtSvc_SystemGetQuota_Handler,
+		},
+		{
+			MethodName: "SystemGetAccess",
+			Handler:    _MgmtSvc_SystemGetAccess_Handler,
+		},
+		{
+			MethodName: "SystemGetAudit",
+			Handler:    _MgmtSvc_SystemGetAudit_Handler,
+		},
+		{
+			MethodName: "SystemAddAudit",
+			Handler:    _MgmtSvc_SystemAddAudit_Handler,
+		},
 		{
 			MethodName: "FaultInjectReport",
 			Handler:    _MgmtSvc_FaultInjectReport_Handler,
@@ -1793,6 +2770,32 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _MgmtSvc_FaultInjectMgmtPoolFault_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SystemStopStream",
+			Handler:       _MgmtSvc_SystemStopStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemStreamEvents",
+			Handler:       _MgmtSvc_SystemStreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemDBSnapshot",
+			Handler:       _MgmtSvc_SystemDBSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemDBRestore",
+			Handler:       _MgmtSvc_SystemDBRestore_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SystemDBReplica",
+			Handler:       _MgmtSvc_SystemDBReplica_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "mgmt/mgmt.proto",
 }
@@ -310,6 +310,145 @@ func (x *SystemStopResp) GetAbsenthosts() string {
 	return ""
 }
 
+// SystemStopProgress reports the results for the ranks on a single host as
+// they are received during a stop phase.
+type SystemStopProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action  string               `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // stop phase, "prep shutdown" or "stop"
+	Results []*shared.RankResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SystemStopProgress) Reset() {
+	*x = SystemStopProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStopProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStopProgress) ProtoMessage() {}
+
+func (x *SystemStopProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStopProgress.ProtoReflect.Descriptor instead.
+func (*SystemStopProgress) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SystemStopProgress) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SystemStopProgress) GetResults() []*shared.RankResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SystemStopStreamResp is sent on a SystemStopStream. Progress messages are
+// followed by a single message containing the overall result.
+type SystemStopStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*SystemStopStreamResp_Progress
+	//	*SystemStopStreamResp_Resp
+	Msg isSystemStopStreamResp_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *SystemStopStreamResp) Reset() {
+	*x = SystemStopStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStopStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStopStreamResp) ProtoMessage() {}
+
+func (x *SystemStopStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStopStreamResp.ProtoReflect.Descriptor instead.
+func (*SystemStopStreamResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *SystemStopStreamResp) GetMsg() isSystemStopStreamResp_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *SystemStopStreamResp) GetProgress() *SystemStopProgress {
+	if x, ok := x.GetMsg().(*SystemStopStreamResp_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *SystemStopStreamResp) GetResp() *SystemStopResp {
+	if x, ok := x.GetMsg().(*SystemStopStreamResp_Resp); ok {
+		return x.Resp
+	}
+	return nil
+}
+
+type isSystemStopStreamResp_Msg interface {
+	isSystemStopStreamResp_Msg()
+}
+
+type SystemStopStreamResp_Progress struct {
+	Progress *SystemStopProgress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type SystemStopStreamResp_Resp struct {
+	Resp *SystemStopResp `protobuf:"bytes,2,opt,name=resp,proto3,oneof"`
+}
+
+func (*SystemStopStreamResp_Progress) isSystemStopStreamResp_Msg() {}
+
+func (*SystemStopStreamResp_Resp) isSystemStopStreamResp_Msg() {}
+
 // SystemStartReq supplies system restart parameters.
 type SystemStartReq struct {
 	state         protoimpl.MessageState
@@ -325,7 +464,7 @@ type SystemStartReq struct {
 func (x *SystemStartReq) Reset() {
 	*x = SystemStartReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[3]
+		mi := &file_mgmt_system_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +477,7 @@ func (x *SystemStartReq) String() string {
 func (*SystemStartReq) ProtoMessage() {}
 
 func (x *SystemStartReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[3]
+	mi := &file_mgmt_system_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +490,7 @@ func (x *SystemStartReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemStartReq.ProtoReflect.Descriptor instead.
 func (*SystemStartReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{3}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SystemStartReq) GetSys() string {
@@ -397,7 +536,7 @@ type SystemStartResp struct {
 func (x *SystemStartResp) Reset() {
 	*x = SystemStartResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[4]
+		mi := &file_mgmt_system_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -410,7 +549,7 @@ func (x *SystemStartResp) String() string {
 func (*SystemStartResp) ProtoMessage() {}
 
 func (x *SystemStartResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[4]
+	mi := &file_mgmt_system_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +562,7 @@ func (x *SystemStartResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemStartResp.ProtoReflect.Descriptor instead.
 func (*SystemStartResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{4}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SystemStartResp) GetResults() []*shared.RankResult {
@@ -462,7 +601,7 @@ type SystemExcludeReq struct {
 func (x *SystemExcludeReq) Reset() {
 	*x = SystemExcludeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[5]
+		mi := &file_mgmt_system_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -475,7 +614,7 @@ func (x *SystemExcludeReq) String() string {
 func (*SystemExcludeReq) ProtoMessage() {}
 
 func (x *SystemExcludeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[5]
+	mi := &file_mgmt_system_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +627,7 @@ func (x *SystemExcludeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemExcludeReq.ProtoReflect.Descriptor instead.
 func (*SystemExcludeReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{5}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SystemExcludeReq) GetSys() string {
@@ -531,7 +670,7 @@ type SystemExcludeResp struct {
 func (x *SystemExcludeResp) Reset() {
 	*x = SystemExcludeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[6]
+		mi := &file_mgmt_system_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -544,7 +683,7 @@ func (x *SystemExcludeResp) String() string {
 func (*SystemExcludeResp) ProtoMessage() {}
 
 func (x *SystemExcludeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[6]
+	mi := &file_mgmt_system_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,7 +696,7 @@ func (x *SystemExcludeResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemExcludeResp.ProtoReflect.Descriptor instead.
 func (*SystemExcludeResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{6}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SystemExcludeResp) GetResults() []*shared.RankResult {
@@ -582,7 +721,7 @@ type SystemQueryReq struct {
 func (x *SystemQueryReq) Reset() {
 	*x = SystemQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[7]
+		mi := &file_mgmt_system_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -595,7 +734,7 @@ func (x *SystemQueryReq) String() string {
 func (*SystemQueryReq) ProtoMessage() {}
 
 func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[7]
+	mi := &file_mgmt_system_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +747,7 @@ func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemQueryReq.ProtoReflect.Descriptor instead.
 func (*SystemQueryReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{7}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SystemQueryReq) GetSys() string {
@@ -655,7 +794,7 @@ type SystemQueryResp struct {
 func (x *SystemQueryResp) Reset() {
 	*x = SystemQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[8]
+		mi := &file_mgmt_system_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +807,7 @@ func (x *SystemQueryResp) String() string {
 func (*SystemQueryResp) ProtoMessage() {}
 
 func (x *SystemQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[8]
+	mi := &file_mgmt_system_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +820,7 @@ func (x *SystemQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemQueryResp.ProtoReflect.Descriptor instead.
 func (*SystemQueryResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{8}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SystemQueryResp) GetMembers() []*SystemMember {
@@ -731,7 +870,7 @@ type SystemEraseReq struct {
 func (x *SystemEraseReq) Reset() {
 	*x = SystemEraseReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[9]
+		mi := &file_mgmt_system_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -744,7 +883,7 @@ func (x *SystemEraseReq) String() string {
 func (*SystemEraseReq) ProtoMessage() {}
 
 func (x *SystemEraseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[9]
+	mi := &file_mgmt_system_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +896,7 @@ func (x *SystemEraseReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemEraseReq.ProtoReflect.Descriptor instead.
 func (*SystemEraseReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{9}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SystemEraseReq) GetSys() string {
@@ -778,7 +917,7 @@ type SystemEraseResp struct {
 func (x *SystemEraseResp) Reset() {
 	*x = SystemEraseResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[10]
+		mi := &file_mgmt_system_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -791,7 +930,7 @@ func (x *SystemEraseResp) String() string {
 func (*SystemEraseResp) ProtoMessage() {}
 
 func (x *SystemEraseResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[10]
+	mi := &file_mgmt_system_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +943,7 @@ func (x *SystemEraseResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemEraseResp.ProtoReflect.Descriptor instead.
 func (*SystemEraseResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{10}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SystemEraseResp) GetResults() []*shared.RankResult {
@@ -827,7 +966,7 @@ type SystemCleanupReq struct {
 func (x *SystemCleanupReq) Reset() {
 	*x = SystemCleanupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[11]
+		mi := &file_mgmt_system_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -840,7 +979,7 @@ func (x *SystemCleanupReq) String() string {
 func (*SystemCleanupReq) ProtoMessage() {}
 
 func (x *SystemCleanupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[11]
+	mi := &file_mgmt_system_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +992,7 @@ func (x *SystemCleanupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCleanupReq.ProtoReflect.Descriptor instead.
 func (*SystemCleanupReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{11}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SystemCleanupReq) GetSys() string {
@@ -882,7 +1021,7 @@ type SystemCleanupResp struct {
 func (x *SystemCleanupResp) Reset() {
 	*x = SystemCleanupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[12]
+		mi := &file_mgmt_system_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1034,7 @@ func (x *SystemCleanupResp) String() string {
 func (*SystemCleanupResp) ProtoMessage() {}
 
 func (x *SystemCleanupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[12]
+	mi := &file_mgmt_system_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1047,7 @@ func (x *SystemCleanupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCleanupResp.ProtoReflect.Descriptor instead.
 func (*SystemCleanupResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{12}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SystemCleanupResp) GetResults() []*SystemCleanupResp_CleanupResult {
@@ -931,7 +1070,7 @@ type SystemSetAttrReq struct {
 func (x *SystemSetAttrReq) Reset() {
 	*x = SystemSetAttrReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[13]
+		mi := &file_mgmt_system_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -944,7 +1083,7 @@ func (x *SystemSetAttrReq) String() string {
 func (*SystemSetAttrReq) ProtoMessage() {}
 
 func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[13]
+	mi := &file_mgmt_system_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +1096,7 @@ func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemSetAttrReq.ProtoReflect.Descriptor instead.
 func (*SystemSetAttrReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{13}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SystemSetAttrReq) GetSys() string {
@@ -988,7 +1127,7 @@ type SystemGetAttrReq struct {
 func (x *SystemGetAttrReq) Reset() {
 	*x = SystemGetAttrReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[14]
+		mi := &file_mgmt_system_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1001,7 +1140,7 @@ func (x *SystemGetAttrReq) String() string {
 func (*SystemGetAttrReq) ProtoMessage() {}
 
 func (x *SystemGetAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[14]
+	mi := &file_mgmt_system_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1153,7 @@ func (x *SystemGetAttrReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetAttrReq.ProtoReflect.Descriptor instead.
 func (*SystemGetAttrReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{14}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SystemGetAttrReq) GetSys() string {
@@ -1043,7 +1182,7 @@ type SystemGetAttrResp struct {
 func (x *SystemGetAttrResp) Reset() {
 	*x = SystemGetAttrResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[15]
+		mi := &file_mgmt_system_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1195,7 @@ func (x *SystemGetAttrResp) String() string {
 func (*SystemGetAttrResp) ProtoMessage() {}
 
 func (x *SystemGetAttrResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[15]
+	mi := &file_mgmt_system_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1208,7 @@ func (x *SystemGetAttrResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetAttrResp.ProtoReflect.Descriptor instead.
 func (*SystemGetAttrResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{15}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SystemGetAttrResp) GetAttributes() map[string]string {
@@ -1092,7 +1231,7 @@ type SystemSetPropReq struct {
 func (x *SystemSetPropReq) Reset() {
 	*x = SystemSetPropReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[16]
+		mi := &file_mgmt_system_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1105,7 +1244,7 @@ func (x *SystemSetPropReq) String() string {
 func (*SystemSetPropReq) ProtoMessage() {}
 
 func (x *SystemSetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[16]
+	mi := &file_mgmt_system_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1257,7 @@ func (x *SystemSetPropReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemSetPropReq.ProtoReflect.Descriptor instead.
 func (*SystemSetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{16}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SystemSetPropReq) GetSys() string {
@@ -1149,7 +1288,7 @@ type SystemGetPropReq struct {
 func (x *SystemGetPropReq) Reset() {
 	*x = SystemGetPropReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[17]
+		mi := &file_mgmt_system_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1162,7 +1301,7 @@ func (x *SystemGetPropReq) String() string {
 func (*SystemGetPropReq) ProtoMessage() {}
 
 func (x *SystemGetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[17]
+	mi := &file_mgmt_system_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1314,7 @@ func (x *SystemGetPropReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetPropReq.ProtoReflect.Descriptor instead.
 func (*SystemGetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{17}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SystemGetPropReq) GetSys() string {
@@ -1204,7 +1343,7 @@ type SystemGetPropResp struct {
 func (x *SystemGetPropResp) Reset() {
 	*x = SystemGetPropResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[18]
+		mi := &file_mgmt_system_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1217,7 +1356,7 @@ func (x *SystemGetPropResp) String() string {
 func (*SystemGetPropResp) ProtoMessage() {}
 
 func (x *SystemGetPropResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[18]
+	mi := &file_mgmt_system_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1369,7 @@ func (x *SystemGetPropResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetPropResp.ProtoReflect.Descriptor instead.
 func (*SystemGetPropResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{18}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SystemGetPropResp) GetProperties() map[string]string {
@@ -1240,34 +1379,40 @@ func (x *SystemGetPropResp) GetProperties() map[string]string {
 	return nil
 }
 
-type SystemCleanupResp_CleanupResult struct {
+// SystemGetEventsReq contains a request to retrieve RAS events from the
+// management service event history. Unset fields match all events.
+type SystemGetEventsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`              // Status of the evict on the specific pool
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                     // Error message if status indicates an error
-	PoolId string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // uuid of pool
-	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                // number of pool handles cleaned up
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Ranks    string   `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`                       // rankset of ranks involved in events
+	Severity uint32   `protobuf:"varint,3,opt,name=severity,proto3" json:"severity,omitempty"`                // minimum (most permissive) severity of events
+	Ids      []uint32 `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`                   // RAS event IDs to match
+	PoolUuid string   `protobuf:"bytes,5,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"` // UUID of pool involved in events
+	Since    string   `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`                       // earliest event timestamp
+	Until    string   `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`                       // latest event timestamp
+	Limit    uint32   `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`                      // maximum number of (most recent) events to return
 }
 
-func (x *SystemCleanupResp_CleanupResult) Reset() {
-	*x = SystemCleanupResp_CleanupResult{}
+func (x *SystemGetEventsReq) Reset() {
+	*x = SystemGetEventsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[19]
+		mi := &file_mgmt_system_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemCleanupResp_CleanupResult) String() string {
+func (x *SystemGetEventsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemCleanupResp_CleanupResult) ProtoMessage() {}
+func (*SystemGetEventsReq) ProtoMessage() {}
 
-func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[19]
+func (x *SystemGetEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1278,205 +1423,3283 @@ func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemCleanupResp_CleanupResult.ProtoReflect.Descriptor instead.
-func (*SystemCleanupResp_CleanupResult) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use SystemGetEventsReq.ProtoReflect.Descriptor instead.
+func (*SystemGetEventsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetStatus() int32 {
+func (x *SystemGetEventsReq) GetSys() string {
 	if x != nil {
-		return x.Status
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemGetEventsReq) GetRanks() string {
+	if x != nil {
+		return x.Ranks
+	}
+	return ""
+}
+
+func (x *SystemGetEventsReq) GetSeverity() uint32 {
+	if x != nil {
+		return x.Severity
 	}
 	return 0
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetMsg() string {
+func (x *SystemGetEventsReq) GetIds() []uint32 {
 	if x != nil {
-		return x.Msg
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SystemGetEventsReq) GetPoolUuid() string {
+	if x != nil {
+		return x.PoolUuid
 	}
 	return ""
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetPoolId() string {
+func (x *SystemGetEventsReq) GetSince() string {
 	if x != nil {
-		return x.PoolId
+		return x.Since
 	}
 	return ""
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetCount() uint32 {
+func (x *SystemGetEventsReq) GetUntil() string {
 	if x != nil {
-		return x.Count
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SystemGetEventsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-var File_mgmt_system_proto protoreflect.FileDescriptor
+// SystemGetEventsResp contains matching RAS events in chronological order.
+type SystemGetEventsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_mgmt_system_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x02,
-	0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
-	0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72,
-	0x69, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f,
-	0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x46, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x55, 0x72, 0x69, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72,
-	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x72, 0x65, 0x70, 0x12, 0x12,
-	0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b, 0x69,
-	0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72,
-	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65,
-	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
-	0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62,
-	0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62,
-	0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x66,
-	0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f,
-	0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x41, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xc4, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62,
-	0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22,
-	0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x68, 0x0a, 0x0d, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
-	0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74,
-	0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01,
-	0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x10,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b,
-	0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f,
-	0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Events []*shared.RASEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *SystemGetEventsResp) Reset() {
+	*x = SystemGetEventsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetEventsResp) ProtoMessage() {}
+
+func (x *SystemGetEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetEventsResp.ProtoReflect.Descriptor instead.
+func (*SystemGetEventsResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SystemGetEventsResp) GetEvents() []*shared.RASEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// SystemStreamEventsReq contains a request to subscribe to RAS events as they
+// are raised on the management service leader. Unset fields match all events.
+type SystemStreamEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Type     uint32   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`         // RAS event type to match
+	Severity uint32   `protobuf:"varint,3,opt,name=severity,proto3" json:"severity,omitempty"` // minimum (most permissive) severity of events
+	Ids      []uint32 `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`    // RAS event IDs to match
+}
+
+func (x *SystemStreamEventsReq) Reset() {
+	*x = SystemStreamEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStreamEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStreamEventsReq) ProtoMessage() {}
+
+func (x *SystemStreamEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStreamEventsReq.ProtoReflect.Descriptor instead.
+func (*SystemStreamEventsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SystemStreamEventsReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemStreamEventsReq) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SystemStreamEventsReq) GetSeverity() uint32 {
+	if x != nil {
+		return x.Severity
+	}
+	return 0
+}
+
+func (x *SystemStreamEventsReq) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// EventPolicy describes the runtime publication policy for a RAS event ID.
+type EventPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`             // RAS event ID
+	Disabled bool   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"` // events with this ID are not published
+	Debounce bool   `protobuf:"varint,3,opt,name=debounce,proto3" json:"debounce,omitempty"` // duplicate events with this ID are suppressed
+	Cooldown uint64 `protobuf:"varint,4,opt,name=cooldown,proto3" json:"cooldown,omitempty"` // minimum nanoseconds between duplicates, 0 means publish once
+}
+
+func (x *EventPolicy) Reset() {
+	*x = EventPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPolicy) ProtoMessage() {}
+
+func (x *EventPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPolicy.ProtoReflect.Descriptor instead.
+func (*EventPolicy) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EventPolicy) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventPolicy) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *EventPolicy) GetDebounce() bool {
+	if x != nil {
+		return x.Debounce
+	}
+	return false
+}
+
+func (x *EventPolicy) GetCooldown() uint64 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
+}
+
+// SystemSetEventPolicyReq contains a request to update the RAS event policy.
+// Each supplied policy replaces any existing policy for the same event ID, a
+// policy with neither disabled nor debounce set removes it.
+type SystemSetEventPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string         `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Policies []*EventPolicy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *SystemSetEventPolicyReq) Reset() {
+	*x = SystemSetEventPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSetEventPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetEventPolicyReq) ProtoMessage() {}
+
+func (x *SystemSetEventPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetEventPolicyReq.ProtoReflect.Descriptor instead.
+func (*SystemSetEventPolicyReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SystemSetEventPolicyReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemSetEventPolicyReq) GetPolicies() []*EventPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// SystemGetEventPolicyReq contains a request to retrieve the RAS event policy.
+type SystemGetEventPolicyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemGetEventPolicyReq) Reset() {
+	*x = SystemGetEventPolicyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetEventPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetEventPolicyReq) ProtoMessage() {}
+
+func (x *SystemGetEventPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetEventPolicyReq.ProtoReflect.Descriptor instead.
+func (*SystemGetEventPolicyReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SystemGetEventPolicyReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemGetEventPolicyResp contains the current RAS event policy.
+type SystemGetEventPolicyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies []*EventPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *SystemGetEventPolicyResp) Reset() {
+	*x = SystemGetEventPolicyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetEventPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetEventPolicyResp) ProtoMessage() {}
+
+func (x *SystemGetEventPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetEventPolicyResp.ProtoReflect.Descriptor instead.
+func (*SystemGetEventPolicyResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SystemGetEventPolicyResp) GetPolicies() []*EventPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// SystemDBSnapshotReq contains a request to snapshot the system database on
+// the management service leader.
+type SystemDBSnapshotReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemDBSnapshotReq) Reset() {
+	*x = SystemDBSnapshotReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBSnapshotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBSnapshotReq) ProtoMessage() {}
+
+func (x *SystemDBSnapshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBSnapshotReq.ProtoReflect.Descriptor instead.
+func (*SystemDBSnapshotReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SystemDBSnapshotReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemDBSnapshotResp contains a chunk of a system database snapshot archive.
+// Snapshot details are only set in the first message of the stream.
+type SystemDBSnapshotResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // raft index of the snapshot
+	Term  uint64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`   // raft term of the snapshot
+	Size  uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`   // total size of the snapshot archive in bytes
+	Data  []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`    // chunk of the snapshot archive
+}
+
+func (x *SystemDBSnapshotResp) Reset() {
+	*x = SystemDBSnapshotResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBSnapshotResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBSnapshotResp) ProtoMessage() {}
+
+func (x *SystemDBSnapshotResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBSnapshotResp.ProtoReflect.Descriptor instead.
+func (*SystemDBSnapshotResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SystemDBSnapshotResp) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SystemDBSnapshotResp) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *SystemDBSnapshotResp) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SystemDBSnapshotResp) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// SystemDBRestoreReq contains a request to validate, and optionally restore,
+// a system database snapshot archive on the management service leader. The
+// archive is streamed in chunks: the first message of the stream contains the
+// request options and the size of the archive, and each subsequent message
+// contains the next chunk of archive data.
+type SystemDBRestoreReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`    // chunk of the snapshot archive
+	Apply bool   `protobuf:"varint,3,opt,name=apply,proto3" json:"apply,omitempty"` // restore the snapshot rather than only validating it
+	Force bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"` // restore even if the snapshot differs from the running system
+	Size  uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`   // total size of the snapshot archive
+}
+
+func (x *SystemDBRestoreReq) Reset() {
+	*x = SystemDBRestoreReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBRestoreReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBRestoreReq) ProtoMessage() {}
+
+func (x *SystemDBRestoreReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBRestoreReq.ProtoReflect.Descriptor instead.
+func (*SystemDBRestoreReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SystemDBRestoreReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemDBRestoreReq) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SystemDBRestoreReq) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+func (x *SystemDBRestoreReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *SystemDBRestoreReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// SystemDBRestoreResp describes how the snapshot differs from the running system.
+type SystemDBRestoreResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index           uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                                    // raft index of the snapshot
+	Term            uint64   `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`                                                      // raft term of the snapshot
+	Version         uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                                                // system database version in the snapshot
+	MapVersion      uint32   `protobuf:"varint,4,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"`                        // system map version in the snapshot
+	MissingRanks    string   `protobuf:"bytes,5,opt,name=missing_ranks,json=missingRanks,proto3" json:"missing_ranks,omitempty"`                   // ranks in the running system but not the snapshot
+	ExtraRanks      string   `protobuf:"bytes,6,opt,name=extra_ranks,json=extraRanks,proto3" json:"extra_ranks,omitempty"`                         // ranks in the snapshot but not the running system
+	ChangedRanks    string   `protobuf:"bytes,7,opt,name=changed_ranks,json=changedRanks,proto3" json:"changed_ranks,omitempty"`                   // ranks with a different UUID or address in the snapshot
+	MissingPools    []string `protobuf:"bytes,8,rep,name=missing_pools,json=missingPools,proto3" json:"missing_pools,omitempty"`                   // pools in the running system but not the snapshot
+	ExtraPools      []string `protobuf:"bytes,9,rep,name=extra_pools,json=extraPools,proto3" json:"extra_pools,omitempty"`                         // pools in the snapshot but not the running system
+	ChangedPools    []string `protobuf:"bytes,10,rep,name=changed_pools,json=changedPools,proto3" json:"changed_pools,omitempty"`                  // pools with different service replicas in the snapshot
+	MissingFindings []uint64 `protobuf:"varint,11,rep,packed,name=missing_findings,json=missingFindings,proto3" json:"missing_findings,omitempty"` // checker findings in the running system but not the snapshot
+	ExtraFindings   []uint64 `protobuf:"varint,12,rep,packed,name=extra_findings,json=extraFindings,proto3" json:"extra_findings,omitempty"`       // checker findings in the snapshot but not the running system
+	Applied         bool     `protobuf:"varint,13,opt,name=applied,proto3" json:"applied,omitempty"`                                               // the snapshot was restored
+}
+
+func (x *SystemDBRestoreResp) Reset() {
+	*x = SystemDBRestoreResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBRestoreResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBRestoreResp) ProtoMessage() {}
+
+func (x *SystemDBRestoreResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBRestoreResp.ProtoReflect.Descriptor instead.
+func (*SystemDBRestoreResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SystemDBRestoreResp) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SystemDBRestoreResp) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *SystemDBRestoreResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SystemDBRestoreResp) GetMapVersion() uint32 {
+	if x != nil {
+		return x.MapVersion
+	}
+	return 0
+}
+
+func (x *SystemDBRestoreResp) GetMissingRanks() string {
+	if x != nil {
+		return x.MissingRanks
+	}
+	return ""
+}
+
+func (x *SystemDBRestoreResp) GetExtraRanks() string {
+	if x != nil {
+		return x.ExtraRanks
+	}
+	return ""
+}
+
+func (x *SystemDBRestoreResp) GetChangedRanks() string {
+	if x != nil {
+		return x.ChangedRanks
+	}
+	return ""
+}
+
+func (x *SystemDBRestoreResp) GetMissingPools() []string {
+	if x != nil {
+		return x.MissingPools
+	}
+	return nil
+}
+
+func (x *SystemDBRestoreResp) GetExtraPools() []string {
+	if x != nil {
+		return x.ExtraPools
+	}
+	return nil
+}
+
+func (x *SystemDBRestoreResp) GetChangedPools() []string {
+	if x != nil {
+		return x.ChangedPools
+	}
+	return nil
+}
+
+func (x *SystemDBRestoreResp) GetMissingFindings() []uint64 {
+	if x != nil {
+		return x.MissingFindings
+	}
+	return nil
+}
+
+func (x *SystemDBRestoreResp) GetExtraFindings() []uint64 {
+	if x != nil {
+		return x.ExtraFindings
+	}
+	return nil
+}
+
+func (x *SystemDBRestoreResp) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// SystemReplicaReq supplies the control address of a server to be added to,
+// or removed from, the MS replica set.
+type SystemReplicaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`      // DAOS system identifier
+	Addr  string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`    // control address (host:port) of the server
+	Force bool   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"` // skip the quorum safety checks
+}
+
+func (x *SystemReplicaReq) Reset() {
+	*x = SystemReplicaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplicaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplicaReq) ProtoMessage() {}
+
+func (x *SystemReplicaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplicaReq.ProtoReflect.Descriptor instead.
+func (*SystemReplicaReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SystemReplicaReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemReplicaReq) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *SystemReplicaReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// SystemLeaderTransferReq requests that MS leadership is transferred.
+type SystemLeaderTransferReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys  string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`   // DAOS system identifier
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"` // control address of the new leader, any replica if unset
+}
+
+func (x *SystemLeaderTransferReq) Reset() {
+	*x = SystemLeaderTransferReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemLeaderTransferReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemLeaderTransferReq) ProtoMessage() {}
+
+func (x *SystemLeaderTransferReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemLeaderTransferReq.ProtoReflect.Descriptor instead.
+func (*SystemLeaderTransferReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SystemLeaderTransferReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemLeaderTransferReq) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+// SystemReplicaResp describes the MS replica set after a change.
+type SystemReplicaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replicas []string `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"` // control addresses of the MS replicas
+	Leader   string   `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`     // control address of the MS leader, if known
+}
+
+func (x *SystemReplicaResp) Reset() {
+	*x = SystemReplicaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplicaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplicaResp) ProtoMessage() {}
+
+func (x *SystemReplicaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplicaResp.ProtoReflect.Descriptor instead.
+func (*SystemReplicaResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SystemReplicaResp) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *SystemReplicaResp) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+// SetAccessPointsReq is sent by the MS leader to every server that is not a MS
+// replica after the MS replica set has been changed.
+type SetAccessPointsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys          string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                       // DAOS system identifier
+	AccessPoints []string `protobuf:"bytes,2,rep,name=access_points,json=accessPoints,proto3" json:"access_points,omitempty"` // control addresses of the MS replicas
+}
+
+func (x *SetAccessPointsReq) Reset() {
+	*x = SetAccessPointsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAccessPointsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccessPointsReq) ProtoMessage() {}
+
+func (x *SetAccessPointsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccessPointsReq.ProtoReflect.Descriptor instead.
+func (*SetAccessPointsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetAccessPointsReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SetAccessPointsReq) GetAccessPoints() []string {
+	if x != nil {
+		return x.AccessPoints
+	}
+	return nil
+}
+
+type SetAccessPointsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetAccessPointsResp) Reset() {
+	*x = SetAccessPointsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAccessPointsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccessPointsResp) ProtoMessage() {}
+
+func (x *SetAccessPointsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccessPointsResp.ProtoReflect.Descriptor instead.
+func (*SetAccessPointsResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{36}
+}
+
+// SystemDBVerifyReq requests a consistency check of the system database on
+// the management service leader.
+type SystemDBVerifyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+}
+
+func (x *SystemDBVerifyReq) Reset() {
+	*x = SystemDBVerifyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBVerifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBVerifyReq) ProtoMessage() {}
+
+func (x *SystemDBVerifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBVerifyReq.ProtoReflect.Descriptor instead.
+func (*SystemDBVerifyReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SystemDBVerifyReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemDBInconsistency describes an inconsistency found in the system database.
+type SystemDBInconsistency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check       string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"` // name of the check that found the inconsistency
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *SystemDBInconsistency) Reset() {
+	*x = SystemDBInconsistency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBInconsistency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBInconsistency) ProtoMessage() {}
+
+func (x *SystemDBInconsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBInconsistency.ProtoReflect.Descriptor instead.
+func (*SystemDBInconsistency) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SystemDBInconsistency) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *SystemDBInconsistency) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// SystemDBVerifyResp lists the inconsistencies found in the system database.
+type SystemDBVerifyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version         uint64                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // system database version that was checked
+	Inconsistencies []*SystemDBInconsistency `protobuf:"bytes,2,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+}
+
+func (x *SystemDBVerifyResp) Reset() {
+	*x = SystemDBVerifyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBVerifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBVerifyResp) ProtoMessage() {}
+
+func (x *SystemDBVerifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBVerifyResp.ProtoReflect.Descriptor instead.
+func (*SystemDBVerifyResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SystemDBVerifyResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SystemDBVerifyResp) GetInconsistencies() []*SystemDBInconsistency {
+	if x != nil {
+		return x.Inconsistencies
+	}
+	return nil
+}
+
+// SystemDBReplicaReq requests the local copy of the system database held by
+// a management service replica.
+type SystemDBReplicaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+}
+
+func (x *SystemDBReplicaReq) Reset() {
+	*x = SystemDBReplicaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBReplicaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBReplicaReq) ProtoMessage() {}
+
+func (x *SystemDBReplicaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBReplicaReq.ProtoReflect.Descriptor instead.
+func (*SystemDBReplicaReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SystemDBReplicaReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemDBReplicaResp contains a chunk of the local copy of the system database
+// held by a management service replica. The version and size are only set in
+// the first message of the stream.
+type SystemDBReplicaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // system database version
+	Data    []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`        // chunk of the system database in snapshot format
+	Size    uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // total size of the system database data in bytes
+}
+
+func (x *SystemDBReplicaResp) Reset() {
+	*x = SystemDBReplicaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBReplicaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBReplicaResp) ProtoMessage() {}
+
+func (x *SystemDBReplicaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBReplicaResp.ProtoReflect.Descriptor instead.
+func (*SystemDBReplicaResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SystemDBReplicaResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SystemDBReplicaResp) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SystemDBReplicaResp) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// SystemDBDiffReq requests a comparison of the copies of the system database
+// held by two management service replicas.
+type SystemDBDiffReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`           // DAOS system identifier
+	Replicas []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"` // control addresses of one or two replicas, the leader is used if one is supplied
+}
+
+func (x *SystemDBDiffReq) Reset() {
+	*x = SystemDBDiffReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBDiffReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBDiffReq) ProtoMessage() {}
+
+func (x *SystemDBDiffReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBDiffReq.ProtoReflect.Descriptor instead.
+func (*SystemDBDiffReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SystemDBDiffReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemDBDiffReq) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+// SystemDBDiffResp describes how the copy of the system database held by the
+// second replica differs from that held by the first.
+type SystemDBDiffResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	First           string   `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`                                                     // control address of the first replica
+	FirstVersion    uint64   `protobuf:"varint,2,opt,name=first_version,json=firstVersion,proto3" json:"first_version,omitempty"`                  // system database version on the first replica
+	Second          string   `protobuf:"bytes,3,opt,name=second,proto3" json:"second,omitempty"`                                                   // control address of the second replica
+	SecondVersion   uint64   `protobuf:"varint,4,opt,name=second_version,json=secondVersion,proto3" json:"second_version,omitempty"`               // system database version on the second replica
+	MissingRanks    string   `protobuf:"bytes,5,opt,name=missing_ranks,json=missingRanks,proto3" json:"missing_ranks,omitempty"`                   // ranks on the first replica but not the second
+	ExtraRanks      string   `protobuf:"bytes,6,opt,name=extra_ranks,json=extraRanks,proto3" json:"extra_ranks,omitempty"`                         // ranks on the second replica but not the first
+	ChangedRanks    string   `protobuf:"bytes,7,opt,name=changed_ranks,json=changedRanks,proto3" json:"changed_ranks,omitempty"`                   // ranks with a different UUID or address on the second replica
+	MissingPools    []string `protobuf:"bytes,8,rep,name=missing_pools,json=missingPools,proto3" json:"missing_pools,omitempty"`                   // pools on the first replica but not the second
+	ExtraPools      []string `protobuf:"bytes,9,rep,name=extra_pools,json=extraPools,proto3" json:"extra_pools,omitempty"`                         // pools on the second replica but not the first
+	ChangedPools    []string `protobuf:"bytes,10,rep,name=changed_pools,json=changedPools,proto3" json:"changed_pools,omitempty"`                  // pools with different service replicas on the second replica
+	MissingFindings []uint64 `protobuf:"varint,11,rep,packed,name=missing_findings,json=missingFindings,proto3" json:"missing_findings,omitempty"` // checker findings on the first replica but not the second
+	ExtraFindings   []uint64 `protobuf:"varint,12,rep,packed,name=extra_findings,json=extraFindings,proto3" json:"extra_findings,omitempty"`       // checker findings on the second replica but not the first
+}
+
+func (x *SystemDBDiffResp) Reset() {
+	*x = SystemDBDiffResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDBDiffResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDBDiffResp) ProtoMessage() {}
+
+func (x *SystemDBDiffResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDBDiffResp.ProtoReflect.Descriptor instead.
+func (*SystemDBDiffResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SystemDBDiffResp) GetFirst() string {
+	if x != nil {
+		return x.First
+	}
+	return ""
+}
+
+func (x *SystemDBDiffResp) GetFirstVersion() uint64 {
+	if x != nil {
+		return x.FirstVersion
+	}
+	return 0
+}
+
+func (x *SystemDBDiffResp) GetSecond() string {
+	if x != nil {
+		return x.Second
+	}
+	return ""
+}
+
+func (x *SystemDBDiffResp) GetSecondVersion() uint64 {
+	if x != nil {
+		return x.SecondVersion
+	}
+	return 0
+}
+
+func (x *SystemDBDiffResp) GetMissingRanks() string {
+	if x != nil {
+		return x.MissingRanks
+	}
+	return ""
+}
+
+func (x *SystemDBDiffResp) GetExtraRanks() string {
+	if x != nil {
+		return x.ExtraRanks
+	}
+	return ""
+}
+
+func (x *SystemDBDiffResp) GetChangedRanks() string {
+	if x != nil {
+		return x.ChangedRanks
+	}
+	return ""
+}
+
+func (x *SystemDBDiffResp) GetMissingPools() []string {
+	if x != nil {
+		return x.MissingPools
+	}
+	return nil
+}
+
+func (x *SystemDBDiffResp) GetExtraPools() []string {
+	if x != nil {
+		return x.ExtraPools
+	}
+	return nil
+}
+
+func (x *SystemDBDiffResp) GetChangedPools() []string {
+	if x != nil {
+		return x.ChangedPools
+	}
+	return nil
+}
+
+func (x *SystemDBDiffResp) GetMissingFindings() []uint64 {
+	if x != nil {
+		return x.MissingFindings
+	}
+	return nil
+}
+
+func (x *SystemDBDiffResp) GetExtraFindings() []uint64 {
+	if x != nil {
+		return x.ExtraFindings
+	}
+	return nil
+}
+
+// SystemQuota describes the limits on the pools owned by a user or group ACL
+// principal, along with the resources currently consumed by those pools.
+type SystemQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User          string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`                                                  // user ACL principal the quota applies to, e.g. "bob@"
+	Group         string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`                                                // group ACL principal the quota applies to, e.g. "builders@"
+	MaxPools      uint32   `protobuf:"varint,3,opt,name=max_pools,json=maxPools,proto3" json:"max_pools,omitempty"`                         // maximum number of pools, 0 for unlimited
+	TierBytes     []uint64 `protobuf:"varint,4,rep,packed,name=tier_bytes,json=tierBytes,proto3" json:"tier_bytes,omitempty"`               // maximum storage per tier across all pools, 0 for unlimited
+	UsedPools     uint32   `protobuf:"varint,5,opt,name=used_pools,json=usedPools,proto3" json:"used_pools,omitempty"`                      // number of pools counted against the quota
+	UsedTierBytes []uint64 `protobuf:"varint,6,rep,packed,name=used_tier_bytes,json=usedTierBytes,proto3" json:"used_tier_bytes,omitempty"` // storage per tier counted against the quota
+}
+
+func (x *SystemQuota) Reset() {
+	*x = SystemQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemQuota) ProtoMessage() {}
+
+func (x *SystemQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemQuota.ProtoReflect.Descriptor instead.
+func (*SystemQuota) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SystemQuota) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SystemQuota) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SystemQuota) GetMaxPools() uint32 {
+	if x != nil {
+		return x.MaxPools
+	}
+	return 0
+}
+
+func (x *SystemQuota) GetTierBytes() []uint64 {
+	if x != nil {
+		return x.TierBytes
+	}
+	return nil
+}
+
+func (x *SystemQuota) GetUsedPools() uint32 {
+	if x != nil {
+		return x.UsedPools
+	}
+	return 0
+}
+
+func (x *SystemQuota) GetUsedTierBytes() []uint64 {
+	if x != nil {
+		return x.UsedTierBytes
+	}
+	return nil
+}
+
+// SystemSetQuotaReq sets the quota for a user or group ACL principal. If no
+// limits are set, any existing quota for the principal is removed.
+type SystemSetQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                      // DAOS system identifier
+	User      string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`                                    // user ACL principal, mutually exclusive with group
+	Group     string   `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`                                  // group ACL principal, mutually exclusive with user
+	MaxPools  uint32   `protobuf:"varint,4,opt,name=max_pools,json=maxPools,proto3" json:"max_pools,omitempty"`           // maximum number of pools, 0 for unlimited
+	TierBytes []uint64 `protobuf:"varint,5,rep,packed,name=tier_bytes,json=tierBytes,proto3" json:"tier_bytes,omitempty"` // maximum storage per tier across all pools, 0 for unlimited
+}
+
+func (x *SystemSetQuotaReq) Reset() {
+	*x = SystemSetQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetQuotaReq) ProtoMessage() {}
+
+func (x *SystemSetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetQuotaReq.ProtoReflect.Descriptor instead.
+func (*SystemSetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SystemSetQuotaReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemSetQuotaReq) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SystemSetQuotaReq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SystemSetQuotaReq) GetMaxPools() uint32 {
+	if x != nil {
+		return x.MaxPools
+	}
+	return 0
+}
+
+func (x *SystemSetQuotaReq) GetTierBytes() []uint64 {
+	if x != nil {
+		return x.TierBytes
+	}
+	return nil
+}
+
+// SystemGetQuotaReq requests the quotas for one or more users or groups. If no
+// principals are supplied, all quotas are returned.
+type SystemGetQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys    string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`       // DAOS system identifier
+	Users  []string `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`   // user ACL principals
+	Groups []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"` // group ACL principals
+}
+
+func (x *SystemGetQuotaReq) Reset() {
+	*x = SystemGetQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetQuotaReq) ProtoMessage() {}
+
+func (x *SystemGetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetQuotaReq.ProtoReflect.Descriptor instead.
+func (*SystemGetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SystemGetQuotaReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemGetQuotaReq) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SystemGetQuotaReq) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// SystemGetQuotaResp contains the requested quotas.
+type SystemGetQuotaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quotas []*SystemQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+}
+
+func (x *SystemGetQuotaResp) Reset() {
+	*x = SystemGetQuotaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetQuotaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetQuotaResp) ProtoMessage() {}
+
+func (x *SystemGetQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetQuotaResp.ProtoReflect.Descriptor instead.
+func (*SystemGetQuotaResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SystemGetQuotaResp) GetQuotas() []*SystemQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// PoolOp describes a rebuild-triggering pool operation queued by the MS.
+type PoolOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                        // operation UUID
+	PoolUuid  string   `protobuf:"bytes,2,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"`            // UUID of the pool the operation applies to
+	Type      string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                    // exclude, drain, reintegrate or extend
+	State     string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                                  // queued, running, completed, failed or canceled
+	Ranks     []uint32 `protobuf:"varint,5,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`                          // ranks affected by the operation
+	TargetIdx []uint32 `protobuf:"varint,6,rep,packed,name=target_idx,json=targetIdx,proto3" json:"target_idx,omitempty"` // targets affected on the rank, all if empty
+	NotBefore int64    `protobuf:"varint,7,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`        // operation will not start before this time (unix seconds), 0 if unset
+	NotAfter  int64    `protobuf:"varint,8,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`           // operation will not start after this time (unix seconds), 0 if unset
+	Created   int64    `protobuf:"varint,9,opt,name=created,proto3" json:"created,omitempty"`                             // time the operation was queued (unix seconds)
+	Started   int64    `protobuf:"varint,10,opt,name=started,proto3" json:"started,omitempty"`                            // time the operation was started (unix seconds), 0 if not started
+	Finished  int64    `protobuf:"varint,11,opt,name=finished,proto3" json:"finished,omitempty"`                          // time the operation finished (unix seconds), 0 if not finished
+	Error     string   `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`                                 // reason the operation failed
+}
+
+func (x *PoolOp) Reset() {
+	*x = PoolOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolOp) ProtoMessage() {}
+
+func (x *PoolOp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolOp.ProtoReflect.Descriptor instead.
+func (*PoolOp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PoolOp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolOp) GetPoolUuid() string {
+	if x != nil {
+		return x.PoolUuid
+	}
+	return ""
+}
+
+func (x *PoolOp) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PoolOp) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PoolOp) GetRanks() []uint32 {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+func (x *PoolOp) GetTargetIdx() []uint32 {
+	if x != nil {
+		return x.TargetIdx
+	}
+	return nil
+}
+
+func (x *PoolOp) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *PoolOp) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+func (x *PoolOp) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *PoolOp) GetStarted() int64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *PoolOp) GetFinished() int64 {
+	if x != nil {
+		return x.Finished
+	}
+	return 0
+}
+
+func (x *PoolOp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PoolOpScheduleReq queues a rebuild-triggering pool operation to be run
+// within a time window.
+type PoolOpScheduleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id  string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // uuid or label of the pool
+	// Types that are assignable to Op:
+	//	*PoolOpScheduleReq_Exclude
+	//	*PoolOpScheduleReq_Drain
+	//	*PoolOpScheduleReq_Reintegrate
+	//	*PoolOpScheduleReq_Extend
+	Op        isPoolOpScheduleReq_Op `protobuf_oneof:"op"`
+	NotBefore int64                  `protobuf:"varint,7,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"` // operation will not start before this time (unix seconds), 0 if unset
+	NotAfter  int64                  `protobuf:"varint,8,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`    // operation will not start after this time (unix seconds), 0 if unset
+}
+
+func (x *PoolOpScheduleReq) Reset() {
+	*x = PoolOpScheduleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolOpScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolOpScheduleReq) ProtoMessage() {}
+
+func (x *PoolOpScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolOpScheduleReq.ProtoReflect.Descriptor instead.
+func (*PoolOpScheduleReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PoolOpScheduleReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolOpScheduleReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (m *PoolOpScheduleReq) GetOp() isPoolOpScheduleReq_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *PoolOpScheduleReq) GetExclude() *PoolExcludeReq {
+	if x, ok := x.GetOp().(*PoolOpScheduleReq_Exclude); ok {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *PoolOpScheduleReq) GetDrain() *PoolDrainReq {
+	if x, ok := x.GetOp().(*PoolOpScheduleReq_Drain); ok {
+		return x.Drain
+	}
+	return nil
+}
+
+func (x *PoolOpScheduleReq) GetReintegrate() *PoolReintegrateReq {
+	if x, ok := x.GetOp().(*PoolOpScheduleReq_Reintegrate); ok {
+		return x.Reintegrate
+	}
+	return nil
+}
+
+func (x *PoolOpScheduleReq) GetExtend() *PoolExtendReq {
+	if x, ok := x.GetOp().(*PoolOpScheduleReq_Extend); ok {
+		return x.Extend
+	}
+	return nil
+}
+
+func (x *PoolOpScheduleReq) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *PoolOpScheduleReq) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+type isPoolOpScheduleReq_Op interface {
+	isPoolOpScheduleReq_Op()
+}
+
+type PoolOpScheduleReq_Exclude struct {
+	Exclude *PoolExcludeReq `protobuf:"bytes,3,opt,name=exclude,proto3,oneof"`
+}
+
+type PoolOpScheduleReq_Drain struct {
+	Drain *PoolDrainReq `protobuf:"bytes,4,opt,name=drain,proto3,oneof"`
+}
+
+type PoolOpScheduleReq_Reintegrate struct {
+	Reintegrate *PoolReintegrateReq `protobuf:"bytes,5,opt,name=reintegrate,proto3,oneof"`
+}
+
+type PoolOpScheduleReq_Extend struct {
+	Extend *PoolExtendReq `protobuf:"bytes,6,opt,name=extend,proto3,oneof"`
+}
+
+func (*PoolOpScheduleReq_Exclude) isPoolOpScheduleReq_Op() {}
+
+func (*PoolOpScheduleReq_Drain) isPoolOpScheduleReq_Op() {}
+
+func (*PoolOpScheduleReq_Reintegrate) isPoolOpScheduleReq_Op() {}
+
+func (*PoolOpScheduleReq_Extend) isPoolOpScheduleReq_Op() {}
+
+// PoolOpScheduleResp returns the ID of the queued operation.
+type PoolOpScheduleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpId string `protobuf:"bytes,1,opt,name=op_id,json=opId,proto3" json:"op_id,omitempty"`
+}
+
+func (x *PoolOpScheduleResp) Reset() {
+	*x = PoolOpScheduleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolOpScheduleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolOpScheduleResp) ProtoMessage() {}
+
+func (x *PoolOpScheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolOpScheduleResp.ProtoReflect.Descriptor instead.
+func (*PoolOpScheduleResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PoolOpScheduleResp) GetOpId() string {
+	if x != nil {
+		return x.OpId
+	}
+	return ""
+}
+
+// PoolOpListReq requests the operations queued for a pool.
+type PoolOpListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id  string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // uuid or label of the pool, all pools if empty
+}
+
+func (x *PoolOpListReq) Reset() {
+	*x = PoolOpListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolOpListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolOpListReq) ProtoMessage() {}
+
+func (x *PoolOpListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolOpListReq.ProtoReflect.Descriptor instead.
+func (*PoolOpListReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PoolOpListReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolOpListReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PoolOpListResp contains the requested pool operations, oldest first.
+type PoolOpListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ops []*PoolOp `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
+}
+
+func (x *PoolOpListResp) Reset() {
+	*x = PoolOpListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolOpListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolOpListResp) ProtoMessage() {}
+
+func (x *PoolOpListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolOpListResp.ProtoReflect.Descriptor instead.
+func (*PoolOpListResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PoolOpListResp) GetOps() []*PoolOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+// PoolOpCancelReq cancels a queued pool operation.
+type PoolOpCancelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys  string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`               // DAOS system identifier
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                 // uuid or label of the pool
+	OpId string `protobuf:"bytes,3,opt,name=op_id,json=opId,proto3" json:"op_id,omitempty"` // UUID of the operation to cancel
+}
+
+func (x *PoolOpCancelReq) Reset() {
+	*x = PoolOpCancelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolOpCancelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolOpCancelReq) ProtoMessage() {}
+
+func (x *PoolOpCancelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolOpCancelReq.ProtoReflect.Descriptor instead.
+func (*PoolOpCancelReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PoolOpCancelReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolOpCancelReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolOpCancelReq) GetOpId() string {
+	if x != nil {
+		return x.OpId
+	}
+	return ""
+}
+
+// SystemGetAccessReq requests the access granted to the caller.
+type SystemGetAccessReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+}
+
+func (x *SystemGetAccessReq) Reset() {
+	*x = SystemGetAccessReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetAccessReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetAccessReq) ProtoMessage() {}
+
+func (x *SystemGetAccessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetAccessReq.ProtoReflect.Descriptor instead.
+func (*SystemGetAccessReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SystemGetAccessReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemGetAccessResp describes the access granted to the caller by the
+// management service leader.
+type SystemGetAccessResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string   `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // component identified by the caller's certificate
+	Role      string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`           // role granted to the caller
+	Subject   string   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`     // subject of the caller's certificate
+	Sans      []string `protobuf:"bytes,4,rep,name=sans,proto3" json:"sans,omitempty"`           // subject alternative names of the caller's certificate
+	Insecure  bool     `protobuf:"varint,5,opt,name=insecure,proto3" json:"insecure,omitempty"`  // transport security is disabled, so access is not checked
+}
+
+func (x *SystemGetAccessResp) Reset() {
+	*x = SystemGetAccessResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetAccessResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetAccessResp) ProtoMessage() {}
+
+func (x *SystemGetAccessResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetAccessResp.ProtoReflect.Descriptor instead.
+func (*SystemGetAccessResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SystemGetAccessResp) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *SystemGetAccessResp) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SystemGetAccessResp) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SystemGetAccessResp) GetSans() []string {
+	if x != nil {
+		return x.Sans
+	}
+	return nil
+}
+
+func (x *SystemGetAccessResp) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+// SystemGetAuditReq contains the filters for an audit log query.
+type SystemGetAuditReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys       string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`             // DAOS system identifier
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"` // operation name, e.g. PoolCreate
+	Subject   string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`     // substring of the caller's certificate subject
+	Since     string `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`         // earliest record timestamp
+	Until     string `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`         // latest record timestamp
+	Limit     uint32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`        // maximum number of (most recent) records to return
+}
+
+func (x *SystemGetAuditReq) Reset() {
+	*x = SystemGetAuditReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetAuditReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetAuditReq) ProtoMessage() {}
+
+func (x *SystemGetAuditReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetAuditReq.ProtoReflect.Descriptor instead.
+func (*SystemGetAuditReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SystemGetAuditReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemGetAuditReq) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *SystemGetAuditReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SystemGetAuditReq) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *SystemGetAuditReq) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SystemGetAuditReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// AuditRecord describes a mutating control-plane operation.
+type AuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`              // sequence number of the record
+	Time      string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`           // time at which the operation completed
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"` // operation name, e.g. PoolCreate
+	Component string `protobuf:"bytes,4,opt,name=component,proto3" json:"component,omitempty"` // component identified by the caller's certificate
+	Role      string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`           // role granted to the caller
+	Subject   string `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`     // subject of the caller's certificate
+	Address   string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`     // caller's network address
+	Request   string `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`     // request parameters as JSON
+	Error     string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`         // error returned by the operation, empty on success
+	Denied    bool   `protobuf:"varint,10,opt,name=denied,proto3" json:"denied,omitempty"`     // true if the call was denied by access control
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AuditRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditRecord) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetDenied() bool {
+	if x != nil {
+		return x.Denied
+	}
+	return false
+}
+
+// SystemGetAuditResp contains matching audit records in the order in which
+// they were recorded.
+type SystemGetAuditResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Dropped uint64         `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"` // number of older records discarded due to the retention limit
+}
+
+func (x *SystemGetAuditResp) Reset() {
+	*x = SystemGetAuditResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemGetAuditResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemGetAuditResp) ProtoMessage() {}
+
+func (x *SystemGetAuditResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemGetAuditResp.ProtoReflect.Descriptor instead.
+func (*SystemGetAuditResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SystemGetAuditResp) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *SystemGetAuditResp) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// SystemAddAuditReq contains a record created by a server other than the MS
+// leader, to be added to the audit log.
+type SystemAddAuditReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys    string       `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Record *AuditRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *SystemAddAuditReq) Reset() {
+	*x = SystemAddAuditReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemAddAuditReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAddAuditReq) ProtoMessage() {}
+
+func (x *SystemAddAuditReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAddAuditReq.ProtoReflect.Descriptor instead.
+func (*SystemAddAuditReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SystemAddAuditReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemAddAuditReq) GetRecord() *AuditRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type SystemCleanupResp_CleanupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`              // Status of the evict on the specific pool
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                     // Error message if status indicates an error
+	PoolId string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // uuid of pool
+	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                // number of pool handles cleaned up
+}
+
+func (x *SystemCleanupResp_CleanupResult) Reset() {
+	*x = SystemCleanupResp_CleanupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemCleanupResp_CleanupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCleanupResp_CleanupResult) ProtoMessage() {}
+
+func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCleanupResp_CleanupResult.ProtoReflect.Descriptor instead.
+func (*SystemCleanupResp_CleanupResult) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_mgmt_system_proto protoreflect.FileDescriptor
+
+var file_mgmt_system_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd6, 0x02, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x62, 0x72, 0x69,
+	0x63, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x62,
+	0x72, 0x69, 0x63, 0x55, 0x72, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72, 0x69, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0d,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70,
+	0x72, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x5a,
+	0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x72,
+	0x65, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x48,
+	0x00, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x6d,
+	0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x83, 0x01,
+	0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x41, 0x0a, 0x11, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6d,
+	0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xc4, 0x01,
+	0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x1a, 0x68, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38,
+	0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x3f, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x52, 0x41, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x22, 0x71, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x22, 0x5a, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73,
+	0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22,
+	0x2b, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x49, 0x0a, 0x18,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x44, 0x42, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73,
+	0x22, 0x68, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65,
+	0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7a, 0x0a, 0x12, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xbc, 0x03, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x44, 0x42, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x47, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
+	0x4b, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x25, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x12, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0f, 0x69,
+	0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x44, 0x42, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x22, 0x26, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x57, 0x0a, 0x13, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x42, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x22, 0xb4, 0x03, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
+	0x42, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0b,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x54,
+	0x69, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x3f, 0x0a, 0x12, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x29, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0xb6, 0x02, 0x0a,
+	0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x78, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc2, 0x02, 0x0a, 0x11, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x70,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a,
+	0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12,
+	0x2a, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x48, 0x00, 0x52, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x72,
+	0x65, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x48, 0x00,
+	0x52, 0x06, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x6f,
+	0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22, 0x29, 0x0a, 0x12, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4f, 0x70, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x13, 0x0a, 0x05, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6f, 0x70, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x4f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x03, 0x6f, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4f, 0x70, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x13, 0x0a, 0x05, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6f, 0x70, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x91, 0x01, 0x0a,
+	0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x61, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x22, 0x9f, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x69,
+	0x65, 0x64, 0x22, 0x5b, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22,
+	0x50, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f,
+	0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1491,50 +4714,111 @@ func file_mgmt_system_proto_rawDescGZIP() []byte {
 	return file_mgmt_system_proto_rawDescData
 }
 
-var file_mgmt_system_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_mgmt_system_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_mgmt_system_proto_goTypes = []interface{}{
 	(*SystemMember)(nil),                    // 0: mgmt.SystemMember
 	(*SystemStopReq)(nil),                   // 1: mgmt.SystemStopReq
 	(*SystemStopResp)(nil),                  // 2: mgmt.SystemStopResp
-	(*SystemStartReq)(nil),                  // 3: mgmt.SystemStartReq
-	(*SystemStartResp)(nil),                 // 4: mgmt.SystemStartResp
-	(*SystemExcludeReq)(nil),                // 5: mgmt.SystemExcludeReq
-	(*SystemExcludeResp)(nil),               // 6: mgmt.SystemExcludeResp
-	(*SystemQueryReq)(nil),                  // 7: mgmt.SystemQueryReq
-	(*SystemQueryResp)(nil),                 // 8: mgmt.SystemQueryResp
-	(*SystemEraseReq)(nil),                  // 9: mgmt.SystemEraseReq
-	(*SystemEraseResp)(nil),                 // 10: mgmt.SystemEraseResp
-	(*SystemCleanupReq)(nil),                // 11: mgmt.SystemCleanupReq
-	(*SystemCleanupResp)(nil),               // 12: mgmt.SystemCleanupResp
-	(*SystemSetAttrReq)(nil),                // 13: mgmt.SystemSetAttrReq
-	(*SystemGetAttrReq)(nil),                // 14: mgmt.SystemGetAttrReq
-	(*SystemGetAttrResp)(nil),               // 15: mgmt.SystemGetAttrResp
-	(*SystemSetPropReq)(nil),                // 16: mgmt.SystemSetPropReq
-	(*SystemGetPropReq)(nil),                // 17: mgmt.SystemGetPropReq
-	(*SystemGetPropResp)(nil),               // 18: mgmt.SystemGetPropResp
-	(*SystemCleanupResp_CleanupResult)(nil), // 19: mgmt.SystemCleanupResp.CleanupResult
-	nil,                                     // 20: mgmt.SystemSetAttrReq.AttributesEntry
-	nil,                                     // 21: mgmt.SystemGetAttrResp.AttributesEntry
-	nil,                                     // 22: mgmt.SystemSetPropReq.PropertiesEntry
-	nil,                                     // 23: mgmt.SystemGetPropResp.PropertiesEntry
-	(*shared.RankResult)(nil),               // 24: shared.RankResult
+	(*SystemStopProgress)(nil),              // 3: mgmt.SystemStopProgress
+	(*SystemStopStreamResp)(nil),            // 4: mgmt.SystemStopStreamResp
+	(*SystemStartReq)(nil),                  // 5: mgmt.SystemStartReq
+	(*SystemStartResp)(nil),                 // 6: mgmt.SystemStartResp
+	(*SystemExcludeReq)(nil),                // 7: mgmt.SystemExcludeReq
+	(*SystemExcludeResp)(nil),               // 8: mgmt.SystemExcludeResp
+	(*SystemQueryReq)(nil),                  // 9: mgmt.SystemQueryReq
+	(*SystemQueryResp)(nil),                 // 10: mgmt.SystemQueryResp
+	(*SystemEraseReq)(nil),                  // 11: mgmt.SystemEraseReq
+	(*SystemEraseResp)(nil),                 // 12: mgmt.SystemEraseResp
+	(*SystemCleanupReq)(nil),                // 13: mgmt.SystemCleanupReq
+	(*SystemCleanupResp)(nil),               // 14: mgmt.SystemCleanupResp
+	(*SystemSetAttrReq)(nil),                // 15: mgmt.SystemSetAttrReq
+	(*SystemGetAttrReq)(nil),                // 16: mgmt.SystemGetAttrReq
+	(*SystemGetAttrResp)(nil),               // 17: mgmt.SystemGetAttrResp
+	(*SystemSetPropReq)(nil),                // 18: mgmt.SystemSetPropReq
+	(*SystemGetPropReq)(nil),                // 19: mgmt.SystemGetPropReq
+	(*SystemGetPropResp)(nil),               // 20: mgmt.SystemGetPropResp
+	(*SystemGetEventsReq)(nil),              // 21: mgmt.SystemGetEventsReq
+	(*SystemGetEventsResp)(nil),             // 22: mgmt.SystemGetEventsResp
+	(*SystemStreamEventsReq)(nil),           // 23: mgmt.SystemStreamEventsReq
+	(*EventPolicy)(nil),                     // 24: mgmt.EventPolicy
+	(*SystemSetEventPolicyReq)(nil),         // 25: mgmt.SystemSetEventPolicyReq
+	(*SystemGetEventPolicyReq)(nil),         // 26: mgmt.SystemGetEventPolicyReq
+	(*SystemGetEventPolicyResp)(nil),        // 27: mgmt.SystemGetEventPolicyResp
+	(*SystemDBSnapshotReq)(nil),             // 28: mgmt.SystemDBSnapshotReq
+	(*SystemDBSnapshotResp)(nil),            // 29: mgmt.SystemDBSnapshotResp
+	(*SystemDBRestoreReq)(nil),              // 30: mgmt.SystemDBRestoreReq
+	(*SystemDBRestoreResp)(nil),             // 31: mgmt.SystemDBRestoreResp
+	(*SystemReplicaReq)(nil),                // 32: mgmt.SystemReplicaReq
+	(*SystemLeaderTransferReq)(nil),         // 33: mgmt.SystemLeaderTransferReq
+	(*SystemReplicaResp)(nil),               // 34: mgmt.SystemReplicaResp
+	(*SetAccessPointsReq)(nil),              // 35: mgmt.SetAccessPointsReq
+	(*SetAccessPointsResp)(nil),             // 36: mgmt.SetAccessPointsResp
+	(*SystemDBVerifyReq)(nil),               // 37: mgmt.SystemDBVerifyReq
+	(*SystemDBInconsistency)(nil),           // 38: mgmt.SystemDBInconsistency
+	(*SystemDBVerifyResp)(nil),              // 39: mgmt.SystemDBVerifyResp
+	(*SystemDBReplicaReq)(nil),              // 40: mgmt.SystemDBReplicaReq
+	(*SystemDBReplicaResp)(nil),             // 41: mgmt.SystemDBReplicaResp
+	(*SystemDBDiffReq)(nil),                 // 42: mgmt.SystemDBDiffReq
+	(*SystemDBDiffResp)(nil),                // 43: mgmt.SystemDBDiffResp
+	(*SystemQuota)(nil),                     // 44: mgmt.SystemQuota
+	(*SystemSetQuotaReq)(nil),               // 45: mgmt.SystemSetQuotaReq
+	(*SystemGetQuotaReq)(nil),               // 46: mgmt.SystemGetQuotaReq
+	(*SystemGetQuotaResp)(nil),              // 47: mgmt.SystemGetQuotaResp
+	(*PoolOp)(nil),                          // 48: mgmt.PoolOp
+	(*PoolOpScheduleReq)(nil),               // 49: mgmt.PoolOpScheduleReq
+	(*PoolOpScheduleResp)(nil),              // 50: mgmt.PoolOpScheduleResp
+	(*PoolOpListReq)(nil),                   // 51: mgmt.PoolOpListReq
+	(*PoolOpListResp)(nil),                  // 52: mgmt.PoolOpListResp
+	(*PoolOpCancelReq)(nil),                 // 53: mgmt.PoolOpCancelReq
+	(*SystemGetAccessReq)(nil),              // 54: mgmt.SystemGetAccessReq
+	(*SystemGetAccessResp)(nil),             // 55: mgmt.SystemGetAccessResp
+	(*SystemGetAuditReq)(nil),               // 56: mgmt.SystemGetAuditReq
+	(*AuditRecord)(nil),                     // 57: mgmt.AuditRecord
+	(*SystemGetAuditResp)(nil),              // 58: mgmt.SystemGetAuditResp
+	(*SystemAddAuditReq)(nil),               // 59: mgmt.SystemAddAuditReq
+	(*SystemCleanupResp_CleanupResult)(nil), // 60: mgmt.SystemCleanupResp.CleanupResult
+	nil,                                     // 61: mgmt.SystemSetAttrReq.AttributesEntry
+	nil,                                     // 62: mgmt.SystemGetAttrResp.AttributesEntry
+	nil,                                     // 63: mgmt.SystemSetPropReq.PropertiesEntry
+	nil,                                     // 64: mgmt.SystemGetPropResp.PropertiesEntry
+	(*shared.RankResult)(nil),               // 65: shared.RankResult
+	(*shared.RASEvent)(nil),                 // 66: shared.RASEvent
+	(*PoolExcludeReq)(nil),                  // 67: mgmt.PoolExcludeReq
+	(*PoolDrainReq)(nil),                    // 68: mgmt.PoolDrainReq
+	(*PoolReintegrateReq)(nil),              // 69: mgmt.PoolReintegrateReq
+	(*PoolExtendReq)(nil),                   // 70: mgmt.PoolExtendReq
 }
 var file_mgmt_system_proto_depIdxs = []int32{
-	24, // 0: mgmt.SystemStopResp.results:type_name -> shared.RankResult
-	24, // 1: mgmt.SystemStartResp.results:type_name -> shared.RankResult
-	24, // 2: mgmt.SystemExcludeResp.results:type_name -> shared.RankResult
-	0,  // 3: mgmt.SystemQueryResp.members:type_name -> mgmt.SystemMember
-	24, // 4: mgmt.SystemEraseResp.results:type_name -> shared.RankResult
-	19, // 5: mgmt.SystemCleanupResp.results:type_name -> mgmt.SystemCleanupResp.CleanupResult
-	20, // 6: mgmt.SystemSetAttrReq.attributes:type_name -> mgmt.SystemSetAttrReq.AttributesEntry
-	21, // 7: mgmt.SystemGetAttrResp.attributes:type_name -> mgmt.SystemGetAttrResp.AttributesEntry
-	22, // 8: mgmt.SystemSetPropReq.properties:type_name -> mgmt.SystemSetPropReq.PropertiesEntry
-	23, // 9: mgmt.SystemGetPropResp.properties:type_name -> mgmt.SystemGetPropResp.PropertiesEntry
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	65, // 0: mgmt.SystemStopResp.results:type_name -> shared.RankResult
+	65, // 1: mgmt.SystemStopProgress.results:type_name -> shared.RankResult
+	3,  // 2: mgmt.SystemStopStreamResp.progress:type_name -> mgmt.SystemStopProgress
+	2,  // 3: mgmt.SystemStopStreamResp.resp:type_name -> mgmt.SystemStopResp
+	65, // 4: mgmt.SystemStartResp.results:type_name -> shared.RankResult
+	65, // 5: mgmt.SystemExcludeResp.results:type_name -> shared.RankResult
+	0,  // 6: mgmt.SystemQueryResp.members:type_name -> mgmt.SystemMember
+	65, // 7: mgmt.SystemEraseResp.results:type_name -> shared.RankResult
+	60, // 8: mgmt.SystemCleanupResp.results:type_name -> mgmt.SystemCleanupResp.CleanupResult
+	61, // 9: mgmt.SystemSetAttrReq.attributes:type_name -> mgmt.SystemSetAttrReq.AttributesEntry
+	62, // 10: mgmt.SystemGetAttrResp.attributes:type_name -> mgmt.SystemGetAttrResp.AttributesEntry
+	63, // 11: mgmt.SystemSetPropReq.properties:type_name -> mgmt.SystemSetPropReq.PropertiesEntry
+	64, // 12: mgmt.SystemGetPropResp.properties:type_name -> mgmt.SystemGetPropResp.PropertiesEntry
+	66, // 13: mgmt.SystemGetEventsResp.events:type_name -> shared.RASEvent
+	24, // 14: mgmt.SystemSetEventPolicyReq.policies:type_name -> mgmt.EventPolicy
+	24, // 15: mgmt.SystemGetEventPolicyResp.policies:type_name -> mgmt.EventPolicy
+	38, // 16: mgmt.SystemDBVerifyResp.inconsistencies:type_name -> mgmt.SystemDBInconsistency
+	44, // 17: mgmt.SystemGetQuotaResp.quotas:type_name -> mgmt.SystemQuota
+	67, // 18: mgmt.PoolOpScheduleReq.exclude:type_name -> mgmt.PoolExcludeReq
+	68, // 19: mgmt.PoolOpScheduleReq.drain:type_name -> mgmt.PoolDrainReq
+	69, // 20: mgmt.PoolOpScheduleReq.reintegrate:type_name -> mgmt.PoolReintegrateReq
+	70, // 21: mgmt.PoolOpScheduleReq.extend:type_name -> mgmt.PoolExtendReq
+	48, // 22: mgmt.PoolOpListResp.ops:type_name -> mgmt.PoolOp
+	57, // 23: mgmt.SystemGetAuditResp.records:type_name -> mgmt.AuditRecord
+	57, // 24: mgmt.SystemAddAuditReq.record:type_name -> mgmt.AuditRecord
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_mgmt_system_proto_init() }
@@ -1542,6 +4826,7 @@ func file_mgmt_system_proto_init() {
 	if File_mgmt_system_proto != nil {
 		return
 	}
+	file_mgmt_pool_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mgmt_system_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemMember); i {
@@ -1555,8 +4840,248 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStopReq); i {
+		file_mgmt_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStartResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemExcludeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemExcludeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemQueryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemQueryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEraseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEraseResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemCleanupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemCleanupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetAttrReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetAttrReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetAttrResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetPropReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetPropReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetPropResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetEventsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1567,8 +5092,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStopResp); i {
+		file_mgmt_system_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetEventsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,8 +5104,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStartReq); i {
+		file_mgmt_system_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStreamEventsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1591,8 +5116,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStartResp); i {
+		file_mgmt_system_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1603,8 +5128,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemExcludeReq); i {
+		file_mgmt_system_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetEventPolicyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1615,8 +5140,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemExcludeResp); i {
+		file_mgmt_system_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetEventPolicyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1627,8 +5152,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemQueryReq); i {
+		file_mgmt_system_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetEventPolicyResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1639,8 +5164,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemQueryResp); i {
+		file_mgmt_system_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBSnapshotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1651,8 +5176,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemEraseReq); i {
+		file_mgmt_system_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBSnapshotResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1663,8 +5188,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemEraseResp); i {
+		file_mgmt_system_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBRestoreReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1675,8 +5200,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemCleanupReq); i {
+		file_mgmt_system_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBRestoreResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1687,8 +5212,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemCleanupResp); i {
+		file_mgmt_system_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplicaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1699,8 +5224,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemSetAttrReq); i {
+		file_mgmt_system_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemLeaderTransferReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1711,8 +5236,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGetAttrReq); i {
+		file_mgmt_system_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplicaResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1723,8 +5248,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGetAttrResp); i {
+		file_mgmt_system_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccessPointsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1735,8 +5260,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemSetPropReq); i {
+		file_mgmt_system_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccessPointsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1747,8 +5272,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGetPropReq); i {
+		file_mgmt_system_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBVerifyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1759,8 +5284,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemGetPropResp); i {
+		file_mgmt_system_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBInconsistency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1771,7 +5296,259 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBVerifyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBReplicaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBReplicaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBDiffReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemDBDiffResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetQuotaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolOpScheduleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolOpScheduleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolOpListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolOpListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolOpCancelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetAccessReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetAccessResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetAuditReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemGetAuditResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemAddAuditReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupResp_CleanupResult); i {
 			case 0:
 				return &v.state
@@ -1784,13 +5561,23 @@ func file_mgmt_system_proto_init() {
 			}
 		}
 	}
+	file_mgmt_system_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*SystemStopStreamResp_Progress)(nil),
+		(*SystemStopStreamResp_Resp)(nil),
+	}
+	file_mgmt_system_proto_msgTypes[49].OneofWrappers = []interface{}{
+		(*PoolOpScheduleReq_Exclude)(nil),
+		(*PoolOpScheduleReq_Drain)(nil),
+		(*PoolOpScheduleReq_Reintegrate)(nil),
+		(*PoolOpScheduleReq_Extend)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_system_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
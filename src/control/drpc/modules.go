@@ -158,6 +158,7 @@ func (m MgmtMethod) String() string {
 		MethodPoolUpgrade:          "PoolUpgrade",
 		MethodLedManage:            "LedManage",
 		MethodSetupClientTelemetry: "SetupClientTelemetry",
+		MethodPoolSetAttr:          "PoolSetAttr",
 	}[m]; ok {
 		return s
 	}
@@ -257,6 +258,8 @@ const (
 	MethodLedManage MgmtMethod = C.DRPC_METHOD_MGMT_LED_MANAGE
 	// MethodSetupClientTelemetry defines a method to setup client telemetry
 	MethodSetupClientTelemetry MgmtMethod = C.DRPC_METHOD_MGMT_SETUP_CLIENT_TELEM
+	// MethodPoolSetAttr defines a method to set pool user attributes
+	MethodPoolSetAttr MgmtMethod = C.DRPC_METHOD_MGMT_POOL_SET_ATTR
 )
 
 type srvMethod int32
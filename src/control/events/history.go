@@ -0,0 +1,96 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// HistoryFilter specifies the criteria used to select events from an event
+// history. Zero-valued fields match all events.
+type HistoryFilter struct {
+	Ranks    *ranklist.RankSet
+	Severity RASSeverityID // minimum severity (most permissive) to match
+	IDs      []RASID
+	PoolUUID string
+	Since    time.Time
+	Until    time.Time
+	Limit    int // maximum number of most recent matching events
+}
+
+func (hf *HistoryFilter) matches(evt *RASEvent, ranks map[ranklist.Rank]struct{}) bool {
+	if len(ranks) > 0 {
+		if _, found := ranks[ranklist.Rank(evt.Rank)]; !found {
+			return false
+		}
+	}
+	// Lower severity values are more severe, unknown severities only match
+	// an unset filter.
+	if hf.Severity != RASSeverityUnknown &&
+		(evt.Severity == RASSeverityUnknown || evt.Severity > hf.Severity) {
+		return false
+	}
+	if len(hf.IDs) > 0 {
+		found := false
+		for _, id := range hf.IDs {
+			if id == evt.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if hf.PoolUUID != "" && hf.PoolUUID != evt.PoolUUID {
+		return false
+	}
+	if !hf.Since.IsZero() || !hf.Until.IsZero() {
+		ts, err := evt.GetTimestamp()
+		if err != nil {
+			return false
+		}
+		if !hf.Since.IsZero() && ts.Before(hf.Since) {
+			return false
+		}
+		if !hf.Until.IsZero() && ts.After(hf.Until) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Filter returns the events that match the filter, in the order supplied.
+// Events are expected in chronological order so that a limit selects the most
+// recent matching events.
+func (hf *HistoryFilter) Filter(evts []*RASEvent) []*RASEvent {
+	if hf == nil {
+		return evts
+	}
+
+	ranks := make(map[ranklist.Rank]struct{})
+	if hf.Ranks != nil {
+		for _, r := range hf.Ranks.Ranks() {
+			ranks[r] = struct{}{}
+		}
+	}
+
+	out := []*RASEvent{}
+	for _, evt := range evts {
+		if hf.matches(evt, ranks) {
+			out = append(out, evt)
+		}
+	}
+
+	if hf.Limit > 0 && len(out) > hf.Limit {
+		out = out[len(out)-hf.Limit:]
+	}
+	return out
+}
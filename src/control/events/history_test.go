@@ -0,0 +1,196 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func mockHistoryEvt(id RASID, sev RASSeverityID, rank uint32, poolUUID string, ts time.Time) *RASEvent {
+	return fill(&RASEvent{
+		ID:        id,
+		Type:      RASTypeStateChange,
+		Severity:  sev,
+		Msg:       "test event",
+		Hostname:  tHost,
+		Rank:      rank,
+		PoolUUID:  poolUUID,
+		Timestamp: common.FormatTime(ts),
+	})
+}
+
+func evtMsgs(evts []*RASEvent) []string {
+	msgs := make([]string, 0, len(evts))
+	for _, evt := range evts {
+		msgs = append(msgs, evt.String())
+	}
+	return msgs
+}
+
+func TestEvents_HistoryFilter_Filter(t *testing.T) {
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	poolUUID := test.MockUUID(1)
+	ts := func(i int) time.Time {
+		return start.Add(time.Duration(i) * time.Minute)
+	}
+	evts := []*RASEvent{
+		mockHistoryEvt(RASEngineDied, RASSeverityError, 0, "", ts(0)),
+		mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", ts(1)),
+		mockHistoryEvt(RASPoolRepsUpdate, RASSeverityNotice, 1, poolUUID, ts(2)),
+		mockHistoryEvt(RASNVMeLinkSpeedChanged, RASSeverityWarning, 2, "", ts(3)),
+		mockHistoryEvt(RASEngineDied, RASSeverityError, 2, "", ts(4)),
+	}
+
+	for name, tc := range map[string]struct {
+		filter  *HistoryFilter
+		expEvts []*RASEvent
+	}{
+		"nil filter": {
+			expEvts: evts,
+		},
+		"empty filter": {
+			filter:  &HistoryFilter{},
+			expEvts: evts,
+		},
+		"ranks": {
+			filter:  &HistoryFilter{Ranks: ranklist.MustCreateRankSet("0,2")},
+			expEvts: []*RASEvent{evts[0], evts[3], evts[4]},
+		},
+		"severity error": {
+			filter:  &HistoryFilter{Severity: RASSeverityError},
+			expEvts: []*RASEvent{evts[0], evts[4]},
+		},
+		"severity warning": {
+			filter:  &HistoryFilter{Severity: RASSeverityWarning},
+			expEvts: []*RASEvent{evts[0], evts[3], evts[4]},
+		},
+		"ids": {
+			filter:  &HistoryFilter{IDs: []RASID{RASSwimRankDead, RASPoolRepsUpdate}},
+			expEvts: []*RASEvent{evts[1], evts[2]},
+		},
+		"pool uuid": {
+			filter:  &HistoryFilter{PoolUUID: poolUUID},
+			expEvts: []*RASEvent{evts[2]},
+		},
+		"time range": {
+			filter:  &HistoryFilter{Since: ts(1), Until: ts(3)},
+			expEvts: []*RASEvent{evts[1], evts[2], evts[3]},
+		},
+		"limit returns most recent": {
+			filter:  &HistoryFilter{Limit: 2},
+			expEvts: []*RASEvent{evts[3], evts[4]},
+		},
+		"combined": {
+			filter:  &HistoryFilter{Ranks: ranklist.MustCreateRankSet("2"), IDs: []RASID{RASEngineDied}},
+			expEvts: []*RASEvent{evts[4]},
+		},
+		"no matches": {
+			filter: &HistoryFilter{Since: ts(10)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotEvts := tc.filter.Filter(evts)
+
+			if diff := cmp.Diff(evtMsgs(tc.expEvts), evtMsgs(gotEvts)); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestEvents_RASIDFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expID  RASID
+		expErr error
+	}{
+		"engine died": {
+			in:    "engine_died",
+			expID: RASEngineDied,
+		},
+		"last in list": {
+			in:    "device_link_width_changed",
+			expID: RASNVMeLinkWidthChanged,
+		},
+		"unknown": {
+			in:     "foo",
+			expErr: errors.New("unknown RAS event"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			id, err := RASIDFromString(tc.in)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expID, id, "unexpected id")
+		})
+	}
+}
+
+func TestEvents_RASSeverityFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expSev RASSeverityID
+		expErr error
+	}{
+		"error": {
+			in:     "error",
+			expSev: RASSeverityError,
+		},
+		"warning": {
+			in:     "WARNING",
+			expSev: RASSeverityWarning,
+		},
+		"notice": {
+			in:     "Notice",
+			expSev: RASSeverityNotice,
+		},
+		"unknown": {
+			in:     "critical",
+			expErr: errors.New("unknown RAS severity"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sev, err := RASSeverityFromString(tc.in)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expSev, sev, "unexpected severity")
+		})
+	}
+}
+
+func TestEvents_RASTypeFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in      string
+		expType RASTypeID
+		expErr  error
+	}{
+		"state change": {
+			in:      "state_change",
+			expType: RASTypeStateChange,
+		},
+		"info": {
+			in:      "INFO",
+			expType: RASTypeInfoOnly,
+		},
+		"unknown": {
+			in:     "any",
+			expErr: errors.New("unknown RAS event type"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			typ, err := RASTypeFromString(tc.in)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expType, typ, "unexpected type")
+		})
+	}
+}
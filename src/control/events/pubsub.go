@@ -81,6 +81,44 @@ func (de dbncEvts) updateLastSeen(id RASID, key string) {
 	de[id][key] = time.Now()
 }
 
+// debounce returns true if the event duplicates one seen within the cooldown
+// set for its ID in ctrl, and records the event as seen.
+func (de dbncEvts) debounce(ctrl dbncCtrl, event *RASEvent) bool {
+	msg, isControlled := ctrl[event.ID]
+	if !isControlled {
+		return false
+	}
+
+	key := msg.keyFn(event)
+	if lastSeen, found := de[event.ID][key]; found {
+		if msg.cooldown == 0 || time.Since(lastSeen) < msg.cooldown {
+			de.updateLastSeen(event.ID, key)
+			return true
+		}
+	}
+
+	de.updateLastSeen(event.ID, key)
+	return false
+}
+
+// clean removes events that have not been seen for longer than the clean
+// interval plus the cooldown set for their ID in ctrl.
+func (de dbncEvts) clean(ctrl dbncCtrl, interval time.Duration) {
+	for id, events := range de {
+		msg, found := ctrl[id]
+		if !found {
+			delete(de, id)
+			continue
+		}
+
+		for key, lastSeen := range events {
+			if time.Since(lastSeen) > interval+msg.cooldown {
+				delete(events, key)
+			}
+		}
+	}
+}
+
 // PubSub stores subscriptions to event topics and handlers to be called on
 // receipt of events pertaining to a particular topic.
 type PubSub struct {
@@ -184,37 +222,13 @@ func (ps *PubSub) Debounce(id RASID, cooldown time.Duration, keyFn DebounceKeyFn
 	}
 }
 
-func (ps *PubSub) debounceEvent(event *RASEvent) bool {
-	ctrl, isControlled := ps.dbncCtrl[event.ID]
-	if !isControlled {
-		return false
-	}
-
-	key := ctrl.keyFn(event)
-	if lastSeen, found := ps.dbncEvts[event.ID][key]; found {
-		if ctrl.cooldown == 0 || time.Since(lastSeen) < ctrl.cooldown {
-			ps.dbncEvts.updateLastSeen(event.ID, key)
-			return true
-		}
-	}
-
-	ps.dbncEvts.updateLastSeen(event.ID, key)
-	return false
-}
-
-func (ps *PubSub) cleanDebouncedEvents() {
-	for id, events := range ps.dbncEvts {
-		ctrl, found := ps.dbncCtrl[id]
-		if !found {
-			delete(ps.dbncEvts, id)
-			continue
-		}
-
-		for key, lastSeen := range events {
-			if time.Since(lastSeen) > ps.dbncCleanInterval+ctrl.cooldown {
-				delete(events, key)
-			}
-		}
+// RemoveDebounce removes any debounce control previously registered for the
+// given event ID.
+func (ps *PubSub) RemoveDebounce(id RASID) {
+	select {
+	case <-time.After(submitTimeout):
+		ps.log.Errorf("failed to submit debounce update within %s", submitTimeout)
+	case ps.dbncCtrlMsgs <- &dbncCtrlMsg{id: id}:
 	}
 }
 
@@ -223,7 +237,7 @@ func (ps *PubSub) publish(ctx context.Context, event *RASEvent) {
 		return
 	}
 
-	if ps.debounceEvent(event) {
+	if ps.dbncEvts.debounce(ps.dbncCtrl, event) {
 		return
 	}
 
@@ -271,9 +285,14 @@ func (ps *PubSub) eventLoop(ctx context.Context) {
 		case fu := <-ps.filterUpdates:
 			ps.updateFilter(fu)
 		case msg := <-ps.dbncCtrlMsgs:
-			ps.dbncCtrl[msg.id] = msg
+			if msg.keyFn == nil {
+				delete(ps.dbncCtrl, msg.id)
+				delete(ps.dbncEvts, msg.id)
+			} else {
+				ps.dbncCtrl[msg.id] = msg
+			}
 		case <-cleanDebounceTicker.C:
-			ps.cleanDebouncedEvents()
+			ps.dbncEvts.clean(ps.dbncCtrl, ps.dbncCleanInterval)
 		}
 	}
 }
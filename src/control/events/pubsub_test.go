@@ -296,3 +296,33 @@ func TestEvents_PubSub_Debounce_Cooldown(t *testing.T) {
 		}
 	}
 }
+
+func TestEvents_PubSub_RemoveDebounce(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ps := NewPubSub(test.Context(t), log)
+	defer ps.Close()
+
+	evt1 := mockSwimRankDeadEvt(1, 1)
+	tally := newTally(3)
+
+	ps.Subscribe(RASTypeStateChange, tally)
+	ps.Debounce(evt1.ID, 0, func(ev *RASEvent) string {
+		return fmt.Sprintf("%d:%x", ev.Rank, ev.Incarnation)
+	})
+
+	for i := 0; i < 4; i++ {
+		ps.Publish(evt1)
+	}
+
+	// once removed, duplicate events should no longer be suppressed
+	ps.RemoveDebounce(evt1.ID)
+	ps.Publish(evt1)
+	ps.Publish(evt1)
+
+	<-tally.finished
+
+	test.AssertStringsEqual(t, []string{evt1.String(), evt1.String(), evt1.String()},
+		tally.getRx(), "unexpected slice of received events")
+}
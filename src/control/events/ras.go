@@ -57,6 +57,10 @@ const (
 	RASSystemFabricProvChanged RASID = C.RAS_SYSTEM_FABRIC_PROV_CHANGED // info
 	RASNVMeLinkSpeedChanged    RASID = C.RAS_DEVICE_LINK_SPEED_CHANGED  // warning|notice
 	RASNVMeLinkWidthChanged    RASID = C.RAS_DEVICE_LINK_WIDTH_CHANGED  // warning|notice
+	RASCertExpiring            RASID = C.RAS_CERT_EXPIRING              // warning
+	RASNVMeWearOut             RASID = C.RAS_DEVICE_WEAR_OUT            // warning
+	RASNVMeHealthPolicy        RASID = C.RAS_DEVICE_HEALTH_POLICY       // warning
+	RASScmBadBlocks            RASID = C.RAS_SCM_BAD_BLOCKS             // error
 )
 
 func (id RASID) String() string {
@@ -68,6 +72,22 @@ func (id RASID) Uint32() uint32 {
 	return uint32(id)
 }
 
+// RASIDFromString returns the RASID with the supplied string identifier.
+func RASIDFromString(name string) (RASID, error) {
+	for id := RASUnknownEvent + 1; ; id++ {
+		str := id.String()
+		if str == name {
+			return id, nil
+		}
+		// ras_event2str() returns this for IDs past the end of the list
+		if str == "unknown_unknown" {
+			break
+		}
+	}
+
+	return RASUnknownEvent, errors.Errorf("unknown RAS event %q", name)
+}
+
 // RASTypeID identifies the type of a given RAS event.
 type RASTypeID uint32
 
@@ -87,6 +107,18 @@ func (typ RASTypeID) Uint32() uint32 {
 	return uint32(typ)
 }
 
+// RASTypeFromString returns the RASTypeID matching the supplied
+// (case-insensitive) type name.
+func RASTypeFromString(name string) (RASTypeID, error) {
+	for _, typ := range []RASTypeID{RASTypeStateChange, RASTypeInfoOnly} {
+		if strings.EqualFold(typ.String(), name) {
+			return typ, nil
+		}
+	}
+
+	return RASTypeAny, errors.Errorf("unknown RAS event type %q", name)
+}
+
 // RASSeverityID identifies the severity of a given RAS event.
 type RASSeverityID uint32
 
@@ -107,6 +139,18 @@ func (sev RASSeverityID) Uint32() uint32 {
 	return uint32(sev)
 }
 
+// RASSeverityFromString returns the RASSeverityID matching the supplied
+// (case-insensitive) severity name.
+func RASSeverityFromString(name string) (RASSeverityID, error) {
+	for _, sev := range []RASSeverityID{RASSeverityError, RASSeverityWarning, RASSeverityNotice} {
+		if strings.EqualFold(sev.String(), name) {
+			return sev, nil
+		}
+	}
+
+	return RASSeverityUnknown, errors.Errorf("unknown RAS severity %q", name)
+}
+
 // SyslogPriority maps RAS severity to syslog package priority.
 func (sev RASSeverityID) SyslogPriority() syslog.Priority {
 	slSev := map[RASSeverityID]syslog.Priority{
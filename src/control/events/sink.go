@@ -0,0 +1,479 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// DefaultWebhookTimeout is the default timeout for each webhook POST.
+	DefaultWebhookTimeout = 5 * time.Second
+	// DefaultWebhookRetries is the default number of times a failed
+	// webhook POST is retried.
+	DefaultWebhookRetries = 3
+
+	webhookBackoffBase  = 500 * time.Millisecond
+	webhookBackoffLimit = 5 // 8s
+
+	// sinkQueueSize is the number of events that may be waiting to be
+	// written by a sink before further events are dropped.
+	sinkQueueSize = 1024
+)
+
+// SinkType identifies the kind of destination that a RAS event sink writes
+// events to.
+type SinkType string
+
+// SinkType constant definitions.
+const (
+	SinkTypeFile    SinkType = "file"
+	SinkTypeWebhook SinkType = "webhook"
+	SinkTypeUDP     SinkType = "udp"
+)
+
+// SinkDebounceConfig describes how duplicate events with the given ID should
+// be suppressed by a sink.
+type SinkDebounceConfig struct {
+	ID       string        `yaml:"id"`
+	Cooldown time.Duration `yaml:"cooldown,omitempty"`
+}
+
+// SinkConfig describes a destination for RAS events along with the filters
+// applied to events before they are sent there.
+type SinkConfig struct {
+	Type     SinkType             `yaml:"type"`
+	Path     string               `yaml:"path,omitempty"`
+	URL      string               `yaml:"url,omitempty"`
+	Address  string               `yaml:"address,omitempty"`
+	Timeout  time.Duration        `yaml:"timeout,omitempty"`
+	Retries  *int                 `yaml:"retries,omitempty"`
+	Severity string               `yaml:"severity,omitempty"`
+	IDs      []string             `yaml:"ids,omitempty"`
+	Debounce []SinkDebounceConfig `yaml:"debounce,omitempty"`
+}
+
+func (cfg *SinkConfig) String() string {
+	switch cfg.Type {
+	case SinkTypeFile:
+		return fmt.Sprintf("%s sink %s", cfg.Type, cfg.Path)
+	case SinkTypeWebhook:
+		return fmt.Sprintf("%s sink %s", cfg.Type, cfg.URL)
+	default:
+		return fmt.Sprintf("%s sink %s", cfg.Type, cfg.Address)
+	}
+}
+
+// Validate returns an error if the sink configuration is invalid.
+func (cfg *SinkConfig) Validate() error {
+	if cfg == nil {
+		return errors.New("nil RAS sink config")
+	}
+
+	switch cfg.Type {
+	case SinkTypeFile:
+		if cfg.Path == "" {
+			return errors.Errorf("%s RAS sink requires a path", cfg.Type)
+		}
+	case SinkTypeWebhook:
+		if cfg.URL == "" {
+			return errors.Errorf("%s RAS sink requires a url", cfg.Type)
+		}
+		u, err := url.Parse(cfg.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid %s RAS sink url %q", cfg.Type, cfg.URL)
+		}
+		if cfg.Timeout < 0 {
+			return errors.Errorf("%s RAS sink timeout must not be negative", cfg.Type)
+		}
+		if cfg.Retries != nil && *cfg.Retries < 0 {
+			return errors.Errorf("%s RAS sink retries must not be negative", cfg.Type)
+		}
+	case SinkTypeUDP:
+		if cfg.Address == "" {
+			return errors.Errorf("%s RAS sink requires an address", cfg.Type)
+		}
+		if _, _, err := net.SplitHostPort(cfg.Address); err != nil {
+			return errors.Wrapf(err, "invalid %s RAS sink address %q", cfg.Type, cfg.Address)
+		}
+	default:
+		return errors.Errorf("unknown RAS sink type %q (valid types: %s, %s, %s)",
+			cfg.Type, SinkTypeFile, SinkTypeWebhook, SinkTypeUDP)
+	}
+
+	if cfg.Severity != "" {
+		if _, err := RASSeverityFromString(cfg.Severity); err != nil {
+			return err
+		}
+	}
+	for _, name := range cfg.IDs {
+		if _, err := RASIDFromString(name); err != nil {
+			return err
+		}
+	}
+	for _, dc := range cfg.Debounce {
+		if _, err := RASIDFromString(dc.ID); err != nil {
+			return errors.Wrap(err, "invalid debounce")
+		}
+		if dc.Cooldown < 0 {
+			return errors.Errorf("debounce cooldown for %s must not be negative", dc.ID)
+		}
+	}
+
+	return nil
+}
+
+// sinkWriter is implemented by the destinations that RAS events can be
+// written to.
+type sinkWriter interface {
+	writeEvent(context.Context, *RASEvent) error
+	close() error
+}
+
+// Sink implements the Handler interface and sends events matching its
+// filters to a configured destination. Events are queued and written in order
+// by a single worker, and are dropped if the queue is full.
+type Sink struct {
+	sync.RWMutex
+	log      logging.Logger
+	name     string
+	severity RASSeverityID
+	ids      map[RASID]struct{}
+	dbncCtrl dbncCtrl
+	dbncEvts dbncEvts
+	queue    chan *RASEvent
+	closed   bool
+	done     chan struct{}
+	dropped  atomic.Uint64
+	writer   sinkWriter
+}
+
+// LocationDebounceKey is a DebounceKeyFn that identifies duplicate events by
+// the host, rank, pool and container that they relate to.
+func LocationDebounceKey(evt *RASEvent) string {
+	return fmt.Sprintf("%s:%d:%s:%s", evt.Hostname, evt.Rank, evt.PoolUUID, evt.ContUUID)
+}
+
+// NewSink returns a Sink created from the supplied config. Events are
+// delivered to the destination asynchronously, after any configured
+// debouncing has been applied.
+func NewSink(ctx context.Context, log logging.Logger, cfg *SinkConfig) (*Sink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var writer sinkWriter
+	var err error
+	switch cfg.Type {
+	case SinkTypeFile:
+		writer, err = newFileSinkWriter(cfg.Path)
+	case SinkTypeWebhook:
+		writer = newWebhookSinkWriter(log, cfg)
+	case SinkTypeUDP:
+		writer, err = newUDPSinkWriter(cfg.Address)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", cfg)
+	}
+
+	sink := newSink(log, cfg.String(), writer, sinkQueueSize)
+	if cfg.Severity != "" {
+		sink.severity, _ = RASSeverityFromString(cfg.Severity)
+	}
+	if len(cfg.IDs) > 0 {
+		sink.ids = make(map[RASID]struct{})
+		for _, name := range cfg.IDs {
+			id, _ := RASIDFromString(name)
+			sink.ids[id] = struct{}{}
+		}
+	}
+	for _, dc := range cfg.Debounce {
+		id, _ := RASIDFromString(dc.ID)
+		sink.dbncCtrl[id] = &dbncCtrlMsg{id: id, keyFn: LocationDebounceKey, cooldown: dc.Cooldown}
+	}
+
+	go sink.run(ctx)
+
+	return sink, nil
+}
+
+func newSink(log logging.Logger, name string, writer sinkWriter, queueSize int) *Sink {
+	return &Sink{
+		log:      log,
+		name:     name,
+		dbncCtrl: make(dbncCtrl),
+		dbncEvts: make(dbncEvts),
+		queue:    make(chan *RASEvent, queueSize),
+		done:     make(chan struct{}),
+		writer:   writer,
+	}
+}
+
+// NewSinks returns Sinks created from each of the supplied configs.
+func NewSinks(ctx context.Context, log logging.Logger, cfgs []*SinkConfig) ([]*Sink, error) {
+	sinks := make([]*Sink, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		sink, err := NewSink(ctx, log, cfg)
+		if err != nil {
+			for _, s := range sinks {
+				s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
+
+func (s *Sink) matches(evt *RASEvent) bool {
+	if s.severity != RASSeverityUnknown &&
+		(evt.Severity == RASSeverityUnknown || evt.Severity > s.severity) {
+		return false
+	}
+	if s.ids != nil {
+		if _, found := s.ids[evt.ID]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Sink) write(ctx context.Context, evt *RASEvent) {
+	if err := s.writer.writeEvent(ctx, evt); err != nil {
+		s.log.Errorf("%s: failed to write %s event: %s", s.name, evt.ID, err)
+	}
+}
+
+// run writes queued events to the destination until the queue is closed and
+// drained.
+func (s *Sink) run(ctx context.Context) {
+	defer close(s.done)
+
+	cleanDebounceTicker := time.NewTicker(defaultDebounceCleanInterval)
+	defer cleanDebounceTicker.Stop()
+
+	for {
+		select {
+		case evt, ok := <-s.queue:
+			if !ok {
+				return
+			}
+			if s.dbncEvts.debounce(s.dbncCtrl, evt) {
+				continue
+			}
+			s.write(ctx, evt)
+		case <-cleanDebounceTicker.C:
+			s.dbncEvts.clean(s.dbncCtrl, defaultDebounceCleanInterval)
+		}
+	}
+}
+
+// OnEvent implements the Handler interface.
+func (s *Sink) OnEvent(_ context.Context, evt *RASEvent) {
+	switch {
+	case evt == nil:
+		return
+	case evt.IsForwarded():
+		return // event has already been sent at source
+	case !s.matches(evt):
+		return
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return
+	}
+	select {
+	case s.queue <- evt:
+	default:
+		if s.dropped.Add(1) == 1 {
+			s.log.Errorf("%s: event queue full, dropping events", s.name)
+		}
+		s.log.Debugf("%s: dropped %s event", s.name, evt.ID)
+	}
+}
+
+// Dropped returns the number of events dropped because the sink's queue was
+// full.
+func (s *Sink) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close stops accepting events, waits for any queued events to be written and
+// releases any resources held by the sink.
+func (s *Sink) Close() {
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.queue)
+	s.Unlock()
+
+	<-s.done
+	if dropped := s.Dropped(); dropped > 0 {
+		s.log.Noticef("%s: %d events were dropped", s.name, dropped)
+	}
+	if err := s.writer.close(); err != nil {
+		s.log.Errorf("%s: failed to close: %s", s.name, err)
+	}
+}
+
+// fileSinkWriter appends events to a file as JSON lines.
+type fileSinkWriter struct {
+	sync.Mutex
+	file *os.File
+}
+
+func newFileSinkWriter(path string) (*fileSinkWriter, error) {
+	f, err := common.AppendFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSinkWriter{file: f}, nil
+}
+
+func (w *fileSinkWriter) writeEvent(_ context.Context, evt *RASEvent) error {
+	data, err := evt.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	_, err = w.file.Write(append(data, '\n'))
+	return err
+}
+
+func (w *fileSinkWriter) close() error {
+	w.Lock()
+	defer w.Unlock()
+
+	return w.file.Close()
+}
+
+// webhookSinkWriter POSTs events as JSON to an HTTP endpoint, retrying
+// failed requests with exponential backoff.
+type webhookSinkWriter struct {
+	log     logging.Logger
+	url     string
+	client  *http.Client
+	retries int
+}
+
+func newWebhookSinkWriter(log logging.Logger, cfg *SinkConfig) *webhookSinkWriter {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	retries := DefaultWebhookRetries
+	if cfg.Retries != nil {
+		retries = *cfg.Retries
+	}
+
+	return &webhookSinkWriter{
+		log:     log,
+		url:     cfg.URL,
+		client:  &http.Client{Timeout: timeout},
+		retries: retries,
+	}
+}
+
+func (w *webhookSinkWriter) post(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("HTTP response: %s", resp.Status)
+	}
+	return nil
+}
+
+func (w *webhookSinkWriter) writeEvent(ctx context.Context, evt *RASEvent) error {
+	data, err := evt.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	for try := 0; ; try++ {
+		err = w.post(ctx, data)
+		if err == nil || try >= w.retries {
+			return err
+		}
+
+		backoff := common.ExpBackoff(webhookBackoffBase, uint64(try+1), webhookBackoffLimit)
+		w.log.Debugf("webhook %s: retrying %s event after %s: %s", w.url, evt.ID, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (w *webhookSinkWriter) close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// udpSinkWriter sends each event as a JSON datagram.
+type udpSinkWriter struct {
+	conn net.Conn
+}
+
+func newUDPSinkWriter(addr string) (*udpSinkWriter, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &udpSinkWriter{conn: conn}, nil
+}
+
+func (w *udpSinkWriter) writeEvent(_ context.Context, evt *RASEvent) error {
+	data, err := evt.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.conn.Write(data)
+	return err
+}
+
+func (w *udpSinkWriter) close() error {
+	return w.conn.Close()
+}
@@ -0,0 +1,417 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestEvents_SinkConfig_Validate(t *testing.T) {
+	negRetries := -1
+
+	for name, tc := range map[string]struct {
+		cfg    *SinkConfig
+		expErr error
+	}{
+		"nil": {
+			expErr: errors.New("nil RAS sink config"),
+		},
+		"unknown type": {
+			cfg:    &SinkConfig{Type: "snmp"},
+			expErr: errors.New("unknown RAS sink type"),
+		},
+		"file without path": {
+			cfg:    &SinkConfig{Type: SinkTypeFile},
+			expErr: errors.New("requires a path"),
+		},
+		"file": {
+			cfg: &SinkConfig{Type: SinkTypeFile, Path: "/tmp/events.json"},
+		},
+		"webhook without url": {
+			cfg:    &SinkConfig{Type: SinkTypeWebhook},
+			expErr: errors.New("requires a url"),
+		},
+		"webhook bad scheme": {
+			cfg:    &SinkConfig{Type: SinkTypeWebhook, URL: "ftp://host/path"},
+			expErr: errors.New("invalid webhook RAS sink url"),
+		},
+		"webhook negative timeout": {
+			cfg: &SinkConfig{
+				Type: SinkTypeWebhook, URL: "http://host/path", Timeout: -time.Second,
+			},
+			expErr: errors.New("timeout must not be negative"),
+		},
+		"webhook negative retries": {
+			cfg: &SinkConfig{
+				Type: SinkTypeWebhook, URL: "http://host/path", Retries: &negRetries,
+			},
+			expErr: errors.New("retries must not be negative"),
+		},
+		"webhook": {
+			cfg: &SinkConfig{Type: SinkTypeWebhook, URL: "https://host:8443/path"},
+		},
+		"udp without address": {
+			cfg:    &SinkConfig{Type: SinkTypeUDP},
+			expErr: errors.New("requires an address"),
+		},
+		"udp without port": {
+			cfg:    &SinkConfig{Type: SinkTypeUDP, Address: "host"},
+			expErr: errors.New("invalid udp RAS sink address"),
+		},
+		"udp": {
+			cfg: &SinkConfig{Type: SinkTypeUDP, Address: "host:5140"},
+		},
+		"bad severity": {
+			cfg: &SinkConfig{
+				Type: SinkTypeFile, Path: "/tmp/events.json", Severity: "bad",
+			},
+			expErr: errors.New("bad"),
+		},
+		"bad id": {
+			cfg: &SinkConfig{
+				Type: SinkTypeFile, Path: "/tmp/events.json", IDs: []string{"bad"},
+			},
+			expErr: errors.New("bad"),
+		},
+		"bad debounce id": {
+			cfg: &SinkConfig{
+				Type: SinkTypeFile, Path: "/tmp/events.json",
+				Debounce: []SinkDebounceConfig{{ID: "bad"}},
+			},
+			expErr: errors.New("invalid debounce"),
+		},
+		"negative debounce cooldown": {
+			cfg: &SinkConfig{
+				Type: SinkTypeFile, Path: "/tmp/events.json",
+				Debounce: []SinkDebounceConfig{
+					{ID: RASSwimRankDead.String(), Cooldown: -time.Second},
+				},
+			},
+			expErr: errors.New("must not be negative"),
+		},
+		"filters": {
+			cfg: &SinkConfig{
+				Type: SinkTypeFile, Path: "/tmp/events.json",
+				Severity: "warning",
+				IDs:      []string{RASEngineDied.String(), RASSwimRankDead.String()},
+				Debounce: []SinkDebounceConfig{
+					{ID: RASSwimRankDead.String(), Cooldown: time.Minute},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func readSinkLines(t *testing.T, path string, expCount int) []string {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var lines []string
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		f.Close()
+
+		if len(lines) >= expCount || time.Now().After(deadline) {
+			return lines
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func mustMarshalEvt(t *testing.T, evt *RASEvent) string {
+	t.Helper()
+
+	data, err := evt.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestEvents_Sink_File(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *SinkConfig
+		evts   []*RASEvent
+		expIdx []int // indices of evts expected in output
+	}{
+		"no filter": {
+			cfg: &SinkConfig{},
+			evts: []*RASEvent{
+				mockEvtDied(t),
+				mockEvtGeneric(t),
+			},
+			expIdx: []int{0, 1},
+		},
+		"nil and forwarded events skipped": {
+			cfg: &SinkConfig{},
+			evts: []*RASEvent{
+				nil,
+				mockEvtDied(t).WithForwarded(true),
+				mockEvtGeneric(t),
+			},
+			expIdx: []int{2},
+		},
+		"severity filter": {
+			cfg: &SinkConfig{Severity: RASSeverityError.String()},
+			evts: []*RASEvent{
+				mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", time.Now()),
+				mockHistoryEvt(RASEngineDied, RASSeverityError, 2, "", time.Now()),
+				mockHistoryEvt(RASSwimRankDead, RASSeverityWarning, 3, "", time.Now()),
+			},
+			expIdx: []int{1},
+		},
+		"id filter": {
+			cfg: &SinkConfig{IDs: []string{RASSwimRankDead.String()}},
+			evts: []*RASEvent{
+				mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", time.Now()),
+				mockHistoryEvt(RASEngineDied, RASSeverityError, 2, "", time.Now()),
+			},
+			expIdx: []int{0},
+		},
+		"debounced": {
+			cfg: &SinkConfig{
+				Debounce: []SinkDebounceConfig{{ID: RASSwimRankDead.String()}},
+			},
+			evts: []*RASEvent{
+				mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", time.Now()),
+				mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", time.Now()),
+				mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 2, "", time.Now()),
+				mockHistoryEvt(RASEngineDied, RASSeverityError, 1, "", time.Now()),
+				mockHistoryEvt(RASEngineDied, RASSeverityError, 1, "", time.Now()),
+			},
+			expIdx: []int{0, 2, 3, 4},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			tc.cfg.Type = SinkTypeFile
+			tc.cfg.Path = filepath.Join(tmpDir, "events.json")
+
+			sink, err := NewSink(test.Context(t), log, tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer sink.Close()
+
+			var expLines []string
+			for _, idx := range tc.expIdx {
+				expLines = append(expLines, mustMarshalEvt(t, tc.evts[idx]))
+			}
+			for _, evt := range tc.evts {
+				sink.OnEvent(test.Context(t), evt)
+			}
+
+			gotLines := readSinkLines(t, tc.cfg.Path, len(expLines))
+			test.AssertStringsEqual(t, expLines, gotLines, "unexpected sink output")
+		})
+	}
+}
+
+type mockSinkWriter struct {
+	sync.Mutex
+	block   chan struct{}
+	written []*RASEvent
+	closed  bool
+}
+
+func (w *mockSinkWriter) writeEvent(_ context.Context, evt *RASEvent) error {
+	<-w.block
+
+	w.Lock()
+	defer w.Unlock()
+	w.written = append(w.written, evt)
+	return nil
+}
+
+func (w *mockSinkWriter) close() error {
+	w.Lock()
+	defer w.Unlock()
+	w.closed = true
+	return nil
+}
+
+func TestEvents_Sink_Queue(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	w := &mockSinkWriter{block: make(chan struct{})}
+	sink := newSink(log, "test sink", w, 2)
+	go sink.run(test.Context(t))
+
+	evts := []*RASEvent{
+		mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", time.Now()),
+		mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 2, "", time.Now()),
+		mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 3, "", time.Now()),
+	}
+
+	// The first event is taken from the queue by the worker, which then
+	// blocks writing it, so the queue only has room for one more.
+	sink.OnEvent(test.Context(t), evts[0])
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sink.queue) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("event not taken from queue")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, evt := range evts[1:] {
+		sink.OnEvent(test.Context(t), evt)
+	}
+	sink.OnEvent(test.Context(t), mockEvtDied(t))
+	test.AssertEqual(t, uint64(1), sink.Dropped(), "unexpected dropped count")
+
+	// Queued events are written before Close returns.
+	close(w.block)
+	sink.Close()
+	test.AssertEqual(t, evts, w.written, "unexpected events written")
+	test.AssertTrue(t, w.closed, "writer not closed")
+
+	sink.OnEvent(test.Context(t), mockEvtDied(t))
+	test.AssertEqual(t, 3, len(w.written), "event written after close")
+}
+
+func TestEvents_Sink_Webhook(t *testing.T) {
+	for name, tc := range map[string]struct {
+		failures   int32
+		retries    int
+		expAttempt int32
+		expRecv    bool
+		expErr     string
+	}{
+		"success": {
+			expAttempt: 1,
+			expRecv:    true,
+		},
+		"success after retry": {
+			failures:   1,
+			retries:    2,
+			expAttempt: 2,
+			expRecv:    true,
+		},
+		"retries exhausted": {
+			failures:   5,
+			retries:    1,
+			expAttempt: 2,
+			expErr:     "500 Internal Server Error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var attempts atomic.Int32
+			recvCh := make(chan string, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) <= tc.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				if r.Header.Get("Content-Type") != "application/json" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				data, _ := io.ReadAll(r.Body)
+				recvCh <- string(data)
+			}))
+			defer srv.Close()
+
+			w := newWebhookSinkWriter(log, &SinkConfig{
+				Type:    SinkTypeWebhook,
+				URL:     srv.URL,
+				Retries: &tc.retries,
+			})
+			defer w.close()
+
+			evt := mockEvtDied(t)
+			gotErr := w.writeEvent(test.Context(t), evt)
+			if tc.expErr != "" {
+				test.CmpErr(t, errors.New(tc.expErr), gotErr)
+			} else if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+			test.AssertEqual(t, tc.expAttempt, attempts.Load(), "unexpected number of attempts")
+
+			if !tc.expRecv {
+				return
+			}
+			select {
+			case got := <-recvCh:
+				test.AssertEqual(t, mustMarshalEvt(t, evt), got, "unexpected request body")
+			default:
+				t.Fatal("event not received")
+			}
+		})
+	}
+}
+
+func TestEvents_Sink_UDP(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	sink, err := NewSink(test.Context(t), log, &SinkConfig{
+		Type:     SinkTypeUDP,
+		Address:  conn.LocalAddr().String(),
+		Severity: RASSeverityError.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	evt := mockEvtDied(t)
+	sink.OnEvent(test.Context(t), mockHistoryEvt(RASSwimRankDead, RASSeverityNotice, 1, "", time.Now()))
+	sink.OnEvent(test.Context(t), evt)
+
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 64*1024)
+	n, _, err := conn.ReadFrom(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertEqual(t, mustMarshalEvt(t, evt), string(data[:n]), "unexpected datagram")
+}
@@ -154,6 +154,7 @@ const (
 	ServerNoCompatibilityInsecure
 	ServerPoolHasContainers
 	ServerHugepagesDisabled
+	ServerPoolQuotaExceeded
 )
 
 // server config fault codes
@@ -193,6 +194,10 @@ const (
 	ServerConfigScmDiffClass
 	ServerConfigEngineBdevRolesMismatch
 	ServerConfigSysRsvdZero
+	ServerConfigBadRASSink
+	ServerConfigBadAccessControl
+	ServerConfigBadNvmeHealthPolicy
+	ServerConfigBadAuditMaxRecords
 )
 
 // SPDK library bindings codes
@@ -6,6 +6,8 @@ toolchain go1.22.3
 
 require (
 	github.com/Jille/raft-grpc-transport v1.2.0
+	github.com/armon/go-metrics v0.4.0
+	github.com/desertbit/go-shlex v0.1.1
 	github.com/desertbit/grumble v1.1.3
 	github.com/dustin/go-humanize v1.0.0
 	github.com/google/go-cmp v0.6.0
@@ -29,12 +31,10 @@ require (
 )
 
 require (
-	github.com/armon/go-metrics v0.4.0 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/desertbit/closer/v3 v3.1.2 // indirect
 	github.com/desertbit/columnize v2.1.0+incompatible // indirect
-	github.com/desertbit/go-shlex v0.1.1 // indirect
 	github.com/desertbit/readline v1.5.1 // indirect
 	github.com/fatih/color v1.13.0 // indirect
 	github.com/golang/protobuf v1.5.3 // indirect
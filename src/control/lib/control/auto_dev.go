@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	// DefaultDevClusterDir is the default location for the files of a developer cluster.
+	DefaultDevClusterDir = "/tmp/daos_dev_cluster"
+
+	defaultDevNetProvider  = "ofi+tcp"
+	defaultDevNetInterface = "lo"
+	defaultDevTargetCount  = 2
+	defaultDevBdevFileSize = 4 // GB
+	devClusterMaxEngines   = 8
+)
+
+// DevClusterReq contains the inputs for generating the config of a developer cluster, a
+// single-host DAOS system that requires neither PMem nor NVMe.
+type DevClusterReq struct {
+	// Number of engines to include in generated config.
+	NrEngines int
+	// Directory under which all mount points, backing files, logs and sockets are located.
+	BaseDir string
+	// Fabric provider and interface, loopback TCP if unset.
+	NetProvider  string
+	NetInterface string
+	// Port of the management service, default used if zero.
+	ControlPort int
+	// Number of targets per engine.
+	TargetCount int
+	// Size (in GiB) of each engine's ramdisk, calculated on start if zero.
+	ScmRamdiskSize uint
+	// Size (in GB) of each engine's AIO backing file.
+	BdevFileSize int
+	// Kernel block devices to use instead of AIO files, assigned evenly to engines.
+	KdevList []string
+	Log      logging.Logger
+}
+
+func (req *DevClusterReq) setDefaults() {
+	if req.BaseDir == "" {
+		req.BaseDir = DefaultDevClusterDir
+	}
+	if req.NetProvider == "" {
+		req.NetProvider = defaultDevNetProvider
+	}
+	if req.NetInterface == "" {
+		req.NetInterface = defaultDevNetInterface
+	}
+	if req.TargetCount == 0 {
+		req.TargetCount = defaultDevTargetCount
+	}
+	if req.BdevFileSize == 0 {
+		req.BdevFileSize = defaultDevBdevFileSize
+	}
+}
+
+// DevClusterEngineDir returns the directory holding an engine's backing file and log.
+func DevClusterEngineDir(baseDir string, idx int) string {
+	return filepath.Join(baseDir, fmt.Sprintf("engine%d", idx))
+}
+
+// DevClusterSocketDir returns the socket directory of a developer cluster.
+func DevClusterSocketDir(baseDir string) string {
+	return filepath.Join(baseDir, "run")
+}
+
+func devBdevTier(req DevClusterReq, idx int) *storage.TierConfig {
+	tier := storage.NewTierConfig()
+
+	if len(req.KdevList) == 0 {
+		return tier.WithStorageClass(storage.ClassFile.String()).
+			WithBdevDeviceList(filepath.Join(DevClusterEngineDir(req.BaseDir, idx),
+				"daos_bdev")).
+			WithBdevFileSize(req.BdevFileSize)
+	}
+
+	perEngine := len(req.KdevList) / req.NrEngines
+	return tier.WithStorageClass(storage.ClassKdev.String()).
+		WithBdevDeviceList(req.KdevList[idx*perEngine : (idx+1)*perEngine]...)
+}
+
+// DevClusterConfGenerate generates a server config with the requested number of engines, each
+// backed by a tmpfs RAM-disk SCM tier and either an AIO file or kernel block device bdev tier.
+// Every engine listens on its own fabric port and the local host runs the management service.
+// All paths are located under the request base directory so that a developer cluster never
+// touches the locations used by a production install. Storage is formatted automatically on
+// start.
+//
+// Engines of the same server share a socket directory in which each engine creates a socket
+// named after its index.
+func DevClusterConfGenerate(req DevClusterReq) (*config.Server, error) {
+	if req.Log == nil {
+		req.Log = logging.NewStdoutLogger("")
+	}
+	req.setDefaults()
+
+	if req.NrEngines < 1 || req.NrEngines > devClusterMaxEngines {
+		return nil, errors.Errorf("number of dev-cluster engines must be between 1 and %d",
+			devClusterMaxEngines)
+	}
+	if !filepath.IsAbs(req.BaseDir) {
+		return nil, errors.Errorf("dev-cluster directory %q is not an absolute path",
+			req.BaseDir)
+	}
+	if len(req.KdevList) > 0 && len(req.KdevList)%req.NrEngines != 0 {
+		return nil, errors.Errorf("%d kernel block devices can't be evenly assigned to %d engines",
+			len(req.KdevList), req.NrEngines)
+	}
+
+	ecs := make([]*engine.Config, 0, req.NrEngines)
+	for idx := 0; idx < req.NrEngines; idx++ {
+		engDir := DevClusterEngineDir(req.BaseDir, idx)
+
+		scmTier := storage.NewTierConfig().WithStorageClass(storage.ClassRam.String()).
+			WithScmMountPoint(filepath.Join(req.BaseDir, fmt.Sprintf("daos%d", idx))).
+			WithScmRamdiskSize(req.ScmRamdiskSize)
+
+		ecs = append(ecs, engine.NewConfig().
+			WithLogFile(filepath.Join(engDir, "daos_engine.log")).
+			WithStorage(scmTier, devBdevTier(req, idx)).
+			WithFabricProvider(req.NetProvider).
+			WithFabricInterface(req.NetInterface).
+			WithFabricInterfacePort(defaultFiPort+idx*defaultFiPortInterval))
+	}
+
+	ap := "localhost"
+	if req.ControlPort != 0 {
+		ap = fmt.Sprintf("%s:%d", ap, req.ControlPort)
+	}
+	cfg, err := genServerConfig(ConfGenerateReq{
+		AccessPoints: []string{ap},
+		Log:          req.Log,
+	}, ecs, &threadCounts{nrTgts: req.TargetCount})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Path = "" // Not read from file.
+	cfg.WithSocketDir(DevClusterSocketDir(req.BaseDir)).
+		WithControlLogFile(filepath.Join(req.BaseDir, "daos_server.log"))
+	cfg.TransportConfig.AllowInsecure = true
+	cfg.AutoFormat = true
+
+	return cfg, nil
+}
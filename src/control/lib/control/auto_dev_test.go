@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestControl_AutoConfig_DevClusterConfGenerate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        DevClusterReq
+		expClass   storage.Class
+		expBdevs   [][]string
+		expBaseDir string
+		expAP      string
+		expErr     error
+	}{
+		"zero engines": {
+			expErr: errors.New("between 1 and"),
+		},
+		"too many engines": {
+			req:    DevClusterReq{NrEngines: devClusterMaxEngines + 1},
+			expErr: errors.New("between 1 and"),
+		},
+		"relative base dir": {
+			req:    DevClusterReq{NrEngines: 1, BaseDir: "dev"},
+			expErr: errors.New("not an absolute path"),
+		},
+		"uneven kdev list": {
+			req: DevClusterReq{
+				NrEngines: 2,
+				KdevList:  []string{"/dev/sdb", "/dev/sdc", "/dev/sdd"},
+			},
+			expErr: errors.New("can't be evenly assigned"),
+		},
+		"single engine; defaults": {
+			req:      DevClusterReq{NrEngines: 1},
+			expClass: storage.ClassFile,
+			expBdevs: [][]string{
+				{DefaultDevClusterDir + "/engine0/daos_bdev"},
+			},
+			expBaseDir: DefaultDevClusterDir,
+		},
+		"three engines; files": {
+			req:      DevClusterReq{NrEngines: 3, BaseDir: "/scratch/dev"},
+			expClass: storage.ClassFile,
+			expBdevs: [][]string{
+				{"/scratch/dev/engine0/daos_bdev"},
+				{"/scratch/dev/engine1/daos_bdev"},
+				{"/scratch/dev/engine2/daos_bdev"},
+			},
+			expBaseDir: "/scratch/dev",
+		},
+		"two engines; kdevs; custom port": {
+			req: DevClusterReq{
+				NrEngines:   2,
+				ControlPort: 10002,
+				KdevList:    []string{"/dev/sdb", "/dev/sdc", "/dev/sdd", "/dev/sde"},
+			},
+			expClass: storage.ClassKdev,
+			expBdevs: [][]string{
+				{"/dev/sdb", "/dev/sdc"},
+				{"/dev/sdd", "/dev/sde"},
+			},
+			expBaseDir: DefaultDevClusterDir,
+			expAP:      "localhost:10002",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tc.req.Log = log
+			cfg, gotErr := DevClusterConfGenerate(tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.expAP == "" {
+				tc.expAP = "localhost:10001"
+			}
+			test.AssertEqual(t, []string{tc.expAP}, cfg.AccessPoints,
+				"unexpected access points")
+			test.AssertEqual(t, tc.expBaseDir+"/run", cfg.SocketDir, "unexpected socket dir")
+			test.AssertTrue(t, cfg.TransportConfig.AllowInsecure, "expected insecure transport")
+			test.AssertTrue(t, cfg.AutoFormat, "expected auto-format")
+			test.AssertEqual(t, "", cfg.Path, "unexpected config path")
+			test.AssertEqual(t, len(tc.expBdevs), len(cfg.Engines), "unexpected engine count")
+
+			for idx, ec := range cfg.Engines {
+				test.AssertEqual(t, defaultFiPort+idx*defaultFiPortInterval,
+					ec.Fabric.InterfacePort, "unexpected fabric port")
+				test.AssertEqual(t, "lo", ec.Fabric.Interface, "unexpected fabric interface")
+				test.AssertEqual(t, defaultDevTargetCount, ec.TargetCount,
+					"unexpected target count")
+				test.AssertEqual(t, cfg.SocketDir, ec.SocketDir, "unexpected engine socket dir")
+				test.AssertEqual(t, fmt.Sprintf("%s/engine%d/daos_engine.log", tc.expBaseDir, idx),
+					ec.LogFile, "unexpected log file")
+
+				scs := ec.Storage.Tiers.ScmConfigs()
+				test.AssertEqual(t, 1, len(scs), "unexpected number of scm tiers")
+				test.AssertEqual(t, storage.ClassRam, scs[0].Class, "unexpected scm class")
+				test.AssertEqual(t, fmt.Sprintf("%s/daos%d", tc.expBaseDir, idx),
+					scs[0].Scm.MountPoint, "unexpected scm mount")
+
+				bcs := ec.Storage.Tiers.BdevConfigs()
+				test.AssertEqual(t, 1, len(bcs), "unexpected number of bdev tiers")
+				test.AssertEqual(t, tc.expClass, bcs[0].Class, "unexpected bdev class")
+				if diff := cmp.Diff(tc.expBdevs[idx], bcs[0].Bdev.DeviceList.Devices()); diff != "" {
+					t.Fatalf("unexpected bdev list (-want, +got):\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 // ContSetOwnerReq contains the parameters for the set owner request
@@ -62,3 +63,59 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	return errors.Wrap(ur.getMSError(), "container set-owner failed")
 }
+
+// ListContReq contains the parameters for the list containers request.
+type ListContReq struct {
+	msRequest
+	unaryRequest
+	PoolID string // UUID or label of the pool
+}
+
+// ListContResp contains the UUIDs of the containers in a pool.
+type ListContResp struct {
+	Containers []string `json:"containers"`
+}
+
+// ListContainers fetches the UUIDs of the containers in a DAOS pool.
+func ListContainers(ctx context.Context, rpcClient UnaryInvoker, req *ListContReq) (*ListContResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ListContainers(ctx, &mgmtpb.ListContReq{
+			Sys: req.getSystem(rpcClient),
+			Id:  req.PoolID,
+		})
+	})
+
+	rpcClient.Debugf("List DAOS containers request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "container list failed")
+	}
+
+	pbResp, ok := msg.(*mgmtpb.ListContResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msg)
+	}
+	if pbResp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(pbResp.Status), "container list failed")
+	}
+
+	resp := &ListContResp{Containers: make([]string, 0, len(pbResp.Containers))}
+	for _, c := range pbResp.Containers {
+		resp.Containers = append(resp.Containers, c.GetUuid())
+	}
+
+	return resp, nil
+}
@@ -9,11 +9,13 @@ package control
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -132,3 +134,92 @@ func TestControl_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ListContainers(t *testing.T) {
+	testContUUIDs := []string{test.MockUUID(1), test.MockUUID(2)}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ListContReq
+		expResp *ListContResp
+		expErr  error
+	}{
+		"nil request": {
+			req:    nil,
+			expErr: errors.New("nil request"),
+		},
+		"no pool ID": {
+			req:    &ListContReq{},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"local failure": {
+			req: &ListContReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &ListContReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"DAOS error status": {
+			req: &ListContReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{Status: int32(daos.Nonexistent)},
+				),
+			},
+			expErr: daos.Nonexistent,
+		},
+		"no containers": {
+			req: &ListContReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{},
+				),
+			},
+			expResp: &ListContResp{Containers: []string{}},
+		},
+		"success": {
+			req: &ListContReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ListContResp{
+						Containers: []*mgmtpb.ListContResp_Cont{
+							{Uuid: testContUUIDs[0]},
+							{Uuid: testContUUIDs[1]},
+						},
+					},
+				),
+			},
+			expResp: &ListContResp{Containers: testContUUIDs},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ListContainers(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -8,16 +8,27 @@ package control
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"log"
 	"log/syslog"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -67,21 +78,390 @@ func eventNotify(ctx context.Context, rpcClient UnaryInvoker, seq uint64, evt *e
 	return convertMSResponse(ur, new(EventNotifyResp))
 }
 
+type (
+	// SystemGetEventsReq contains the inputs for a request to retrieve RAS
+	// events from the event history stored in the system database. Unset
+	// fields match all events.
+	SystemGetEventsReq struct {
+		unaryRequest
+		msRequest
+
+		Ranks    *ranklist.RankSet
+		Severity events.RASSeverityID // minimum severity
+		IDs      []events.RASID
+		PoolUUID string
+		Since    time.Time
+		Until    time.Time
+		Limit    int // maximum number of most recent events
+	}
+
+	// SystemGetEventsResp contains matching RAS events in chronological
+	// order.
+	SystemGetEventsResp struct {
+		Events []*events.RASEvent `json:"events"`
+	}
+)
+
+// SystemGetEvents retrieves RAS events from the management service event
+// history.
+func SystemGetEvents(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetEventsReq) (*SystemGetEventsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+	if !req.Since.IsZero() && !req.Until.IsZero() && req.Until.Before(req.Since) {
+		return nil, errors.New("until time must not be before since time")
+	}
+
+	pbReq := &mgmtpb.SystemGetEventsReq{
+		Sys:      req.getSystem(rpcClient),
+		Ranks:    req.Ranks.String(),
+		Severity: req.Severity.Uint32(),
+		PoolUuid: req.PoolUUID,
+		Limit:    uint32(req.Limit),
+	}
+	for _, id := range req.IDs {
+		pbReq.Ids = append(pbReq.Ids, id.Uint32())
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = common.FormatTime(req.Since)
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = common.FormatTime(req.Until)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemGetEvents(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemGetEvents request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemGetEventsResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	resp := &SystemGetEventsResp{
+		Events: make([]*events.RASEvent, 0, len(pbResp.Events)),
+	}
+	for _, pbEvt := range pbResp.Events {
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert event")
+		}
+		resp.Events = append(resp.Events, evt)
+	}
+
+	return resp, nil
+}
+
+// SystemStreamEventsReq contains the inputs for a request to subscribe to
+// RAS events as they are raised on the MS leader. Unset fields match all
+// events.
+type SystemStreamEventsReq struct {
+	streamRequest
+	msRequest
+	retryableRequest
+
+	Type     events.RASTypeID
+	Severity events.RASSeverityID // minimum severity
+	IDs      []events.RASID
+}
+
+func (r *SystemStreamEventsReq) getDeadline() time.Time {
+	// If the request has a custom deadline, use that. Otherwise the
+	// subscription lasts until the caller's context is canceled.
+	if !r.deadline.IsZero() {
+		return r.deadline
+	}
+	return time.Now().Add(math.MaxInt64)
+}
+
+// streamRecvErr converts an error received on a server stream into the same
+// form as errors returned by unary RPCs.
+func streamRecvErr(err error, target string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	if uErr := pbUtil.UnwrapError(st); uErr.Error() != st.Err().Error() {
+		return uErr
+	}
+	return connErrToFault(st, target)
+}
+
+// recvEvents receives events from the stream and passes them to the supplied
+// function until the stream ends.
+func recvEvents(stream mgmtpb.MgmtSvc_SystemStreamEventsClient, target string, recv func(proto.Message)) error {
+	for {
+		pbEvt, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return streamRecvErr(err, target)
+		}
+
+		recv(pbEvt)
+	}
+}
+
+// SystemStreamEvents subscribes to RAS events raised on the MS leader and
+// passes each received event to the supplied handler. The call blocks until
+// the context is canceled, at which point nil is returned. If the stream is
+// interrupted by a change of MS leader, the subscription is resumed on the
+// new leader; events raised in the meantime are not delivered.
+func SystemStreamEvents(ctx context.Context, rpcClient StreamInvoker, req *SystemStreamEventsReq, handler events.Handler) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if handler == nil {
+		return errors.New("nil event handler")
+	}
+
+	pbReq := &mgmtpb.SystemStreamEventsReq{
+		Sys:      req.getSystem(rpcClient),
+		Type:     req.Type.Uint32(),
+		Severity: req.Severity.Uint32(),
+	}
+	for _, id := range req.IDs {
+		pbReq.Ids = append(pbReq.Ids, id.Uint32())
+	}
+
+	// Only attempt to resubscribe after a connection failure if a
+	// subscription has previously been established, otherwise report
+	// the failure.
+	var subscribed atomic.Bool
+	req.retryTestFn = func(err error, _ uint) bool {
+		return subscribed.Load() && (IsConnErr(err) || status.Code(errors.Cause(err)) == codes.Unavailable)
+	}
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn, recv func(proto.Message)) (proto.Message, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemStreamEvents(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+
+		// The MS leader sends a header once the subscription has been
+		// registered, so a nil header indicates that the stream failed.
+		if md, _ := stream.Header(); md != nil {
+			rpcClient.Debugf("subscribed to RAS events on %s", conn.Target())
+			subscribed.Store(true)
+		}
+
+		if err := recvEvents(stream, conn.Target(), recv); err != nil {
+			return nil, err
+		}
+		return new(sharedpb.RASEvent), nil
+	})
+
+	rpcClient.Debugf("DAOS SystemStreamEvents request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeStreamRPC(ctx, req, func(addr string, msg proto.Message) {
+		pbEvt, ok := msg.(*sharedpb.RASEvent)
+		if !ok {
+			return
+		}
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			rpcClient.Debugf("failed to convert event from %s: %s", addr, err)
+			return
+		}
+		handler.OnEvent(ctx, evt)
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := ur.getMSResponse(); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// EventPolicy describes the runtime publication policy for a RAS event ID.
+// Disabled events are not published by the MS leader. Duplicate events, those
+// with the same ID relating to the same host, rank, pool and container, are
+// suppressed until Cooldown has elapsed if Debounce is set (a zero Cooldown
+// means that a duplicate is never published).
+type EventPolicy struct {
+	ID       events.RASID
+	Disabled bool
+	Debounce bool
+	Cooldown time.Duration
+}
+
+// MarshalJSON outputs the event ID and cooldown in human readable form.
+func (p *EventPolicy) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(&struct {
+		ID       string `json:"id"`
+		Disabled bool   `json:"disabled"`
+		Debounce bool   `json:"debounce"`
+		Cooldown string `json:"cooldown"`
+	}{
+		ID:       p.ID.String(),
+		Disabled: p.Disabled,
+		Debounce: p.Debounce,
+		Cooldown: p.Cooldown.String(),
+	})
+}
+
+// SystemSetEventPolicyReq contains the inputs for a request to update the RAS
+// event policy. Each supplied policy replaces any existing policy for the same
+// event ID, a policy with neither Disabled nor Debounce set removes it.
+type SystemSetEventPolicyReq struct {
+	unaryRequest
+	msRequest
+
+	Policies []*EventPolicy
+}
+
+// SystemSetEventPolicy updates the RAS event policy applied by the MS leader.
+// The policy is stored in the system database and so persists across leader
+// changes.
+func SystemSetEventPolicy(ctx context.Context, rpcClient UnaryInvoker, req *SystemSetEventPolicyReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if len(req.Policies) == 0 {
+		return errors.New("policies cannot be empty")
+	}
+
+	pbReq := &mgmtpb.SystemSetEventPolicyReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	for _, p := range req.Policies {
+		if p.Cooldown < 0 {
+			return errors.Errorf("cooldown for %s events must not be negative", p.ID)
+		}
+		pbReq.Policies = append(pbReq.Policies, &mgmtpb.EventPolicy{
+			Id:       p.ID.Uint32(),
+			Disabled: p.Disabled,
+			Debounce: p.Debounce,
+			Cooldown: uint64(p.Cooldown),
+		})
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSetEventPolicy(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemSetEventPolicy request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+type (
+	// SystemGetEventPolicyReq contains the inputs for a request to retrieve
+	// the RAS event policy.
+	SystemGetEventPolicyReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemGetEventPolicyResp contains the policies for each event ID that
+	// has one, in ID order.
+	SystemGetEventPolicyResp struct {
+		Policies []*EventPolicy `json:"policies"`
+	}
+)
+
+// SystemGetEventPolicy retrieves the RAS event policy stored in the system
+// database.
+func SystemGetEventPolicy(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetEventPolicyReq) (*SystemGetEventPolicyResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemGetEventPolicyReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemGetEventPolicy(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemGetEventPolicy request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemGetEventPolicyResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	resp := &SystemGetEventPolicyResp{
+		Policies: make([]*EventPolicy, 0, len(pbResp.Policies)),
+	}
+	for _, pbPolicy := range pbResp.Policies {
+		resp.Policies = append(resp.Policies, &EventPolicy{
+			ID:       events.RASID(pbPolicy.Id),
+			Disabled: pbPolicy.Disabled,
+			Debounce: pbPolicy.Debounce,
+			Cooldown: time.Duration(pbPolicy.Cooldown),
+		})
+	}
+
+	return resp, nil
+}
+
 // EventForwarder implements the events.Handler interface, increments sequence
 // number for each event forwarded and distributes requests to MS access points.
 type EventForwarder struct {
+	sync.RWMutex
 	seq       <-chan uint64
 	client    UnaryInvoker
 	accessPts []string
 }
 
+// SetAccessPoints updates the MS access points that events are forwarded to.
+func (ef *EventForwarder) SetAccessPoints(accessPts []string) {
+	ef.Lock()
+	defer ef.Unlock()
+
+	ef.accessPts = accessPts
+}
+
 // OnEvent implements the events.Handler interface.
 func (ef *EventForwarder) OnEvent(ctx context.Context, evt *events.RASEvent) {
+	ef.RLock()
+	accessPts := ef.accessPts
+	ef.RUnlock()
+
 	switch {
 	case evt == nil:
 		ef.client.Debug("skip event forwarding, nil event")
 		return
-	case len(ef.accessPts) == 0:
+	case len(accessPts) == 0:
 		ef.client.Debug("skip event forwarding, missing access points")
 		return
 	case !evt.ShouldForward():
@@ -89,7 +469,7 @@ func (ef *EventForwarder) OnEvent(ctx context.Context, evt *events.RASEvent) {
 		return
 	}
 
-	if err := eventNotify(ctx, ef.client, <-ef.seq, evt, ef.accessPts); err != nil {
+	if err := eventNotify(ctx, ef.client, <-ef.seq, evt, accessPts); err != nil {
 		ef.client.Debugf("failed to forward event to MS: %s", err)
 	}
 }
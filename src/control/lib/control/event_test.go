@@ -7,21 +7,34 @@
 package control
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"log/syslog"
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common"
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 func mockEvtEngineDied(t *testing.T) *events.RASEvent {
@@ -84,6 +97,396 @@ func TestControl_eventNotify(t *testing.T) {
 	}
 }
 
+func TestControl_SystemGetEvents(t *testing.T) {
+	evt := mockEvtEngineDied(t)
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		req     *SystemGetEventsReq
+		mic     *MockInvokerConfig
+		expResp *SystemGetEventsResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"negative limit": {
+			req:    &SystemGetEventsReq{Limit: -1},
+			expErr: errors.New("limit must not be negative"),
+		},
+		"until before since": {
+			req: &SystemGetEventsReq{
+				Since: now,
+				Until: now.Add(-time.Minute),
+			},
+			expErr: errors.New("until time must not be before since"),
+		},
+		"req fails": {
+			req: &SystemGetEventsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no events": {
+			req: &SystemGetEventsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemGetEventsResp{}),
+				},
+			},
+			expResp: &SystemGetEventsResp{
+				Events: []*events.RASEvent{},
+			},
+		},
+		"success": {
+			req: &SystemGetEventsReq{
+				Ranks:    ranklist.MustCreateRankSet("0-3"),
+				Severity: events.RASSeverityError,
+				IDs:      []events.RASID{events.RASEngineDied},
+				Since:    now.Add(-time.Hour),
+				Until:    now,
+				Limit:    10,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemGetEventsResp{
+						Events: []*sharedpb.RASEvent{pbEvt},
+					}),
+				},
+			},
+			expResp: &SystemGetEventsResp{
+				Events: []*events.RASEvent{evt},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemGetEvents(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(events.RASEvent{}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+type mockEventStream struct {
+	grpc.ClientStream
+	evts []*sharedpb.RASEvent
+	err  error
+}
+
+func (ms *mockEventStream) Recv() (*sharedpb.RASEvent, error) {
+	if len(ms.evts) == 0 {
+		if ms.err != nil {
+			return nil, ms.err
+		}
+		return nil, io.EOF
+	}
+
+	evt := ms.evts[0]
+	ms.evts = ms.evts[1:]
+	return evt, nil
+}
+
+func TestControl_recvEvents(t *testing.T) {
+	evt := mockEvtEngineDied(t)
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		stream  *mockEventStream
+		expEvts []*events.RASEvent
+		expErr  error
+	}{
+		"end of stream": {
+			stream: &mockEventStream{},
+		},
+		"events received": {
+			stream: &mockEventStream{
+				evts: []*sharedpb.RASEvent{pbEvt, pbEvt},
+			},
+			expEvts: []*events.RASEvent{evt, evt},
+		},
+		"not leader": {
+			stream: &mockEventStream{
+				evts: []*sharedpb.RASEvent{pbEvt},
+				err: pbUtil.AnnotateError(&system.ErrNotLeader{
+					LeaderHint: "host2",
+				}),
+			},
+			expEvts: []*events.RASEvent{evt},
+			expErr:  &system.ErrNotLeader{LeaderHint: "host2"},
+		},
+		"connection closed": {
+			stream: &mockEventStream{
+				err: status.Error(codes.Unavailable, "transport is closing"),
+			},
+			expErr: FaultConnectionClosed("host1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotEvts []*events.RASEvent
+			gotErr := recvEvents(tc.stream, "host1", func(msg proto.Message) {
+				evt, err := events.NewFromProto(msg.(*sharedpb.RASEvent))
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotEvts = append(gotEvts, evt)
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(events.RASEvent{}),
+			}
+			if diff := cmp.Diff(tc.expEvts, gotEvts, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemStreamEvents(t *testing.T) {
+	var gotEvts int
+	countHandler := events.HandlerFunc(func(context.Context, *events.RASEvent) {
+		gotEvts++
+	})
+	mockPBEvt, err := mockEvtEngineDied(t).ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req     *SystemStreamEventsReq
+		handler events.Handler
+		mic     *MockInvokerConfig
+		expEvts int
+		expErr  error
+	}{
+		"nil req": {
+			handler: countHandler,
+			expErr:  errors.New("nil"),
+		},
+		"nil handler": {
+			req:    &SystemStreamEventsReq{},
+			expErr: errors.New("nil event handler"),
+		},
+		"req fails": {
+			req:     &SystemStreamEventsReq{},
+			handler: countHandler,
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"connection failure before subscription": {
+			req:     &SystemStreamEventsReq{},
+			handler: countHandler,
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", FaultConnectionRefused("host1"), nil),
+				},
+			},
+			expErr: FaultConnectionRefused("host1"),
+		},
+		"events received": {
+			req:     &SystemStreamEventsReq{},
+			handler: countHandler,
+			mic: &MockInvokerConfig{
+				StreamMessages: []*HostResponse{
+					{Addr: "host1", Message: mockPBEvt},
+					{Addr: "host1", Message: mockPBEvt},
+				},
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &sharedpb.RASEvent{}),
+				},
+			},
+			expEvts: 2,
+		},
+		"stream ends": {
+			req: &SystemStreamEventsReq{
+				Type:     events.RASTypeStateChange,
+				Severity: events.RASSeverityWarning,
+				IDs:      []events.RASID{events.RASEngineDied},
+			},
+			handler: countHandler,
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &sharedpb.RASEvent{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			gotEvts = 0
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemStreamEvents(test.Context(t), client, tc.req, tc.handler)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expEvts, gotEvts, "unexpected number of events")
+		})
+	}
+}
+
+func TestControl_SystemSetEventPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SystemSetEventPolicyReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no policies": {
+			req:    &SystemSetEventPolicyReq{},
+			expErr: errors.New("policies cannot be empty"),
+		},
+		"negative cooldown": {
+			req: &SystemSetEventPolicyReq{
+				Policies: []*EventPolicy{
+					{ID: events.RASEngineFormatRequired, Debounce: true, Cooldown: -time.Second},
+				},
+			},
+			expErr: errors.New("must not be negative"),
+		},
+		"req fails": {
+			req: &SystemSetEventPolicyReq{
+				Policies: []*EventPolicy{
+					{ID: events.RASEngineFormatRequired, Disabled: true},
+				},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemSetEventPolicyReq{
+				Policies: []*EventPolicy{
+					{ID: events.RASEngineFormatRequired, Disabled: true},
+					{ID: events.RASNVMeLinkSpeedChanged, Debounce: true, Cooldown: time.Minute},
+				},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemSetEventPolicy(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemGetEventPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemGetEventPolicyReq
+		mic     *MockInvokerConfig
+		expResp *SystemGetEventPolicyResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemGetEventPolicyReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemGetEventPolicyReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemGetEventPolicyResp{
+						Policies: []*mgmtpb.EventPolicy{
+							{Id: events.RASEngineFormatRequired.Uint32(), Disabled: true},
+							{
+								Id:       events.RASNVMeLinkSpeedChanged.Uint32(),
+								Debounce: true,
+								Cooldown: uint64(time.Minute),
+							},
+						},
+					}),
+				},
+			},
+			expResp: &SystemGetEventPolicyResp{
+				Policies: []*EventPolicy{
+					{ID: events.RASEngineFormatRequired, Disabled: true},
+					{ID: events.RASNVMeLinkSpeedChanged, Debounce: true, Cooldown: time.Minute},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemGetEventPolicy(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_EventPolicy_MarshalJSON(t *testing.T) {
+	p := &EventPolicy{
+		ID:       events.RASNVMeLinkSpeedChanged,
+		Debounce: true,
+		Cooldown: 5 * time.Minute,
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expJSON := `{"id":"device_link_speed_changed","disabled":false,"debounce":true,"cooldown":"5m0s"}`
+	test.AssertEqual(t, expJSON, string(data), "unexpected JSON")
+}
+
 func TestControl_EventForwarder_OnEvent(t *testing.T) {
 	rasEventEngineDied := mockEvtEngineDied(t).WithForwardable(false)
 	rasEventEngineDiedFwdable := mockEvtEngineDied(t).WithForwardable(true)
@@ -57,6 +57,7 @@ type (
 		UnaryResponseSet    []*UnaryResponse
 		UnaryResponseDelays [][]time.Duration
 		HostResponses       HostResponseChan
+		StreamMessages      []*HostResponse // intermediate messages passed to stream handlers
 		ReqTimeout          time.Duration
 		RetryTimeout        time.Duration
 	}
@@ -183,6 +184,15 @@ func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryReques
 	return responses, nil
 }
 
+func (mi *MockInvoker) InvokeStreamRPC(ctx context.Context, sReq StreamRequest, handler HostStreamHandler) (*UnaryResponse, error) {
+	if handler != nil {
+		for _, hr := range mi.cfg.StreamMessages {
+			handler(hr.Addr, hr.Message)
+		}
+	}
+	return invokeStreamRPC(ctx, mi, sReq, handler)
+}
+
 func (mi *MockInvoker) SetConfig(_ *Config) {}
 
 // DefaultMockInvokerConfig returns the default MockInvoker
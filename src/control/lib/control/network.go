@@ -241,6 +241,7 @@ type (
 		ClientNetHint           ClientNetworkHint     `json:"client_net_hint"`
 		AlternateClientNetHints []ClientNetworkHint   `json:"secondary_client_net_hints"`
 		BuildInfo               BuildInfo             `json:"build_info"`
+		AccessPoints            []string              `json:"access_points"`
 	}
 )
 
@@ -315,6 +315,7 @@ func TestControl_GetAttachInfo(t *testing.T) {
 					ClientNetHint: &mgmtpb.ClientNetHint{
 						Provider: "cow",
 					},
+					AccessPoints: []string{"host1:10001", "host2:10001"},
 				}),
 			},
 			req: &GetAttachInfoReq{},
@@ -328,6 +329,7 @@ func TestControl_GetAttachInfo(t *testing.T) {
 				ClientNetHint: ClientNetworkHint{
 					Provider: "cow",
 				},
+				AccessPoints: []string{"host1:10001", "host2:10001"},
 			},
 		},
 	} {
@@ -290,7 +290,9 @@ func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCre
 	if in.userExt == nil {
 		in.userExt = &auth.External{}
 	}
-	// ensure pool ownership is set up correctly
+	// ensure pool ownership is set up correctly; the server may replace a
+	// default owner with one mapped from the caller's certificate
+	userDefault, groupDefault := in.User == "", in.UserGroup == ""
 	in.User, in.UserGroup, err = formatNameGroup(in.userExt, in.User, in.UserGroup)
 	if err != nil {
 		return
@@ -310,6 +312,8 @@ func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCre
 	}
 
 	out.Uuid = uuid.New().String()
+	out.UserDefault = userDefault
+	out.GroupDefault = groupDefault
 	return
 }
 
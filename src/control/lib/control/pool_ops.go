@@ -0,0 +1,258 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+type (
+	// PoolOpScheduleReq contains the inputs for a request to queue a
+	// rebuild-triggering pool operation to be run by the MS within a time
+	// window. Exactly one operation must be supplied.
+	PoolOpScheduleReq struct {
+		poolRequest
+		ID          string
+		Exclude     *PoolExcludeReq
+		Drain       *PoolDrainReq
+		Reintegrate *PoolReintegrateReq
+		Extend      *PoolExtendReq
+		NotBefore   time.Time // start as soon as possible if unset
+		NotAfter    time.Time // no deadline if unset
+	}
+
+	// PoolOpScheduleResp contains the ID of the scheduled operation.
+	PoolOpScheduleResp struct {
+		OpID string `json:"op_id"`
+	}
+
+	// PoolOp describes a pool operation that has been queued by the MS.
+	PoolOp struct {
+		ID        string          `json:"id"`
+		PoolUUID  string          `json:"pool_uuid"`
+		Type      string          `json:"type"`
+		State     string          `json:"state"`
+		Ranks     []ranklist.Rank `json:"ranks"`
+		TargetIdx []uint32        `json:"target_idx"`
+		NotBefore time.Time       `json:"not_before"`
+		NotAfter  time.Time       `json:"not_after"`
+		Created   time.Time       `json:"created"`
+		Started   time.Time       `json:"started"`
+		Finished  time.Time       `json:"finished"`
+		Error     string          `json:"error,omitempty"`
+	}
+
+	// PoolOpListReq contains the inputs for a request to list the
+	// operations queued by the MS.
+	PoolOpListReq struct {
+		poolRequest
+		ID string // operations on all pools are returned if empty
+	}
+
+	// PoolOpListResp contains the queued operations.
+	PoolOpListResp struct {
+		Ops []*PoolOp `json:"ops"`
+	}
+
+	// PoolOpCancelReq contains the inputs for a request to cancel a queued
+	// pool operation.
+	PoolOpCancelReq struct {
+		poolRequest
+		ID   string
+		OpID string
+	}
+)
+
+func (req *PoolOpScheduleReq) toPB(sys string) (*mgmtpb.PoolOpScheduleReq, error) {
+	pbReq := &mgmtpb.PoolOpScheduleReq{
+		Sys: sys,
+		Id:  req.ID,
+	}
+	if !req.NotBefore.IsZero() {
+		pbReq.NotBefore = req.NotBefore.Unix()
+	}
+	if !req.NotAfter.IsZero() {
+		pbReq.NotAfter = req.NotAfter.Unix()
+	}
+
+	var numOps int
+	if req.Exclude != nil {
+		numOps++
+		pbReq.Op = &mgmtpb.PoolOpScheduleReq_Exclude{
+			Exclude: &mgmtpb.PoolExcludeReq{
+				Sys:       sys,
+				Id:        req.ID,
+				Rank:      req.Exclude.Rank.Uint32(),
+				Targetidx: req.Exclude.Targetidx,
+			},
+		}
+	}
+	if req.Drain != nil {
+		numOps++
+		pbReq.Op = &mgmtpb.PoolOpScheduleReq_Drain{
+			Drain: &mgmtpb.PoolDrainReq{
+				Sys:       sys,
+				Id:        req.ID,
+				Rank:      req.Drain.Rank.Uint32(),
+				Targetidx: req.Drain.Targetidx,
+			},
+		}
+	}
+	if req.Reintegrate != nil {
+		numOps++
+		pbReq.Op = &mgmtpb.PoolOpScheduleReq_Reintegrate{
+			Reintegrate: &mgmtpb.PoolReintegrateReq{
+				Sys:       sys,
+				Id:        req.ID,
+				Rank:      req.Reintegrate.Rank.Uint32(),
+				Targetidx: req.Reintegrate.Targetidx,
+			},
+		}
+	}
+	if req.Extend != nil {
+		numOps++
+		extReq, err := genPoolExtendRequest(req.Extend)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate PoolExtend request")
+		}
+		extReq.Sys = sys
+		extReq.Id = req.ID
+		pbReq.Op = &mgmtpb.PoolOpScheduleReq_Extend{Extend: extReq}
+	}
+	if numOps != 1 {
+		return nil, errors.Errorf("exactly one pool operation must be supplied, got %d", numOps)
+	}
+
+	return pbReq, nil
+}
+
+// PoolOpSchedule queues a pool exclude, drain, reintegrate or extend operation
+// to be started by the MS within the requested time window.
+func PoolOpSchedule(ctx context.Context, rpcClient UnaryInvoker, req *PoolOpScheduleReq) (*PoolOpScheduleResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq, err := req.toPB(req.getSystem(rpcClient))
+	if err != nil {
+		return nil, err
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolOpSchedule(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Schedule DAOS pool operation request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "pool operation schedule failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.PoolOpScheduleResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	return &PoolOpScheduleResp{OpID: pbResp.OpId}, nil
+}
+
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// PoolOpList lists the operations queued by the MS on a pool, or on all
+// pools if no pool is specified.
+func PoolOpList(ctx context.Context, rpcClient UnaryInvoker, req *PoolOpListReq) (*PoolOpListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.PoolOpListReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolOpList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("List DAOS pool operations request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "pool operation list failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.PoolOpListResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	resp := new(PoolOpListResp)
+	for _, pbOp := range pbResp.GetOps() {
+		resp.Ops = append(resp.Ops, &PoolOp{
+			ID:        pbOp.GetId(),
+			PoolUUID:  pbOp.GetPoolUuid(),
+			Type:      pbOp.GetType(),
+			State:     pbOp.GetState(),
+			Ranks:     ranklist.RanksFromUint32(pbOp.GetRanks()),
+			TargetIdx: pbOp.GetTargetIdx(),
+			NotBefore: unixTime(pbOp.GetNotBefore()),
+			NotAfter:  unixTime(pbOp.GetNotAfter()),
+			Created:   unixTime(pbOp.GetCreated()),
+			Started:   unixTime(pbOp.GetStarted()),
+			Finished:  unixTime(pbOp.GetFinished()),
+			Error:     pbOp.GetError(),
+		})
+	}
+
+	return resp, nil
+}
+
+// PoolOpCancel cancels a queued pool operation that has not yet been started.
+func PoolOpCancel(ctx context.Context, rpcClient UnaryInvoker, req *PoolOpCancelReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.OpID == "" {
+		return errors.New("pool operation ID cannot be empty")
+	}
+
+	pbReq := &mgmtpb.PoolOpCancelReq{
+		Sys:  req.getSystem(rpcClient),
+		Id:   req.ID,
+		OpId: req.OpID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolOpCancel(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Cancel DAOS pool operation request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(ur.getMSError(), "pool operation cancel failed")
+}
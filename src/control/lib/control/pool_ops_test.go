@@ -0,0 +1,258 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_PoolOpScheduleReq_toPB(t *testing.T) {
+	notBefore := time.Unix(1700000000, 0)
+
+	for name, tc := range map[string]struct {
+		req    *PoolOpScheduleReq
+		expReq *mgmtpb.PoolOpScheduleReq
+		expErr error
+	}{
+		"no operation": {
+			req:    &PoolOpScheduleReq{ID: test.MockUUID()},
+			expErr: errors.New("got 0"),
+		},
+		"multiple operations": {
+			req: &PoolOpScheduleReq{
+				ID:      test.MockUUID(),
+				Exclude: &PoolExcludeReq{Rank: 1},
+				Drain:   &PoolDrainReq{Rank: 1},
+			},
+			expErr: errors.New("got 2"),
+		},
+		"drain": {
+			req: &PoolOpScheduleReq{
+				ID:        test.MockUUID(),
+				Drain:     &PoolDrainReq{Rank: 1, Targetidx: []uint32{2}},
+				NotBefore: notBefore,
+			},
+			expReq: &mgmtpb.PoolOpScheduleReq{
+				Sys: "daos_server",
+				Id:  test.MockUUID(),
+				Op: &mgmtpb.PoolOpScheduleReq_Drain{
+					Drain: &mgmtpb.PoolDrainReq{
+						Sys:       "daos_server",
+						Id:        test.MockUUID(),
+						Rank:      1,
+						Targetidx: []uint32{2},
+					},
+				},
+				NotBefore: notBefore.Unix(),
+			},
+		},
+		"extend": {
+			req: &PoolOpScheduleReq{
+				ID:       test.MockUUID(),
+				Extend:   &PoolExtendReq{Ranks: []ranklist.Rank{3, 4}},
+				NotAfter: notBefore,
+			},
+			expReq: &mgmtpb.PoolOpScheduleReq{
+				Sys: "daos_server",
+				Id:  test.MockUUID(),
+				Op: &mgmtpb.PoolOpScheduleReq_Extend{
+					Extend: &mgmtpb.PoolExtendReq{
+						Sys:   "daos_server",
+						Id:    test.MockUUID(),
+						Ranks: []uint32{3, 4},
+					},
+				},
+				NotAfter: notBefore.Unix(),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotReq, gotErr := tc.req.toPB("daos_server")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReq, gotReq, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolOpSchedule(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *PoolOpScheduleReq
+		mic     *MockInvokerConfig
+		expResp *PoolOpScheduleResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &PoolOpScheduleReq{ID: test.MockUUID(), Drain: &PoolDrainReq{}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &PoolOpScheduleReq{ID: test.MockUUID(), Drain: &PoolDrainReq{}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.PoolOpScheduleResp{OpId: test.MockUUID(1)}),
+				},
+			},
+			expResp: &PoolOpScheduleResp{OpID: test.MockUUID(1)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := PoolOpSchedule(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolOpList(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+
+	for name, tc := range map[string]struct {
+		req     *PoolOpListReq
+		mic     *MockInvokerConfig
+		expResp *PoolOpListResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &PoolOpListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &PoolOpListReq{ID: test.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.PoolOpListResp{
+						Ops: []*mgmtpb.PoolOp{
+							{
+								Id:       test.MockUUID(1),
+								PoolUuid: test.MockUUID(),
+								Type:     "drain",
+								State:    "failed",
+								Ranks:    []uint32{2},
+								Created:  created.Unix(),
+								Finished: created.Add(time.Minute).Unix(),
+								Error:    "bad things",
+							},
+						},
+					}),
+				},
+			},
+			expResp: &PoolOpListResp{
+				Ops: []*PoolOp{
+					{
+						ID:       test.MockUUID(1),
+						PoolUUID: test.MockUUID(),
+						Type:     "drain",
+						State:    "failed",
+						Ranks:    []ranklist.Rank{2},
+						Created:  created,
+						Finished: created.Add(time.Minute),
+						Error:    "bad things",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := PoolOpList(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolOpCancel(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *PoolOpCancelReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"empty op ID": {
+			req:    &PoolOpCancelReq{ID: test.MockUUID()},
+			expErr: errors.New("ID cannot be empty"),
+		},
+		"req fails": {
+			req: &PoolOpCancelReq{ID: test.MockUUID(), OpID: test.MockUUID(1)},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &PoolOpCancelReq{ID: test.MockUUID(), OpID: test.MockUUID(1)},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := PoolOpCancel(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
@@ -0,0 +1,406 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// PoolRedunLevelRank sets the rank as the unit of failure for a pool's
+	// redundancy factor.
+	PoolRedunLevelRank = "rank"
+	// PoolRedunLevelNode sets the node (the fault domain a rank belongs to) as
+	// the unit of failure for a pool's redundancy factor.
+	PoolRedunLevelNode = "node"
+)
+
+type (
+	// PoolPlanReq contains the parameters of a simulated pool create. Storage
+	// sizes are specified as for a PoolCreateReq.
+	PoolPlanReq struct {
+		// auto-config params
+		TotalBytes uint64
+		TierRatio  []float64
+		NumRanks   uint32
+		// manual params
+		Ranks     []ranklist.Rank
+		TierBytes []uint64
+		// redundancy params
+		RedunFac   uint32
+		RedunLevel string
+	}
+
+	// PoolPlanRank describes the space available on a rank and the space that
+	// the planned pool would consume on it.
+	PoolPlanRank struct {
+		Rank        ranklist.Rank `json:"rank"`
+		FaultDomain string        `json:"fault_domain"`
+		ScmFree     uint64        `json:"scm_free"`
+		NvmeFree    uint64        `json:"nvme_free"`
+		ScmBytes    uint64        `json:"scm_bytes"`
+		NvmeBytes   uint64        `json:"nvme_bytes"`
+	}
+
+	// PoolPlanResp contains the result of a simulated pool create.
+	PoolPlanResp struct {
+		Feasible     bool            `json:"feasible"`
+		Reasons      []string        `json:"reasons"`
+		Ranks        []*PoolPlanRank `json:"ranks"`
+		TierBytes    []uint64        `json:"tier_bytes"`
+		RedunFac     uint32          `json:"rd_fac"`
+		RedunLevel   string          `json:"rd_lvl"`
+		FaultDomains int             `json:"fault_domains"`
+		// Number of ranks lost if the rd_fac largest fault domains fail.
+		RebuildRanks int `json:"rebuild_ranks"`
+		// Fraction of pool capacity that can be used while still leaving
+		// room to rebuild the data of the lost ranks.
+		RebuildFillLimit float64 `json:"rebuild_fill_limit"`
+	}
+)
+
+func subtractOrZero(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+// ScmHeadroom returns the SCM space left on the rank after the pool is created.
+func (ppr *PoolPlanRank) ScmHeadroom() uint64 {
+	return subtractOrZero(ppr.ScmFree, ppr.ScmBytes)
+}
+
+// NvmeHeadroom returns the NVMe space left on the rank after the pool is created.
+func (ppr *PoolPlanRank) NvmeHeadroom() uint64 {
+	return subtractOrZero(ppr.NvmeFree, ppr.NvmeBytes)
+}
+
+// RankList returns the ranks the pool would be created on.
+func (ppr *PoolPlanResp) RankList() ranklist.RankList {
+	ranks := make(ranklist.RankList, 0, len(ppr.Ranks))
+	for _, r := range ppr.Ranks {
+		ranks = append(ranks, r.Rank)
+	}
+	return ranks
+}
+
+func (ppr *PoolPlanResp) addReason(format string, args ...interface{}) {
+	ppr.Reasons = append(ppr.Reasons, fmt.Sprintf(format, args...))
+}
+
+// rankFailureDomains walks the fault domain tree built from the system members
+// and groups ranks by the fault domain that fails as a unit at the given
+// redundancy level.
+func rankFailureDomains(members system.Members, level string) (map[ranklist.Rank]string, error) {
+	tree := system.NewFaultDomainTree()
+	for _, m := range members {
+		if err := tree.AddDomain(system.MemberFaultDomain(m)); err != nil {
+			return nil, errors.Wrapf(err, "adding fault domain of rank %d", m.Rank)
+		}
+	}
+
+	domains := make(map[ranklist.Rank]string)
+	var walk func(node *system.FaultDomainTree, parent string)
+	walk = func(node *system.FaultDomainTree, parent string) {
+		if !node.IsLeaf() || node.IsRoot() {
+			for _, child := range node.Children {
+				walk(child, node.Domain.String())
+			}
+			return
+		}
+
+		var rank uint32
+		n, err := fmt.Sscanf(node.Domain.BottomLevel(), system.RankFaultDomainPrefix+"%d", &rank)
+		if err != nil || n != 1 {
+			return
+		}
+		if level == PoolRedunLevelRank {
+			domains[ranklist.Rank(rank)] = node.Domain.String()
+			return
+		}
+		domains[ranklist.Rank(rank)] = parent
+	}
+	walk(tree, "")
+
+	return domains, nil
+}
+
+// spreadRanks selects the requested number of ranks round-robin across fault
+// domains so that the pool spans as many domains as possible, preferring the
+// ranks with the most free space within each domain.
+func spreadRanks(candidates []*PoolPlanRank, nRanks int) []*PoolPlanRank {
+	byDomain := make(map[string][]*PoolPlanRank)
+	for _, c := range candidates {
+		byDomain[c.FaultDomain] = append(byDomain[c.FaultDomain], c)
+	}
+
+	domains := make([]string, 0, len(byDomain))
+	for d, ranks := range byDomain {
+		domains = append(domains, d)
+		sort.Slice(ranks, func(i, j int) bool {
+			if ranks[i].NvmeFree != ranks[j].NvmeFree {
+				return ranks[i].NvmeFree > ranks[j].NvmeFree
+			}
+			if ranks[i].ScmFree != ranks[j].ScmFree {
+				return ranks[i].ScmFree > ranks[j].ScmFree
+			}
+			return ranks[i].Rank < ranks[j].Rank
+		})
+	}
+	sort.Strings(domains)
+
+	selected := make([]*PoolPlanRank, 0, nRanks)
+	for len(selected) < nRanks {
+		for _, d := range domains {
+			if len(byDomain[d]) == 0 || len(selected) == nRanks {
+				continue
+			}
+			selected = append(selected, byDomain[d][0])
+			byDomain[d] = byDomain[d][1:]
+		}
+	}
+
+	return selected
+}
+
+// getRankFreeSpace returns the usable SCM and NVMe space of each rank that
+// reports mounted storage.
+func getRankFreeSpace(ctx context.Context, rpcClient UnaryInvoker) (map[ranklist.Rank]*PoolPlanRank, error) {
+	resp, err := StorageScan(ctx, rpcClient, &StorageScanReq{Usage: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.HostStorage) == 0 {
+		return nil, errors.New("Empty host storage response from StorageScan")
+	}
+
+	filterRank := newFilterRankFunc(nil)
+	rankNVMeFreeSpace := make(rankFreeSpaceMap)
+	free := make(map[ranklist.Rank]*PoolPlanRank)
+	for _, key := range resp.HostStorage.Keys() {
+		hostStorage := resp.HostStorage[key].HostStorage
+
+		for _, ns := range hostStorage.ScmNamespaces {
+			if ns.Mount == nil {
+				continue
+			}
+			if _, exists := free[ns.Mount.Rank]; exists {
+				return nil, errors.Errorf("Multiple SCM devices found for rank %d",
+					ns.Mount.Rank)
+			}
+			free[ns.Mount.Rank] = &PoolPlanRank{
+				Rank:    ns.Mount.Rank,
+				ScmFree: ns.Mount.UsableBytes,
+			}
+			rankNVMeFreeSpace[ns.Mount.Rank] = 0
+		}
+
+		if err := processNVMeSpaceStats(rpcClient, filterRank, hostStorage.NvmeDevices, rankNVMeFreeSpace); err != nil {
+			return nil, err
+		}
+	}
+
+	for rank, nvmeBytes := range rankNVMeFreeSpace {
+		free[rank].NvmeFree = nvmeBytes
+	}
+
+	return free, nil
+}
+
+// planRanks returns the ranks the pool would be created on, recording the
+// reasons why the requested ranks can't be used in the response.
+func planRanks(req *PoolPlanReq, candidates map[ranklist.Rank]*PoolPlanRank, resp *PoolPlanResp) []*PoolPlanRank {
+	available := make([]*PoolPlanRank, 0, len(candidates))
+	for _, c := range candidates {
+		available = append(available, c)
+	}
+	sort.Slice(available, func(i, j int) bool { return available[i].Rank < available[j].Rank })
+
+	switch {
+	case len(req.Ranks) > 0:
+		ranks := ranklist.RankSetFromRanks(req.Ranks).Ranks()
+		selected := make([]*PoolPlanRank, 0, len(ranks))
+		for _, r := range ranks {
+			c, found := candidates[r]
+			if !found {
+				resp.addReason("rank %d is not available or has no mounted storage", r)
+				continue
+			}
+			selected = append(selected, c)
+		}
+		return selected
+	case req.NumRanks > 0:
+		if int(req.NumRanks) > len(available) {
+			resp.addReason("%d ranks requested but only %d available", req.NumRanks,
+				len(available))
+			return available
+		}
+		selected := spreadRanks(available, int(req.NumRanks))
+		sort.Slice(selected, func(i, j int) bool { return selected[i].Rank < selected[j].Rank })
+		return selected
+	default:
+		return available
+	}
+}
+
+// planRedundancy checks that the pool spans enough fault domains for the
+// redundancy factor and calculates how much of the pool can be filled while
+// leaving room to rebuild the data of the ranks in the largest failed domains.
+func planRedundancy(ranks []*PoolPlanRank, resp *PoolPlanResp) {
+	domainSizes := make(map[string]int)
+	for _, r := range ranks {
+		domainSizes[r.FaultDomain]++
+	}
+	resp.FaultDomains = len(domainSizes)
+
+	if resp.RedunFac > 0 && uint32(resp.FaultDomains) <= resp.RedunFac {
+		resp.addReason("rd_fac:%d requires at least %d %s fault domains but pool would span %d",
+			resp.RedunFac, resp.RedunFac+1, resp.RedunLevel, resp.FaultDomains)
+	}
+
+	sizes := make([]int, 0, len(domainSizes))
+	for _, size := range domainSizes {
+		sizes = append(sizes, size)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+	for i := 0; i < int(resp.RedunFac) && i < len(sizes); i++ {
+		resp.RebuildRanks += sizes[i]
+	}
+
+	if len(ranks) > resp.RebuildRanks {
+		resp.RebuildFillLimit = float64(len(ranks)-resp.RebuildRanks) / float64(len(ranks))
+	}
+}
+
+// PoolPlan simulates the creation of a pool with the requested size, tier ratio
+// and redundancy factor against the storage currently free on each available
+// rank and the system fault domain tree. The response reports whether the pool
+// would fit, the SCM and NVMe space it would consume on each rank and the share
+// of the pool that can be filled while still allowing data to be rebuilt after
+// the failure of rd_fac fault domains.
+//
+// When a number of ranks is requested, ranks are spread across fault domains.
+// As the server picks ranks at random in that case, the resulting rank list
+// should be supplied explicitly when creating the pool.
+func PoolPlan(ctx context.Context, rpcClient UnaryInvoker, req *PoolPlanReq) (*PoolPlanResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.RedunFac > daos.PoolRedunFacMax {
+		return nil, errors.Errorf("invalid rd_fac %d (valid values: 0-%d)", req.RedunFac,
+			daos.PoolRedunFacMax)
+	}
+	if req.RedunLevel == "" {
+		req.RedunLevel = PoolRedunLevelNode
+	}
+	if req.RedunLevel != PoolRedunLevelNode && req.RedunLevel != PoolRedunLevelRank {
+		return nil, errors.Errorf("invalid rd_lvl %q (valid values: %s, %s)", req.RedunLevel,
+			PoolRedunLevelNode, PoolRedunLevelRank)
+	}
+
+	sqResp, err := SystemQuery(ctx, rpcClient, &SystemQueryReq{})
+	if err != nil {
+		return nil, err
+	}
+	free, err := getRankFreeSpace(ctx, rpcClient)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := rankFailureDomains(sqResp.Members, req.RedunLevel)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make(map[ranklist.Rank]*PoolPlanRank)
+	for _, m := range sqResp.Members {
+		if m.State&system.AvailableMemberFilter == 0 {
+			continue
+		}
+		if c, found := free[m.Rank]; found {
+			c.FaultDomain = domains[m.Rank]
+			candidates[m.Rank] = c
+		}
+	}
+
+	resp := &PoolPlanResp{
+		RedunFac:   req.RedunFac,
+		RedunLevel: req.RedunLevel,
+	}
+	resp.Ranks = planRanks(req, candidates, resp)
+	if len(resp.Ranks) == 0 {
+		resp.addReason("no ranks available to create pool on")
+		return resp, nil
+	}
+
+	var nvmeFree uint64
+	for _, r := range resp.Ranks {
+		nvmeFree += r.NvmeFree
+	}
+
+	// Validate and resolve the requested sizes in the same way as a create.
+	pcReq := &PoolCreateReq{
+		TotalBytes: req.TotalBytes,
+		TierRatio:  append([]float64{}, req.TierRatio...),
+		TierBytes:  append([]uint64{}, req.TierBytes...),
+	}
+	if nvmeFree == 0 && len(pcReq.TierRatio) == 2 && pcReq.TotalBytes > 0 {
+		rpcClient.Debugf("no NVMe free on pool ranks; excluding NVMe from pool plan")
+		pcReq.TierRatio = []float64{1.00, 0.00}
+	}
+	getMaxPoolSz := func() (uint64, uint64, error) {
+		scmBytes, nvmeBytes := uint64(math.MaxUint64), uint64(math.MaxUint64)
+		for _, r := range resp.Ranks {
+			if r.ScmFree < scmBytes {
+				scmBytes = r.ScmFree
+			}
+			if r.NvmeFree < nvmeBytes {
+				nvmeBytes = r.NvmeFree
+			}
+		}
+		return scmBytes, nvmeBytes, nil
+	}
+	if err := poolCreateReqChkSizes(rpcClient, getMaxPoolSz, pcReq); err != nil {
+		return nil, err
+	}
+
+	resp.TierBytes = pcReq.TierBytes
+	if len(resp.TierBytes) == 0 {
+		resp.TierBytes = make([]uint64, len(pcReq.TierRatio))
+		for tierIdx := range resp.TierBytes {
+			resp.TierBytes[tierIdx] = uint64(float64(pcReq.TotalBytes)*pcReq.TierRatio[tierIdx]) /
+				uint64(len(resp.Ranks))
+		}
+	}
+
+	for _, r := range resp.Ranks {
+		r.ScmBytes, r.NvmeBytes = resp.TierBytes[0], resp.TierBytes[1]
+		if r.ScmBytes > r.ScmFree {
+			resp.addReason("rank %d: %s SCM required but %s free", r.Rank,
+				humanize.Bytes(r.ScmBytes), humanize.Bytes(r.ScmFree))
+		}
+		if r.NvmeBytes > r.NvmeFree {
+			resp.addReason("rank %d: %s NVMe required but %s free", r.Rank,
+				humanize.Bytes(r.NvmeBytes), humanize.Bytes(r.NvmeFree))
+		}
+	}
+
+	planRedundancy(resp.Ranks, resp)
+	resp.Feasible = len(resp.Reasons) == 0
+
+	return resp, nil
+}
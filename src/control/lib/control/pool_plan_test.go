@@ -0,0 +1,276 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_PoolPlan(t *testing.T) {
+	rankStorage := func(ranks ...ranklist.Rank) ([]MockScmConfig, []MockNvmeConfig) {
+		var scm []MockScmConfig
+		var nvme []MockNvmeConfig
+		for _, r := range ranks {
+			scm = append(scm, MockScmConfig{
+				MockStorageConfig: MockStorageConfig{
+					TotalBytes:  100 * humanize.GByte,
+					AvailBytes:  100 * humanize.GByte,
+					UsableBytes: 100 * humanize.GByte,
+				},
+				Rank: r,
+			})
+			nvme = append(nvme, MockNvmeConfig{
+				MockStorageConfig: MockStorageConfig{
+					TotalBytes:  1 * humanize.TByte,
+					AvailBytes:  1 * humanize.TByte,
+					UsableBytes: 1 * humanize.TByte,
+				},
+				Rank: r,
+			})
+		}
+		return scm, nvme
+	}
+	hosts := map[string][]ranklist.Rank{
+		"host1": {0, 1},
+		"host2": {2},
+		"host3": {3},
+	}
+	member := func(rank uint32, host string, state system.MemberState) *mgmtpb.SystemMember {
+		return &mgmtpb.SystemMember{
+			Rank:        rank,
+			Uuid:        test.MockUUID(int32(rank)),
+			State:       state.String(),
+			Addr:        "127.0.0.1:10001",
+			FaultDomain: "/" + host,
+		}
+	}
+	allJoined := []*mgmtpb.SystemMember{
+		member(0, "host1", system.MemberStateJoined),
+		member(1, "host1", system.MemberStateJoined),
+		member(2, "host2", system.MemberStateJoined),
+		member(3, "host3", system.MemberStateJoined),
+	}
+	planRank := func(rank ranklist.Rank, host string, scmBytes, nvmeBytes uint64) *PoolPlanRank {
+		return &PoolPlanRank{
+			Rank:        rank,
+			FaultDomain: "/" + host,
+			ScmFree:     100 * humanize.GByte,
+			NvmeFree:    1 * humanize.TByte,
+			ScmBytes:    scmBytes,
+			NvmeBytes:   nvmeBytes,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		members []*mgmtpb.SystemMember
+		req     *PoolPlanReq
+		expResp *PoolPlanResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invalid rd_fac": {
+			req:    &PoolPlanReq{TierBytes: []uint64{1, 1}, RedunFac: 5},
+			expErr: errors.New("invalid rd_fac"),
+		},
+		"invalid rd_lvl": {
+			req:    &PoolPlanReq{TierBytes: []uint64{1, 1}, RedunLevel: "rack"},
+			expErr: errors.New("invalid rd_lvl"),
+		},
+		"invalid size params": {
+			members: allJoined,
+			req:     &PoolPlanReq{TotalBytes: 1},
+			expErr:  errors.New("unexpected parameters"),
+		},
+		"auto-total; all ranks; rd_fac 1": {
+			members: allJoined,
+			req: &PoolPlanReq{
+				TotalBytes: 400 * humanize.GByte,
+				TierRatio:  []float64{0.25, 0.75},
+				RedunFac:   1,
+			},
+			expResp: &PoolPlanResp{
+				Feasible: true,
+				Ranks: []*PoolPlanRank{
+					planRank(0, "host1", 25*humanize.GByte, 75*humanize.GByte),
+					planRank(1, "host1", 25*humanize.GByte, 75*humanize.GByte),
+					planRank(2, "host2", 25*humanize.GByte, 75*humanize.GByte),
+					planRank(3, "host3", 25*humanize.GByte, 75*humanize.GByte),
+				},
+				TierBytes:        []uint64{25 * humanize.GByte, 75 * humanize.GByte},
+				RedunFac:         1,
+				RedunLevel:       PoolRedunLevelNode,
+				FaultDomains:     3,
+				RebuildRanks:     2,
+				RebuildFillLimit: 0.5,
+			},
+		},
+		"auto-total; too large": {
+			members: allJoined,
+			req: &PoolPlanReq{
+				TotalBytes: 1200 * humanize.GByte,
+				TierRatio:  []float64{0.5, 0.5},
+				Ranks:      []ranklist.Rank{2, 3},
+				RedunLevel: PoolRedunLevelRank,
+			},
+			expResp: &PoolPlanResp{
+				Reasons: []string{
+					"rank 2: 300 GB SCM required but 100 GB free",
+					"rank 3: 300 GB SCM required but 100 GB free",
+				},
+				Ranks: []*PoolPlanRank{
+					{
+						Rank:        2,
+						FaultDomain: "/host2/rank2",
+						ScmFree:     100 * humanize.GByte,
+						NvmeFree:    1 * humanize.TByte,
+						ScmBytes:    300 * humanize.GByte,
+						NvmeBytes:   300 * humanize.GByte,
+					},
+					{
+						Rank:        3,
+						FaultDomain: "/host3/rank3",
+						ScmFree:     100 * humanize.GByte,
+						NvmeFree:    1 * humanize.TByte,
+						ScmBytes:    300 * humanize.GByte,
+						NvmeBytes:   300 * humanize.GByte,
+					},
+				},
+				TierBytes:        []uint64{300 * humanize.GByte, 300 * humanize.GByte},
+				RedunLevel:       PoolRedunLevelRank,
+				FaultDomains:     2,
+				RebuildFillLimit: 1,
+			},
+		},
+		"nranks; spread across fault domains": {
+			members: allJoined,
+			req: &PoolPlanReq{
+				NumRanks:  2,
+				TierBytes: []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+				RedunFac:  1,
+			},
+			expResp: &PoolPlanResp{
+				Feasible: true,
+				Ranks: []*PoolPlanRank{
+					planRank(0, "host1", 10*humanize.GByte, 100*humanize.GByte),
+					planRank(2, "host2", 10*humanize.GByte, 100*humanize.GByte),
+				},
+				TierBytes:        []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+				RedunFac:         1,
+				RedunLevel:       PoolRedunLevelNode,
+				FaultDomains:     2,
+				RebuildRanks:     1,
+				RebuildFillLimit: 0.5,
+			},
+		},
+		"too many nranks": {
+			members: allJoined,
+			req: &PoolPlanReq{
+				NumRanks:  5,
+				TierBytes: []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+			},
+			expResp: &PoolPlanResp{
+				Reasons: []string{"5 ranks requested but only 4 available"},
+				Ranks: []*PoolPlanRank{
+					planRank(0, "host1", 10*humanize.GByte, 100*humanize.GByte),
+					planRank(1, "host1", 10*humanize.GByte, 100*humanize.GByte),
+					planRank(2, "host2", 10*humanize.GByte, 100*humanize.GByte),
+					planRank(3, "host3", 10*humanize.GByte, 100*humanize.GByte),
+				},
+				TierBytes:        []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+				RedunLevel:       PoolRedunLevelNode,
+				FaultDomains:     3,
+				RebuildFillLimit: 1,
+			},
+		},
+		"percentage; stopped rank excluded; rd_fac exceeds fault domains": {
+			members: []*mgmtpb.SystemMember{
+				member(0, "host1", system.MemberStateJoined),
+				member(1, "host1", system.MemberStateJoined),
+				member(2, "host2", system.MemberStateJoined),
+				member(3, "host3", system.MemberStateStopped),
+			},
+			req: &PoolPlanReq{
+				TierRatio: []float64{0.5, 0.5},
+				RedunFac:  2,
+			},
+			expResp: &PoolPlanResp{
+				Reasons: []string{
+					"rd_fac:2 requires at least 3 node fault domains but pool would span 2",
+				},
+				Ranks: []*PoolPlanRank{
+					planRank(0, "host1", 50*humanize.GByte, 500*humanize.GByte),
+					planRank(1, "host1", 50*humanize.GByte, 500*humanize.GByte),
+					planRank(2, "host2", 50*humanize.GByte, 500*humanize.GByte),
+				},
+				TierBytes:    []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+				RedunFac:     2,
+				RedunLevel:   PoolRedunLevelNode,
+				FaultDomains: 2,
+				RebuildRanks: 3,
+			},
+		},
+		"explicit ranks; unavailable rank": {
+			members: allJoined,
+			req: &PoolPlanReq{
+				Ranks:     []ranklist.Rank{5},
+				TierBytes: []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+			},
+			expResp: &PoolPlanResp{
+				Reasons: []string{
+					"rank 5 is not available or has no mounted storage",
+					"no ranks available to create pool on",
+				},
+				Ranks:      []*PoolPlanRank{},
+				RedunLevel: PoolRedunLevelNode,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			scanResp := &UnaryResponse{}
+			for _, host := range []string{"host1", "host2", "host3"} {
+				scm, nvme := rankStorage(hosts[host]...)
+				scanResp.Responses = append(scanResp.Responses, &HostResponse{
+					Addr:    host,
+					Message: MockStorageScanResp(t, scm, nvme),
+				})
+			}
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+						Members: tc.members,
+					}),
+					scanResp,
+				},
+			})
+
+			gotResp, gotErr := PoolPlan(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
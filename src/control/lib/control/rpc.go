@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2020-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -94,11 +94,37 @@ type (
 		InvokeUnaryRPCAsync(ctx context.Context, req UnaryRequest) (HostResponseChan, error)
 	}
 
+	// streamRPC defines the function signature for a closure that invokes
+	// a server-streaming gRPC method. Each intermediate message received
+	// on the stream is passed to the supplied function, and the final
+	// message or error is returned.
+	streamRPC func(context.Context, *grpc.ClientConn, func(proto.Message)) (proto.Message, error)
+
+	// HostStreamHandler defines the function signature for a callback
+	// that is invoked for each intermediate message received on a
+	// stream from the host with the given address.
+	HostStreamHandler func(addr string, msg proto.Message)
+
+	// StreamRequest defines an interface to be implemented by requests
+	// that invoke a server-streaming RPC.
+	StreamRequest interface {
+		UnaryRequest
+		setStreamHandler(HostStreamHandler)
+	}
+
+	// StreamInvoker defines an interface to be implemented by clients
+	// capable of invoking a server-streaming RPC (N intermediate messages
+	// followed by 1 response for 1 request).
+	StreamInvoker interface {
+		UnaryInvoker
+		InvokeStreamRPC(ctx context.Context, req StreamRequest, handler HostStreamHandler) (*UnaryResponse, error)
+	}
+
 	// Invoker defines an interface to be implemented by clients
 	// capable of invoking unary or stream RPCs.
 	Invoker interface {
 		UnaryInvoker
-		//TODO: StreamInvoker
+		StreamInvoker
 		SetConfig(*Config)
 	}
 )
@@ -120,13 +146,64 @@ func (r *unaryRequest) setRPC(rpc unaryRPC) {
 	r.rpc = rpc
 }
 
+// streamRequest is an embeddable struct to be used by requests which
+// implement the StreamRequest interface.
+type streamRequest struct {
+	unaryRequest
+	streamRPC streamRPC
+	handler   HostStreamHandler
+}
+
+// getRPC returns a unary RPC closure which consumes the request's stream,
+// passing intermediate messages to the stream handler and returning the
+// final message. If no stream RPC has been set, the request's unary RPC is
+// returned instead.
+func (r *streamRequest) getRPC() unaryRPC {
+	if r.streamRPC == nil {
+		return r.unaryRequest.getRPC()
+	}
+
+	return func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		addr := conn.Target()
+		return r.streamRPC(ctx, conn, func(msg proto.Message) {
+			if r.handler != nil {
+				r.handler(addr, msg)
+			}
+		})
+	}
+}
+
+// setStreamRPC sets the request's stream RPC closure.
+func (r *streamRequest) setStreamRPC(rpc streamRPC) {
+	r.streamRPC = rpc
+}
+
+// setStreamHandler sets the callback for intermediate stream messages.
+func (r *streamRequest) setStreamHandler(handler HostStreamHandler) {
+	r.handler = handler
+}
+
 type (
 	// Client implements the Invoker interface and should be provided to
 	// API methods to invoke RPCs.
 	Client struct {
-		config    *Config
-		log       debugLogger
-		component build.Component
+		configLock  sync.RWMutex
+		config      *Config
+		log         debugLogger
+		component   build.Component
+		cacheMS     bool
+		msCacheLock sync.Mutex
+		msLeader    string
+		msReplicas  []string
+	}
+
+	// msCacher is implemented by invokers that can remember the MS leader
+	// and replicas learned from previous requests.
+	msCacher interface {
+		cachesMS() bool
+		getMSCache() (string, []string)
+		setMSLeader(string)
+		setMSReplicas([]string)
 	}
 
 	// ClientOption defines the signature for functional Client options.
@@ -154,6 +231,16 @@ func WithConfig(cfg *Config) ClientOption {
 	}
 }
 
+// WithClientMSCache enables caching of the MS leader and replicas, so that
+// requests which don't specify a host list are sent straight to the last
+// known leader. Only leaders reported by a leader query or in a not-leader
+// redirect are cached.
+func WithClientMSCache() ClientOption {
+	return func(c *Client) {
+		c.cacheMS = true
+	}
+}
+
 // NewClient returns an initialized Client with its
 // parameters set by the provided ClientOption list.
 func NewClient(opts ...ClientOption) *Client {
@@ -189,13 +276,68 @@ func (c *Client) GetComponent() build.Component {
 // SetConfig sets the client configuration for an
 // existing Client.
 func (c *Client) SetConfig(cfg *Config) {
+	c.configLock.Lock()
+	defer c.configLock.Unlock()
+
 	c.config = cfg
+	c.clearMSCache()
+}
+
+// SetHostList replaces the default list of hosts that requests are sent to,
+// e.g. when the set of MS access points has changed.
+func (c *Client) SetHostList(hostList []string) {
+	c.configLock.Lock()
+	defer c.configLock.Unlock()
+
+	cfg := *c.config
+	cfg.HostList = hostList
+	c.config = &cfg
+	c.clearMSCache()
+}
+
+func (c *Client) getConfig() *Config {
+	c.configLock.RLock()
+	defer c.configLock.RUnlock()
+
+	return c.config
 }
 
 // GetConfig retrieves the system name from the client configuration and
 // implements the sysGetter interface.
 func (c *Client) GetSystem() string {
-	return c.config.SystemName
+	return c.getConfig().SystemName
+}
+
+// cachesMS returns true if the client caches the MS leader and replicas.
+func (c *Client) cachesMS() bool {
+	return c.cacheMS
+}
+
+// getMSCache returns the last known MS leader and replicas.
+func (c *Client) getMSCache() (string, []string) {
+	c.msCacheLock.Lock()
+	defer c.msCacheLock.Unlock()
+
+	return c.msLeader, c.msReplicas
+}
+
+func (c *Client) setMSLeader(addr string) {
+	c.msCacheLock.Lock()
+	defer c.msCacheLock.Unlock()
+
+	c.msLeader = addr
+}
+
+func (c *Client) setMSReplicas(replicas []string) {
+	c.msCacheLock.Lock()
+	defer c.msCacheLock.Unlock()
+
+	c.msReplicas = replicas
+}
+
+func (c *Client) clearMSCache() {
+	c.setMSLeader("")
+	c.setMSReplicas(nil)
 }
 
 func (c *Client) Debug(msg string) {
@@ -218,7 +360,7 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 		grpc.FailOnNonTempDialError(true),
 	}
 
-	creds, err := security.DialOptionForTransportConfig(c.config.TransportConfig)
+	creds, err := security.DialOptionForTransportConfig(c.getConfig().TransportConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +412,7 @@ func wrapReqTimeout(req UnaryRequest, err error) error {
 // provides access to a stream of HostResponse items as they are received, and
 // is closed when no more responses are expected.
 func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (HostResponseChan, error) {
-	hosts, err := getRequestHosts(c.config, req)
+	hosts, err := getRequestHosts(c.getConfig(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -315,6 +457,71 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 	return respChan, nil
 }
 
+// selectMSCandidates chooses a random subset of the default hostlist, with the
+// idea that at least one of them will be up and running enough to return
+// ErrNotReplica in order to learn the actual list of MS replicas. We may also
+// get lucky and send the request to a server that can handle the request
+// directly.
+func selectMSCandidates(defaultHosts []string) ([]string, error) {
+	rnd := rand.New(msCandidateRandSource)
+	msCandidates := hostlist.MustCreateSet("")
+
+	numCandidates := maxMSCandidates
+	if len(defaultHosts) < numCandidates {
+		numCandidates = len(defaultHosts)
+	}
+
+	for msCandidates.Count() < numCandidates {
+		if _, err := msCandidates.Insert(defaultHosts[rnd.Intn(len(defaultHosts))]); err != nil {
+			return nil, errors.Wrap(err, "failed to build MS candidates set")
+		}
+	}
+
+	return msCandidates.Slice(), nil
+}
+
+// refreshMSCache sends a leader query to the cached MS replicas, or to a
+// random set of candidates if none are cached, and updates the cache with the
+// result. The hosts to send the next MS request to are returned.
+func refreshMSCache(ctx context.Context, c UnaryInvoker, cacher msCacher, defaultHosts []string) ([]string, error) {
+	_, hosts := cacher.getMSCache()
+	if len(hosts) == 0 {
+		var err error
+		if hosts, err = selectMSCandidates(defaultHosts); err != nil {
+			return nil, err
+		}
+	}
+
+	req := new(LeaderQueryReq)
+	req.SetHostList(hosts)
+	resp, err := leaderQuery(ctx, c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	cacher.setMSReplicas(resp.Replicas)
+	if resp.Leader == "" {
+		cacher.setMSLeader("")
+		return resp.Replicas, nil
+	}
+	cacher.setMSLeader(resp.Leader)
+	return []string{resp.Leader}, nil
+}
+
+// findLeaderHint returns the leader hint from the first not-leader error in
+// the responses, if any.
+func findLeaderHint(ur *UnaryResponse) string {
+	for _, hr := range ur.Responses {
+		if hr == nil || hr.Error == nil {
+			continue
+		}
+		if nle, ok := errors.Cause(hr.Error).(*system.ErrNotLeader); ok && nle.LeaderHint != "" {
+			return nle.LeaderHint
+		}
+	}
+	return ""
+}
+
 // invokeUnaryRPC is the actual implementation which is called by the
 // real Client as well as the MockInvoker. This allows us to ensure that
 // the retry logic here gets adequate test coverage.
@@ -352,26 +559,33 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 		return ur, nil
 	}
 
+	// If the invoker remembers the MS leader from a previous request, send
+	// the request straight to it. If the cached leader turns out to be
+	// unavailable, the cache is refreshed and the request redirected. The
+	// cache is left alone for requests sent to a specific set of hosts.
+	var cacher msCacher
+	var cachedLeader string
 	if len(req.getHostList()) == 0 {
-		// For a MS request with no specific hostlist, choose a random subset
-		// of the default hostlist, with the idea that at least one of them
-		// will be up and running enough to return ErrNotReplica in order to
-		// learn the actual list of MS replicas. We may also get lucky and
-		// send the request to a server that can handle the request directly.
-		rnd := rand.New(msCandidateRandSource)
-		msCandidates := hostlist.MustCreateSet("")
-
-		numCandidates := maxMSCandidates
-		if len(defaultHosts) < numCandidates {
-			numCandidates = len(defaultHosts)
+		var cachedReplicas []string
+		if mc, ok := c.(msCacher); ok && mc.cachesMS() {
+			cacher = mc
+			cachedLeader, cachedReplicas = cacher.getMSCache()
 		}
 
-		for msCandidates.Count() < numCandidates {
-			if _, err := msCandidates.Insert(defaultHosts[rnd.Intn(len(defaultHosts))]); err != nil {
-				return nil, errors.Wrap(err, "failed to build MS candidates set")
+		switch {
+		case cachedLeader != "":
+			log.Debugf("sending MS request to cached leader %s", cachedLeader)
+			req.SetHostList([]string{cachedLeader})
+		case len(cachedReplicas) > 0:
+			log.Debugf("sending MS request to cached replicas %v", cachedReplicas)
+			req.SetHostList(cachedReplicas)
+		default:
+			candidates, err := selectMSCandidates(defaultHosts)
+			if err != nil {
+				return nil, err
 			}
+			req.SetHostList(candidates)
 		}
-		req.SetHostList(msCandidates.Slice())
 		if len(req.getHostList()) == 0 {
 			return nil, errors.New("unable to select MS candidates")
 		}
@@ -420,7 +634,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 	// to service the request. In this case we may get multiple responses, and
 	// we just return the first successful response as we assume that every
 	// replica is returning the same answer.
-	var try uint = 0
+	var try, redirects uint
 	for {
 		tryCtx := reqCtx
 		if tryTimeout := req.getRetryTimeout(); tryTimeout > 0 {
@@ -438,8 +652,44 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 		if isHardFailure(err, reqCtx) {
 			return nil, wrapReqTimeout(req, err)
 		}
+		tryTimedOut := isTimeout(err)
+
+		var msResp *HostResponse
+		msResp, err = ur.findMSResponse()
+		if err == nil {
+			// The replica that serviced the request isn't necessarily
+			// the leader, but any other replica that declined it will
+			// have said who the leader is.
+			if cacher != nil && cachedLeader == "" {
+				if hint := findLeaderHint(ur); hint != "" {
+					cacher.setMSLeader(hint)
+				}
+			}
+			if redirects > 0 {
+				log.Debugf("MS request serviced by %s after %d redirect(s)", msResp.Addr, redirects)
+			}
+		}
+
+		if cachedLeader != "" && (tryTimedOut || IsConnErr(err) || isTimeout(err)) && reqCtx.Err() == nil {
+			// The cached leader is gone; ask the replicas (or a fresh set
+			// of candidates) who the current leader is and redirect there.
+			log.Debugf("cached MS leader %s unavailable: %s", cachedLeader, err)
+			cacher.setMSLeader("")
+			cachedLeader = ""
+			hosts, rErr := refreshMSCache(reqCtx, c, cacher, defaultHosts)
+			if rErr != nil {
+				log.Debugf("failed to refresh MS leader: %s", rErr)
+				if hosts, rErr = selectMSCandidates(defaultHosts); rErr != nil {
+					return nil, rErr
+				}
+			}
+			req.SetHostList(hosts)
+			startHostList = hosts
+			redirects++
+			try++
+			continue
+		}
 
-		err = ur.getMSError()
 		// If the request specifies that the error is retryable,
 		// check to see if it also defines its own retry logic
 		// and run that if so. Otherwise, let the usual retry
@@ -461,6 +711,13 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			// send the retry. In the event that the hint was
 			// empty (as can happen during an election), just send
 			// the retry to all of the replicas.
+			redirects++
+			if cacher != nil {
+				cacher.setMSLeader(e.LeaderHint)
+				if len(e.Replicas) > 0 {
+					cacher.setMSReplicas(e.Replicas)
+				}
+			}
 			if e.LeaderHint == "" {
 				if len(e.Replicas) > 0 {
 					req.SetHostList(e.Replicas)
@@ -468,11 +725,27 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 				break
 			}
 			req.SetHostList([]string{e.LeaderHint})
+			if try == 0 {
+				// Follow a hint received in response to the first
+				// attempt right away rather than backing off, as
+				// the request was never handled. Hints received
+				// after other redirects or retries back off as
+				// usual in case the leadership is changing.
+				try++
+				continue
+			}
 		case *system.ErrNotReplica:
 			// If we went the request to a non-replica host, then
 			// the error should give us the list of replicas to try.
 			// One of them should be the current leader and will
 			// service the request.
+			redirects++
+			if cacher != nil {
+				cacher.setMSLeader("")
+				if len(e.Replicas) > 0 {
+					cacher.setMSReplicas(e.Replicas)
+				}
+			}
 			if len(e.Replicas) > 0 {
 				req.SetHostList(e.Replicas)
 			}
@@ -484,7 +757,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			}
 
 			// If the RPC handler is unimplemented, then we shouldn't retry.
-			if status.Code(errors.Cause(err)) == codes.Unimplemented {
+			if isUnimplemented(err) {
 				return nil, err
 			}
 
@@ -523,10 +796,44 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 	}
 }
 
+// isUnimplemented returns true if the error indicates that the server does
+// not implement the invoked gRPC method, e.g. because it is running an older
+// version.
+func isUnimplemented(err error) bool {
+	return status.Code(errors.Cause(err)) == codes.Unimplemented
+}
+
+// invokeStreamRPC is the implementation shared by the real Client and the
+// MockInvoker. The stream is consumed on each host via the unary invocation
+// path, so that fan-out, MS leader discovery and retries behave exactly as
+// they do for unary requests. Calls to the handler are serialized, so that
+// messages from streams on different hosts are never delivered concurrently.
+func invokeStreamRPC(ctx context.Context, c UnaryInvoker, req StreamRequest, handler HostStreamHandler) (*UnaryResponse, error) {
+	if handler != nil {
+		var handlerLock sync.Mutex
+		req.setStreamHandler(func(addr string, msg proto.Message) {
+			handlerLock.Lock()
+			defer handlerLock.Unlock()
+
+			handler(addr, msg)
+		})
+	}
+
+	return c.InvokeUnaryRPC(ctx, req)
+}
+
+// InvokeStreamRPC performs a synchronous (blocking) invocation of the request's
+// server-streaming RPC across all hosts in the request. Intermediate messages are
+// passed to the handler along with the address of the host that sent them as they
+// are received, and the response contains the final message or error for each host.
+func (c *Client) InvokeStreamRPC(ctx context.Context, req StreamRequest, handler HostStreamHandler) (*UnaryResponse, error) {
+	return invokeStreamRPC(ctx, c, req, handler)
+}
+
 // InvokeUnaryRPC performs a synchronous (blocking) invocation of the request's
 // RPC across all hosts in the request. The response contains a slice of HostResponse
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	return invokeUnaryRPC(ctx, c.log, c, req, c.getConfig().HostList)
 }
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +12,8 @@ import (
 	"os"
 	"runtime"
 	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
@@ -555,3 +558,198 @@ func TestControl_InvokeUnaryRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_InvokeUnaryRPC_MSLeaderCache(t *testing.T) {
+	// None of the default hosts is the leader, so requests sent to random
+	// MS candidates always have to be redirected.
+	var defaultHosts []string
+	for i := 0; i < maxMSCandidates*2; i++ {
+		defaultHosts = append(defaultHosts, fmt.Sprintf("host%02d:10001", i))
+	}
+	leaderHost := "host20:10001"
+	replicaHosts := []string{"host01:10001", leaderHost}
+
+	for name, tc := range map[string]struct {
+		hostList     []string
+		noCache      bool
+		notLeader    error
+		expLeader    string
+		expReplicas  []string
+		expTargets   []string
+		expRedirects uint
+	}{
+		"leader hint": {
+			notLeader: &system.ErrNotLeader{
+				LeaderHint: leaderHost,
+				Replicas:   replicaHosts,
+			},
+			expLeader:    leaderHost,
+			expReplicas:  replicaHosts,
+			expTargets:   []string{leaderHost},
+			expRedirects: 1,
+		},
+		"leader hint from replica that didn't service request": {
+			hostList: replicaHosts,
+			notLeader: &system.ErrNotLeader{
+				LeaderHint: leaderHost,
+				Replicas:   replicaHosts,
+			},
+			expLeader:  leaderHost,
+			expTargets: []string{leaderHost},
+		},
+		"not a replica": {
+			notLeader: &system.ErrNotReplica{
+				Replicas: replicaHosts,
+			},
+			expReplicas:  replicaHosts,
+			expTargets:   replicaHosts,
+			expRedirects: 1,
+		},
+		"caching not enabled": {
+			noCache: true,
+			notLeader: &system.ErrNotLeader{
+				LeaderHint: leaderHost,
+				Replicas:   replicaHosts,
+			},
+			expRedirects: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			clientCfg := DefaultConfig()
+			clientCfg.TransportConfig.AllowInsecure = true
+			clientCfg.HostList = defaultHosts
+			if tc.hostList != nil {
+				clientCfg.HostList = tc.hostList
+			}
+			opts := []ClientOption{WithConfig(clientCfg), WithClientLogger(log)}
+			if !tc.noCache {
+				opts = append(opts, WithClientMSCache())
+			}
+			client := NewClient(opts...)
+
+			var targetLock sync.Mutex
+			var targets []string
+			leaderOnly := func(_ context.Context, cc *grpc.ClientConn) (proto.Message, error) {
+				targetLock.Lock()
+				targets = append(targets, cc.Target())
+				targetLock.Unlock()
+
+				if cc.Target() != leaderHost {
+					return nil, tc.notLeader
+				}
+				return defaultMessage, nil
+			}
+
+			// Redirected requests are reported in the debug log.
+			redirectLogs := func() uint {
+				return uint(strings.Count(buf.String(), "after 1 redirect(s)"))
+			}
+
+			// Requests sent to specific hosts leave the cache alone.
+			req := &testRequest{toMS: true, rpcFn: leaderOnly}
+			req.SetHostList([]string{"host02:10001", "host03:10001"})
+			if _, err := client.InvokeUnaryRPC(test.Context(t), req); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, uint(1), redirectLogs(), "redirect count")
+			gotLeader, gotReplicas := client.getMSCache()
+			test.AssertEqual(t, "", gotLeader, "cached MS leader")
+			test.AssertEqual(t, 0, len(gotReplicas), "cached MS replicas")
+
+			// A request without hosts learns the leader and replicas
+			// from the replies of the replicas that declined it.
+			req = &testRequest{toMS: true, rpcFn: leaderOnly}
+			if _, err := client.InvokeUnaryRPC(test.Context(t), req); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, 1+tc.expRedirects, redirectLogs(), "redirect count")
+			gotLeader, gotReplicas = client.getMSCache()
+			test.AssertEqual(t, tc.expLeader, gotLeader, "cached MS leader")
+			if diff := cmp.Diff(tc.expReplicas, gotReplicas); diff != "" {
+				t.Fatalf("unexpected cached replicas (-want, +got):\n%s\n", diff)
+			}
+			if tc.noCache {
+				return
+			}
+
+			// The next request should go straight to the cached leader,
+			// or to the cached replicas if the leader isn't known.
+			targets = nil
+			req = &testRequest{toMS: true, rpcFn: leaderOnly}
+			if _, err := client.InvokeUnaryRPC(test.Context(t), req); err != nil {
+				t.Fatal(err)
+			}
+			sort.Strings(targets)
+			if diff := cmp.Diff(tc.expTargets, targets); diff != "" {
+				t.Fatalf("unexpected request targets (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, 1+tc.expRedirects, redirectLogs(), "redirect count")
+
+			// Changing the host list invalidates the cache.
+			client.SetHostList(clientCfg.HostList)
+			gotLeader, gotReplicas = client.getMSCache()
+			test.AssertEqual(t, "", gotLeader, "cached MS leader")
+			test.AssertEqual(t, 0, len(gotReplicas), "cached MS replicas")
+		})
+	}
+}
+
+func TestControl_refreshMSCache(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cachedReplicas []string
+		uResp          *UnaryResponse
+		expHosts       []string
+		expLeader      string
+		expReplicas    []string
+		expErr         error
+	}{
+		"leader known": {
+			cachedReplicas: []string{"host1:10001", "host2:10001"},
+			uResp: MockMSResponse("host1:10001", nil, &mgmtpb.LeaderQueryResp{
+				CurrentLeader: "host2:10001",
+				Replicas:      []string{"host1:10001", "host2:10001"},
+			}),
+			expHosts:    []string{"host2:10001"},
+			expLeader:   "host2:10001",
+			expReplicas: []string{"host1:10001", "host2:10001"},
+		},
+		"election in progress": {
+			uResp: MockMSResponse("host1:10001", nil, &mgmtpb.LeaderQueryResp{
+				Replicas: []string{"host1:10001", "host2:10001"},
+			}),
+			expHosts:    []string{"host1:10001", "host2:10001"},
+			expReplicas: []string{"host1:10001", "host2:10001"},
+		},
+		"query fails": {
+			uResp:  MockMSResponse("host1:10001", errors.New("whoops"), nil),
+			expErr: errors.New("whoops"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{UnaryResponse: tc.uResp})
+			client := NewClient(WithClientLogger(log))
+			client.setMSReplicas(tc.cachedReplicas)
+
+			gotHosts, gotErr := refreshMSCache(test.Context(t), mi, client, []string{"host1:10001"})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expHosts, gotHosts); diff != "" {
+				t.Fatalf("unexpected hosts (-want, +got):\n%s\n", diff)
+			}
+			gotLeader, gotReplicas := client.getMSCache()
+			test.AssertEqual(t, tc.expLeader, gotLeader, "cached MS leader")
+			if diff := cmp.Diff(tc.expReplicas, gotReplicas); diff != "" {
+				t.Fatalf("unexpected cached replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2020-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +9,7 @@ package control
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
@@ -287,10 +288,24 @@ func StorageScan(ctx context.Context, rpcClient UnaryInvoker, req *StorageScanRe
 type (
 	// StorageFormatReq contains the parameters for a storage format request.
 	StorageFormatReq struct {
-		unaryRequest
-		Reformat bool
+		streamRequest
+		Reformat   bool
+		progressCb StorageFormatProgressFn
+	}
+
+	// StorageFormatProgress describes a format step that has completed on
+	// one of the engines of a host.
+	StorageFormatProgress struct {
+		Addr      string
+		EngineIdx uint32
+		Message   string
+		Error     string
 	}
 
+	// StorageFormatProgressFn defines the function signature for a callback
+	// that is invoked for each format step as it completes.
+	StorageFormatProgressFn func(*StorageFormatProgress)
+
 	// StorageFormatResp contains the response from a storage format request.
 	StorageFormatResp struct {
 		HostErrorsResp
@@ -298,6 +313,13 @@ type (
 	}
 )
 
+// SetProgressCb sets a callback to be invoked with the progress of the format
+// on each host. Progress is only reported if both the client and the server
+// support streaming the format.
+func (req *StorageFormatReq) SetProgressCb(cb StorageFormatProgressFn) {
+	req.progressCb = cb
+}
+
 // addHostResponse is responsible for validating the given HostResponse
 // and adding it to the StorageFormatResp.
 func (sfr *StorageFormatResp) addHostResponse(hr *HostResponse) (err error) {
@@ -423,7 +445,9 @@ func checkFormatReq(ctx context.Context, rpcClient UnaryInvoker, req *StorageFor
 
 // StorageFormat concurrently performs storage preparation steps across
 // all hosts supplied in the request's hostlist, or all configured hosts
-// if not explicitly specified. The function blocks until all results
+// if not explicitly specified. If the client supports streaming RPCs,
+// progress is reported to the request's progress callback, if set, as each
+// host completes a format step. The function blocks until all results
 // (successful or otherwise) are received, and returns a single response
 // structure containing results for all host storage prepare operations.
 func StorageFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageFormatReq) (*StorageFormatResp, error) {
@@ -435,15 +459,74 @@ func StorageFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageForm
 	if err := convert.Types(req, pbReq); err != nil {
 		return nil, err
 	}
-	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+	formatRPC := func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return ctlpb.NewCtlSvcClient(conn).StorageFormat(ctx, pbReq)
+	}
+	req.setRPC(formatRPC)
+
+	streamer, ok := rpcClient.(StreamInvoker)
+	if !ok {
+		ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return newStorageFormatResp(ur)
+	}
+
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn, progress func(proto.Message)) (proto.Message, error) {
+		stream, err := ctlpb.NewCtlSvcClient(conn).StorageFormatStream(ctx, pbReq)
+		if err != nil {
+			if isUnimplemented(err) {
+				return formatRPC(ctx, conn)
+			}
+			return nil, err
+		}
+
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return nil, errors.New("format stream ended without a result")
+			}
+			if err != nil {
+				// Servers that predate the stream only support
+				// the unary format RPC.
+				if isUnimplemented(err) {
+					return formatRPC(ctx, conn)
+				}
+				return nil, streamRecvErr(err, conn.Target())
+			}
+
+			if resp := msg.GetResp(); resp != nil {
+				return resp, nil
+			}
+			if prog := msg.GetProgress(); prog != nil {
+				progress(prog)
+			}
+		}
 	})
 
-	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	ur, err := streamer.InvokeStreamRPC(ctx, req, func(addr string, msg proto.Message) {
+		pbProg, ok := msg.(*ctlpb.StorageFormatProgress)
+		if !ok || req.progressCb == nil {
+			return
+		}
+		req.progressCb(&StorageFormatProgress{
+			Addr:      addr,
+			EngineIdx: pbProg.GetInstanceIdx(),
+			Message:   pbProg.GetMessage(),
+			Error:     pbProg.GetError(),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return newStorageFormatResp(ur)
+}
+
+// newStorageFormatResp collects the results of a storage format request from
+// each host.
+func newStorageFormatResp(ur *UnaryResponse) (*StorageFormatResp, error) {
 	sfr := new(StorageFormatResp)
 	for _, hostResp := range ur.Responses {
 		if hostResp.Error != nil {
@@ -0,0 +1,118 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+type (
+	// NvmeHealthTrendReq is a request for the health history and wear-out
+	// estimates of NVMe devices.
+	NvmeHealthTrendReq struct {
+		unaryRequest
+		IncludeSamples bool `json:"include_samples"`
+	}
+
+	// NvmeHealthTrend describes the wear of an NVMe device over its sampled
+	// health history.
+	NvmeHealthTrend struct {
+		storage.NvmeWearEstimate
+		UUID    string                      `json:"uuid"`
+		TrAddr  string                      `json:"tr_addr"`
+		Rank    ranklist.Rank               `json:"rank"`
+		Samples []*storage.NvmeHealthSample `json:"samples,omitempty"`
+	}
+
+	// HostNvmeHealthTrendMap maps a host name to a slice of NVMe device
+	// health trends.
+	HostNvmeHealthTrendMap map[string][]*NvmeHealthTrend
+
+	// NvmeHealthTrendResp contains the NVMe device health trends reported
+	// by a set of hosts.
+	NvmeHealthTrendResp struct {
+		HostErrorsResp
+		HostTrends HostNvmeHealthTrendMap `json:"host_trends"`
+	}
+)
+
+// Keys returns the sorted list of keys from the HostNvmeHealthTrendMap.
+func (m HostNvmeHealthTrendMap) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (tr *NvmeHealthTrendResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.NvmeHealthTrendResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	trends := make([]*NvmeHealthTrend, 0, len(pbResp.GetDevices()))
+	if err := convert.Types(pbResp.GetDevices(), &trends); err != nil {
+		return errors.Wrapf(err, "converting %T to %T", pbResp.Devices, &trends)
+	}
+
+	if tr.HostTrends == nil {
+		tr.HostTrends = make(HostNvmeHealthTrendMap)
+	}
+	tr.HostTrends[hr.Addr] = trends
+
+	return nil
+}
+
+// StorageHealthTrend concurrently requests the NVMe device health history and
+// wear-out estimates from all hosts supplied in the request's hostlist, or all
+// configured hosts if not explicitly specified. The function blocks until all
+// results (successful or otherwise) are received, and returns a single response
+// structure containing results for all hosts.
+func StorageHealthTrend(ctx context.Context, rpcClient UnaryInvoker, req *NvmeHealthTrendReq) (*NvmeHealthTrendResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageHealthTrend(ctx, &ctlpb.NvmeHealthTrendReq{
+			IncludeSamples: req.IncludeSamples,
+		})
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(NvmeHealthTrendResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hostResp); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
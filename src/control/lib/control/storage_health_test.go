@@ -0,0 +1,130 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestControl_StorageHealthTrend(t *testing.T) {
+	sampleTime := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *NvmeHealthTrendReq
+		expResp *NvmeHealthTrendResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"local failure": {
+			req: &NvmeHealthTrendReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &NvmeHealthTrendReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expResp: &NvmeHealthTrendResp{
+				HostErrorsResp: HostErrorsResp{
+					HostErrors: HostErrorsMap{
+						"remote failed": &HostErrorSet{
+							HostSet:   createTestHostSet(t, "host1"),
+							HostError: errors.New("remote failed"),
+						},
+					},
+				},
+			},
+		},
+		"success": {
+			req: &NvmeHealthTrendReq{IncludeSamples: true},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.NvmeHealthTrendResp{
+					Devices: []*ctlpb.NvmeHealthTrend{
+						{
+							Uuid:          test.MockUUID(1),
+							TrAddr:        "0000:81:00.0",
+							Rank:          1,
+							Wear:          0.5,
+							WriteRate:     1024,
+							DaysRemaining: 12.5,
+							NewMediaErrs:  2,
+							Samples: []*ctlpb.NvmeHealthSample{
+								{
+									Time:             sampleTime.Format(time.RFC3339Nano),
+									MediaWearRaw:     50 * 1024,
+									NandBytesWritten: 4096,
+								},
+							},
+						},
+					},
+				}),
+			},
+			expResp: &NvmeHealthTrendResp{
+				HostTrends: HostNvmeHealthTrendMap{
+					"host1": {
+						{
+							NvmeWearEstimate: storage.NvmeWearEstimate{
+								Wear:           0.5,
+								WriteRate:      1024,
+								DaysRemaining:  12.5,
+								NewMediaErrors: 2,
+							},
+							UUID:   test.MockUUID(1),
+							TrAddr: "0000:81:00.0",
+							Rank:   1,
+							Samples: []*storage.NvmeHealthSample{
+								{
+									Time:             sampleTime,
+									MediaWearRaw:     50 * 1024,
+									NandBytesWritten: 4096,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := StorageHealthTrend(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, getCmpOpts()...); diff != "" {
+				t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+type (
+	// ScmHealthReq is a request for the SCM usage and PMem namespace health
+	// of the engines.
+	ScmHealthReq struct {
+		unaryRequest
+	}
+
+	// EngineScmHealth describes the usage of the SCM mounted by an engine
+	// and the health of any PMem namespaces backing it.
+	EngineScmHealth struct {
+		InstanceIdx uint32                        `json:"instance_idx"`
+		Rank        ranklist.Rank                 `json:"rank"`
+		Class       storage.Class                 `json:"class"`
+		Path        string                        `json:"path"`
+		TotalBytes  uint64                        `json:"total_bytes"`
+		AvailBytes  uint64                        `json:"avail_bytes"`
+		ScmSize     uint64                        `json:"scm_size"`
+		Namespaces  []*storage.ScmNamespaceHealth `json:"namespaces"`
+	}
+
+	// HostScmHealthMap maps a host name to a slice of engine SCM health
+	// reports.
+	HostScmHealthMap map[string][]*EngineScmHealth
+
+	// ScmHealthResp contains the SCM health reported by a set of hosts.
+	ScmHealthResp struct {
+		HostErrorsResp
+		HostScmHealth HostScmHealthMap `json:"host_scm_health"`
+	}
+)
+
+// Mounted returns true if usage has been reported for the engine's SCM.
+func (esh *EngineScmHealth) Mounted() bool {
+	return esh.TotalBytes != 0
+}
+
+// Keys returns the sorted list of keys from the HostScmHealthMap.
+func (m HostScmHealthMap) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (sr *ScmHealthResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.ScmHealthResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	engines := make([]*EngineScmHealth, 0, len(pbResp.GetEngines()))
+	if err := convert.Types(pbResp.GetEngines(), &engines); err != nil {
+		return errors.Wrapf(err, "converting %T to %T", pbResp.Engines, &engines)
+	}
+
+	if sr.HostScmHealth == nil {
+		sr.HostScmHealth = make(HostScmHealthMap)
+	}
+	sr.HostScmHealth[hr.Addr] = engines
+
+	return nil
+}
+
+// StorageScmHealth concurrently requests the SCM usage and PMem namespace
+// health (media health state, bad blocks and dirty shutdown count) from all
+// hosts supplied in the request's hostlist, or all configured hosts if not
+// explicitly specified. The function blocks until all results (successful or
+// otherwise) are received, and returns a single response structure containing
+// results for all hosts.
+func StorageScmHealth(ctx context.Context, rpcClient UnaryInvoker, req *ScmHealthReq) (*ScmHealthResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageScmHealth(ctx, &ctlpb.ScmHealthReq{})
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ScmHealthResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hostResp); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestControl_StorageScmHealth(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ScmHealthReq
+		expResp *ScmHealthResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"local failure": {
+			req: &ScmHealthReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &ScmHealthReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expResp: &ScmHealthResp{
+				HostErrorsResp: HostErrorsResp{
+					HostErrors: HostErrorsMap{
+						"remote failed": &HostErrorSet{
+							HostSet:   createTestHostSet(t, "host1"),
+							HostError: errors.New("remote failed"),
+						},
+					},
+				},
+			},
+		},
+		"success": {
+			req: &ScmHealthReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.ScmHealthResp{
+					Engines: []*ctlpb.EngineScmHealth{
+						{
+							Rank:       1,
+							Class:      "dcpm",
+							Path:       "/mnt/daos0",
+							TotalBytes: 3 << 40,
+							AvailBytes: 1 << 40,
+							Namespaces: []*ctlpb.ScmNamespaceHealth{
+								{
+									Uuid:           test.MockUUID(1),
+									Blockdev:       "pmem0",
+									Dev:            "namespace0.0",
+									Size:           3 << 40,
+									HealthState:    "non-critical",
+									DirtyShutdowns: 2,
+									Badblocks: []*ctlpb.ScmBadBlock{
+										{Offset: 8, Length: 2, Dimms: []string{"nmem0"}},
+									},
+								},
+							},
+						},
+						{
+							InstanceIdx: 1,
+							Rank:        2,
+							Class:       "ram",
+							Path:        "/mnt/daos1",
+							ScmSize:     16 << 30,
+						},
+					},
+				}),
+			},
+			expResp: &ScmHealthResp{
+				HostScmHealth: HostScmHealthMap{
+					"host1": {
+						{
+							Rank:       1,
+							Class:      storage.ClassDcpm,
+							Path:       "/mnt/daos0",
+							TotalBytes: 3 << 40,
+							AvailBytes: 1 << 40,
+							Namespaces: []*storage.ScmNamespaceHealth{
+								{
+									UUID:           test.MockUUID(1),
+									BlockDevice:    "pmem0",
+									Name:           "namespace0.0",
+									Size:           3 << 40,
+									HealthState:    "non-critical",
+									DirtyShutdowns: 2,
+									BadBlocks: []*storage.ScmBadBlock{
+										{Offset: 8, Length: 2, Dimms: []string{"nmem0"}},
+									},
+								},
+							},
+						},
+						{
+							InstanceIdx: 1,
+							Rank:        2,
+							Class:       storage.ClassRam,
+							Path:        "/mnt/daos1",
+							ScmSize:     16 << 30,
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := StorageScmHealth(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, getCmpOpts()...); diff != "" {
+				t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -732,6 +732,102 @@ func TestControl_StorageFormat(t *testing.T) {
 	}
 }
 
+func TestControl_StorageFormat_Progress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		unaryOnly   bool
+		streamMsgs  []*HostResponse
+		expProgress []*StorageFormatProgress
+	}{
+		"no progress": {},
+		"progress from multiple hosts": {
+			streamMsgs: []*HostResponse{
+				{
+					Addr: "host1",
+					Message: &ctlpb.StorageFormatProgress{
+						InstanceIdx: 0,
+						Message:     "SCM format complete",
+					},
+				},
+				{
+					Addr: "host2",
+					Message: &ctlpb.StorageFormatProgress{
+						InstanceIdx: 1,
+						Message:     "NVMe format failed",
+						Error:       "bad device",
+					},
+				},
+			},
+			expProgress: []*StorageFormatProgress{
+				{
+					Addr:    "host1",
+					Message: "SCM format complete",
+				},
+				{
+					Addr:      "host2",
+					EngineIdx: 1,
+					Message:   "NVMe format failed",
+					Error:     "bad device",
+				},
+			},
+		},
+		"unexpected message type ignored": {
+			streamMsgs: []*HostResponse{
+				{
+					Addr:    "host1",
+					Message: &ctlpb.StorageFormatResp{},
+				},
+			},
+		},
+		"client without stream support": {
+			unaryOnly: true,
+			streamMsgs: []*HostResponse{
+				{
+					Addr: "host1",
+					Message: &ctlpb.StorageFormatProgress{
+						Message: "SCM format complete",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:    "host1",
+							Message: &ctlpb.StorageFormatResp{},
+						},
+					},
+				},
+				StreamMessages: tc.streamMsgs,
+			})
+
+			var gotProgress []*StorageFormatProgress
+			req := new(StorageFormatReq)
+			req.SetProgressCb(func(prog *StorageFormatProgress) {
+				gotProgress = append(gotProgress, prog)
+			})
+
+			var invoker UnaryInvoker = mi
+			if tc.unaryOnly {
+				invoker = struct{ UnaryInvoker }{mi}
+			}
+
+			if _, err := StorageFormat(test.Context(t), invoker, req); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expProgress, gotProgress); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_checkFormatReq(t *testing.T) {
 	reqHosts := func(h ...string) []string {
 		return h
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"strings"
 	"time"
@@ -140,10 +141,11 @@ func (req *SystemJoinReq) MarshalJSON() ([]byte, error) {
 
 // SystemJoinResp contains the request response.
 type SystemJoinResp struct {
-	Rank       ranklist.Rank
-	State      system.MemberState
-	LocalJoin  bool
-	MapVersion uint32 `json:"map_version"`
+	Rank         ranklist.Rank
+	State        system.MemberState
+	LocalJoin    bool
+	MapVersion   uint32   `json:"map_version"`
+	AccessPoints []string `json:"access_points"`
 }
 
 func (resp *SystemJoinResp) UnmarshalJSON(data []byte) error {
@@ -402,12 +404,31 @@ func SystemStart(ctx context.Context, rpcClient UnaryInvoker, req *SystemStartRe
 
 // SystemStopReq contains the inputs for the system stop command.
 type SystemStopReq struct {
-	unaryRequest
+	streamRequest
 	msRequest
 	sysRequest
-	Force bool
+	Force      bool
+	progressCb SystemStopProgressFn
+}
+
+// SetProgressCb sets a callback to be invoked with the results for the ranks
+// on each host as they are received by the MS. Progress is only reported if
+// both the client and the MS support streaming the system stop.
+func (req *SystemStopReq) SetProgressCb(cb SystemStopProgressFn) {
+	req.progressCb = cb
 }
 
+// SystemStopProgress contains the results for the ranks on a single host, as
+// received by the MS during a phase of the system stop.
+type SystemStopProgress struct {
+	Action  string
+	Results system.MemberResults
+}
+
+// SystemStopProgressFn defines the function signature for a callback that is
+// invoked for each SystemStopProgress update.
+type SystemStopProgressFn func(*SystemStopProgress)
+
 // SystemStopResp contains the request response.
 type SystemStopResp struct {
 	sysResponse
@@ -458,12 +479,69 @@ func SystemStop(ctx context.Context, rpcClient UnaryInvoker, req *SystemStopReq)
 	pbReq.Force = req.Force
 	pbReq.Sys = req.getSystem(rpcClient)
 
-	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+	stopRPC := func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemStop(ctx, pbReq)
-	})
+	}
+	req.setRPC(stopRPC)
 
 	rpcClient.Debugf("DAOS system stop request: %s", pbUtil.Debug(pbReq))
-	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+
+	streamer, ok := rpcClient.(StreamInvoker)
+	if !ok {
+		ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := new(SystemStopResp)
+		return resp, convertMSResponse(ur, resp)
+	}
+
+	req.setStreamRPC(func(ctx context.Context, conn *grpc.ClientConn, progress func(proto.Message)) (proto.Message, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemStopStream(ctx, pbReq)
+		if err != nil {
+			if isUnimplemented(err) {
+				return stopRPC(ctx, conn)
+			}
+			return nil, err
+		}
+
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return nil, errors.New("system stop stream ended without a result")
+			}
+			if err != nil {
+				// Servers that predate the stream only support
+				// the unary system stop RPC.
+				if isUnimplemented(err) {
+					return stopRPC(ctx, conn)
+				}
+				return nil, streamRecvErr(err, conn.Target())
+			}
+
+			if resp := msg.GetResp(); resp != nil {
+				return resp, nil
+			}
+			if prog := msg.GetProgress(); prog != nil {
+				progress(prog)
+			}
+		}
+	})
+
+	ur, err := streamer.InvokeStreamRPC(ctx, req, func(_ string, msg proto.Message) {
+		pbProg, ok := msg.(*mgmtpb.SystemStopProgress)
+		if !ok || req.progressCb == nil {
+			return
+		}
+
+		prog := &SystemStopProgress{Action: pbProg.GetAction()}
+		if err := convert.Types(pbProg.GetResults(), &prog.Results); err != nil {
+			rpcClient.Debugf("failed to convert system stop progress: %s", err)
+			return
+		}
+		req.progressCb(prog)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -679,24 +757,13 @@ type LeaderQueryResp struct {
 // LeaderQuery requests the current Management Service leader and the set of
 // MS replicas.
 func LeaderQuery(ctx context.Context, rpcClient UnaryInvoker, req *LeaderQueryReq) (*LeaderQueryResp, error) {
-	pbReq := &mgmtpb.LeaderQueryReq{Sys: req.getSystem(rpcClient)}
-	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return mgmtpb.NewMgmtSvcClient(conn).LeaderQuery(ctx, pbReq)
-	})
-
-	rpcClient.Debugf("DAOS system leader-query request: %s", pbUtil.Debug(pbReq))
-	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	resp, err := leaderQuery(ctx, rpcClient, req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := new(LeaderQueryResp)
-	if err = convertMSResponse(ur, resp); err != nil {
-		return nil, errors.Wrap(err, "converting MS to LeaderQuery resp")
-	}
-
 	req.SetHostList(resp.Replicas)
-	ur, err = rpcClient.InvokeUnaryRPC(ctx, req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -711,6 +778,28 @@ func LeaderQuery(ctx context.Context, rpcClient UnaryInvoker, req *LeaderQueryRe
 	return resp, nil
 }
 
+// leaderQuery requests the current Management Service leader and the set of
+// MS replicas without checking which of the replicas are responsive.
+func leaderQuery(ctx context.Context, rpcClient UnaryInvoker, req *LeaderQueryReq) (*LeaderQueryResp, error) {
+	pbReq := &mgmtpb.LeaderQueryReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).LeaderQuery(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system leader-query request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(LeaderQueryResp)
+	if err = convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "converting MS to LeaderQuery resp")
+	}
+
+	return resp, nil
+}
+
 // RanksReq contains the parameters for a system ranks request.
 type RanksReq struct {
 	unaryRequest
@@ -0,0 +1,61 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// SystemGetAccessReq contains the inputs for a request to get the
+	// access granted to the caller.
+	SystemGetAccessReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemGetAccessResp describes the access granted to the caller, as
+	// determined from its certificate.
+	SystemGetAccessResp struct {
+		Component string   `json:"component"`
+		Role      string   `json:"role"`
+		Subject   string   `json:"subject"`
+		SANs      []string `json:"sans"`
+		Insecure  bool     `json:"insecure"`
+	}
+)
+
+// SystemGetAccess gets the component and role granted to the caller by the
+// management service.
+func SystemGetAccess(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetAccessReq) (*SystemGetAccessResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemGetAccessReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemGetAccess(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemGetAccess request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemGetAccessResp)
+	return resp, convertMSResponse(ur, resp)
+}
@@ -0,0 +1,144 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common"
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// SystemGetAuditReq contains the inputs for a request to query the
+	// audit log of mutating control-plane operations. Unset fields match
+	// all records.
+	SystemGetAuditReq struct {
+		unaryRequest
+		msRequest
+
+		Operation string // operation name, e.g. PoolCreate
+		Subject   string // substring of the caller's certificate subject
+		Since     time.Time
+		Until     time.Time
+		Limit     int // maximum number of most recent records
+	}
+
+	// AuditRecord describes a mutating control-plane operation or a call
+	// denied by access control, the caller that requested it and its result.
+	AuditRecord struct {
+		ID        uint64    `json:"id"`
+		Time      time.Time `json:"time"`
+		Operation string    `json:"operation"`
+		Component string    `json:"component"`
+		Role      string    `json:"role"`
+		Subject   string    `json:"subject"`
+		Address   string    `json:"address"`
+		Request   string    `json:"request"`
+		Error     string    `json:"error"`
+		Denied    bool      `json:"denied"`
+	}
+
+	// SystemGetAuditResp contains matching audit records in the order in
+	// which they were recorded, and the number of older records discarded
+	// due to the retention limit.
+	SystemGetAuditResp struct {
+		Records []*AuditRecord `json:"records"`
+		Dropped uint64         `json:"dropped"`
+	}
+
+	// SystemAddAuditReq contains an audit record created by a server other
+	// than the MS leader, to be added to the audit log.
+	SystemAddAuditReq struct {
+		unaryRequest
+		msRequest
+
+		Record *AuditRecord
+	}
+)
+
+// SystemGetAudit queries the audit log of mutating control-plane operations
+// maintained by the management service.
+func SystemGetAudit(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetAuditReq) (*SystemGetAuditResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+	if !req.Since.IsZero() && !req.Until.IsZero() && req.Until.Before(req.Since) {
+		return nil, errors.New("until time must not be before since time")
+	}
+
+	pbReq := &mgmtpb.SystemGetAuditReq{
+		Sys:       req.getSystem(rpcClient),
+		Operation: req.Operation,
+		Subject:   req.Subject,
+		Limit:     uint32(req.Limit),
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = common.FormatTime(req.Since)
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = common.FormatTime(req.Until)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemGetAudit(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemGetAudit request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemGetAuditResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemAddAudit forwards an audit record to the management service leader to
+// be added to the audit log.
+func SystemAddAudit(ctx context.Context, rpcClient UnaryInvoker, req *SystemAddAuditReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.Record == nil {
+		return errors.New("nil audit record")
+	}
+
+	pbReq := &mgmtpb.SystemAddAuditReq{
+		Sys: req.getSystem(rpcClient),
+		Record: &mgmtpb.AuditRecord{
+			Time:      common.FormatTime(req.Record.Time),
+			Operation: req.Record.Operation,
+			Component: req.Record.Component,
+			Role:      req.Record.Role,
+			Subject:   req.Record.Subject,
+			Address:   req.Record.Address,
+			Request:   req.Record.Request,
+			Error:     req.Record.Error,
+			Denied:    req.Record.Denied,
+		},
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemAddAudit(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemAddAudit request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
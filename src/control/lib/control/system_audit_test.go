@@ -0,0 +1,162 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_SystemGetAudit(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		req     *SystemGetAuditReq
+		mic     *MockInvokerConfig
+		expResp *SystemGetAuditResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"negative limit": {
+			req:    &SystemGetAuditReq{Limit: -1},
+			expErr: errors.New("limit must not be negative"),
+		},
+		"until before since": {
+			req: &SystemGetAuditReq{
+				Since: ts,
+				Until: ts.Add(-time.Hour),
+			},
+			expErr: errors.New("until time must not be before since"),
+		},
+		"req fails": {
+			req: &SystemGetAuditReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemGetAuditReq{Operation: "PoolCreate"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemGetAuditResp{
+						Records: []*mgmtpb.AuditRecord{
+							{
+								Id:        1,
+								Time:      common.FormatTime(ts),
+								Operation: "PoolCreate",
+								Component: "admin",
+								Role:      "admin",
+								Subject:   "CN=admin",
+								Address:   "10.0.0.1:4321",
+								Request:   `{"uuid":"foo"}`,
+								Error:     "DER_NOSPACE(-1007): No space on storage target",
+							},
+						},
+						Dropped: 5,
+					}),
+				},
+			},
+			expResp: &SystemGetAuditResp{
+				Records: []*AuditRecord{
+					{
+						ID:        1,
+						Time:      ts,
+						Operation: "PoolCreate",
+						Component: "admin",
+						Role:      "admin",
+						Subject:   "CN=admin",
+						Address:   "10.0.0.1:4321",
+						Request:   `{"uuid":"foo"}`,
+						Error:     "DER_NOSPACE(-1007): No space on storage target",
+					},
+				},
+				Dropped: 5,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemGetAudit(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) }),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemAddAudit(t *testing.T) {
+	rec := &AuditRecord{
+		Time:      time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		Operation: "StorageFormat",
+		Component: "admin",
+		Subject:   "CN=admin",
+	}
+
+	for name, tc := range map[string]struct {
+		req    *SystemAddAuditReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"nil record": {
+			req:    &SystemAddAuditReq{},
+			expErr: errors.New("nil audit record"),
+		},
+		"req fails": {
+			req: &SystemAddAuditReq{Record: rec},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemAddAuditReq{Record: rec},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemAddAudit(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
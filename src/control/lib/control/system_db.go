@@ -0,0 +1,501 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+type (
+	// SystemDBSnapshotReq contains the inputs for a request to snapshot
+	// the system database.
+	SystemDBSnapshotReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemDBSnapshotResp contains a snapshot archive of the system
+	// database which may be restored with SystemDBRestore or
+	// daos_server ms restore.
+	SystemDBSnapshotResp struct {
+		Index uint64 `json:"index"`
+		Term  uint64 `json:"term"`
+		Data  []byte `json:"-"`
+	}
+)
+
+// recvDBSnapshot assembles the snapshot archive from the chunks received on
+// the stream.
+func recvDBSnapshot(stream mgmtpb.MgmtSvc_SystemDBSnapshotClient, target string) (*mgmtpb.SystemDBSnapshotResp, error) {
+	var snap *mgmtpb.SystemDBSnapshotResp
+	for {
+		pbResp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, streamRecvErr(err, target)
+		}
+
+		if snap == nil {
+			snap = pbResp
+			continue
+		}
+		snap.Data = append(snap.Data, pbResp.Data...)
+	}
+
+	if snap == nil {
+		return nil, errors.New("no snapshot data received")
+	}
+	if uint64(len(snap.Data)) != snap.Size {
+		return nil, errors.Errorf("incomplete snapshot received (%d/%d bytes)",
+			len(snap.Data), snap.Size)
+	}
+
+	return snap, nil
+}
+
+// SystemDBSnapshot creates a point-in-time snapshot of the system database on
+// the MS leader and returns it as a portable archive.
+func SystemDBSnapshot(ctx context.Context, rpcClient UnaryInvoker, req *SystemDBSnapshotReq) (*SystemDBSnapshotResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemDBSnapshotReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemDBSnapshot(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return recvDBSnapshot(stream, conn.Target())
+	})
+
+	rpcClient.Debugf("DAOS SystemDBSnapshot request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.SystemDBSnapshotResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	return &SystemDBSnapshotResp{
+		Index: pbResp.Index,
+		Term:  pbResp.Term,
+		Data:  pbResp.Data,
+	}, nil
+}
+
+// dbRestoreChunkSize is the maximum amount of snapshot archive data sent in
+// a single restore stream message.
+const dbRestoreChunkSize = 1 << 20
+
+type (
+	// SystemDBRestoreReq contains the inputs for a request to validate,
+	// and optionally restore, a system database snapshot archive.
+	SystemDBRestoreReq struct {
+		unaryRequest
+		msRequest
+		Data  []byte // snapshot archive
+		Apply bool   // restore the snapshot rather than only validating it
+		Force bool   // restore even if the snapshot differs from the running system
+	}
+
+	// SystemDBRestoreResp describes how the snapshot differs from the
+	// running system, and whether it was restored.
+	SystemDBRestoreResp struct {
+		Index           uint64            `json:"index"`
+		Term            uint64            `json:"term"`
+		Version         uint64            `json:"version"`
+		MapVersion      uint32            `json:"map_version"`
+		MissingRanks    *ranklist.RankSet `json:"missing_ranks"`
+		ExtraRanks      *ranklist.RankSet `json:"extra_ranks"`
+		ChangedRanks    *ranklist.RankSet `json:"changed_ranks"`
+		MissingPools    []string          `json:"missing_pools"`
+		ExtraPools      []string          `json:"extra_pools"`
+		ChangedPools    []string          `json:"changed_pools"`
+		MissingFindings []uint64          `json:"missing_findings"`
+		ExtraFindings   []uint64          `json:"extra_findings"`
+		Applied         bool              `json:"applied"`
+	}
+)
+
+// HasDifferences returns true if the snapshot differs from the running system.
+func (resp *SystemDBRestoreResp) HasDifferences() bool {
+	if resp == nil {
+		return false
+	}
+
+	return resp.MissingRanks.Count() > 0 || resp.ExtraRanks.Count() > 0 ||
+		resp.ChangedRanks.Count() > 0 || len(resp.MissingPools) > 0 ||
+		len(resp.ExtraPools) > 0 || len(resp.ChangedPools) > 0 ||
+		len(resp.MissingFindings) > 0 || len(resp.ExtraFindings) > 0
+}
+
+// sendDBRestore sends the restore request followed by the snapshot archive in
+// chunks, and returns the response received once the stream is closed.
+func sendDBRestore(stream mgmtpb.MgmtSvc_SystemDBRestoreClient, req *mgmtpb.SystemDBRestoreReq, archive []byte, target string) (*mgmtpb.SystemDBRestoreResp, error) {
+	msg := req
+	for {
+		if err := stream.Send(msg); err != nil {
+			// The server has ended the stream early, so the reason
+			// is returned by CloseAndRecv().
+			if err == io.EOF {
+				break
+			}
+			return nil, streamRecvErr(err, target)
+		}
+		if len(archive) == 0 {
+			break
+		}
+
+		n := len(archive)
+		if n > dbRestoreChunkSize {
+			n = dbRestoreChunkSize
+		}
+		msg = &mgmtpb.SystemDBRestoreReq{Data: archive[:n]}
+		archive = archive[n:]
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, streamRecvErr(err, target)
+	}
+	return resp, nil
+}
+
+// SystemDBRestore validates the supplied snapshot archive against the running
+// system and, if Apply is set, restores it on the MS leader. A snapshot that
+// differs from the running system is only restored if Force is also set.
+func SystemDBRestore(ctx context.Context, rpcClient UnaryInvoker, req *SystemDBRestoreReq) (*SystemDBRestoreResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.Data) == 0 {
+		return nil, errors.New("empty snapshot archive")
+	}
+
+	pbReq := &mgmtpb.SystemDBRestoreReq{
+		Sys:   req.getSystem(rpcClient),
+		Apply: req.Apply,
+		Force: req.Force,
+		Size:  uint64(len(req.Data)),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemDBRestore(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sendDBRestore(stream, pbReq, req.Data, conn.Target())
+	})
+
+	rpcClient.Debugf("DAOS SystemDBRestore request: apply=%t force=%t size=%d",
+		req.Apply, req.Force, len(req.Data))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.SystemDBRestoreResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	resp := &SystemDBRestoreResp{
+		Index:           pbResp.Index,
+		Term:            pbResp.Term,
+		Version:         pbResp.Version,
+		MapVersion:      pbResp.MapVersion,
+		MissingPools:    pbResp.MissingPools,
+		ExtraPools:      pbResp.ExtraPools,
+		ChangedPools:    pbResp.ChangedPools,
+		MissingFindings: pbResp.MissingFindings,
+		ExtraFindings:   pbResp.ExtraFindings,
+		Applied:         pbResp.Applied,
+	}
+	for _, rs := range []struct {
+		in  string
+		out **ranklist.RankSet
+	}{
+		{pbResp.MissingRanks, &resp.MissingRanks},
+		{pbResp.ExtraRanks, &resp.ExtraRanks},
+		{pbResp.ChangedRanks, &resp.ChangedRanks},
+	} {
+		if *rs.out, err = ranklist.CreateRankSet(rs.in); err != nil {
+			return nil, errors.Wrap(err, "invalid rank set in response")
+		}
+	}
+
+	return resp, nil
+}
+
+type (
+	// SystemDBVerifyReq contains the inputs for a request to check the
+	// consistency of the system database.
+	SystemDBVerifyReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemDBInconsistency describes an inconsistency found in the system
+	// database.
+	SystemDBInconsistency struct {
+		Check       string `json:"check"`
+		Description string `json:"description"`
+	}
+
+	// SystemDBVerifyResp lists the inconsistencies found in the system
+	// database.
+	SystemDBVerifyResp struct {
+		Version         uint64                   `json:"version"`
+		Inconsistencies []*SystemDBInconsistency `json:"inconsistencies"`
+	}
+)
+
+// SystemDBVerify checks the consistency of the system database on the MS
+// leader and returns any inconsistencies found.
+func SystemDBVerify(ctx context.Context, rpcClient UnaryInvoker, req *SystemDBVerifyReq) (*SystemDBVerifyResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemDBVerifyReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDBVerify(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemDBVerify request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemDBVerifyResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemDBReplicaReq contains the inputs for a request to retrieve the
+	// local copy of the system database from the MS replica in the
+	// request host list.
+	SystemDBReplicaReq struct {
+		unaryRequest
+	}
+
+	// SystemDBReplicaResp contains the local copy of the system database
+	// held by a MS replica.
+	SystemDBReplicaResp struct {
+		Version uint64 `json:"version"`
+		Data    []byte `json:"-"`
+	}
+)
+
+// recvDBReplica assembles the replica's copy of the system database from the
+// chunks received on the stream.
+func recvDBReplica(stream mgmtpb.MgmtSvc_SystemDBReplicaClient, target string) (*mgmtpb.SystemDBReplicaResp, error) {
+	var replica *mgmtpb.SystemDBReplicaResp
+	for {
+		pbResp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, streamRecvErr(err, target)
+		}
+
+		if replica == nil {
+			replica = pbResp
+			continue
+		}
+		replica.Data = append(replica.Data, pbResp.Data...)
+	}
+
+	if replica == nil {
+		return nil, errors.New("no system database data received")
+	}
+	if uint64(len(replica.Data)) != replica.Size {
+		return nil, errors.Errorf("incomplete system database received (%d/%d bytes)",
+			len(replica.Data), replica.Size)
+	}
+
+	return replica, nil
+}
+
+// SystemDBReplica retrieves the local copy of the system database from the
+// single MS replica in the request host list. It is called by the MS leader
+// in order to compare the copies held by different replicas.
+func SystemDBReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemDBReplicaReq) (*SystemDBReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.getHostList()) != 1 {
+		return nil, errors.Errorf("expected exactly one MS replica in host list, got %d",
+			len(req.getHostList()))
+	}
+
+	pbReq := &mgmtpb.SystemDBReplicaReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemDBReplica(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return recvDBReplica(stream, conn.Target())
+	})
+
+	rpcClient.Debugf("DAOS SystemDBReplica request to %s", req.getHostList()[0])
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ur.Responses) != 1 {
+		return nil, errors.Errorf("expected 1 response, got %d", len(ur.Responses))
+	}
+	hr := ur.Responses[0]
+	if hr.Error != nil {
+		return nil, errors.Wrapf(hr.Error, "MS replica %s", hr.Addr)
+	}
+
+	pbResp, ok := hr.Message.(*mgmtpb.SystemDBReplicaResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", hr.Message)
+	}
+
+	return &SystemDBReplicaResp{
+		Version: pbResp.Version,
+		Data:    pbResp.Data,
+	}, nil
+}
+
+type (
+	// SystemDBDiffReq contains the inputs for a request to compare the
+	// copies of the system database held by two MS replicas. If only one
+	// replica is supplied, it is compared with the MS leader.
+	SystemDBDiffReq struct {
+		unaryRequest
+		msRequest
+		Replicas []string // control addresses of the replicas to compare
+	}
+
+	// SystemDBDiffResp describes how the copy of the system database held
+	// by the second replica differs from that held by the first.
+	SystemDBDiffResp struct {
+		First           string            `json:"first"`
+		FirstVersion    uint64            `json:"first_version"`
+		Second          string            `json:"second"`
+		SecondVersion   uint64            `json:"second_version"`
+		MissingRanks    *ranklist.RankSet `json:"missing_ranks"`
+		ExtraRanks      *ranklist.RankSet `json:"extra_ranks"`
+		ChangedRanks    *ranklist.RankSet `json:"changed_ranks"`
+		MissingPools    []string          `json:"missing_pools"`
+		ExtraPools      []string          `json:"extra_pools"`
+		ChangedPools    []string          `json:"changed_pools"`
+		MissingFindings []uint64          `json:"missing_findings"`
+		ExtraFindings   []uint64          `json:"extra_findings"`
+	}
+)
+
+// HasDifferences returns true if the copies of the system database differ.
+func (resp *SystemDBDiffResp) HasDifferences() bool {
+	if resp == nil {
+		return false
+	}
+
+	return resp.MissingRanks.Count() > 0 || resp.ExtraRanks.Count() > 0 ||
+		resp.ChangedRanks.Count() > 0 || len(resp.MissingPools) > 0 ||
+		len(resp.ExtraPools) > 0 || len(resp.ChangedPools) > 0 ||
+		len(resp.MissingFindings) > 0 || len(resp.ExtraFindings) > 0
+}
+
+// SystemDBDiff compares the copies of the system database held by two MS
+// replicas, or by one replica and the MS leader.
+func SystemDBDiff(ctx context.Context, rpcClient UnaryInvoker, req *SystemDBDiffReq) (*SystemDBDiffResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.Replicas) < 1 || len(req.Replicas) > 2 {
+		return nil, errors.Errorf("one or two MS replicas must be supplied, got %d", len(req.Replicas))
+	}
+
+	pbReq := &mgmtpb.SystemDBDiffReq{
+		Sys:      req.getSystem(rpcClient),
+		Replicas: req.Replicas,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDBDiff(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemDBDiff request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.SystemDBDiffResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	resp := &SystemDBDiffResp{
+		First:           pbResp.First,
+		FirstVersion:    pbResp.FirstVersion,
+		Second:          pbResp.Second,
+		SecondVersion:   pbResp.SecondVersion,
+		MissingPools:    pbResp.MissingPools,
+		ExtraPools:      pbResp.ExtraPools,
+		ChangedPools:    pbResp.ChangedPools,
+		MissingFindings: pbResp.MissingFindings,
+		ExtraFindings:   pbResp.ExtraFindings,
+	}
+	for _, rs := range []struct {
+		in  string
+		out **ranklist.RankSet
+	}{
+		{pbResp.MissingRanks, &resp.MissingRanks},
+		{pbResp.ExtraRanks, &resp.ExtraRanks},
+		{pbResp.ChangedRanks, &resp.ChangedRanks},
+	} {
+		if *rs.out, err = ranklist.CreateRankSet(rs.in); err != nil {
+			return nil, errors.Wrap(err, "invalid rank set in response")
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,583 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type mockDBSnapshotStream struct {
+	grpc.ClientStream
+	resps []*mgmtpb.SystemDBSnapshotResp
+	err   error
+}
+
+func (ms *mockDBSnapshotStream) Recv() (*mgmtpb.SystemDBSnapshotResp, error) {
+	if len(ms.resps) == 0 {
+		if ms.err != nil {
+			return nil, ms.err
+		}
+		return nil, io.EOF
+	}
+
+	resp := ms.resps[0]
+	ms.resps = ms.resps[1:]
+	return resp, nil
+}
+
+func TestControl_recvDBSnapshot(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stream  *mockDBSnapshotStream
+		expResp *mgmtpb.SystemDBSnapshotResp
+		expErr  error
+	}{
+		"no data": {
+			stream: &mockDBSnapshotStream{},
+			expErr: errors.New("no snapshot data"),
+		},
+		"not leader": {
+			stream: &mockDBSnapshotStream{
+				err: pbUtil.AnnotateError(&system.ErrNotLeader{
+					LeaderHint: "host2",
+				}),
+			},
+			expErr: &system.ErrNotLeader{LeaderHint: "host2"},
+		},
+		"incomplete": {
+			stream: &mockDBSnapshotStream{
+				resps: []*mgmtpb.SystemDBSnapshotResp{
+					{Index: 5, Term: 2, Size: 6, Data: []byte("abc")},
+				},
+			},
+			expErr: errors.New("incomplete snapshot"),
+		},
+		"success": {
+			stream: &mockDBSnapshotStream{
+				resps: []*mgmtpb.SystemDBSnapshotResp{
+					{Index: 5, Term: 2, Size: 6, Data: []byte("abc")},
+					{Data: []byte("def")},
+				},
+			},
+			expResp: &mgmtpb.SystemDBSnapshotResp{
+				Index: 5, Term: 2, Size: 6, Data: []byte("abcdef"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotResp, gotErr := recvDBSnapshot(tc.stream, "host1")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+type mockDBRestoreStream struct {
+	grpc.ClientStream
+	sent    []*mgmtpb.SystemDBRestoreReq
+	sendErr error
+	resp    *mgmtpb.SystemDBRestoreResp
+	err     error
+}
+
+func (ms *mockDBRestoreStream) Send(req *mgmtpb.SystemDBRestoreReq) error {
+	if ms.sendErr != nil {
+		return ms.sendErr
+	}
+	ms.sent = append(ms.sent, req)
+	return nil
+}
+
+func (ms *mockDBRestoreStream) CloseAndRecv() (*mgmtpb.SystemDBRestoreResp, error) {
+	return ms.resp, ms.err
+}
+
+func TestControl_sendDBRestore(t *testing.T) {
+	bigArchive := make([]byte, dbRestoreChunkSize+3)
+
+	for name, tc := range map[string]struct {
+		archive   []byte
+		stream    *mockDBRestoreStream
+		expChunks []int
+		expErr    error
+	}{
+		"not leader": {
+			archive: []byte("abc"),
+			stream: &mockDBRestoreStream{
+				sendErr: io.EOF,
+				err: pbUtil.AnnotateError(&system.ErrNotLeader{
+					LeaderHint: "host2",
+				}),
+			},
+			expErr: &system.ErrNotLeader{LeaderHint: "host2"},
+		},
+		"single chunk": {
+			archive: []byte("abc"),
+			stream: &mockDBRestoreStream{
+				resp: &mgmtpb.SystemDBRestoreResp{Applied: true},
+			},
+			expChunks: []int{3},
+		},
+		"multiple chunks": {
+			archive: bigArchive,
+			stream: &mockDBRestoreStream{
+				resp: &mgmtpb.SystemDBRestoreResp{Applied: true},
+			},
+			expChunks: []int{dbRestoreChunkSize, 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := &mgmtpb.SystemDBRestoreReq{
+				Apply: true,
+				Size:  uint64(len(tc.archive)),
+			}
+			gotResp, gotErr := sendDBRestore(tc.stream, req, tc.archive, "host1")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertTrue(t, gotResp.Applied, "expected restore response")
+
+			if len(tc.stream.sent) != len(tc.expChunks)+1 {
+				t.Fatalf("expected %d messages, got %d", len(tc.expChunks)+1, len(tc.stream.sent))
+			}
+			test.AssertEqual(t, req, tc.stream.sent[0], "first message is not the request")
+			for i, size := range tc.expChunks {
+				test.AssertEqual(t, size, len(tc.stream.sent[i+1].Data), "unexpected chunk size")
+			}
+		})
+	}
+}
+
+func TestControl_SystemDBSnapshot(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDBSnapshotReq
+		mic     *MockInvokerConfig
+		expResp *SystemDBSnapshotResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemDBSnapshotReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemDBSnapshotReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDBSnapshotResp{
+						Index: 5, Term: 2, Size: 3, Data: []byte("abc"),
+					}),
+				},
+			},
+			expResp: &SystemDBSnapshotResp{
+				Index: 5, Term: 2, Data: []byte("abc"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDBSnapshot(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDBRestore(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDBRestoreReq
+		mic     *MockInvokerConfig
+		expResp *SystemDBRestoreResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no data": {
+			req:    &SystemDBRestoreReq{},
+			expErr: errors.New("empty snapshot archive"),
+		},
+		"req fails": {
+			req: &SystemDBRestoreReq{Data: []byte("abc")},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"bad rank set": {
+			req: &SystemDBRestoreReq{Data: []byte("abc")},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDBRestoreResp{
+						MissingRanks: "bad",
+					}),
+				},
+			},
+			expErr: errors.New("invalid rank set"),
+		},
+		"success": {
+			req: &SystemDBRestoreReq{Data: []byte("abc"), Apply: true},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDBRestoreResp{
+						Index:           5,
+						Term:            2,
+						Version:         10,
+						MapVersion:      3,
+						MissingRanks:    "[1-2]",
+						ChangedRanks:    "4",
+						ExtraPools:      []string{"pool1"},
+						MissingFindings: []uint64{7},
+						Applied:         true,
+					}),
+				},
+			},
+			expResp: &SystemDBRestoreResp{
+				Index:           5,
+				Term:            2,
+				Version:         10,
+				MapVersion:      3,
+				MissingRanks:    ranklist.MustCreateRankSet("[1-2]"),
+				ExtraRanks:      ranklist.MustCreateRankSet(""),
+				ChangedRanks:    ranklist.MustCreateRankSet("4"),
+				ExtraPools:      []string{"pool1"},
+				MissingFindings: []uint64{7},
+				Applied:         true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDBRestore(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertTrue(t, gotResp.HasDifferences(), "expected differences")
+		})
+	}
+}
+
+func TestControl_SystemDBVerify(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDBVerifyReq
+		mic     *MockInvokerConfig
+		expResp *SystemDBVerifyResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: new(SystemDBVerifyReq),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: new(SystemDBVerifyReq),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDBVerifyResp{
+						Version: 10,
+						Inconsistencies: []*mgmtpb.SystemDBInconsistency{
+							{Check: "map-version", Description: "too low"},
+						},
+					}),
+				},
+			},
+			expResp: &SystemDBVerifyResp{
+				Version: 10,
+				Inconsistencies: []*SystemDBInconsistency{
+					{Check: "map-version", Description: "too low"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDBVerify(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+type mockDBReplicaStream struct {
+	grpc.ClientStream
+	resps []*mgmtpb.SystemDBReplicaResp
+	err   error
+}
+
+func (ms *mockDBReplicaStream) Recv() (*mgmtpb.SystemDBReplicaResp, error) {
+	if len(ms.resps) == 0 {
+		if ms.err != nil {
+			return nil, ms.err
+		}
+		return nil, io.EOF
+	}
+
+	resp := ms.resps[0]
+	ms.resps = ms.resps[1:]
+	return resp, nil
+}
+
+func TestControl_recvDBReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stream  *mockDBReplicaStream
+		expResp *mgmtpb.SystemDBReplicaResp
+		expErr  error
+	}{
+		"no data": {
+			stream: &mockDBReplicaStream{},
+			expErr: errors.New("no system database data"),
+		},
+		"not replica": {
+			stream: &mockDBReplicaStream{
+				err: pbUtil.AnnotateError(&system.ErrNotReplica{}),
+			},
+			expErr: &system.ErrNotReplica{},
+		},
+		"incomplete": {
+			stream: &mockDBReplicaStream{
+				resps: []*mgmtpb.SystemDBReplicaResp{
+					{Version: 5, Size: 6, Data: []byte("abc")},
+				},
+			},
+			expErr: errors.New("incomplete system database"),
+		},
+		"success": {
+			stream: &mockDBReplicaStream{
+				resps: []*mgmtpb.SystemDBReplicaResp{
+					{Version: 5, Size: 6, Data: []byte("abc")},
+					{Data: []byte("def")},
+				},
+			},
+			expResp: &mgmtpb.SystemDBReplicaResp{
+				Version: 5, Size: 6, Data: []byte("abcdef"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotResp, gotErr := recvDBReplica(tc.stream, "host1")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDBReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hostList []string
+		mic      *MockInvokerConfig
+		expResp  *SystemDBReplicaResp
+		expErr   error
+	}{
+		"no host": {
+			expErr: errors.New("exactly one MS replica"),
+		},
+		"too many hosts": {
+			hostList: []string{"host1:10001", "host2:10001"},
+			expErr:   errors.New("exactly one MS replica"),
+		},
+		"host fails": {
+			hostList: []string{"host1:10001"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1:10001", Error: errors.New("not a replica")},
+					},
+				},
+			},
+			expErr: errors.New("MS replica host1:10001: not a replica"),
+		},
+		"success": {
+			hostList: []string{"host1:10001"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1:10001",
+							Message: &mgmtpb.SystemDBReplicaResp{
+								Version: 4,
+								Data:    []byte("abc"),
+							},
+						},
+					},
+				},
+			},
+			expResp: &SystemDBReplicaResp{
+				Version: 4,
+				Data:    []byte("abc"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			req := new(SystemDBReplicaReq)
+			req.SetHostList(tc.hostList)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDBReplica(test.Context(t), client, req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDBDiff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDBDiffReq
+		mic     *MockInvokerConfig
+		expResp *SystemDBDiffResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no replicas": {
+			req:    &SystemDBDiffReq{},
+			expErr: errors.New("one or two MS replicas"),
+		},
+		"too many replicas": {
+			req:    &SystemDBDiffReq{Replicas: []string{"a", "b", "c"}},
+			expErr: errors.New("one or two MS replicas"),
+		},
+		"req fails": {
+			req: &SystemDBDiffReq{Replicas: []string{"host1:10001"}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemDBDiffReq{Replicas: []string{"host1:10001", "host2:10001"}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDBDiffResp{
+						First:         "host1:10001",
+						FirstVersion:  10,
+						Second:        "host2:10001",
+						SecondVersion: 9,
+						MissingRanks:  "3",
+						ChangedPools:  []string{"pool1"},
+						ExtraFindings: []uint64{2},
+					}),
+				},
+			},
+			expResp: &SystemDBDiffResp{
+				First:         "host1:10001",
+				FirstVersion:  10,
+				Second:        "host2:10001",
+				SecondVersion: 9,
+				MissingRanks:  ranklist.MustCreateRankSet("3"),
+				ExtraRanks:    ranklist.MustCreateRankSet(""),
+				ChangedRanks:  ranklist.MustCreateRankSet(""),
+				ChangedPools:  []string{"pool1"},
+				ExtraFindings: []uint64{2},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDBDiff(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertTrue(t, gotResp.HasDifferences(), "expected differences")
+		})
+	}
+}
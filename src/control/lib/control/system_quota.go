@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// SystemSetQuotaReq contains the inputs for a request to set the
+	// resource quota for a user or group ACL principal.
+	SystemSetQuotaReq struct {
+		unaryRequest
+		msRequest
+
+		User      string   // user ACL principal, mutually exclusive with Group
+		Group     string   // group ACL principal, mutually exclusive with User
+		MaxPools  uint32   // 0 for unlimited
+		TierBytes []uint64 // per-tier storage limits, 0 for unlimited
+	}
+
+	// SystemQuota describes the limits set for a user or group ACL
+	// principal, along with the resources currently counted against them.
+	SystemQuota struct {
+		User          string   `json:"user,omitempty"`
+		Group         string   `json:"group,omitempty"`
+		MaxPools      uint32   `json:"max_pools"`
+		TierBytes     []uint64 `json:"tier_bytes"`
+		UsedPools     uint32   `json:"used_pools"`
+		UsedTierBytes []uint64 `json:"used_tier_bytes"`
+	}
+
+	// SystemGetQuotaReq contains the inputs for a request to get the
+	// resource quotas for one or more users or groups.
+	SystemGetQuotaReq struct {
+		unaryRequest
+		msRequest
+
+		Users  []string // user ACL principals
+		Groups []string // group ACL principals; all quotas are returned if both are empty
+	}
+
+	// SystemGetQuotaResp contains the requested quotas.
+	SystemGetQuotaResp struct {
+		Quotas []*SystemQuota `json:"quotas"`
+	}
+)
+
+// SystemSetQuota sets the resource quota for a user or group ACL principal. If
+// no limits are set, any existing quota for the principal is removed.
+func SystemSetQuota(ctx context.Context, rpcClient UnaryInvoker, req *SystemSetQuotaReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if (req.User == "") == (req.Group == "") {
+		return errors.New("quota must be set for either a user or a group")
+	}
+
+	pbReq := &mgmtpb.SystemSetQuotaReq{
+		Sys:       req.getSystem(rpcClient),
+		User:      req.User,
+		Group:     req.Group,
+		MaxPools:  req.MaxPools,
+		TierBytes: req.TierBytes,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSetQuota(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemSetQuota request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+// SystemGetQuota gets the resource quotas for the requested users and groups,
+// or all quotas if none are requested.
+func SystemGetQuota(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetQuotaReq) (*SystemGetQuotaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemGetQuotaReq{
+		Sys:    req.getSystem(rpcClient),
+		Users:  req.Users,
+		Groups: req.Groups,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemGetQuota(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemGetQuota request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemGetQuotaResp)
+	return resp, convertMSResponse(ur, resp)
+}
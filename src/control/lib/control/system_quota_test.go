@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_SystemSetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SystemSetQuotaReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no principal": {
+			req:    &SystemSetQuotaReq{MaxPools: 1},
+			expErr: errors.New("either a user or a group"),
+		},
+		"user and group": {
+			req:    &SystemSetQuotaReq{User: "bob@", Group: "builders@", MaxPools: 1},
+			expErr: errors.New("either a user or a group"),
+		},
+		"req fails": {
+			req: &SystemSetQuotaReq{User: "bob@", MaxPools: 1},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemSetQuotaReq{Group: "builders@", MaxPools: 1},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemSetQuota(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemGetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemGetQuotaReq
+		mic     *MockInvokerConfig
+		expResp *SystemGetQuotaResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemGetQuotaReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemGetQuotaReq{Users: []string{"bob@"}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemGetQuotaResp{
+						Quotas: []*mgmtpb.SystemQuota{
+							{
+								User:          "bob@",
+								MaxPools:      2,
+								TierBytes:     []uint64{0, 100},
+								UsedPools:     1,
+								UsedTierBytes: []uint64{5, 50},
+							},
+						},
+					}),
+				},
+			},
+			expResp: &SystemGetQuotaResp{
+				Quotas: []*SystemQuota{
+					{
+						User:          "bob@",
+						MaxPools:      2,
+						TierBytes:     []uint64{0, 100},
+						UsedPools:     1,
+						UsedTierBytes: []uint64{5, 50},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemGetQuota(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// SystemReplicaReq contains the inputs for a request to add a server
+	// to, or remove a server from, the MS replica set.
+	SystemReplicaReq struct {
+		unaryRequest
+		msRequest
+		Addr  string // control address (host:port) of the server
+		Force bool   // skip the quorum safety checks
+	}
+
+	// SystemLeaderTransferReq contains the inputs for a request to transfer
+	// MS leadership to another replica.
+	SystemLeaderTransferReq struct {
+		unaryRequest
+		msRequest
+		Addr string // control address of the new leader, any replica if unset
+	}
+
+	// SystemReplicaResp describes the MS replica set after a change.
+	SystemReplicaResp struct {
+		Replicas []string `json:"replicas"`
+		Leader   string   `json:"leader"`
+	}
+)
+
+// invokeReplicaRPC sends a replica set change request to the MS leader and
+// converts the response.
+func invokeReplicaRPC(ctx context.Context, rpcClient UnaryInvoker, req UnaryRequest) (*SystemReplicaResp, error) {
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemReplicaAdd adds the server at the supplied control address to the MS
+// replica set. The server must host a joined member and, unless Force is set,
+// a quorum of the resulting replica set must be available.
+func SystemReplicaAdd(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Addr == "" {
+		return nil, errors.New("no server address supplied")
+	}
+
+	pbReq := &mgmtpb.SystemReplicaReq{
+		Sys:   req.getSystem(rpcClient),
+		Addr:  req.Addr,
+		Force: req.Force,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicaAdd(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemReplicaAdd request: %s", pbUtil.Debug(pbReq))
+	return invokeReplicaRPC(ctx, rpcClient, req)
+}
+
+// SystemReplicaRemove removes the server at the supplied control address from
+// the MS replica set. The current MS leader may not be removed and, unless
+// Force is set, a quorum of the resulting replica set must be available.
+func SystemReplicaRemove(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Addr == "" {
+		return nil, errors.New("no server address supplied")
+	}
+
+	pbReq := &mgmtpb.SystemReplicaReq{
+		Sys:   req.getSystem(rpcClient),
+		Addr:  req.Addr,
+		Force: req.Force,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplicaRemove(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemReplicaRemove request: %s", pbUtil.Debug(pbReq))
+	return invokeReplicaRPC(ctx, rpcClient, req)
+}
+
+// SystemLeaderTransfer transfers MS leadership to the replica at the supplied
+// control address, or to any other replica if no address is supplied.
+func SystemLeaderTransfer(ctx context.Context, rpcClient UnaryInvoker, req *SystemLeaderTransferReq) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemLeaderTransferReq{
+		Sys:  req.getSystem(rpcClient),
+		Addr: req.Addr,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemLeaderTransfer(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemLeaderTransfer request: %s", pbUtil.Debug(pbReq))
+	return invokeReplicaRPC(ctx, rpcClient, req)
+}
+
+type (
+	// ReplicaQueryReq contains the inputs for a request to check that each
+	// server in the request host list is running a MS replica.
+	ReplicaQueryReq struct {
+		unaryRequest
+	}
+
+	// ReplicaQueryResp contains an error for each server in the request
+	// host list that is not running a MS replica.
+	ReplicaQueryResp struct {
+		HostErrorsResp
+	}
+)
+
+// ReplicaQuery checks that each server in the request host list is running a
+// MS replica, i.e. that it is configured as an access point. Unlike
+// LeaderQuery, the request is not redirected to other replicas.
+func ReplicaQuery(ctx context.Context, rpcClient UnaryInvoker, req *ReplicaQueryReq) (*ReplicaQueryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.LeaderQueryReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).LeaderQuery(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS ReplicaQuery request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ReplicaQueryResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+type (
+	// SetAccessPointsReq contains the MS replica set to be sent to each
+	// server in the request host list.
+	SetAccessPointsReq struct {
+		unaryRequest
+		AccessPoints []string
+	}
+
+	// SetAccessPointsResp contains the results of a request to update the
+	// MS access points on a set of servers.
+	SetAccessPointsResp struct {
+		HostErrorsResp
+	}
+)
+
+// SetAccessPoints updates the MS replica set used by each server in the
+// request host list. It is called by the MS leader after the replica set has
+// been changed so that servers that are not MS replicas forward requests to
+// the new set of access points. Each server verifies the request with the MS
+// before accepting it.
+func SetAccessPoints(ctx context.Context, rpcClient UnaryInvoker, req *SetAccessPointsReq) (*SetAccessPointsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.AccessPoints) == 0 {
+		return nil, errors.New("no access points supplied")
+	}
+
+	pbReq := &mgmtpb.SetAccessPointsReq{
+		Sys:          req.getSystem(rpcClient),
+		AccessPoints: req.AccessPoints,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SetAccessPoints(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SetAccessPoints request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SetAccessPointsResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resp, nil
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2020-2024 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -949,6 +949,106 @@ func TestControl_SystemStop(t *testing.T) {
 	}
 }
 
+func TestControl_SystemStop_Progress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		unaryOnly   bool
+		streamMsgs  []*HostResponse
+		expProgress []*SystemStopProgress
+	}{
+		"no progress": {},
+		"results from each phase": {
+			streamMsgs: []*HostResponse{
+				{
+					Addr: "host1",
+					Message: &mgmtpb.SystemStopProgress{
+						Action: "prep shutdown",
+						Results: []*sharedpb.RankResult{
+							{
+								Rank:  0,
+								State: system.MemberStateStopping.String(),
+							},
+						},
+					},
+				},
+				{
+					Addr: "host1",
+					Message: &mgmtpb.SystemStopProgress{
+						Action: "stop",
+						Results: []*sharedpb.RankResult{
+							{
+								Rank:  0,
+								State: system.MemberStateStopped.String(),
+							},
+							{
+								Rank:    1,
+								Errored: true,
+								Msg:     "kill failed",
+								State:   system.MemberStateErrored.String(),
+							},
+						},
+					},
+				},
+			},
+			expProgress: []*SystemStopProgress{
+				{
+					Action: "prep shutdown",
+					Results: system.MemberResults{
+						system.NewMemberResult(0, nil, system.MemberStateStopping),
+					},
+				},
+				{
+					Action: "stop",
+					Results: system.MemberResults{
+						system.NewMemberResult(0, nil, system.MemberStateStopped),
+						system.NewMemberResult(1, errors.New("kill failed"), system.MemberStateErrored),
+					},
+				},
+			},
+		},
+		"client without stream support": {
+			unaryOnly: true,
+			streamMsgs: []*HostResponse{
+				{
+					Addr: "host1",
+					Message: &mgmtpb.SystemStopProgress{
+						Action: "stop",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse:  MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{}),
+				StreamMessages: tc.streamMsgs,
+			})
+
+			var gotProgress []*SystemStopProgress
+			req := new(SystemStopReq)
+			req.SetProgressCb(func(prog *SystemStopProgress) {
+				gotProgress = append(gotProgress, prog)
+			})
+
+			var invoker UnaryInvoker = mi
+			if tc.unaryOnly {
+				invoker = struct{ UnaryInvoker }{mi}
+			}
+
+			if _, err := SystemStop(test.Context(t), invoker, req); err != nil {
+				t.Fatal(err)
+			}
+
+			cmpOpts := []cmp.Option{cmpopts.IgnoreUnexported(system.MemberResult{})}
+			if diff := cmp.Diff(tc.expProgress, gotProgress, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_SystemStopRespErrors(t *testing.T) {
 	successResults := system.MemberResults{
 		system.NewMemberResult(1, nil, system.MemberStateReady),
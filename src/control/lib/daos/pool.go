@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -79,6 +80,8 @@ type (
 		DisabledRanks    *ranklist.RankSet    `json:"disabled_ranks,omitempty"`
 		PoolLayoutVer    uint32               `json:"pool_layout_ver"`
 		UpgradeLayoutVer uint32               `json:"upgrade_layout_ver"`
+		Creator          string               `json:"creator,omitempty"`
+		Created          *time.Time           `json:"created,omitempty"`
 	}
 
 	PoolQueryTargetType  int32
@@ -0,0 +1,232 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+package promexp
+
+import (
+	"strings"
+	"sync"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	// GoMetricsCollector implements the go-metrics MetricSink interface in
+	// order to bridge metrics emitted by libraries that use go-metrics
+	// (e.g. hashicorp/raft) into Prometheus. Metric vectors are created on
+	// demand as new keys are emitted.
+	GoMetricsCollector struct {
+		log       logging.Logger
+		namespace string
+		mutex     sync.Mutex // To protect the metric maps
+		types     map[string]string
+		gauges    map[string]*prometheus.GaugeVec
+		counters  map[string]*prometheus.CounterVec
+		summaries map[string]*prometheus.SummaryVec
+	}
+)
+
+const (
+	goMetricsGauge   = "gauge"
+	goMetricsCounter = "counter"
+	goMetricsSample  = "sample"
+)
+
+var _ metrics.MetricSink = (*GoMetricsCollector)(nil)
+
+// NewGoMetricsCollector initializes a new collector for go-metrics. The
+// namespace is prepended to the name of every metric.
+func NewGoMetricsCollector(log logging.Logger, namespace string) *GoMetricsCollector {
+	return &GoMetricsCollector{
+		log:       log,
+		namespace: namespace,
+		types:     make(map[string]string),
+		gauges:    make(map[string]*prometheus.GaugeVec),
+		counters:  make(map[string]*prometheus.CounterVec),
+		summaries: make(map[string]*prometheus.SummaryVec),
+	}
+}
+
+func (c *GoMetricsCollector) metricName(key []string) string {
+	name := sanitizeMetricName(strings.Join(key, "_"))
+	if c.namespace == "" {
+		return name
+	}
+	return c.namespace + "_" + name
+}
+
+func goMetricsLabels(labels []metrics.Label) (labelMap, []string) {
+	lm := make(labelMap)
+	for _, l := range labels {
+		lm[sanitizeMetricName(l.Name)] = l.Value
+	}
+	return lm, lm.keys()
+}
+
+// checkType ensures that a metric name is only ever used for one type of
+// metric, as Prometheus would reject the duplicate names. The caller must
+// hold the mutex.
+func (c *GoMetricsCollector) checkType(name, metricType string) error {
+	if cur, found := c.types[name]; found && cur != metricType {
+		return errors.Errorf("%s already registered as a %s", name, cur)
+	}
+	c.types[name] = metricType
+
+	return nil
+}
+
+func (c *GoMetricsCollector) logErr(name string, err error) {
+	c.log.Debugf("[%s]: dropping go-metrics value: %s", name, err)
+}
+
+// SetGauge implements the go-metrics MetricSink interface.
+func (c *GoMetricsCollector) SetGauge(key []string, val float32) {
+	c.SetGaugeWithLabels(key, val, nil)
+}
+
+// SetGaugeWithLabels implements the go-metrics MetricSink interface.
+func (c *GoMetricsCollector) SetGaugeWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := c.metricName(key)
+	lm, keys := goMetricsLabels(labels)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.checkType(name, goMetricsGauge); err != nil {
+		c.logErr(name, err)
+		return
+	}
+	gv, found := c.gauges[name]
+	if !found {
+		gv = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: name,
+			Help: strings.Join(key, "."),
+		}, keys)
+		c.gauges[name] = gv
+	}
+
+	g, err := gv.GetMetricWith(prometheus.Labels(lm))
+	if err != nil {
+		c.logErr(name, err)
+		return
+	}
+	g.Set(float64(val))
+}
+
+// EmitKey implements the go-metrics MetricSink interface. Emitted keys are
+// treated as gauges.
+func (c *GoMetricsCollector) EmitKey(key []string, val float32) {
+	c.SetGaugeWithLabels(key, val, nil)
+}
+
+// IncrCounter implements the go-metrics MetricSink interface.
+func (c *GoMetricsCollector) IncrCounter(key []string, val float32) {
+	c.IncrCounterWithLabels(key, val, nil)
+}
+
+// IncrCounterWithLabels implements the go-metrics MetricSink interface.
+func (c *GoMetricsCollector) IncrCounterWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := c.metricName(key)
+	lm, keys := goMetricsLabels(labels)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.checkType(name, goMetricsCounter); err != nil {
+		c.logErr(name, err)
+		return
+	}
+	cv, found := c.counters[name]
+	if !found {
+		cv = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: name,
+			Help: strings.Join(key, "."),
+		}, keys)
+		c.counters[name] = cv
+	}
+
+	ctr, err := cv.GetMetricWith(prometheus.Labels(lm))
+	if err != nil {
+		c.logErr(name, err)
+		return
+	}
+	if val < 0 {
+		c.logErr(name, errors.Errorf("negative counter increment %f", val))
+		return
+	}
+	ctr.Add(float64(val))
+}
+
+// AddSample implements the go-metrics MetricSink interface.
+func (c *GoMetricsCollector) AddSample(key []string, val float32) {
+	c.AddSampleWithLabels(key, val, nil)
+}
+
+// AddSampleWithLabels implements the go-metrics MetricSink interface. Samples
+// are exported as summaries.
+func (c *GoMetricsCollector) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := c.metricName(key)
+	lm, keys := goMetricsLabels(labels)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.checkType(name, goMetricsSample); err != nil {
+		c.logErr(name, err)
+		return
+	}
+	sv, found := c.summaries[name]
+	if !found {
+		sv = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+			Name:       name,
+			Help:       strings.Join(key, "."),
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		}, keys)
+		c.summaries[name] = sv
+	}
+
+	o, err := sv.GetMetricWith(prometheus.Labels(lm))
+	if err != nil {
+		c.logErr(name, err)
+		return
+	}
+	o.Observe(float64(val))
+}
+
+// Describe implements the prometheus.Collector interface. As the metrics are
+// created on demand, nothing is described and the collector is unchecked.
+func (c *GoMetricsCollector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements the prometheus.Collector interface.
+func (c *GoMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	if c == nil {
+		return
+	}
+	if ch == nil {
+		c.log.Error("passed a nil channel")
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, gv := range c.gauges {
+		gv.Collect(ch)
+	}
+	for _, cv := range c.counters {
+		cv.Collect(ch)
+	}
+	for _, sv := range c.summaries {
+		sv.Collect(ch)
+	}
+}
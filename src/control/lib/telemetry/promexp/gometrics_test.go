@@ -0,0 +1,106 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"testing"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestPromExp_GoMetricsCollector(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	c := NewGoMetricsCollector(log, "server")
+	c.SetGauge([]string{"raft", "peers"}, 3)
+	c.SetGauge([]string{"raft", "peers"}, 5)
+	c.EmitKey([]string{"raft", "leader", "dispatchNumLogs"}, 2)
+	c.IncrCounter([]string{"raft", "state", "leader"}, 1)
+	c.IncrCounter([]string{"raft", "state", "leader"}, 1)
+	c.IncrCounterWithLabels([]string{"ms", "fsm", "apply"}, 1, []metrics.Label{{Name: "op", Value: "addMember"}})
+	c.IncrCounterWithLabels([]string{"ms", "fsm", "apply"}, 1, []metrics.Label{{Name: "op", Value: "removeMember"}})
+	c.IncrCounterWithLabels([]string{"ms", "fsm", "apply"}, 1, []metrics.Label{{Name: "op", Value: "addMember"}})
+	c.AddSample([]string{"raft", "commitTime"}, 4)
+	c.AddSample([]string{"raft", "commitTime"}, 6)
+
+	// Values that can't be bridged are dropped.
+	c.SetGauge([]string{"raft", "commitTime"}, 1)
+	c.IncrCounterWithLabels([]string{"raft", "state", "leader"}, 1, []metrics.Label{{Name: "bad", Value: "label"}})
+	c.IncrCounter([]string{"raft", "state", "leader"}, -1)
+
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(c); err != nil {
+		t.Fatal(err)
+	}
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		Type   dto.MetricType
+		Values map[string]float64
+	}
+	gotResults := make(map[string]result)
+	for _, mf := range families {
+		res := result{
+			Type:   mf.GetType(),
+			Values: make(map[string]float64),
+		}
+		for _, m := range mf.GetMetric() {
+			var labels string
+			for _, lp := range m.GetLabel() {
+				labels += lp.GetName() + "=" + lp.GetValue()
+			}
+			switch mf.GetType() {
+			case dto.MetricType_GAUGE:
+				res.Values[labels] = m.GetGauge().GetValue()
+			case dto.MetricType_COUNTER:
+				res.Values[labels] = m.GetCounter().GetValue()
+			case dto.MetricType_SUMMARY:
+				res.Values[labels] = m.GetSummary().GetSampleSum()
+			}
+		}
+		gotResults[mf.GetName()] = res
+	}
+
+	expResults := map[string]result{
+		"server_raft_peers": {
+			Type:   dto.MetricType_GAUGE,
+			Values: map[string]float64{"": 5},
+		},
+		"server_raft_leader_dispatchNumLogs": {
+			Type:   dto.MetricType_GAUGE,
+			Values: map[string]float64{"": 2},
+		},
+		"server_raft_state_leader": {
+			Type:   dto.MetricType_COUNTER,
+			Values: map[string]float64{"": 2},
+		},
+		"server_ms_fsm_apply": {
+			Type: dto.MetricType_COUNTER,
+			Values: map[string]float64{
+				"op=addMember":    2,
+				"op=removeMember": 1,
+			},
+		},
+		"server_raft_commitTime": {
+			Type:   dto.MetricType_SUMMARY,
+			Values: map[string]float64{"": 10},
+		},
+	}
+	if diff := cmp.Diff(expResults, gotResults); diff != "" {
+		t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+	}
+}
@@ -21,16 +21,26 @@ func (p ACLPrincipalFlag) String() string {
 	return string(p)
 }
 
+// ParseACLPrincipal converts the supplied string to a DAOS ACL principal,
+// adding a trailing '@' to a bare user or group name, and returns an error if
+// the result is not a valid principal.
+func ParseACLPrincipal(in string) (string, error) {
+	principal := in
+	if !strings.ContainsRune(principal, '@') {
+		principal += "@"
+	}
+	if !daos.ACLPrincipalIsValid(principal) {
+		return "", errors.Errorf("invalid ACL principal %q", in)
+	}
+
+	return principal, nil
+}
+
 // UnmarshalFlag implements the go-flags.Unmarshaler interface.
 func (p *ACLPrincipalFlag) UnmarshalFlag(fv string) error {
-	pv := fv // Copy to avoid modifying the original string.
-	// If necessary, try adding a trailing '@' to the string
-	// in order to convert a bare user/group name to a principal.
-	if !strings.ContainsRune(pv, '@') {
-		pv += "@"
-	}
-	if !daos.ACLPrincipalIsValid(pv) {
-		return errors.Errorf("invalid ACL principal %q", fv)
+	pv, err := ParseACLPrincipal(fv)
+	if err != nil {
+		return err
 	}
 
 	*p = ACLPrincipalFlag(pv)
@@ -16,23 +16,38 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ui"
 )
 
+var aclTooLong = strings.Repeat("a", daos.ACLPrincipalMaxLen+1)
+
+var aclPrincipalTestCases = []struct {
+	in     string
+	out    string
+	expErr error
+}{
+	{"user", "user@", nil},
+	{"user@", "user@", nil},
+	{"user@domain", "user@domain", nil},
+	{"user@domain@", "", errors.New("invalid ACL principal \"user@domain@\"")},
+	{"@user", "", errors.New("invalid ACL principal \"@user\"")},
+	{"@", "", errors.New("invalid ACL principal \"@\"")},
+	{aclTooLong, "", errors.New("invalid ACL principal \"" + aclTooLong + "\"")},
+}
+
+func TestUI_ParseACLPrincipal(t *testing.T) {
+	for _, tc := range aclPrincipalTestCases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, gotErr := ui.ParseACLPrincipal(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if got != tc.out {
+				t.Fatalf("got %q, want %q", got, tc.out)
+			}
+		})
+	}
+}
+
 func TestUI_ACLPrincipalFlag(t *testing.T) {
 	var p ui.ACLPrincipalFlag
-	tooLong := strings.Repeat("a", daos.ACLPrincipalMaxLen+1)
-
-	for _, tc := range []struct {
-		in     string
-		out    string
-		expErr error
-	}{
-		{"user", "user@", nil},
-		{"user@", "user@", nil},
-		{"user@domain", "user@domain", nil},
-		{"user@domain@", "", errors.New("invalid ACL principal \"user@domain@\"")},
-		{"@user", "", errors.New("invalid ACL principal \"@user\"")},
-		{"@", "", errors.New("invalid ACL principal \"@\"")},
-		{tooLong, "", errors.New("invalid ACL principal \"" + tooLong + "\"")},
-	} {
+
+	for _, tc := range aclPrincipalTestCases {
 		t.Run(tc.in, func(t *testing.T) {
 			gotErr := p.UnmarshalFlag(tc.in)
 			test.CmpErr(t, tc.expErr, gotErr)
@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// CertWatchInterval is the interval at which the certificate files are
+// checked for changes.
+const CertWatchInterval = 30 * time.Second
+
+type certFileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func getCertFileState(path string) certFileState {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return certFileState{}
+	}
+	return certFileState{modTime: fi.ModTime(), size: fi.Size()}
+}
+
+// CertWatcher reloads the certificate data of a TransportConfig when any of
+// its CA root, certificate or key files change, so that renewed certificates
+// are used for new connections without a restart.
+type CertWatcher struct {
+	log      logging.Logger
+	cfg      *TransportConfig
+	mutex    sync.Mutex
+	files    map[string]certFileState
+	onReload []func()
+}
+
+// NewCertWatcher returns a CertWatcher for the given TransportConfig, which
+// should already have its certificate data loaded.
+func NewCertWatcher(log logging.Logger, cfg *TransportConfig) *CertWatcher {
+	w := &CertWatcher{
+		log:   log,
+		cfg:   cfg,
+		files: make(map[string]certFileState),
+	}
+	for _, path := range w.paths() {
+		w.files[path] = getCertFileState(path)
+	}
+
+	return w
+}
+
+func (w *CertWatcher) paths() []string {
+	return []string{w.cfg.CARootPath, w.cfg.CertificatePath, w.cfg.PrivateKeyPath}
+}
+
+// OnReload registers a callback to be run after the certificates have been
+// reloaded.
+func (w *CertWatcher) OnReload(fn func()) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.onReload = append(w.onReload, fn)
+}
+
+// Check reloads the certificate data if any of the files have changed since
+// the last check, returning true if the certificates were reloaded. If the new
+// certificates are invalid, e.g. because only some of the files have been
+// replaced yet, the previous certificates stay in use until the next change.
+func (w *CertWatcher) Check() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	var changed []string
+	for _, path := range w.paths() {
+		state := getCertFileState(path)
+		if state != w.files[path] {
+			changed = append(changed, path)
+			w.files[path] = state
+		}
+	}
+	if len(changed) == 0 {
+		return false
+	}
+
+	w.log.Debugf("certificate files changed: %v", changed)
+	if err := w.cfg.ReloadCertData(); err != nil {
+		w.log.Errorf("unable to reload certificates; continuing to use the previous certificates: %s", err)
+		return false
+	}
+	w.log.Noticef("reloaded certificate %s", w.cfg.CertificatePath)
+
+	for _, fn := range w.onReload {
+		fn()
+	}
+
+	return true
+}
+
+// Start checks the certificate files for changes at the given interval until
+// the context is canceled.
+func (w *CertWatcher) Start(ctx context.Context, interval time.Duration) {
+	if w.cfg.AllowInsecure {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.Check()
+			}
+		}
+	}()
+}
@@ -0,0 +1,99 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSecurity_CertWatcher(t *testing.T) {
+	useTestKeyBits(t)
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	caCert, caKey := genTestCA(t)
+	cfg := writeTestCerts(t, testDir, caCert, caKey, ComponentServer)
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	origCert := cfg.CertificateChain()[0]
+
+	// Make sure that rewritten files are seen as modified.
+	modTime := time.Now()
+	touch := func(paths ...string) {
+		t.Helper()
+		modTime = modTime.Add(time.Second)
+		for _, path := range paths {
+			if err := os.Chtimes(path, modTime, modTime); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	var reloads int
+	w := NewCertWatcher(log, cfg)
+	w.OnReload(func() { reloads++ })
+
+	test.AssertFalse(t, w.Check(), "reloaded unchanged certificates")
+
+	// A key that doesn't match the certificate is rejected and the
+	// previous certificate is kept.
+	_, badKey, err := IssueCertificate(caCert, caKey, &CertRequest{Component: ComponentServer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WritePrivateKey(cfg.PrivateKeyPath, badKey); err != nil {
+		t.Fatal(err)
+	}
+	touch(cfg.PrivateKeyPath)
+
+	test.AssertFalse(t, w.Check(), "reloaded invalid certificates")
+	test.AssertTrue(t, origCert.Equal(cfg.CertificateChain()[0]), "certificate changed after failed reload")
+	test.AssertEqual(t, 0, reloads, "unexpected reload callbacks")
+
+	// Once the certificate matching the key is written, both are loaded.
+	newCfg := writeTestCerts(t, t.TempDir(), caCert, caKey, ComponentServer)
+	for src, dst := range map[string]string{
+		newCfg.CertificatePath: cfg.CertificatePath,
+		newCfg.PrivateKeyPath:  cfg.PrivateKeyPath,
+	} {
+		if err := os.Rename(src, dst); err != nil {
+			t.Fatal(err)
+		}
+	}
+	touch(cfg.CertificatePath, cfg.PrivateKeyPath)
+
+	test.AssertTrue(t, w.Check(), "certificates not reloaded")
+	test.AssertEqual(t, 1, reloads, "unexpected reload callbacks")
+	newCert := cfg.CertificateChain()[0]
+	test.AssertFalse(t, origCert.Equal(newCert), "certificate unchanged after reload")
+
+	// New connections use the reloaded certificate.
+	connCfg, err := serverTLSConfig(cfg).GetConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, bytes.Equal(newCert.Raw, connCfg.Certificates[0].Certificate[0]),
+		"server TLS config not using reloaded certificate")
+	clientCert, err := clientTLSConfig(cfg).GetClientCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, bytes.Equal(newCert.Raw, clientCert.Certificate[0]),
+		"client TLS config not using reloaded certificate")
+
+	test.AssertFalse(t, w.Check(), "reloaded unchanged certificates")
+}
@@ -0,0 +1,211 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultCertValidity is the default validity period of generated
+	// certificates.
+	DefaultCertValidity = 1095 * 24 * time.Hour
+	// CACommonName is the common name of generated CA certificates.
+	CACommonName = "DAOS CA"
+	// CertOrganization is the organization of generated certificates.
+	CertOrganization = "DAOS"
+)
+
+// certKeyBits is the size of the RSA keys of generated certificates.
+var certKeyBits = 3072
+
+// CertRequest describes a certificate to be generated.
+type CertRequest struct {
+	Component          Component
+	OrganizationalUnit []string
+	DNSNames           []string
+	IPAddresses        []net.IP
+	Validity           time.Duration
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	if err != nil {
+		return nil, errors.Wrap(err, "generating certificate serial number")
+	}
+	return serial, nil
+}
+
+func validityPeriod(validity time.Duration) (time.Time, time.Time) {
+	if validity == 0 {
+		validity = DefaultCertValidity
+	}
+	now := time.Now()
+	// Allow for some clock skew between the nodes.
+	return now.Add(-time.Hour), now.Add(validity)
+}
+
+func createCertificate(tmpl, parent *x509.Certificate, key *rsa.PrivateKey, signer *rsa.PrivateKey) (*x509.Certificate, error) {
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating certificate")
+	}
+	return x509.ParseCertificate(der)
+}
+
+// GenerateCA creates a self-signed CA certificate and private key that may be
+// used to sign the certificates of the DAOS components.
+func GenerateCA(validity time.Duration) (*x509.Certificate, *rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, certKeyBits)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "generating CA key")
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notBefore, notAfter := validityPeriod(validity)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{CertOrganization},
+			CommonName:   CACommonName,
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            1,
+	}
+
+	cert, err := createCertificate(tmpl, tmpl, key, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// IssueCertificate creates a certificate and private key for a DAOS component,
+// signed by the given CA.
+func IssueCertificate(caCert *x509.Certificate, caKey *rsa.PrivateKey, req *CertRequest) (*x509.Certificate, *rsa.PrivateKey, error) {
+	if req == nil {
+		return nil, nil, errors.New("nil CertRequest")
+	}
+	if caCert == nil || caKey == nil {
+		return nil, nil, errors.New("CA certificate and key are required")
+	}
+	if !caCert.IsCA {
+		return nil, nil, errors.Errorf("%q is not a CA certificate", caCert.Subject.CommonName)
+	}
+
+	var extKeyUsage []x509.ExtKeyUsage
+	switch req.Component {
+	case ComponentServer:
+		extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	case ComponentAdmin, ComponentAgent:
+		extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	default:
+		return nil, nil, errors.Errorf("unable to issue a certificate for component %q", req.Component)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, certKeyBits)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "generating key")
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notBefore, notAfter := validityPeriod(req.Validity)
+	if notAfter.After(caCert.NotAfter) {
+		if req.Validity != 0 {
+			return nil, nil, errors.Errorf("certificate would expire after the CA certificate (%s)",
+				caCert.NotAfter.Format(time.RFC3339))
+		}
+		// By default, the certificate is valid for as long as the CA.
+		notAfter = caCert.NotAfter
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization:       []string{CertOrganization},
+			OrganizationalUnit: req.OrganizationalUnit,
+			// The common name identifies the component and must not
+			// be changed.
+			CommonName: req.Component.String(),
+		},
+		DNSNames:              req.DNSNames,
+		IPAddresses:           req.IPAddresses,
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           extKeyUsage,
+		BasicConstraintsValid: true,
+	}
+
+	cert, err := createCertificate(tmpl, caCert, key, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// writeFileAtomic writes the data to a temporary file which is then renamed to
+// the given path, so that the file is never seen partially written.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// WriteCertificate writes the certificate to the given path in PEM format.
+func WriteCertificate(path string, cert *x509.Certificate) error {
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	return errors.Wrapf(writeFileAtomic(path, data, 0644), "writing certificate %s", path)
+}
+
+// WritePrivateKey writes the private key to the given path in PEM format,
+// readable only by the owner.
+func WritePrivateKey(path string, key *rsa.PrivateKey) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return errors.Wrap(err, "encoding private key")
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	return errors.Wrapf(writeFileAtomic(path, data, MaxUserOnlyKeyPerm), "writing private key %s", path)
+}
@@ -0,0 +1,207 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+// useTestKeyBits reduces the size of generated keys to speed up the tests.
+func useTestKeyBits(t *testing.T) {
+	t.Helper()
+
+	orig := certKeyBits
+	certKeyBits = 1024
+	t.Cleanup(func() {
+		certKeyBits = orig
+	})
+}
+
+func genTestCA(t *testing.T) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+
+	caCert, caKey, err := GenerateCA(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return caCert, caKey
+}
+
+// writeTestCerts writes a CA and a certificate for the given component to the
+// directory and returns a TransportConfig using them.
+func writeTestCerts(t *testing.T, dir string, caCert *x509.Certificate, caKey *rsa.PrivateKey, comp Component) *TransportConfig {
+	t.Helper()
+
+	cert, key, err := IssueCertificate(caCert, caKey, &CertRequest{Component: comp})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &TransportConfig{
+		CertificateConfig: CertificateConfig{
+			CARootPath:      filepath.Join(dir, "daosCA.crt"),
+			CertificatePath: filepath.Join(dir, comp.String()+".crt"),
+			PrivateKeyPath:  filepath.Join(dir, comp.String()+".key"),
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	if err := WriteCertificate(cfg.CARootPath, caCert); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteCertificate(cfg.CertificatePath, cert); err != nil {
+		t.Fatal(err)
+	}
+	if err := WritePrivateKey(cfg.PrivateKeyPath, key); err != nil {
+		t.Fatal(err)
+	}
+
+	return cfg
+}
+
+func TestSecurity_GenerateCA(t *testing.T) {
+	useTestKeyBits(t)
+
+	caCert, _, err := GenerateCA(24 * time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertTrue(t, caCert.IsCA, "expected CA certificate")
+	test.AssertEqual(t, CACommonName, caCert.Subject.CommonName, "unexpected CN")
+	test.AssertEqual(t, 25*time.Hour, caCert.NotAfter.Sub(caCert.NotBefore), "unexpected validity")
+	if err := caCert.CheckSignatureFrom(caCert); err != nil {
+		t.Fatalf("CA certificate is not self-signed: %s", err)
+	}
+}
+
+func TestSecurity_IssueCertificate(t *testing.T) {
+	useTestKeyBits(t)
+	caCert, caKey := genTestCA(t)
+	otherCA, _ := genTestCA(t)
+
+	for name, tc := range map[string]struct {
+		caCert      *x509.Certificate
+		caKey       *rsa.PrivateKey
+		req         *CertRequest
+		expKeyUsage []x509.ExtKeyUsage
+		expErr      error
+	}{
+		"nil request": {
+			caCert: caCert,
+			caKey:  caKey,
+			expErr: errors.New("nil CertRequest"),
+		},
+		"missing CA": {
+			req:    &CertRequest{Component: ComponentServer},
+			expErr: errors.New("CA certificate and key are required"),
+		},
+		"undefined component": {
+			caCert: caCert,
+			caKey:  caKey,
+			req:    &CertRequest{},
+			expErr: errors.New("component \"undefined\""),
+		},
+		"outlives CA": {
+			caCert: caCert,
+			caKey:  caKey,
+			req:    &CertRequest{Component: ComponentAgent, Validity: 2 * DefaultCertValidity},
+			expErr: errors.New("expire after the CA"),
+		},
+		"wrong CA key": {
+			caCert: otherCA,
+			caKey:  caKey,
+			req:    &CertRequest{Component: ComponentAgent, Validity: time.Hour},
+			expErr: errors.New("creating certificate"),
+		},
+		"server": {
+			caCert:      caCert,
+			caKey:       caKey,
+			req:         &CertRequest{Component: ComponentServer, Validity: time.Hour},
+			expKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		},
+		"agent": {
+			caCert:      caCert,
+			caKey:       caKey,
+			req:         &CertRequest{Component: ComponentAgent, Validity: time.Hour},
+			expKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+		"admin with OU and SANs": {
+			caCert: caCert,
+			caKey:  caKey,
+			req: &CertRequest{
+				Component:          ComponentAdmin,
+				OrganizationalUnit: []string{"ops"},
+				DNSNames:           []string{"admin.example.com"},
+				IPAddresses:        []net.IP{net.ParseIP("10.0.0.1")},
+				Validity:           time.Hour,
+			},
+			expKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cert, key, err := IssueCertificate(tc.caCert, tc.caKey, tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.req.Component, CommonNameToComponent(cert.Subject.CommonName), "unexpected component")
+			test.AssertFalse(t, cert.IsCA, "issued certificate is a CA")
+			if diff := cmp.Diff(tc.expKeyUsage, cert.ExtKeyUsage); diff != "" {
+				t.Fatalf("unexpected key usage (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.req.OrganizationalUnit, cert.Subject.OrganizationalUnit); diff != "" {
+				t.Fatalf("unexpected OU (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.req.DNSNames, cert.DNSNames); diff != "" {
+				t.Fatalf("unexpected DNS names (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, key.PublicKey.N.Cmp(cert.PublicKey.(*rsa.PublicKey).N), 0, "key doesn't match certificate")
+
+			roots := x509.NewCertPool()
+			roots.AddCert(tc.caCert)
+			if _, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: tc.expKeyUsage}); err != nil {
+				t.Fatalf("issued certificate failed verification: %s", err)
+			}
+		})
+	}
+}
+
+func TestSecurity_WriteCertificates(t *testing.T) {
+	useTestKeyBits(t)
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caCert, caKey := genTestCA(t)
+	cfg := writeTestCerts(t, testDir, caCert, caKey, ComponentServer)
+
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	chain := cfg.CertificateChain()
+	test.AssertEqual(t, 2, len(chain), "unexpected chain length")
+	test.AssertEqual(t, ComponentServer.String(), chain[0].Subject.CommonName, "unexpected leaf")
+	test.AssertEqual(t, CACommonName, chain[1].Subject.CommonName, "unexpected root")
+
+	key, err := LoadPrivateKey(cfg.PrivateKeyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, chain[0].PublicKey.(*rsa.PublicKey).N.Cmp(key.(*rsa.PrivateKey).N), 0,
+		"loaded key doesn't match certificate")
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -47,15 +48,16 @@ func (tc *TransportConfig) String() string {
 // component. ServerName is only needed if the config is being used as a
 // transport credential for a gRPC tls client.
 type CertificateConfig struct {
-	ServerName      string           `yaml:"-"`
-	ClientCertDir   string           `yaml:"client_cert_dir,omitempty"`
-	CARootPath      string           `yaml:"ca_cert"`
-	CertificatePath string           `yaml:"cert"`
-	PrivateKeyPath  string           `yaml:"key"`
-	tlsKeypair      *tls.Certificate `yaml:"-"`
-	caPool          *x509.CertPool   `yaml:"-"`
-	maxKeyPerms     fs.FileMode      `yaml:"-"`
-	verifyTime      time.Time        `yaml:"-"` // for testing
+	ServerName      string              `yaml:"-"`
+	ClientCertDir   string              `yaml:"client_cert_dir,omitempty"`
+	CARootPath      string              `yaml:"ca_cert"`
+	CertificatePath string              `yaml:"cert"`
+	PrivateKeyPath  string              `yaml:"key"`
+	tlsKeypair      *tls.Certificate    `yaml:"-"`
+	caPool          *x509.CertPool      `yaml:"-"`
+	certChain       []*x509.Certificate `yaml:"-"`
+	maxKeyPerms     fs.FileMode         `yaml:"-"`
+	verifyTime      time.Time           `yaml:"-"` // for testing
 }
 
 // DefaultAgentTransportConfig provides a default transport config disabling
@@ -114,6 +116,10 @@ func DefaultServerTransportConfig() *TransportConfig {
 	}
 }
 
+// certDataLock protects the certificate data loaded into TransportConfigs, as
+// it may be reloaded while in use by established TLS configurations.
+var certDataLock sync.RWMutex
+
 // PreLoadCertData reads the certificate files in and parses them into TLS key
 // pair and Certificate pool to provide a mechanism for detecting certificate
 // error before first use.
@@ -121,7 +127,7 @@ func (tc *TransportConfig) PreLoadCertData() error {
 	if tc == nil {
 		return errors.New("nil TransportConfig")
 	}
-	if tc.tlsKeypair != nil && tc.caPool != nil || tc.AllowInsecure {
+	if keypair, caPool := tc.certData(); keypair != nil && caPool != nil || tc.AllowInsecure {
 		// In this case the data is already preloaded.
 		// In order to reload data use ReloadCertData
 		return nil
@@ -135,39 +141,86 @@ func (tc *TransportConfig) PreLoadCertData() error {
 		}
 	}
 
+	certificate, certPool, chain, err := tc.loadCertData()
+	if certificate != nil {
+		tc.setCertData(certificate, certPool, chain)
+	}
+
+	return err
+}
+
+// loadCertData loads the certificate files and verifies the certificate. The
+// loaded data is returned along with any verification error.
+func (tc *TransportConfig) loadCertData() (*tls.Certificate, *x509.CertPool, []*x509.Certificate, error) {
 	certificate, certPool, err := loadCertWithCustomCA(tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath, tc.maxKeyPerms)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	tc.tlsKeypair = certificate
-	tc.caPool = certPool
-
 	// Pre-parse the Leaf Certificate
-	tc.tlsKeypair.Leaf, err = x509.ParseCertificate(tc.tlsKeypair.Certificate[0])
+	certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0])
 	if err != nil {
-		return err
+		return certificate, certPool, nil, err
 	}
 
-	if _, err = tc.tlsKeypair.Leaf.Verify(x509.VerifyOptions{
+	chains, err := certificate.Leaf.Verify(x509.VerifyOptions{
 		CurrentTime: tc.CertificateConfig.verifyTime, // for testing - by default this is 0, which is treated as current time
 		Roots:       certPool,
 		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
-	}); isInvalidCert(err) {
-		return FaultInvalidCertFile(tc.CertificatePath, err)
+	})
+	if isInvalidCert(err) {
+		return certificate, certPool, nil, FaultInvalidCertFile(tc.CertificatePath, err)
 	} else if err != nil {
-		return err
+		return certificate, certPool, nil, err
 	}
 
-	return nil
+	return certificate, certPool, chains[0], nil
+}
+
+func (tc *TransportConfig) setCertData(certificate *tls.Certificate, certPool *x509.CertPool, chain []*x509.Certificate) {
+	certDataLock.Lock()
+	defer certDataLock.Unlock()
+
+	tc.tlsKeypair = certificate
+	tc.caPool = certPool
+	tc.certChain = chain
 }
 
 // ReloadCertData reloads and stores the certificate data in the case when
-// certificate data has changed since initial loading.
+// certificate data has changed since initial loading. If the new certificate
+// data is invalid, the previously loaded data is kept.
 func (tc *TransportConfig) ReloadCertData() error {
-	tc.tlsKeypair = nil
-	tc.caPool = nil
-	return tc.PreLoadCertData()
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+	if tc.AllowInsecure {
+		return nil
+	}
+
+	certificate, certPool, chain, err := tc.loadCertData()
+	if err != nil {
+		return err
+	}
+	tc.setCertData(certificate, certPool, chain)
+
+	return nil
+}
+
+// certData returns the currently loaded TLS key pair and CA certificate pool.
+func (tc *TransportConfig) certData() (*tls.Certificate, *x509.CertPool) {
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	return tc.tlsKeypair, tc.caPool
+}
+
+// CertificateChain returns the loaded certificate followed by the certificates
+// that it was verified against, ending with the CA root certificate.
+func (tc *TransportConfig) CertificateChain() []*x509.Certificate {
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	return tc.certChain
 }
 
 // PrivateKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -176,13 +229,15 @@ func (tc *TransportConfig) PrivateKey() (crypto.PrivateKey, error) {
 		return nil, nil
 	}
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
+	keypair, caPool := tc.certData()
+	if keypair == nil || caPool == nil {
 		err := tc.ReloadCertData()
 		if err != nil {
 			return nil, err
 		}
+		keypair, _ = tc.certData()
 	}
-	return tc.tlsKeypair.PrivateKey, nil
+	return keypair.PrivateKey, nil
 }
 
 // PublicKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -191,11 +246,13 @@ func (tc *TransportConfig) PublicKey() (crypto.PublicKey, error) {
 		return nil, nil
 	}
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
+	keypair, caPool := tc.certData()
+	if keypair == nil || caPool == nil {
 		err := tc.ReloadCertData()
 		if err != nil {
 			return nil, err
 		}
+		keypair, _ = tc.certData()
 	}
-	return tc.tlsKeypair.Leaf.PublicKey, nil
+	return keypair.Leaf.PublicKey, nil
 }
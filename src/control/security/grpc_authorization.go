@@ -30,6 +30,7 @@ func (c Component) String() string {
 var methodAuthorizations = map[string][]Component{
 	"/ctl.CtlSvc/StorageScan":                {ComponentAdmin},
 	"/ctl.CtlSvc/StorageFormat":              {ComponentAdmin},
+	"/ctl.CtlSvc/StorageFormatStream":        {ComponentAdmin},
 	"/ctl.CtlSvc/StorageNvmeRebind":          {ComponentAdmin},
 	"/ctl.CtlSvc/StorageNvmeAddDevice":       {ComponentAdmin},
 	"/ctl.CtlSvc/NetworkScan":                {ComponentAdmin},
@@ -37,6 +38,8 @@ var methodAuthorizations = map[string][]Component{
 	"/ctl.CtlSvc/FirmwareQuery":              {ComponentAdmin},
 	"/ctl.CtlSvc/FirmwareUpdate":             {ComponentAdmin},
 	"/ctl.CtlSvc/SmdQuery":                   {ComponentAdmin},
+	"/ctl.CtlSvc/StorageHealthTrend":         {ComponentAdmin},
+	"/ctl.CtlSvc/StorageScmHealth":           {ComponentAdmin},
 	"/ctl.CtlSvc/SmdManage":                  {ComponentAdmin},
 	"/ctl.CtlSvc/SetEngineLogMasks":          {ComponentAdmin},
 	"/ctl.CtlSvc/PrepShutdownRanks":          {ComponentServer},
@@ -50,6 +53,7 @@ var methodAuthorizations = map[string][]Component{
 	"/mgmt.MgmtSvc/SystemErase":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemStart":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemStop":               {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemStopStream":         {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemExclude":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolCreate":               {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolDestroy":              {ComponentAdmin},
@@ -83,10 +87,31 @@ var methodAuthorizations = map[string][]Component{
 	"/mgmt.MgmtSvc/FaultInjectPoolFault":     {ComponentAdmin},
 	"/mgmt.MgmtSvc/FaultInjectMgmtPoolFault": {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolUpgrade":              {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolOpSchedule":           {ComponentAdmin, ComponentServer},
+	"/mgmt.MgmtSvc/PoolOpList":               {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolOpCancel":             {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemSetAttr":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemSetProp":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemGetProp":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemGetEvents":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemStreamEvents":       {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemSetEventPolicy":     {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemGetEventPolicy":     {ComponentAdmin, ComponentServer},
+	"/mgmt.MgmtSvc/SystemDBSnapshot":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDBRestore":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemReplicaAdd":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemReplicaRemove":      {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemLeaderTransfer":     {ComponentAdmin},
+	"/mgmt.MgmtSvc/SetAccessPoints":          {ComponentServer},
+	"/mgmt.MgmtSvc/SystemDBVerify":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDBReplica":          {ComponentServer},
+	"/mgmt.MgmtSvc/SystemDBDiff":             {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemSetQuota":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemGetQuota":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemGetAccess":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemGetAudit":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemAddAudit":           {ComponentServer},
 	"/RaftTransport/AppendEntries":           {ComponentServer},
 	"/RaftTransport/AppendEntriesPipeline":   {ComponentServer},
 	"/RaftTransport/RequestVote":             {ComponentServer},
@@ -55,6 +55,7 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 	testCases := map[string][]Component{
 		"/ctl.CtlSvc/StorageScan":                {ComponentAdmin},
 		"/ctl.CtlSvc/StorageFormat":              {ComponentAdmin},
+		"/ctl.CtlSvc/StorageFormatStream":        {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeRebind":          {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeAddDevice":       {ComponentAdmin},
 		"/ctl.CtlSvc/NetworkScan":                {ComponentAdmin},
@@ -62,6 +63,8 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/ctl.CtlSvc/FirmwareQuery":              {ComponentAdmin},
 		"/ctl.CtlSvc/FirmwareUpdate":             {ComponentAdmin},
 		"/ctl.CtlSvc/SmdQuery":                   {ComponentAdmin},
+		"/ctl.CtlSvc/StorageHealthTrend":         {ComponentAdmin},
+		"/ctl.CtlSvc/StorageScmHealth":           {ComponentAdmin},
 		"/ctl.CtlSvc/SmdManage":                  {ComponentAdmin},
 		"/ctl.CtlSvc/SetEngineLogMasks":          {ComponentAdmin},
 		"/ctl.CtlSvc/PrepShutdownRanks":          {ComponentServer},
@@ -73,6 +76,7 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/LeaderQuery":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemQuery":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStop":               {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemStopStream":         {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemErase":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStart":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemExclude":            {ComponentAdmin},
@@ -108,10 +112,31 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/FaultInjectPoolFault":     {ComponentAdmin},
 		"/mgmt.MgmtSvc/FaultInjectMgmtPoolFault": {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolUpgrade":              {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolOpSchedule":           {ComponentAdmin, ComponentServer},
+		"/mgmt.MgmtSvc/PoolOpList":               {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolOpCancel":             {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetAttr":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetProp":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetProp":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemGetEvents":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemStreamEvents":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemSetEventPolicy":     {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemGetEventPolicy":     {ComponentAdmin, ComponentServer},
+		"/mgmt.MgmtSvc/SystemDBSnapshot":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDBRestore":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemReplicaAdd":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemReplicaRemove":      {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemLeaderTransfer":     {ComponentAdmin},
+		"/mgmt.MgmtSvc/SetAccessPoints":          {ComponentServer},
+		"/mgmt.MgmtSvc/SystemDBVerify":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDBReplica":          {ComponentServer},
+		"/mgmt.MgmtSvc/SystemDBDiff":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemSetQuota":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemGetQuota":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemGetAccess":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemGetAudit":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemAddAudit":           {ComponentServer},
 		"/RaftTransport/AppendEntries":           {ComponentServer},
 		"/RaftTransport/AppendEntriesPipeline":   {ComponentServer},
 		"/RaftTransport/RequestVote":             {ComponentServer},
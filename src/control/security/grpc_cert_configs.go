@@ -28,11 +28,28 @@ import (
 // On the client side we still ensure the CommonName for the server is correct and
 // validate the certificate chain.
 
+// serverTLSConfig returns the server TLS configuration for the transport
+// config. The configuration for each new connection is created from the
+// certificate data loaded at the time, so that certificates reloaded with
+// ReloadCertData are used without restarting the server.
 func serverTLSConfig(cfg *TransportConfig) *tls.Config {
+	tlsCfg := newServerTLSConfig(cfg.certData())
+	tlsCfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		connCfg := newServerTLSConfig(cfg.certData())
+		// gRPC adds HTTP/2 to the protocols of the base configuration
+		// only, so it has to be advertised here as well.
+		connCfg.NextProtos = []string{"h2"}
+		return connCfg, nil
+	}
+
+	return tlsCfg
+}
+
+func newServerTLSConfig(keypair *tls.Certificate, caPool *x509.CertPool) *tls.Config {
 	return &tls.Config{
 		ClientAuth:               tls.RequireAndVerifyClientCert,
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		ClientCAs:                cfg.caPool,
+		Certificates:             []tls.Certificate{*keypair},
+		ClientCAs:                caPool,
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -41,7 +58,7 @@ func serverTLSConfig(cfg *TransportConfig) *tls.Config {
 		},
 		VerifyConnection: func(cs tls.ConnectionState) error {
 			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
+				Roots:         caPool,
 				Intermediates: x509.NewCertPool(),
 				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 			}
@@ -56,10 +73,15 @@ func serverTLSConfig(cfg *TransportConfig) *tls.Config {
 
 const ServerCommonName = "server"
 
+// clientTLSConfig returns the client TLS configuration for the transport
+// config. The certificate data is looked up for each new connection, so that
+// certificates reloaded with ReloadCertData are used without a restart.
 func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 	return &tls.Config{
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		RootCAs:                  cfg.caPool,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			keypair, _ := cfg.certData()
+			return keypair, nil
+		},
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -79,8 +101,9 @@ func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 		// of the received certificate is "server" to ensure we are
 		// communicating with a DAOS server.
 		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, caPool := cfg.certData()
 			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
+				Roots:         caPool,
 				Intermediates: x509.NewCertPool(),
 			}
 			for _, cert := range cs.PeerCertificates[1:] {
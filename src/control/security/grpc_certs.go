@@ -18,11 +18,8 @@ func GetServerTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
-		err := cfg.PreLoadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
 	}
 
 	creds := credentials.NewTLS(serverTLSConfig(cfg))
@@ -34,11 +31,8 @@ func GetClientTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
-		err := cfg.PreLoadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
 	}
 
 	creds := credentials.NewTLS(clientTLSConfig(cfg))
@@ -0,0 +1,352 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Role represents the level of access granted to the holder of an admin
+// certificate. Each role may call the methods available to the roles below it.
+type Role int
+
+const (
+	RoleUndefined Role = iota
+	RoleNone
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	names := [...]string{"undefined", "none", "viewer", "operator", "admin"}
+	if r < 0 || int(r) >= len(names) {
+		return "unknown"
+	}
+	return names[r]
+}
+
+// RoleFromString resolves a role name to a Role.
+func RoleFromString(name string) (Role, error) {
+	for _, r := range []Role{RoleNone, RoleViewer, RoleOperator, RoleAdmin} {
+		if strings.EqualFold(name, r.String()) {
+			return r, nil
+		}
+	}
+	return RoleUndefined, errors.Errorf("unknown role %q", name)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (r Role) MarshalYAML() (interface{}, error) {
+	return r.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (r *Role) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err != nil {
+		return err
+	}
+
+	role, err := RoleFromString(name)
+	if err != nil {
+		return err
+	}
+	*r = role
+
+	return nil
+}
+
+// methodRoles maps admin methods to the lowest role that may call them. Admin
+// methods that are not listed may only be called by RoleAdmin.
+var methodRoles = map[string]Role{
+	"/ctl.CtlSvc/StorageScan":            RoleViewer,
+	"/ctl.CtlSvc/NetworkScan":            RoleViewer,
+	"/ctl.CtlSvc/FirmwareQuery":          RoleViewer,
+	"/ctl.CtlSvc/SmdQuery":               RoleViewer,
+	"/ctl.CtlSvc/StorageHealthTrend":     RoleViewer,
+	"/ctl.CtlSvc/StorageScmHealth":       RoleViewer,
+	"/mgmt.MgmtSvc/LeaderQuery":          RoleViewer,
+	"/mgmt.MgmtSvc/SystemQuery":          RoleViewer,
+	"/mgmt.MgmtSvc/PoolQuery":            RoleViewer,
+	"/mgmt.MgmtSvc/PoolQueryTarget":      RoleViewer,
+	"/mgmt.MgmtSvc/PoolGetProp":          RoleViewer,
+	"/mgmt.MgmtSvc/PoolGetACL":           RoleViewer,
+	"/mgmt.MgmtSvc/ListPools":            RoleViewer,
+	"/mgmt.MgmtSvc/ListContainers":       RoleViewer,
+	"/mgmt.MgmtSvc/SystemCheckQuery":     RoleViewer,
+	"/mgmt.MgmtSvc/SystemCheckGetPolicy": RoleViewer,
+	"/mgmt.MgmtSvc/PoolOpList":           RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetAttr":        RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetProp":        RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetEvents":      RoleViewer,
+	"/mgmt.MgmtSvc/SystemStreamEvents":   RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetEventPolicy": RoleViewer,
+	"/mgmt.MgmtSvc/SystemDBVerify":       RoleViewer,
+	"/mgmt.MgmtSvc/SystemDBDiff":         RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetQuota":       RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetAccess":      RoleViewer,
+	"/mgmt.MgmtSvc/SystemGetAudit":       RoleViewer,
+	"/ctl.CtlSvc/SmdManage":              RoleOperator,
+	"/ctl.CtlSvc/CollectLog":             RoleOperator,
+	"/ctl.CtlSvc/SetEngineLogMasks":      RoleOperator,
+	"/mgmt.MgmtSvc/SystemStart":          RoleOperator,
+	"/mgmt.MgmtSvc/SystemStop":           RoleOperator,
+	"/mgmt.MgmtSvc/SystemStopStream":     RoleOperator,
+	"/mgmt.MgmtSvc/SystemExclude":        RoleOperator,
+	"/mgmt.MgmtSvc/SystemCleanup":        RoleOperator,
+	"/mgmt.MgmtSvc/PoolExclude":          RoleOperator,
+	"/mgmt.MgmtSvc/PoolDrain":            RoleOperator,
+	"/mgmt.MgmtSvc/PoolReintegrate":      RoleOperator,
+	"/mgmt.MgmtSvc/PoolExtend":           RoleOperator,
+	"/mgmt.MgmtSvc/PoolEvict":            RoleOperator,
+	"/mgmt.MgmtSvc/PoolUpgrade":          RoleOperator,
+	"/mgmt.MgmtSvc/PoolOpSchedule":       RoleOperator,
+	"/mgmt.MgmtSvc/PoolOpCancel":         RoleOperator,
+	"/mgmt.MgmtSvc/SystemCheckStart":     RoleOperator,
+	"/mgmt.MgmtSvc/SystemCheckStop":      RoleOperator,
+	"/mgmt.MgmtSvc/SystemDBSnapshot":     RoleOperator,
+}
+
+// HasAccess checks if the role may call the method given in FullMethod.
+func (r Role) HasAccess(FullMethod string) bool {
+	if r <= RoleNone {
+		return false
+	}
+
+	required, found := methodRoles[FullMethod]
+	if !found {
+		required = RoleAdmin
+	}
+
+	return r >= required
+}
+
+// subjectKeys maps the attribute names accepted in role binding subjects to
+// functions returning the values of the attribute in a certificate subject.
+var subjectKeys = map[string]func(*x509.Certificate) []string{
+	"CN":           func(c *x509.Certificate) []string { return []string{c.Subject.CommonName} },
+	"SERIALNUMBER": func(c *x509.Certificate) []string { return []string{c.Subject.SerialNumber} },
+	"C":            func(c *x509.Certificate) []string { return c.Subject.Country },
+	"O":            func(c *x509.Certificate) []string { return c.Subject.Organization },
+	"OU":           func(c *x509.Certificate) []string { return c.Subject.OrganizationalUnit },
+	"L":            func(c *x509.Certificate) []string { return c.Subject.Locality },
+	"ST":           func(c *x509.Certificate) []string { return c.Subject.Province },
+	"STREET":       func(c *x509.Certificate) []string { return c.Subject.StreetAddress },
+	"POSTALCODE":   func(c *x509.Certificate) []string { return c.Subject.PostalCode },
+}
+
+type subjectAttr struct {
+	key   string
+	value string
+}
+
+// parseSubject parses a role binding subject in the form "OU=ops,O=DAOS".
+func parseSubject(subject string) ([]subjectAttr, error) {
+	var attrs []subjectAttr
+	for _, field := range strings.Split(subject, ",") {
+		key, value, found := strings.Cut(field, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if !found || key == "" || value == "" {
+			return nil, errors.Errorf("invalid subject %q: expected attribute=value pairs", subject)
+		}
+		if _, known := subjectKeys[key]; !known {
+			return nil, errors.Errorf("invalid subject %q: unknown attribute %q", subject, key)
+		}
+		attrs = append(attrs, subjectAttr{key: key, value: value})
+	}
+	return attrs, nil
+}
+
+// subjectMatches returns true if the certificate subject has all of the
+// attributes of the given role binding subject.
+func subjectMatches(subject string, cert *x509.Certificate) bool {
+	attrs, err := parseSubject(subject)
+	if err != nil {
+		return false
+	}
+
+	for _, attr := range attrs {
+		found := false
+		for _, val := range subjectKeys[attr.key](cert) {
+			if val == attr.value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// CertificateSANs returns the subject alternative names of a certificate.
+func CertificateSANs(cert *x509.Certificate) []string {
+	sans := append([]string{}, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return sans
+}
+
+// RoleBinding grants a role to the admin certificates that match any of the
+// given subjects or subject alternative names.
+type RoleBinding struct {
+	Role     Role     `yaml:"role"`
+	Subjects []string `yaml:"subjects,omitempty"`
+	SANs     []string `yaml:"sans,omitempty"`
+}
+
+// certMatches returns true if the certificate matches any of the given
+// subjects or subject alternative names.
+func certMatches(subjects, sans []string, cert *x509.Certificate) bool {
+	for _, subject := range subjects {
+		if subjectMatches(subject, cert) {
+			return true
+		}
+	}
+
+	for _, san := range CertificateSANs(cert) {
+		for _, bound := range sans {
+			if strings.EqualFold(san, bound) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Matches returns true if the binding applies to the given certificate.
+func (rb *RoleBinding) Matches(cert *x509.Certificate) bool {
+	return certMatches(rb.Subjects, rb.SANs, cert)
+}
+
+// IdentityMapping maps the admin certificates that match any of the given
+// subjects or subject alternative names to a DAOS user and/or group.
+type IdentityMapping struct {
+	User     string   `yaml:"user,omitempty"`
+	Group    string   `yaml:"group,omitempty"`
+	Subjects []string `yaml:"subjects,omitempty"`
+	SANs     []string `yaml:"sans,omitempty"`
+}
+
+// Matches returns true if the mapping applies to the given certificate.
+func (im *IdentityMapping) Matches(cert *x509.Certificate) bool {
+	return certMatches(im.Subjects, im.SANs, cert)
+}
+
+// AccessControlConfig defines the roles granted to the holders of admin
+// certificates. Certificates are matched against the role bindings in order
+// and the first match determines the role. Certificates that don't match any
+// binding are granted the default role, which must be set if there are any
+// bindings.
+//
+// The identity mappings are matched in the same way and determine the DAOS
+// user and group that own the pools created by the holder of a certificate,
+// unless an owner is given explicitly.
+type AccessControlConfig struct {
+	DefaultRole      Role               `yaml:"default_role,omitempty"`
+	RoleBindings     []*RoleBinding     `yaml:"role_bindings,omitempty"`
+	IdentityMappings []*IdentityMapping `yaml:"identity_mappings,omitempty"`
+}
+
+// Validate checks the access control configuration.
+func (cfg *AccessControlConfig) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if len(cfg.RoleBindings) > 0 && cfg.DefaultRole == RoleUndefined {
+		return errors.New("default_role must be set if role_bindings are configured")
+	}
+
+	for idx, rb := range cfg.RoleBindings {
+		if rb == nil {
+			return errors.Errorf("role binding %d is empty", idx)
+		}
+		if rb.Role == RoleUndefined {
+			return errors.Errorf("role binding %d: role must be set", idx)
+		}
+		if len(rb.Subjects) == 0 && len(rb.SANs) == 0 {
+			return errors.Errorf("role binding %d: at least one subject or SAN must be set", idx)
+		}
+		for _, subject := range rb.Subjects {
+			if _, err := parseSubject(subject); err != nil {
+				return errors.Wrapf(err, "role binding %d", idx)
+			}
+		}
+	}
+
+	for idx, im := range cfg.IdentityMappings {
+		if im == nil {
+			return errors.Errorf("identity mapping %d is empty", idx)
+		}
+		if im.User == "" && im.Group == "" {
+			return errors.Errorf("identity mapping %d: user or group must be set", idx)
+		}
+		if len(im.Subjects) == 0 && len(im.SANs) == 0 {
+			return errors.Errorf("identity mapping %d: at least one subject or SAN must be set", idx)
+		}
+		for _, subject := range im.Subjects {
+			if _, err := parseSubject(subject); err != nil {
+				return errors.Wrapf(err, "identity mapping %d", idx)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RoleForCertificate returns the role granted to the holder of the given
+// admin certificate. If no role bindings are configured, all admin
+// certificates are granted RoleAdmin. Otherwise, certificates that don't match
+// a binding are granted the default role, or RoleNone if it is not set.
+func (cfg *AccessControlConfig) RoleForCertificate(cert *x509.Certificate) Role {
+	if cfg == nil {
+		return RoleAdmin
+	}
+
+	for _, rb := range cfg.RoleBindings {
+		if cert != nil && rb.Matches(cert) {
+			return rb.Role
+		}
+	}
+
+	if cfg.DefaultRole != RoleUndefined {
+		return cfg.DefaultRole
+	}
+	if len(cfg.RoleBindings) == 0 {
+		return RoleAdmin
+	}
+	return RoleNone
+}
+
+// IdentityForCertificate returns the first identity mapping that applies to
+// the given admin certificate, or nil if none do.
+func (cfg *AccessControlConfig) IdentityForCertificate(cert *x509.Certificate) *IdentityMapping {
+	if cfg == nil || cert == nil {
+		return nil
+	}
+
+	for _, im := range cfg.IdentityMappings {
+		if im.Matches(cert) {
+			return im
+		}
+	}
+
+	return nil
+}
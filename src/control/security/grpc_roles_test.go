@@ -0,0 +1,331 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSecurity_MethodRolesAreAdminMethods(t *testing.T) {
+	var invalid []string
+	for method := range methodRoles {
+		if !ComponentAdmin.HasAccess(method) {
+			invalid = append(invalid, method)
+		}
+	}
+	if len(invalid) > 0 {
+		t.Fatalf("roles set for methods that admins can't call (remove from methodRoles):\n%s", strings.Join(invalid, "\n"))
+	}
+}
+
+func TestSecurity_RoleHasAccess(t *testing.T) {
+	for method, expRoles := range map[string][]Role{
+		"/mgmt.MgmtSvc/SystemQuery":      {RoleViewer, RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/SystemGetAccess":  {RoleViewer, RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/SystemStop":       {RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/PoolExclude":      {RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/PoolDestroy":      {RoleAdmin},
+		"/mgmt.MgmtSvc/SystemErase":      {RoleAdmin},
+		"/ctl.CtlSvc/StorageFormat":      {RoleAdmin},
+		"/mgmt.MgmtSvc/SystemDBRestore":  {RoleAdmin},
+		"/mgmt.MgmtSvc/SystemSetProp":    {RoleAdmin},
+		"/mgmt.MgmtSvc/SystemReplicaAdd": {RoleAdmin},
+	} {
+		methodName := strings.SplitAfterN(method, "/", 3)[2]
+		t.Run(methodName, func(t *testing.T) {
+			for _, role := range []Role{RoleUndefined, RoleNone, RoleViewer, RoleOperator, RoleAdmin} {
+				exp := false
+				for _, r := range expRoles {
+					if r == role {
+						exp = true
+					}
+				}
+				test.AssertEqual(t, exp, role.HasAccess(method), role.String()+" access to "+methodName)
+			}
+		})
+	}
+}
+
+func TestSecurity_RoleFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in      string
+		expRole Role
+		expErr  error
+	}{
+		"viewer":       {in: "viewer", expRole: RoleViewer},
+		"mixed case":   {in: "Operator", expRole: RoleOperator},
+		"admin":        {in: "admin", expRole: RoleAdmin},
+		"none":         {in: "none", expRole: RoleNone},
+		"undefined":    {in: "undefined", expErr: errors.New("unknown role")},
+		"unknown role": {in: "root", expErr: errors.New("unknown role \"root\"")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotRole, gotErr := RoleFromString(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expRole, gotRole, "unexpected role")
+		})
+	}
+}
+
+func TestSecurity_AccessControlConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expErr error
+	}{
+		"empty": {},
+		"valid": {
+			in: `
+default_role: viewer
+role_bindings:
+- role: admin
+  subjects: ["OU=storage admins,O=DAOS"]
+- role: operator
+  sans: [ops@example.com]
+`,
+		},
+		"unknown role": {
+			in:     "default_role: root\n",
+			expErr: errors.New("unknown role"),
+		},
+		"bindings without default role": {
+			in:     "role_bindings:\n- role: admin\n  subjects: [OU=ops]\n",
+			expErr: errors.New("default_role must be set"),
+		},
+		"missing role": {
+			in:     "default_role: none\nrole_bindings:\n- subjects: [OU=ops]\n",
+			expErr: errors.New("role binding 0: role must be set"),
+		},
+		"nothing to match": {
+			in:     "default_role: none\nrole_bindings:\n- role: viewer\n",
+			expErr: errors.New("at least one subject or SAN"),
+		},
+		"bad subject": {
+			in:     "default_role: none\nrole_bindings:\n- role: viewer\n  subjects: [ops]\n",
+			expErr: errors.New("expected attribute=value"),
+		},
+		"unknown subject attribute": {
+			in:     "default_role: none\nrole_bindings:\n- role: viewer\n  subjects: [UID=ops]\n",
+			expErr: errors.New("unknown attribute \"UID\""),
+		},
+		"valid identity mappings": {
+			in: `
+identity_mappings:
+- user: alice
+  subjects: ["OU=alice,O=DAOS"]
+- group: ops
+  sans: [ops@example.com]
+`,
+		},
+		"identity mapping without principal": {
+			in:     "identity_mappings:\n- subjects: [OU=alice]\n",
+			expErr: errors.New("identity mapping 0: user or group must be set"),
+		},
+		"identity mapping without match": {
+			in:     "identity_mappings:\n- user: alice\n",
+			expErr: errors.New("identity mapping 0: at least one subject or SAN"),
+		},
+		"identity mapping bad subject": {
+			in:     "identity_mappings:\n- user: alice\n  subjects: [alice]\n",
+			expErr: errors.New("identity mapping 0: invalid subject"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := new(AccessControlConfig)
+			gotErr := yaml.UnmarshalStrict([]byte(tc.in), cfg)
+			if gotErr == nil {
+				gotErr = cfg.Validate()
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestSecurity_AccessControlConfig_RoleForCertificate(t *testing.T) {
+	opsURI, _ := url.Parse("spiffe://example.com/ops")
+	newCert := func(subject pkix.Name) *x509.Certificate {
+		subject.CommonName = "admin"
+		return &x509.Certificate{Subject: subject}
+	}
+
+	cfg := &AccessControlConfig{
+		DefaultRole: RoleViewer,
+		RoleBindings: []*RoleBinding{
+			{
+				Role:     RoleAdmin,
+				Subjects: []string{"O=DAOS, OU=storage admins"},
+			},
+			{
+				Role:     RoleOperator,
+				Subjects: []string{"OU=ops"},
+				SANs:     []string{"ops.example.com", "10.0.0.1", "spiffe://example.com/ops"},
+			},
+			{
+				Role:     RoleNone,
+				Subjects: []string{"OU=retired"},
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		cfg     *AccessControlConfig
+		cert    *x509.Certificate
+		expRole Role
+	}{
+		"no config": {
+			cert:    newCert(pkix.Name{}),
+			expRole: RoleAdmin,
+		},
+		"no default role": {
+			cfg:     &AccessControlConfig{},
+			cert:    newCert(pkix.Name{}),
+			expRole: RoleAdmin,
+		},
+		"no match; no default role": {
+			cfg: &AccessControlConfig{
+				RoleBindings: cfg.RoleBindings,
+			},
+			cert:    newCert(pkix.Name{OrganizationalUnit: []string{"dev"}}),
+			expRole: RoleNone,
+		},
+		"no match": {
+			cfg:     cfg,
+			cert:    newCert(pkix.Name{OrganizationalUnit: []string{"dev"}}),
+			expRole: RoleViewer,
+		},
+		"subject must match all attributes": {
+			cfg:     cfg,
+			cert:    newCert(pkix.Name{OrganizationalUnit: []string{"storage admins"}}),
+			expRole: RoleViewer,
+		},
+		"subject match": {
+			cfg: cfg,
+			cert: newCert(pkix.Name{
+				Organization:       []string{"DAOS"},
+				OrganizationalUnit: []string{"dev", "storage admins"},
+			}),
+			expRole: RoleAdmin,
+		},
+		"first match wins": {
+			cfg: cfg,
+			cert: newCert(pkix.Name{
+				Organization:       []string{"DAOS"},
+				OrganizationalUnit: []string{"ops", "storage admins"},
+			}),
+			expRole: RoleAdmin,
+		},
+		"DNS SAN": {
+			cfg: cfg,
+			cert: &x509.Certificate{
+				Subject:  pkix.Name{CommonName: "admin"},
+				DNSNames: []string{"OPS.example.com"},
+			},
+			expRole: RoleOperator,
+		},
+		"IP SAN": {
+			cfg: cfg,
+			cert: &x509.Certificate{
+				Subject:     pkix.Name{CommonName: "admin"},
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			},
+			expRole: RoleOperator,
+		},
+		"URI SAN": {
+			cfg: cfg,
+			cert: &x509.Certificate{
+				Subject: pkix.Name{CommonName: "admin"},
+				URIs:    []*url.URL{opsURI},
+			},
+			expRole: RoleOperator,
+		},
+		"no access": {
+			cfg:     cfg,
+			cert:    newCert(pkix.Name{OrganizationalUnit: []string{"retired"}}),
+			expRole: RoleNone,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expRole, tc.cfg.RoleForCertificate(tc.cert), "unexpected role")
+		})
+	}
+}
+
+func TestSecurity_AccessControlConfig_IdentityForCertificate(t *testing.T) {
+	alice := &IdentityMapping{User: "alice", Subjects: []string{"OU=alice,O=DAOS"}}
+	ops := &IdentityMapping{User: "ops", Group: "ops", Subjects: []string{"OU=ops"},
+		SANs: []string{"ops.example.com"}}
+	cfg := &AccessControlConfig{
+		IdentityMappings: []*IdentityMapping{alice, ops},
+	}
+	newCert := func(ou ...string) *x509.Certificate {
+		return &x509.Certificate{Subject: pkix.Name{
+			CommonName:         "admin",
+			Organization:       []string{"DAOS"},
+			OrganizationalUnit: ou,
+		}}
+	}
+
+	for name, tc := range map[string]struct {
+		cfg    *AccessControlConfig
+		cert   *x509.Certificate
+		expMap *IdentityMapping
+	}{
+		"no config": {
+			cert: newCert("alice"),
+		},
+		"no cert": {
+			cfg: cfg,
+		},
+		"no match": {
+			cfg:  cfg,
+			cert: newCert("bob"),
+		},
+		"subject match": {
+			cfg:    cfg,
+			cert:   newCert("alice"),
+			expMap: alice,
+		},
+		"first match wins": {
+			cfg:    cfg,
+			cert:   newCert("ops", "alice"),
+			expMap: alice,
+		},
+		"SAN match": {
+			cfg: cfg,
+			cert: &x509.Certificate{
+				Subject:  pkix.Name{CommonName: "admin"},
+				DNSNames: []string{"ops.example.com"},
+			},
+			expMap: ops,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expMap, tc.cfg.IdentityForCertificate(tc.cert), "unexpected mapping")
+		})
+	}
+}
+
+func TestSecurity_RoleString(t *testing.T) {
+	for role, expStr := range map[Role]string{
+		RoleUndefined: "undefined",
+		RoleViewer:    "viewer",
+		RoleAdmin:     "admin",
+		RoleAdmin + 1: "unknown",
+		Role(-1):      "unknown",
+	} {
+		test.AssertEqual(t, expStr, role.String(), "unexpected role string")
+	}
+}
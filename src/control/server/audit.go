@@ -0,0 +1,258 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// auditedMethods maps the mutating control-plane methods that are recorded in
+// the audit log to the name of the operation that is recorded.
+var auditedMethods = map[string]string{
+	"/ctl.CtlSvc/StorageFormat":          "StorageFormat",
+	"/ctl.CtlSvc/StorageFormatStream":    "StorageFormat",
+	"/ctl.CtlSvc/StorageNvmeRebind":      "StorageNvmeRebind",
+	"/ctl.CtlSvc/StorageNvmeAddDevice":   "StorageNvmeAddDevice",
+	"/ctl.CtlSvc/FirmwareUpdate":         "FirmwareUpdate",
+	"/ctl.CtlSvc/SmdManage":              "SmdManage",
+	"/ctl.CtlSvc/SetEngineLogMasks":      "SetEngineLogMasks",
+	"/mgmt.MgmtSvc/SystemErase":          "SystemErase",
+	"/mgmt.MgmtSvc/SystemStart":          "SystemStart",
+	"/mgmt.MgmtSvc/SystemStop":           "SystemStop",
+	"/mgmt.MgmtSvc/SystemStopStream":     "SystemStop",
+	"/mgmt.MgmtSvc/SystemExclude":        "SystemExclude",
+	"/mgmt.MgmtSvc/SystemCleanup":        "SystemCleanup",
+	"/mgmt.MgmtSvc/SystemSetAttr":        "SystemSetAttr",
+	"/mgmt.MgmtSvc/SystemSetProp":        "SystemSetProp",
+	"/mgmt.MgmtSvc/SystemSetEventPolicy": "SystemSetEventPolicy",
+	"/mgmt.MgmtSvc/SystemSetQuota":       "SystemSetQuota",
+	"/mgmt.MgmtSvc/SystemDBRestore":      "SystemDBRestore",
+	"/mgmt.MgmtSvc/SystemReplicaAdd":     "SystemReplicaAdd",
+	"/mgmt.MgmtSvc/SystemReplicaRemove":  "SystemReplicaRemove",
+	"/mgmt.MgmtSvc/SystemLeaderTransfer": "SystemLeaderTransfer",
+	"/mgmt.MgmtSvc/SystemCheckEnable":    "SystemCheckEnable",
+	"/mgmt.MgmtSvc/SystemCheckDisable":   "SystemCheckDisable",
+	"/mgmt.MgmtSvc/SystemCheckStart":     "SystemCheckStart",
+	"/mgmt.MgmtSvc/SystemCheckStop":      "SystemCheckStop",
+	"/mgmt.MgmtSvc/SystemCheckSetPolicy": "SystemCheckSetPolicy",
+	"/mgmt.MgmtSvc/SystemCheckRepair":    "SystemCheckRepair",
+	"/mgmt.MgmtSvc/PoolCreate":           "PoolCreate",
+	"/mgmt.MgmtSvc/PoolDestroy":          "PoolDestroy",
+	"/mgmt.MgmtSvc/PoolSetProp":          "PoolSetProp",
+	"/mgmt.MgmtSvc/PoolOverwriteACL":     "PoolOverwriteACL",
+	"/mgmt.MgmtSvc/PoolUpdateACL":        "PoolUpdateACL",
+	"/mgmt.MgmtSvc/PoolDeleteACL":        "PoolDeleteACL",
+	"/mgmt.MgmtSvc/PoolExclude":          "PoolExclude",
+	"/mgmt.MgmtSvc/PoolDrain":            "PoolDrain",
+	"/mgmt.MgmtSvc/PoolReintegrate":      "PoolReintegrate",
+	"/mgmt.MgmtSvc/PoolEvict":            "PoolEvict",
+	"/mgmt.MgmtSvc/PoolExtend":           "PoolExtend",
+	"/mgmt.MgmtSvc/PoolUpgrade":          "PoolUpgrade",
+	"/mgmt.MgmtSvc/PoolOpSchedule":       "PoolOpSchedule",
+	"/mgmt.MgmtSvc/PoolOpCancel":         "PoolOpCancel",
+	"/mgmt.MgmtSvc/ContSetOwner":         "ContSetOwner",
+}
+
+// methodOperation returns the name of the operation recorded in the audit log
+// for a call to the given method.
+func methodOperation(method string) string {
+	if operation, audited := auditedMethods[method]; audited {
+		return operation
+	}
+	return path.Base(method)
+}
+
+// auditForwardTimeout is the time allowed for forwarding an audit record to
+// the MS leader.
+const auditForwardTimeout = 10 * time.Second
+
+type (
+	// auditDB is the interface to the system database used to store the
+	// audit log.
+	auditDB interface {
+		IsLeader() bool
+		AddAuditRecord(*system.AuditRecord) error
+	}
+
+	// auditForwardFn forwards an audit record to the MS leader.
+	auditForwardFn func(context.Context, *system.AuditRecord) error
+
+	// auditLog adds audit records to the audit log in the system database.
+	// Records created on servers other than the MS leader are forwarded to
+	// the leader.
+	auditLog struct {
+		log     logging.Logger
+		db      auditDB
+		forward auditForwardFn
+	}
+)
+
+func newAuditLog(log logging.Logger, db auditDB, forward auditForwardFn) *auditLog {
+	return &auditLog{
+		log:     log,
+		db:      db,
+		forward: forward,
+	}
+}
+
+// newAuditRecord creates an audit record for a call to the given method,
+// identifying the caller from the access granted to it by the access
+// interceptor.
+func newAuditRecord(ctx context.Context, operation string, req interface{}, opErr error) *system.AuditRecord {
+	rec := &system.AuditRecord{
+		Time:      time.Now(),
+		Operation: operation,
+		Component: "unknown",
+		Address:   "unknown",
+	}
+
+	if access, found := callerAccessFromContext(ctx); found {
+		rec.Component = access.component.String()
+		rec.Subject = access.cert.Subject.String()
+		if access.role != security.RoleUndefined {
+			rec.Role = access.role.String()
+		}
+	}
+	if clientPeer, ok := peer.FromContext(ctx); ok && clientPeer.Addr != nil {
+		rec.Address = clientPeer.Addr.String()
+	}
+	if msg, ok := req.(proto.Message); ok {
+		msg = proto.Clone(msg)
+		stripBytesFields(msg.ProtoReflect())
+		if data, err := protojson.Marshal(msg); err == nil {
+			rec.Request = string(data)
+		}
+	}
+	if opErr != nil {
+		rec.Error = opErr.Error()
+	}
+
+	return rec
+}
+
+// stripBytesFields clears the bytes fields of a request message, and of any
+// messages nested within it, so that opaque data such as the system database
+// snapshot sent to SystemDBRestore is not copied into the audit log.
+func stripBytesFields(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Kind() == protoreflect.BytesKind && !fd.IsMap():
+			msg.Clear(fd)
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.BytesKind {
+				msg.Clear(fd)
+				break
+			}
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					stripBytesFields(mv.Message())
+					return true
+				})
+			}
+		case fd.Message() != nil && fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				stripBytesFields(v.List().Get(i).Message())
+			}
+		case fd.Message() != nil:
+			stripBytesFields(v.Message())
+		}
+		return true
+	})
+}
+
+// add logs the audit record and adds it to the audit log in the system
+// database. On servers other than the MS leader, the record is forwarded to
+// the leader in the background so that the operation isn't delayed.
+func (al *auditLog) add(rec *system.AuditRecord) {
+	al.log.Noticef("audit: %s", rec)
+
+	if al.db.IsLeader() {
+		if err := al.db.AddAuditRecord(rec); err != nil {
+			al.log.Errorf("failed to add %s audit record: %s", rec.Operation, err)
+		}
+		return
+	}
+	if al.forward == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), auditForwardTimeout)
+		defer cancel()
+
+		if err := al.forward(ctx, rec); err != nil {
+			al.log.Errorf("failed to forward %s audit record to MS leader: %s", rec.Operation, err)
+		}
+	}()
+}
+
+// unaryAuditInterceptor records calls to mutating methods in the audit log.
+// Calls that are redirected to another MS replica are not recorded.
+func unaryAuditInterceptor(al *auditLog) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+
+		operation, audited := auditedMethods[info.FullMethod]
+		if !audited || isSentinelErr(err) {
+			return res, err
+		}
+
+		opErr := err
+		if sg, ok := res.(statusGetter); ok && opErr == nil {
+			opErr = dErrFromStatus(sg)
+		}
+		al.add(newAuditRecord(ctx, operation, req, opErr))
+
+		return res, err
+	}
+}
+
+// auditServerStream captures the request received by a server-streaming
+// method.
+type auditServerStream struct {
+	grpc.ServerStream
+	req interface{}
+}
+
+func (ss *auditServerStream) RecvMsg(m interface{}) error {
+	err := ss.ServerStream.RecvMsg(m)
+	if err == nil && ss.req == nil {
+		ss.req = m
+	}
+	return err
+}
+
+// streamAuditInterceptor records calls to mutating streaming methods in the
+// audit log.
+func streamAuditInterceptor(al *auditLog) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		operation, audited := auditedMethods[info.FullMethod]
+		if !audited {
+			return handler(srv, ss)
+		}
+
+		as := &auditServerStream{ServerStream: ss}
+		err := handler(srv, as)
+		if !isSentinelErr(err) {
+			al.add(newAuditRecord(ss.Context(), operation, as.req, err))
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,288 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type mockAuditDB struct {
+	leader  bool
+	records []*system.AuditRecord
+}
+
+func (db *mockAuditDB) IsLeader() bool {
+	return db.leader
+}
+
+func (db *mockAuditDB) AddAuditRecord(rec *system.AuditRecord) error {
+	db.records = append(db.records, rec)
+	return nil
+}
+
+func TestServer_auditedMethodsAreAdminMethods(t *testing.T) {
+	for method := range auditedMethods {
+		if !security.ComponentAdmin.HasAccess(method) {
+			t.Errorf("audited method %s can't be called by admin", method)
+		}
+	}
+}
+
+func TestServer_unaryAuditInterceptor(t *testing.T) {
+	adminCert := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "admin",
+			OrganizationalUnit: []string{"ops"},
+		},
+	}
+	adminAccess := &callerAccess{
+		component: security.ComponentAdmin,
+		role:      security.RoleOperator,
+		cert:      adminCert,
+	}
+
+	for name, tc := range map[string]struct {
+		method     string
+		access     *callerAccess
+		notLeader  bool
+		handlerRes interface{}
+		handlerErr error
+		expRecords []*system.AuditRecord
+		expLogged  bool
+	}{
+		"not audited": {
+			method:     "/mgmt.MgmtSvc/PoolQuery",
+			access:     adminAccess,
+			handlerRes: &mgmtpb.PoolQueryResp{},
+		},
+		"success": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			access:     adminAccess,
+			handlerRes: &mgmtpb.PoolCreateResp{},
+			expRecords: []*system.AuditRecord{
+				{
+					Operation: "PoolCreate",
+					Component: "admin",
+					Role:      "operator",
+					Subject:   "CN=admin,OU=ops",
+					Address:   "127.0.0.1:10001",
+				},
+			},
+			expLogged: true,
+		},
+		"handler error": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			access:     adminAccess,
+			handlerErr: errors.New("failed"),
+			expRecords: []*system.AuditRecord{
+				{
+					Operation: "PoolCreate",
+					Component: "admin",
+					Role:      "operator",
+					Subject:   "CN=admin,OU=ops",
+					Address:   "127.0.0.1:10001",
+					Error:     "failed",
+				},
+			},
+			expLogged: true,
+		},
+		"status in response": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			access:     adminAccess,
+			handlerRes: &mgmtpb.PoolCreateResp{Status: int32(daos.NoSpace)},
+			expRecords: []*system.AuditRecord{
+				{
+					Operation: "PoolCreate",
+					Component: "admin",
+					Role:      "operator",
+					Subject:   "CN=admin,OU=ops",
+					Address:   "127.0.0.1:10001",
+					Error:     daos.NoSpace.Error(),
+				},
+			},
+			expLogged: true,
+		},
+		"insecure": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			handlerRes: &mgmtpb.PoolCreateResp{},
+			expRecords: []*system.AuditRecord{
+				{
+					Operation: "PoolCreate",
+					Component: "unknown",
+					Address:   "127.0.0.1:10001",
+				},
+			},
+			expLogged: true,
+		},
+		"redirected": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			access:     adminAccess,
+			handlerErr: &system.ErrNotLeader{},
+		},
+		"not leader": {
+			method:     "/ctl.CtlSvc/StorageFormat",
+			access:     adminAccess,
+			notLeader:  true,
+			handlerRes: &mgmtpb.DaosResp{},
+			expRecords: []*system.AuditRecord{
+				{
+					Operation: "StorageFormat",
+					Component: "admin",
+					Role:      "operator",
+					Subject:   "CN=admin,OU=ops",
+					Address:   "127.0.0.1:10001",
+				},
+			},
+			expLogged: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := &mockAuditDB{leader: !tc.notLeader}
+			ctx := newTestAuthCtx(test.Context(t), "admin")
+			if tc.access != nil {
+				ctx = context.WithValue(ctx, callerAccessKey{}, tc.access)
+			}
+			req := &mgmtpb.PoolCreateReq{Properties: []*mgmtpb.PoolProperty{
+				{Number: 1, Value: &mgmtpb.PoolProperty_Strval{Strval: "tank"}},
+			}}
+
+			forwarded := make(chan *system.AuditRecord, 1)
+			al := newAuditLog(log, db, func(_ context.Context, rec *system.AuditRecord) error {
+				forwarded <- rec
+				return nil
+			})
+
+			interceptor := unaryAuditInterceptor(al)
+			_, gotErr := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: tc.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return tc.handlerRes, tc.handlerErr
+				})
+			if gotErr != tc.handlerErr {
+				t.Fatalf("expected handler error %v, got %v", tc.handlerErr, gotErr)
+			}
+
+			gotRecords := db.records
+			if tc.notLeader {
+				select {
+				case rec := <-forwarded:
+					gotRecords = append(gotRecords, rec)
+				case <-time.After(5 * time.Second):
+					t.Fatal("audit record not forwarded to leader")
+				}
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(system.AuditRecord{}, "Time", "Request"),
+				cmpopts.EquateEmpty(),
+			}
+			if diff := cmp.Diff(tc.expRecords, gotRecords, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected records (-want, +got):\n%s\n", diff)
+			}
+			for _, rec := range gotRecords {
+				if rec.Time.IsZero() {
+					t.Fatal("record time not set")
+				}
+				if !strings.Contains(rec.Request, "tank") {
+					t.Fatalf("request parameters not recorded: %q", rec.Request)
+				}
+			}
+			test.AssertEqual(t, tc.expLogged, strings.Contains(buf.String(), "audit: "),
+				"unexpected audit log message")
+		})
+	}
+}
+
+type mockAuditServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (ms *mockAuditServerStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockAuditServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), ms.req)
+	return nil
+}
+
+func TestServer_streamAuditInterceptor(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := &mockAuditDB{leader: true}
+	ctx := context.WithValue(newTestAuthCtx(test.Context(t), "admin"), callerAccessKey{}, &callerAccess{
+		component: security.ComponentAdmin,
+		role:      security.RoleAdmin,
+		cert:      &x509.Certificate{Subject: pkix.Name{CommonName: "admin"}},
+	})
+	ss := &mockAuditServerStream{
+		ctx: ctx,
+		req: &mgmtpb.SystemStopReq{Ranks: "0-3"},
+	}
+
+	interceptor := streamAuditInterceptor(newAuditLog(log, db, nil))
+	gotErr := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/mgmt.MgmtSvc/SystemStopStream"},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(new(mgmtpb.SystemStopReq))
+		})
+	if gotErr != nil {
+		t.Fatal(gotErr)
+	}
+
+	if len(db.records) != 1 {
+		t.Fatalf("expected 1 audit record, got %d", len(db.records))
+	}
+	rec := db.records[0]
+	test.AssertEqual(t, "SystemStop", rec.Operation, "unexpected operation")
+	test.AssertEqual(t, "admin", rec.Role, "unexpected role")
+	test.AssertEqual(t, "", rec.Error, "unexpected error")
+	if !strings.Contains(rec.Request, "0-3") {
+		t.Fatalf("request parameters not recorded: %q", rec.Request)
+	}
+}
+
+func TestServer_newAuditRecord_stripsBytes(t *testing.T) {
+	req := &mgmtpb.SystemDBRestoreReq{
+		Data:  bytes.Repeat([]byte("snapshot"), 1024),
+		Apply: true,
+		Size:  8192,
+	}
+
+	rec := newAuditRecord(test.Context(t), "SystemDBRestore", req, nil)
+
+	if strings.Contains(rec.Request, "data") {
+		t.Fatalf("snapshot data recorded in audit log: %q", rec.Request)
+	}
+	if !strings.Contains(rec.Request, "8192") {
+		t.Fatalf("request parameters not recorded: %q", rec.Request)
+	}
+	test.AssertEqual(t, 8192, len(req.Data), "request data modified")
+}
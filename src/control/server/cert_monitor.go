@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const (
+	// certExpiryCheckInterval is the interval at which the expiry of the
+	// loaded certificates is checked.
+	certExpiryCheckInterval = time.Hour
+	// certExpiryWarnPeriod is the time before expiry at which warnings
+	// start to be raised.
+	certExpiryWarnPeriod = 30 * 24 * time.Hour
+	// certExpiryWarnInterval limits how often the warning is repeated for a
+	// certificate.
+	certExpiryWarnInterval = 24 * time.Hour
+)
+
+var certExpiryKey = []string{"cert", "expiry", "seconds"}
+
+// certMonitor reports the time remaining until the loaded certificates expire
+// and raises RAS warnings as the expiry date approaches.
+type certMonitor struct {
+	sync.Mutex
+	log    logging.Logger
+	cfg    *security.TransportConfig
+	pub    events.Publisher
+	warned map[string]time.Time
+	getNow func() time.Time
+}
+
+func newCertMonitor(log logging.Logger, cfg *security.TransportConfig, pub events.Publisher) *certMonitor {
+	return &certMonitor{
+		log:    log,
+		cfg:    cfg,
+		pub:    pub,
+		warned: make(map[string]time.Time),
+		getNow: time.Now,
+	}
+}
+
+func newCertExpiringEvent(cert *x509.Certificate, msg string) *events.RASEvent {
+	info := fmt.Sprintf("subject: %s, serial: %s, expiry: %s", cert.Subject, cert.SerialNumber,
+		common.FormatTime(cert.NotAfter))
+	return events.NewGenericEvent(events.RASCertExpiring, events.RASSeverityWarning, msg, info)
+}
+
+func certExpiryMessage(cert *x509.Certificate, remaining time.Duration) string {
+	name := cert.Subject.CommonName
+	if remaining <= 0 {
+		return fmt.Sprintf("%s certificate expired on %s", name, common.FormatTime(cert.NotAfter))
+	}
+	return fmt.Sprintf("%s certificate expires in %d day(s) on %s", name,
+		int(remaining.Hours()/24), common.FormatTime(cert.NotAfter))
+}
+
+// check updates the expiry gauge of each certificate in the loaded chain and
+// raises a warning for those that are due to expire.
+func (m *certMonitor) check() {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.getNow()
+	for _, cert := range m.cfg.CertificateChain() {
+		remaining := cert.NotAfter.Sub(now)
+		metrics.SetGaugeWithLabels(certExpiryKey, float32(remaining.Seconds()),
+			[]metrics.Label{{Name: "cert", Value: cert.Subject.CommonName}})
+
+		if remaining > certExpiryWarnPeriod {
+			continue
+		}
+
+		serial := cert.SerialNumber.String()
+		if last, found := m.warned[serial]; found && now.Sub(last) < certExpiryWarnInterval {
+			continue
+		}
+		m.warned[serial] = now
+
+		msg := certExpiryMessage(cert, remaining)
+		m.log.Notice(msg)
+		m.pub.Publish(newCertExpiringEvent(cert, msg))
+	}
+}
+
+func (m *certMonitor) start(ctx context.Context) {
+	m.check()
+
+	go func() {
+		ticker := time.NewTicker(certExpiryCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.check()
+			}
+		}
+	}()
+}
+
+// startCertMonitor reloads the certificates when their files change and
+// monitors them for approaching expiry.
+func startCertMonitor(ctx context.Context, log logging.Logger, cfg *security.TransportConfig, pub events.Publisher) {
+	if cfg.AllowInsecure {
+		return
+	}
+
+	mon := newCertMonitor(log, cfg, pub)
+	watcher := security.NewCertWatcher(log, cfg)
+	watcher.OnReload(mon.check)
+
+	watcher.Start(ctx, security.CertWatchInterval)
+	mon.start(ctx)
+}
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestServer_certMonitor_check(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caCert, caKey, err := security.GenerateCA(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, key, err := security.IssueCertificate(caCert, caKey, &security.CertRequest{
+		Component: security.ComponentServer,
+		Validity:  10 * 24 * time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := security.DefaultServerTransportConfig()
+	cfg.ClientCertDir = ""
+	cfg.CARootPath = filepath.Join(testDir, "daosCA.crt")
+	cfg.CertificatePath = filepath.Join(testDir, "server.crt")
+	cfg.PrivateKeyPath = filepath.Join(testDir, "server.key")
+	if err := security.WriteCertificate(cfg.CARootPath, caCert); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.WriteCertificate(cfg.CertificatePath, cert); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.WritePrivateKey(cfg.PrivateKeyPath, key); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	pub := &mockPublisher{}
+	mon := newCertMonitor(log, cfg, pub)
+	now := time.Now()
+
+	for _, step := range []struct {
+		name      string
+		elapsed   time.Duration
+		expEvents int
+		expMsg    string
+	}{
+		{
+			name:      "expiring in 10 days",
+			expEvents: 1,
+			expMsg:    "server certificate expires in 9 day(s)",
+		},
+		{
+			name:      "already warned",
+			elapsed:   time.Hour,
+			expEvents: 1,
+		},
+		{
+			name:      "repeated after a day",
+			elapsed:   25 * time.Hour,
+			expEvents: 2,
+			expMsg:    "server certificate expires in 8 day(s)",
+		},
+		{
+			name:      "expired",
+			elapsed:   11 * 24 * time.Hour,
+			expEvents: 3,
+			expMsg:    "server certificate expired on",
+		},
+	} {
+		mon.getNow = func() time.Time { return now.Add(step.elapsed) }
+		mon.check()
+
+		test.AssertEqual(t, step.expEvents, len(pub.published), step.name+": unexpected number of events")
+		if step.expMsg == "" {
+			continue
+		}
+		evt := pub.published[len(pub.published)-1]
+		test.AssertEqual(t, events.RASCertExpiring, evt.ID, step.name+": unexpected event ID")
+		test.AssertEqual(t, events.RASSeverityWarning, evt.Severity, step.name+": unexpected severity")
+		test.AssertTrue(t, strings.Contains(evt.Msg, step.expMsg),
+			step.name+": unexpected message: "+evt.Msg)
+	}
+}
@@ -41,6 +41,11 @@ var (
 		"invalid telemetry port in configuration",
 		"specify a positive non-zero network port in configuration ('telemetry_port' parameter) and restart the control server",
 	)
+	FaultConfigBadAuditMaxRecords = serverConfigFault(
+		code.ServerConfigBadAuditMaxRecords,
+		"invalid number of audit records in configuration",
+		"specify a positive number of records to retain in configuration ('audit_max_records' parameter) and restart the control server",
+	)
 	FaultConfigBadAccessPoints = serverConfigFault(
 		code.ServerConfigBadAccessPoints,
 		"invalid list of access points in configuration",
@@ -221,6 +226,36 @@ func FaultConfigFaultCallbackInsecure(requiredDir string) *fault.Fault {
 	)
 }
 
+// FaultConfigBadRASSink creates a fault for the scenario where a RAS event
+// sink entry is invalid.
+func FaultConfigBadRASSink(idx int, err error) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadRASSink,
+		fmt.Sprintf("invalid ras_sinks entry %d: %s", idx, err),
+		"fix the RAS event sink definition ('ras_sinks' parameter) and restart the control server",
+	)
+}
+
+// FaultConfigBadAccessControl creates a fault for the scenario where the
+// access control configuration is invalid.
+func FaultConfigBadAccessControl(err error) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadAccessControl,
+		fmt.Sprintf("invalid access_control configuration: %s", err),
+		"fix the role definitions ('access_control' parameter) and restart the control server",
+	)
+}
+
+// FaultConfigBadNvmeHealthPolicy creates a fault for the scenario where the
+// NVMe health policy configuration is invalid.
+func FaultConfigBadNvmeHealthPolicy(err error) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadNvmeHealthPolicy,
+		fmt.Sprintf("invalid nvme_health_policy configuration: %s", err),
+		"fix the policy rules ('nvme_health_policy' parameter) and restart the control server",
+	)
+}
+
 // FaultConfigNrHugepagesOutOfRange creates a fault for the scenario where the number of configured
 // huge pages is smaller than zero or larger than the maximum value allowed.
 func FaultConfigNrHugepagesOutOfRange(req, max int) *fault.Fault {
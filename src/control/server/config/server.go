@@ -21,6 +21,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -40,25 +41,29 @@ const (
 // See utils/config/daos_server.yml for parameter descriptions.
 type Server struct {
 	// control-specific
-	ControlPort       int                       `yaml:"port"`
-	TransportConfig   *security.TransportConfig `yaml:"transport_config"`
-	Engines           []*engine.Config          `yaml:"engines"`
-	BdevExclude       []string                  `yaml:"bdev_exclude,omitempty"`
-	DisableVFIO       bool                      `yaml:"disable_vfio"`
-	DisableVMD        *bool                     `yaml:"disable_vmd"`
-	EnableHotplug     bool                      `yaml:"enable_hotplug"`
-	NrHugepages       int                       `yaml:"nr_hugepages"`        // total for all engines
-	SystemRamReserved int                       `yaml:"system_ram_reserved"` // total for all engines
-	DisableHugepages  bool                      `yaml:"disable_hugepages"`
-	ControlLogMask    common.ControlLogLevel    `yaml:"control_log_mask"`
-	ControlLogFile    string                    `yaml:"control_log_file,omitempty"`
-	ControlLogJSON    bool                      `yaml:"control_log_json,omitempty"`
-	HelperLogFile     string                    `yaml:"helper_log_file,omitempty"`
-	FWHelperLogFile   string                    `yaml:"firmware_helper_log_file,omitempty"`
-	FaultPath         string                    `yaml:"fault_path,omitempty"`
-	TelemetryPort     int                       `yaml:"telemetry_port,omitempty"`
-	CoreDumpFilter    uint8                     `yaml:"core_dump_filter,omitempty"`
-	ClientEnvVars     []string                  `yaml:"client_env_vars,omitempty"`
+	ControlPort       int                           `yaml:"port"`
+	TransportConfig   *security.TransportConfig     `yaml:"transport_config"`
+	Engines           []*engine.Config              `yaml:"engines"`
+	BdevExclude       []string                      `yaml:"bdev_exclude,omitempty"`
+	DisableVFIO       bool                          `yaml:"disable_vfio"`
+	DisableVMD        *bool                         `yaml:"disable_vmd"`
+	EnableHotplug     bool                          `yaml:"enable_hotplug"`
+	NrHugepages       int                           `yaml:"nr_hugepages"`        // total for all engines
+	SystemRamReserved int                           `yaml:"system_ram_reserved"` // total for all engines
+	DisableHugepages  bool                          `yaml:"disable_hugepages"`
+	ControlLogMask    common.ControlLogLevel        `yaml:"control_log_mask"`
+	ControlLogFile    string                        `yaml:"control_log_file,omitempty"`
+	ControlLogJSON    bool                          `yaml:"control_log_json,omitempty"`
+	HelperLogFile     string                        `yaml:"helper_log_file,omitempty"`
+	FWHelperLogFile   string                        `yaml:"firmware_helper_log_file,omitempty"`
+	FaultPath         string                        `yaml:"fault_path,omitempty"`
+	TelemetryPort     int                           `yaml:"telemetry_port,omitempty"`
+	CoreDumpFilter    uint8                         `yaml:"core_dump_filter,omitempty"`
+	ClientEnvVars     []string                      `yaml:"client_env_vars,omitempty"`
+	RASSinks          []*events.SinkConfig          `yaml:"ras_sinks,omitempty"`
+	AccessControl     *security.AccessControlConfig `yaml:"access_control,omitempty"`
+	NvmeHealthPolicy  *storage.BdevHealthPolicy     `yaml:"nvme_health_policy,omitempty"`
+	AuditMaxRecords   int                           `yaml:"audit_max_records,omitempty"`
 
 	// duplicated in engine.Config
 	SystemName string              `yaml:"name"`
@@ -138,6 +143,30 @@ func (cfg *Server) WithClientEnvVars(envVars []string) *Server {
 	return cfg
 }
 
+// WithRASSinks sets the RAS event sinks.
+func (cfg *Server) WithRASSinks(sinks ...*events.SinkConfig) *Server {
+	cfg.RASSinks = sinks
+	return cfg
+}
+
+// WithAccessControl sets the roles granted to admin certificates.
+func (cfg *Server) WithAccessControl(acCfg *security.AccessControlConfig) *Server {
+	cfg.AccessControl = acCfg
+	return cfg
+}
+
+// WithNvmeHealthPolicy sets the policy evaluated against NVMe device health.
+func (cfg *Server) WithNvmeHealthPolicy(policy *storage.BdevHealthPolicy) *Server {
+	cfg.NvmeHealthPolicy = policy
+	return cfg
+}
+
+// WithAuditMaxRecords sets the number of records retained in the audit log.
+func (cfg *Server) WithAuditMaxRecords(maxRecords int) *Server {
+	cfg.AuditMaxRecords = maxRecords
+	return cfg
+}
+
 // WithCrtTimeout sets the top-level CrtTimeout.
 func (cfg *Server) WithCrtTimeout(timeout uint32) *Server {
 	cfg.Fabric.CrtTimeout = timeout
@@ -668,6 +697,24 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigSysRsvdZero
 	}
 
+	for idx, sink := range cfg.RASSinks {
+		if err := sink.Validate(); err != nil {
+			return FaultConfigBadRASSink(idx, err)
+		}
+	}
+
+	if err := cfg.AccessControl.Validate(); err != nil {
+		return FaultConfigBadAccessControl(err)
+	}
+
+	if err := cfg.NvmeHealthPolicy.Validate(); err != nil {
+		return FaultConfigBadNvmeHealthPolicy(err)
+	}
+
+	if cfg.AuditMaxRecords < 0 {
+		return FaultConfigBadAuditMaxRecords
+	}
+
 	// A config without engines is valid when initially discovering hardware prior to adding
 	// per-engine sections with device allocations.
 	if len(cfg.Engines) == 0 {
@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -229,6 +231,7 @@ func TestServerConfig_Constructed(t *testing.T) {
 	}
 
 	var bypass = true
+	sinkRetries := 5
 
 	// Next, construct a config to compare against the first one. It should be
 	// possible to construct an identical configuration with the helpers.
@@ -255,6 +258,73 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithFaultCb("./.daos/fd_callback").
 		WithFaultPath("/vcdu0/rack1/hostname").
 		WithClientEnvVars([]string{"foo=bar"}).
+		WithRASSinks(
+			&events.SinkConfig{
+				Type: events.SinkTypeFile,
+				Path: "/var/log/daos/ras_events.json",
+			},
+			&events.SinkConfig{
+				Type:     events.SinkTypeWebhook,
+				URL:      "https://noc.example.com/daos/events",
+				Timeout:  10 * time.Second,
+				Retries:  &sinkRetries,
+				Severity: "warning",
+				IDs:      []string{"engine_died", "swim_rank_dead"},
+				Debounce: []events.SinkDebounceConfig{
+					{ID: "swim_rank_dead", Cooldown: 5 * time.Minute},
+				},
+			},
+			&events.SinkConfig{
+				Type:     events.SinkTypeUDP,
+				Address:  "noc.example.com:5140",
+				Severity: "error",
+			},
+		).
+		WithAccessControl(&security.AccessControlConfig{
+			DefaultRole: security.RoleViewer,
+			RoleBindings: []*security.RoleBinding{
+				{
+					Role:     security.RoleAdmin,
+					Subjects: []string{"OU=storage admins,O=DAOS"},
+				},
+				{
+					Role:     security.RoleOperator,
+					Subjects: []string{"OU=ops,O=DAOS"},
+					SANs:     []string{"ops.example.com"},
+				},
+			},
+			IdentityMappings: []*security.IdentityMapping{
+				{
+					User:     "alice",
+					Group:    "storage",
+					Subjects: []string{"OU=alice,O=DAOS"},
+				},
+			},
+		}).
+		WithNvmeHealthPolicy(&storage.BdevHealthPolicy{
+			DryRun:         true,
+			SampleInterval: time.Hour,
+			Rules: []*storage.BdevHealthRule{
+				{
+					Name:      "overheating",
+					Condition: storage.BdevConditionTemperature,
+					Threshold: 70,
+					Duration:  2 * time.Hour,
+					Actions: []storage.BdevPolicyAction{
+						storage.BdevActionLED, storage.BdevActionEvent,
+					},
+				},
+				{
+					Name:      "worn_out",
+					Condition: storage.BdevConditionSpareWarning,
+					Actions: []storage.BdevPolicyAction{
+						storage.BdevActionDrain, storage.BdevActionSetFaulty,
+						storage.BdevActionLED, storage.BdevActionEvent,
+					},
+				},
+			},
+		}).
+		WithAuditMaxRecords(50000).
 		WithFabricAuthKey("foo:bar").
 		WithHyperthreads(true). // hyper-threads disabled by default
 		WithSystemRamReserved(5)
@@ -668,6 +738,45 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigSysRsvdZero,
 		},
+		"bad ras sink": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithRASSinks(
+					&events.SinkConfig{Type: events.SinkTypeFile, Path: "/tmp/ras.json"},
+					&events.SinkConfig{Type: events.SinkTypeWebhook},
+				)
+			},
+			expErr: FaultConfigBadRASSink(1,
+				errors.New("webhook RAS sink requires a url")),
+		},
+		"bad access control": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithAccessControl(&security.AccessControlConfig{
+					DefaultRole: security.RoleNone,
+					RoleBindings: []*security.RoleBinding{
+						{Role: security.RoleViewer},
+					},
+				})
+			},
+			expErr: FaultConfigBadAccessControl(
+				errors.New("role binding 0: at least one subject or SAN must be set")),
+		},
+		"bad nvme health policy": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthPolicy(&storage.BdevHealthPolicy{
+					Rules: []*storage.BdevHealthRule{
+						{Name: "hot", Condition: storage.BdevConditionTemperature},
+					},
+				})
+			},
+			expErr: FaultConfigBadNvmeHealthPolicy(
+				errors.New("rule 0: temperature condition requires a threshold")),
+		},
+		"negative audit max records": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithAuditMaxRecords(-1)
+			},
+			expErr: FaultConfigBadAuditMaxRecords,
+		},
 		"control metadata multi-engine": {
 			extraConfig: func(c *Server) *Server {
 				return c.WithControlMetadata(storage.ControlMetadata{
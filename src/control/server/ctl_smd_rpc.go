@@ -72,6 +72,25 @@ func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQu
 	return nil
 }
 
+// listSmdPools returns the pools with targets on the devices used by the engine.
+func listSmdPools(ctx context.Context, ei Engine) (*ctlpb.SmdPoolResp, error) {
+	dresp, err := ei.CallDrpc(ctx, drpc.MethodSmdPools, new(ctlpb.SmdPoolReq))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ctlpb.SmdPoolResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal SmdListPools response")
+	}
+
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "ListPools failed")
+	}
+
+	return resp, nil
+}
+
 func (svc *ControlService) querySmdPools(ctx context.Context, req *ctlpb.SmdQueryReq, resp *ctlpb.SmdQueryResp) error {
 	for _, ei := range svc.harness.Instances() {
 		if !ei.IsReady() {
@@ -91,19 +110,9 @@ func (svc *ControlService) querySmdPools(ctx context.Context, req *ctlpb.SmdQuer
 		rResp := new(ctlpb.SmdQueryResp_RankResp)
 		rResp.Rank = engineRank.Uint32()
 
-		dresp, err := ei.CallDrpc(ctx, drpc.MethodSmdPools, new(ctlpb.SmdPoolReq))
+		rankDevResp, err := listSmdPools(ctx, ei)
 		if err != nil {
-			return err
-		}
-
-		rankDevResp := new(ctlpb.SmdPoolResp)
-		if err = proto.Unmarshal(dresp.Body, rankDevResp); err != nil {
-			return errors.Wrap(err, "unmarshal SmdListPools response")
-		}
-
-		if rankDevResp.Status != 0 {
-			return errors.Wrapf(daos.Status(rankDevResp.Status),
-				"rank %d ListPools failed", engineRank)
+			return errors.Wrapf(err, "rank %d", engineRank)
 		}
 
 		if err := convert.Types(rankDevResp.Pools, &rResp.Pools); err != nil {
@@ -159,6 +168,25 @@ func (svc *ControlService) SmdQuery(ctx context.Context, req *ctlpb.SmdQueryReq)
 	return resp, nil
 }
 
+// StorageHealthTrend implements the method defined for the Management Service.
+//
+// Report the sampled health history and wear-out estimate of each NVMe device.
+func (svc *ControlService) StorageHealthTrend(ctx context.Context, req *ctlpb.NvmeHealthTrendReq) (*ctlpb.NvmeHealthTrendResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if svc.healthMon == nil {
+		return nil, errors.New("nvme health monitor not running")
+	}
+
+	trends, err := svc.healthMon.trends(req.IncludeSamples)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctlpb.NvmeHealthTrendResp{Devices: trends}, nil
+}
+
 type idMap map[string]bool
 
 func (im idMap) Keys() (keys []string) {
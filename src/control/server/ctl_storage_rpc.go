@@ -785,11 +785,27 @@ func checkTmpfsMem(log logging.Logger, scmCfgs map[int]*storage.TierConfig, getM
 	return nil
 }
 
+// formatProgressFn is called with per-engine progress updates as the steps of
+// a storage format complete.
+type formatProgressFn func(*ctlpb.StorageFormatProgress)
+
+func (fn formatProgressFn) report(idx uint32, msg, errMsg string) {
+	if fn == nil {
+		return
+	}
+	fn(&ctlpb.StorageFormatProgress{
+		InstanceIdx: idx,
+		Message:     msg,
+		Error:       errMsg,
+	})
+}
+
 type formatScmReq struct {
 	log        logging.Logger
 	reformat   bool
 	instances  []Engine
 	getMemInfo func() (*common.MemInfo, error)
+	report     formatProgressFn
 }
 
 func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatResp) (map[int]string, map[int]bool, error) {
@@ -853,6 +869,7 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 				Info: "SCM is already formatted",
 			},
 		})
+		req.report.report(uint32(idx), "SCM is already formatted", "")
 
 		// In the normal case, where SCM wasn't already mounted, we want
 		// to trigger NVMe format. In the case where SCM was mounted and
@@ -876,6 +893,7 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 				errored[int(scmResult.GetInstanceidx())] = state.GetError()
 			}
 			resp.Mrets = append(resp.Mrets, scmResult)
+			req.report.report(scmResult.GetInstanceidx(), "SCM format complete", state.GetError())
 		}
 	}
 
@@ -888,6 +906,7 @@ type formatNvmeReq struct {
 	errored     map[int]string
 	skipped     map[int]bool
 	mdFormatted bool
+	report      formatProgressFn
 }
 
 func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageFormatResp) error {
@@ -907,6 +926,7 @@ func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageForma
 			ret := engine.newCret(storage.NilBdevAddress, nil)
 			ret.State.Info = fmt.Sprintf(skipReason, engine.Index())
 			resp.Crets = append(resp.Crets, ret)
+			req.report.report(engine.Index(), ret.State.Info, "")
 			continue
 		}
 
@@ -918,6 +938,7 @@ func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageForma
 			}
 			req.errored[idx] = err.Error()
 			resp.Crets = append(resp.Crets, engine.newCret("", err))
+			req.report.report(engine.Index(), "NVMe format failed", err.Error())
 			continue
 		}
 
@@ -939,6 +960,7 @@ func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageForma
 		if cResults.HasErrors() {
 			req.errored[idx] = cResults.Errors()
 			resp.Crets = append(resp.Crets, cResults...)
+			req.report.report(engine.Index(), "NVMe format failed", cResults.Errors())
 			continue
 		}
 
@@ -948,6 +970,7 @@ func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageForma
 		}
 
 		resp.Crets = append(resp.Crets, cResults...)
+		req.report.report(engine.Index(), "NVMe format complete", req.errored[idx])
 	}
 
 	return nil
@@ -962,6 +985,33 @@ func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageForma
 // Send response containing multiple results of format operations on scm mounts
 // and nvme controllers.
 func (cs *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageFormatReq) (*ctlpb.StorageFormatResp, error) {
+	return cs.storageFormat(ctx, req, nil)
+}
+
+// StorageFormatStream performs the same format as StorageFormat but sends a
+// progress message as each engine completes a step before sending the final
+// response on the stream.
+func (cs *ControlService) StorageFormatStream(req *ctlpb.StorageFormatReq, stream ctlpb.CtlSvc_StorageFormatStreamServer) error {
+	report := func(progress *ctlpb.StorageFormatProgress) {
+		msg := &ctlpb.StorageFormatStreamResp{
+			Msg: &ctlpb.StorageFormatStreamResp_Progress{Progress: progress},
+		}
+		if err := stream.Send(msg); err != nil {
+			cs.log.Errorf("failed to send format progress: %s", err)
+		}
+	}
+
+	resp, err := cs.storageFormat(stream.Context(), req, report)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&ctlpb.StorageFormatStreamResp{
+		Msg: &ctlpb.StorageFormatStreamResp_Resp{Resp: resp},
+	})
+}
+
+func (cs *ControlService) storageFormat(ctx context.Context, req *ctlpb.StorageFormatReq, report formatProgressFn) (*ctlpb.StorageFormatResp, error) {
 	if req == nil {
 		return nil, errNilReq
 	}
@@ -989,6 +1039,7 @@ func (cs *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageF
 		reformat:   req.Reformat,
 		instances:  instances,
 		getMemInfo: cs.getMemInfo,
+		report:     report,
 	}
 	cs.log.Tracef("formatScmReq: %+v", fsr)
 	instanceErrors, instanceSkips, err := formatScm(ctx, fsr, resp)
@@ -1007,6 +1058,7 @@ func (cs *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageF
 			errored:     instanceErrors,
 			skipped:     instanceSkips,
 			mdFormatted: mdFormatted,
+			report:      report,
 		}
 		cs.log.Tracef("formatNvmeReq: %+v", fnr)
 		formatNvme(ctx, fnr, resp)
@@ -1140,3 +1192,23 @@ func (cs *ControlService) StorageNvmeAddDevice(ctx context.Context, req *ctlpb.N
 
 	return resp, nil
 }
+
+// StorageScmHealth implements the method defined for the Management Service.
+//
+// Report the usage of the SCM mounted by each engine and the media health of
+// the PMem namespaces backing them.
+func (cs *ControlService) StorageScmHealth(ctx context.Context, req *ctlpb.ScmHealthReq) (*ctlpb.ScmHealthResp, error) {
+	if req == nil {
+		return nil, errNilReq
+	}
+	if cs.scmHealthMon == nil {
+		return nil, errors.New("scm health monitor not running")
+	}
+
+	engines, err := cs.scmHealthMon.query()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctlpb.ScmHealthResp{Engines: engines}, nil
+}
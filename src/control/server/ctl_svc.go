@@ -19,10 +19,12 @@ import (
 type ControlService struct {
 	ctlpb.UnimplementedCtlSvcServer
 	StorageControlService
-	harness *EngineHarness
-	srvCfg  *config.Server
-	events  *events.PubSub
-	fabric  *hardware.FabricScanner
+	harness      *EngineHarness
+	srvCfg       *config.Server
+	events       *events.PubSub
+	fabric       *hardware.FabricScanner
+	healthMon    *nvmeHealthMonitor
+	scmHealthMon *scmHealthMonitor
 }
 
 // NewControlService returns ControlService to be used as gRPC control service
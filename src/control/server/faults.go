@@ -131,6 +131,14 @@ func FaultPoolDuplicateLabel(dupe string) *fault.Fault {
 	)
 }
 
+func FaultPoolQuotaExceeded(err error) *fault.Fault {
+	return serverFault(
+		code.ServerPoolQuotaExceeded,
+		fmt.Sprintf("pool request exceeds quota: %s", err),
+		"retry the request with a smaller pool, destroy unused pools or ask an administrator to raise the quota",
+	)
+}
+
 func FaultEngineNUMAImbalance(nodeMap map[int]int) *fault.Fault {
 	return serverFault(
 		code.ServerConfigEngineNUMAImbalance,
@@ -7,6 +7,7 @@
 package server
 
 import (
+	"crypto/x509"
 	"fmt"
 	"strings"
 	"time"
@@ -28,7 +29,7 @@ import (
 	"github.com/daos-stack/daos/src/control/system"
 )
 
-func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+func peerCertFromContext(ctx context.Context) (*x509.Certificate, error) {
 	clientPeer, ok := peer.FromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "no peer information found")
@@ -45,43 +46,111 @@ func componentFromContext(ctx context.Context) (comp *security.Component, err er
 		return nil, status.Error(codes.Unauthenticated, "unable to verify client certificates")
 	}
 
-	peerCert := certs[0][0]
+	return certs[0][0], nil
+}
+
+func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+	peerCert, err := peerCertFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	component := security.CommonNameToComponent(peerCert.Subject.CommonName)
 
 	return &component, nil
 }
 
-func checkAccess(ctx context.Context, FullMethod string) error {
-	component, err := componentFromContext(ctx)
+// callerAccess describes the access granted to the caller of a method.
+type callerAccess struct {
+	component security.Component
+	role      security.Role
+	cert      *x509.Certificate
+}
+
+type callerAccessKey struct{}
+
+// callerAccessFromContext returns the access granted to the caller by the
+// access interceptor. Nothing is returned if access is not checked because
+// transport security is disabled.
+func callerAccessFromContext(ctx context.Context) (*callerAccess, bool) {
+	access, ok := ctx.Value(callerAccessKey{}).(*callerAccess)
+	return access, ok
+}
+
+// auditDenial records a method call denied by access control in the audit
+// log and returns the error to be returned to the caller.
+func auditDenial(ctx context.Context, al *auditLog, access *callerAccess, FullMethod string, errMsg string) error {
+	rec := newAuditRecord(context.WithValue(ctx, callerAccessKey{}, access), methodOperation(FullMethod), nil, errors.New(errMsg))
+	rec.Denied = true
+	al.add(rec)
+
+	return status.Error(codes.PermissionDenied, errMsg)
+}
+
+// checkAccess verifies that the caller may call the given method, based on the
+// component identified by its certificate and, for admin certificates, the
+// role granted to it. On success, the access granted to the caller is added to
+// the returned context.
+func checkAccess(ctx context.Context, al *auditLog, acCfg *security.AccessControlConfig, FullMethod string) (context.Context, error) {
+	peerCert, err := peerCertFromContext(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !component.HasAccess(FullMethod) {
-		errMsg := fmt.Sprintf("%s does not have permission to call %s", component, FullMethod)
-		return status.Error(codes.PermissionDenied, errMsg)
+	access := &callerAccess{
+		component: security.CommonNameToComponent(peerCert.Subject.CommonName),
+		cert:      peerCert,
 	}
 
-	return nil
-}
+	if !access.component.HasAccess(FullMethod) {
+		errMsg := fmt.Sprintf("%s does not have permission to call %s", access.component, FullMethod)
+		return nil, auditDenial(ctx, al, access, FullMethod, errMsg)
+	}
 
-func unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if err := checkAccess(ctx, info.FullMethod); err != nil {
-		return nil, errors.Wrapf(err, "access denied for %T", req)
+	if access.component == security.ComponentAdmin {
+		access.role = acCfg.RoleForCertificate(peerCert)
+		if !access.role.HasAccess(FullMethod) {
+			errMsg := fmt.Sprintf("role %s does not have permission to call %s", access.role, FullMethod)
+			return nil, auditDenial(ctx, al, access, FullMethod, errMsg)
+		}
 	}
 
-	return handler(ctx, req)
+	return context.WithValue(ctx, callerAccessKey{}, access), nil
 }
 
-func streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	if err := checkAccess(ss.Context(), info.FullMethod); err != nil {
-		return err
+func unaryAccessInterceptor(al *auditLog, acCfg *security.AccessControlConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := checkAccess(ctx, al, acCfg, info.FullMethod)
+		if err != nil {
+			return nil, errors.Wrapf(err, "access denied for %T", req)
+		}
+
+		return handler(ctx, req)
 	}
+}
 
-	return handler(srv, ss)
+// ctxServerStream overrides the context of a server stream.
+type ctxServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *ctxServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func streamAccessInterceptor(al *auditLog, acCfg *security.AccessControlConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := checkAccess(ss.Context(), al, acCfg, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &ctxServerStream{ServerStream: ss, ctx: ctx})
+	}
 }
 
-func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.UnaryServerInterceptor, error) {
+func unaryInterceptorForTransportConfig(al *auditLog, cfg *security.TransportConfig, acCfg *security.AccessControlConfig) (grpc.UnaryServerInterceptor, error) {
 	if cfg == nil {
 		return nil, errors.New("nil TransportConfig")
 	}
@@ -90,10 +159,10 @@ func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.Una
 		return nil, nil
 	}
 
-	return unaryAccessInterceptor, nil
+	return unaryAccessInterceptor(al, acCfg), nil
 }
 
-func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.StreamServerInterceptor, error) {
+func streamInterceptorForTransportConfig(al *auditLog, cfg *security.TransportConfig, acCfg *security.AccessControlConfig) (grpc.StreamServerInterceptor, error) {
 	if cfg == nil {
 		return nil, errors.New("nil TransportConfig")
 	}
@@ -102,7 +171,7 @@ func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.St
 		return nil, nil
 	}
 
-	return streamAccessInterceptor, nil
+	return streamAccessInterceptor(al, acCfg), nil
 }
 
 var selfServerComponent = func() *build.VersionedComponent {
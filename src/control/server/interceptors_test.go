@@ -11,6 +11,8 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"path"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -24,6 +26,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type testStatus struct {
@@ -77,19 +80,21 @@ func TestServer_unaryStatusInterceptor(t *testing.T) {
 // newTestAuthCtx returns a context with a fake peer.PeerInfo
 // set up to validate component access/versioning.
 func newTestAuthCtx(parent context.Context, commonName string) context.Context {
+	return newTestCertCtx(parent, &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+	})
+}
+
+// newTestCertCtx returns a context with a fake peer.PeerInfo
+// holding the supplied verified certificate.
+func newTestCertCtx(parent context.Context, cert *x509.Certificate) context.Context {
 	ctxPeer := &peer.Peer{
 		Addr: common.LocalhostCtrlAddr(),
 		AuthInfo: credentials.TLSInfo{
 			State: tls.ConnectionState{
-				VerifiedChains: [][]*x509.Certificate{
-					{
-						{
-							Subject: pkix.Name{
-								CommonName: commonName,
-							},
-						},
-					},
-				},
+				VerifiedChains: [][]*x509.Certificate{{cert}},
 			},
 		},
 	}
@@ -97,6 +102,139 @@ func newTestAuthCtx(parent context.Context, commonName string) context.Context {
 	return peer.NewContext(parent, ctxPeer)
 }
 
+func TestServer_checkAccess(t *testing.T) {
+	acCfg := &security.AccessControlConfig{
+		DefaultRole: security.RoleViewer,
+		RoleBindings: []*security.RoleBinding{
+			{
+				Role:     security.RoleOperator,
+				Subjects: []string{"OU=ops"},
+			},
+			{
+				Role: security.RoleNone,
+				SANs: []string{"retired.example.com"},
+			},
+		},
+	}
+	newCert := func(cn string, ou ...string) *x509.Certificate {
+		return &x509.Certificate{
+			Subject: pkix.Name{
+				CommonName:         cn,
+				OrganizationalUnit: ou,
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		acCfg   *security.AccessControlConfig
+		cert    *x509.Certificate
+		method  string
+		expComp security.Component
+		expRole security.Role
+		expErr  error
+	}{
+		"agent calling admin method": {
+			cert:   newCert("agent"),
+			method: "/mgmt.MgmtSvc/SystemQuery",
+			expErr: errors.New("agent does not have permission"),
+		},
+		"agent calling agent method": {
+			acCfg:   acCfg,
+			cert:    newCert("agent"),
+			method:  "/mgmt.MgmtSvc/GetAttachInfo",
+			expComp: security.ComponentAgent,
+		},
+		"admin without access control": {
+			cert:    newCert("admin"),
+			method:  "/mgmt.MgmtSvc/SystemErase",
+			expComp: security.ComponentAdmin,
+			expRole: security.RoleAdmin,
+		},
+		"viewer calling viewer method": {
+			acCfg:   acCfg,
+			cert:    newCert("admin"),
+			method:  "/mgmt.MgmtSvc/SystemQuery",
+			expComp: security.ComponentAdmin,
+			expRole: security.RoleViewer,
+		},
+		"viewer calling operator method": {
+			acCfg:  acCfg,
+			cert:   newCert("admin"),
+			method: "/mgmt.MgmtSvc/SystemStop",
+			expErr: errors.New("role viewer does not have permission"),
+		},
+		"operator calling operator method": {
+			acCfg:   acCfg,
+			cert:    newCert("admin", "ops"),
+			method:  "/mgmt.MgmtSvc/SystemStop",
+			expComp: security.ComponentAdmin,
+			expRole: security.RoleOperator,
+		},
+		"operator calling admin method": {
+			acCfg:  acCfg,
+			cert:   newCert("admin", "ops"),
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+			expErr: errors.New("role operator does not have permission"),
+		},
+		"server scheduling pool op": {
+			// The NVMe health monitor schedules pool drains.
+			acCfg:   acCfg,
+			cert:    newCert("server"),
+			method:  "/mgmt.MgmtSvc/PoolOpSchedule",
+			expComp: security.ComponentServer,
+		},
+		"server calling pool drain": {
+			acCfg:  acCfg,
+			cert:   newCert("server"),
+			method: "/mgmt.MgmtSvc/PoolDrain",
+			expErr: errors.New("server does not have permission"),
+		},
+		"no access": {
+			acCfg: acCfg,
+			cert: &x509.Certificate{
+				Subject:  pkix.Name{CommonName: "admin"},
+				DNSNames: []string{"retired.example.com"},
+			},
+			method: "/mgmt.MgmtSvc/SystemQuery",
+			expErr: errors.New("role none does not have permission"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := &mockAuditDB{leader: true}
+			al := newAuditLog(log, db, nil)
+
+			ctx, gotErr := checkAccess(newTestCertCtx(test.Context(t), tc.cert), al, tc.acCfg, tc.method)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				if len(db.records) != 1 {
+					t.Fatalf("expected 1 audit record, got %d", len(db.records))
+				}
+				rec := db.records[0]
+				test.AssertEqual(t, path.Base(tc.method), rec.Operation, "unexpected operation")
+				test.AssertEqual(t, true, rec.Denied, "record not marked as denied")
+				test.AssertEqual(t, tc.cert.Subject.String(), rec.Subject, "unexpected subject")
+				if !strings.Contains(rec.Error, "does not have permission") {
+					t.Fatalf("unexpected record error: %q", rec.Error)
+				}
+				return
+			}
+			if len(db.records) != 0 {
+				t.Fatalf("unexpected audit records: %+v", db.records)
+			}
+
+			access, found := callerAccessFromContext(ctx)
+			if !found {
+				t.Fatal("caller access not set in context")
+			}
+			test.AssertEqual(t, tc.expComp, access.component, "unexpected component")
+			test.AssertEqual(t, tc.expRole, access.role, "unexpected role")
+		})
+	}
+}
+
 type checkVerReq struct {
 	Sys string
 }
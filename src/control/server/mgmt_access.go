@@ -0,0 +1,43 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// SystemGetAccess returns the component and role granted to the caller, as
+// determined from its certificate by the access interceptor.
+func (svc *mgmtSvc) SystemGetAccess(ctx context.Context, req *mgmtpb.SystemGetAccessReq) (*mgmtpb.SystemGetAccessResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	access, found := callerAccessFromContext(ctx)
+	if !found {
+		// Access isn't checked when transport security is disabled.
+		return &mgmtpb.SystemGetAccessResp{
+			Component: security.ComponentAdmin.String(),
+			Role:      security.RoleAdmin.String(),
+			Insecure:  true,
+		}, nil
+	}
+
+	resp := &mgmtpb.SystemGetAccessResp{
+		Component: access.component.String(),
+		Subject:   access.cert.Subject.String(),
+		Sans:      security.CertificateSANs(access.cert),
+	}
+	if access.component == security.ComponentAdmin {
+		resp.Role = access.role.String()
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestServer_MgmtSvc_SystemGetAccess(t *testing.T) {
+	opsCert := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "admin",
+			OrganizationalUnit: []string{"ops"},
+		},
+		DNSNames: []string{"ops.example.com"},
+	}
+
+	for name, tc := range map[string]struct {
+		access  *callerAccess
+		expResp *mgmtpb.SystemGetAccessResp
+	}{
+		"insecure": {
+			expResp: &mgmtpb.SystemGetAccessResp{
+				Component: "admin",
+				Role:      "admin",
+				Insecure:  true,
+			},
+		},
+		"operator": {
+			access: &callerAccess{
+				component: security.ComponentAdmin,
+				role:      security.RoleOperator,
+				cert:      opsCert,
+			},
+			expResp: &mgmtpb.SystemGetAccessResp{
+				Component: "admin",
+				Role:      "operator",
+				Subject:   "CN=admin,OU=ops",
+				Sans:      []string{"ops.example.com"},
+			},
+		},
+		"agent": {
+			access: &callerAccess{
+				component: security.ComponentAgent,
+				cert:      &x509.Certificate{Subject: pkix.Name{CommonName: "agent"}},
+			},
+			expResp: &mgmtpb.SystemGetAccessResp{
+				Component: "agent",
+				Subject:   "CN=agent",
+				Sans:      []string{},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ctx := test.Context(t)
+			if tc.access != nil {
+				ctx = context.WithValue(ctx, callerAccessKey{}, tc.access)
+			}
+
+			gotResp, gotErr := svc.SystemGetAccess(ctx, &mgmtpb.SystemGetAccessReq{
+				Sys: build.DefaultSystemName,
+			})
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
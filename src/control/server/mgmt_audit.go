@@ -0,0 +1,139 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func auditFilterFromReq(req *mgmtpb.SystemGetAuditReq) (*system.AuditFilter, error) {
+	filter := &system.AuditFilter{
+		Operation: req.GetOperation(),
+		Subject:   req.GetSubject(),
+		Limit:     int(req.GetLimit()),
+	}
+
+	if req.GetSince() != "" {
+		ts, err := common.ParseTime(req.GetSince())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid since timestamp")
+		}
+		filter.Since = ts
+	}
+	if req.GetUntil() != "" {
+		ts, err := common.ParseTime(req.GetUntil())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid until timestamp")
+		}
+		filter.Until = ts
+	}
+
+	return filter, nil
+}
+
+// SystemGetAudit returns the records in the audit log of mutating
+// control-plane operations that match the request filters.
+func (svc *mgmtSvc) SystemGetAudit(ctx context.Context, req *mgmtpb.SystemGetAuditReq) (*mgmtpb.SystemGetAuditResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	filter, err := auditFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := svc.sysdb.GetAuditRecords(filter)
+	if err != nil {
+		return nil, err
+	}
+	dropped, err := svc.sysdb.GetAuditRecordsDropped()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemGetAuditResp{Dropped: dropped}
+	for _, rec := range records {
+		resp.Records = append(resp.Records, &mgmtpb.AuditRecord{
+			Id:        rec.ID,
+			Time:      common.FormatTime(rec.Time),
+			Operation: rec.Operation,
+			Component: rec.Component,
+			Role:      rec.Role,
+			Subject:   rec.Subject,
+			Address:   rec.Address,
+			Request:   rec.Request,
+			Error:     rec.Error,
+			Denied:    rec.Denied,
+		})
+	}
+
+	return resp, nil
+}
+
+// SystemAddAudit adds an audit record forwarded by another server to the audit
+// log.
+func (svc *mgmtSvc) SystemAddAudit(ctx context.Context, req *mgmtpb.SystemAddAuditReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	pbRec := req.GetRecord()
+	if pbRec == nil {
+		return nil, errors.New("nil audit record")
+	}
+	ts, err := common.ParseTime(pbRec.GetTime())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid audit record timestamp")
+	}
+
+	rec := &system.AuditRecord{
+		Time:      ts,
+		Operation: pbRec.GetOperation(),
+		Component: pbRec.GetComponent(),
+		Role:      pbRec.GetRole(),
+		Subject:   pbRec.GetSubject(),
+		Address:   pbRec.GetAddress(),
+		Request:   pbRec.GetRequest(),
+		Error:     pbRec.GetError(),
+		Denied:    pbRec.GetDenied(),
+	}
+	if err := svc.sysdb.AddAuditRecord(rec); err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.DaosResp{}, nil
+}
+
+// forwardAuditRecord sends an audit record created on this server to the MS
+// leader.
+func (svc *mgmtSvc) forwardAuditRecord(ctx context.Context, rec *system.AuditRecord) error {
+	req := &control.SystemAddAuditReq{
+		Record: &control.AuditRecord{
+			Time:      rec.Time,
+			Operation: rec.Operation,
+			Component: rec.Component,
+			Role:      rec.Role,
+			Subject:   rec.Subject,
+			Address:   rec.Address,
+			Request:   rec.Request,
+			Error:     rec.Error,
+			Denied:    rec.Denied,
+		},
+	}
+	req.SetHostList(svc.sysdb.AccessPoints())
+	req.SetSystem(svc.sysdb.SystemName())
+
+	return control.SystemAddAudit(ctx, svc.rpcClient, req)
+}
@@ -0,0 +1,198 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_SystemGetAudit(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	records := []*system.AuditRecord{
+		{
+			Time:      start,
+			Operation: "PoolCreate",
+			Component: "admin",
+			Role:      "admin",
+			Subject:   "CN=admin,OU=storage admins",
+			Address:   "10.0.0.1:4321",
+			Request:   `{"uuid":"foo"}`,
+		},
+		{
+			Time:      start.Add(time.Hour),
+			Operation: "SystemStop",
+			Component: "admin",
+			Role:      "operator",
+			Subject:   "CN=admin,OU=ops",
+			Address:   "10.0.0.2:4321",
+			Error:     "failed",
+		},
+	}
+	pbRecord := func(idx int) *mgmtpb.AuditRecord {
+		rec := records[idx]
+		return &mgmtpb.AuditRecord{
+			Id:        uint64(idx + 1),
+			Time:      common.FormatTime(rec.Time),
+			Operation: rec.Operation,
+			Component: rec.Component,
+			Role:      rec.Role,
+			Subject:   rec.Subject,
+			Address:   rec.Address,
+			Request:   rec.Request,
+			Error:     rec.Error,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemGetAuditReq
+		expResp *mgmtpb.SystemGetAuditResp
+		expErr  error
+	}{
+		"all": {
+			req: &mgmtpb.SystemGetAuditReq{},
+			expResp: &mgmtpb.SystemGetAuditResp{
+				Records: []*mgmtpb.AuditRecord{pbRecord(0), pbRecord(1)},
+			},
+		},
+		"operation": {
+			req: &mgmtpb.SystemGetAuditReq{Operation: "SystemStop"},
+			expResp: &mgmtpb.SystemGetAuditResp{
+				Records: []*mgmtpb.AuditRecord{pbRecord(1)},
+			},
+		},
+		"since": {
+			req: &mgmtpb.SystemGetAuditReq{Since: common.FormatTime(start.Add(time.Minute))},
+			expResp: &mgmtpb.SystemGetAuditResp{
+				Records: []*mgmtpb.AuditRecord{pbRecord(1)},
+			},
+		},
+		"limit": {
+			req: &mgmtpb.SystemGetAuditReq{Limit: 1},
+			expResp: &mgmtpb.SystemGetAuditResp{
+				Records: []*mgmtpb.AuditRecord{pbRecord(1)},
+			},
+		},
+		"no match": {
+			req:     &mgmtpb.SystemGetAuditReq{Subject: "OU=dev"},
+			expResp: &mgmtpb.SystemGetAuditResp{},
+		},
+		"bad timestamp": {
+			req:    &mgmtpb.SystemGetAuditReq{Until: "yesterday"},
+			expErr: errors.New("invalid until timestamp"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, rec := range records {
+				if err := svc.sysdb.AddAuditRecord(rec); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tc.req.Sys = build.DefaultSystemName
+			gotResp, gotErr := svc.SystemGetAudit(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemAddAudit(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pbRec := &mgmtpb.AuditRecord{
+		Time:      common.FormatTime(ts),
+		Operation: "StorageFormat",
+		Component: "admin",
+		Role:      "admin",
+		Subject:   "CN=admin",
+		Address:   "10.0.0.1:4321",
+		Request:   `{"reformat":true}`,
+	}
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemAddAuditReq
+		expErr     error
+		expRecords []*system.AuditRecord
+	}{
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemAddAuditReq{Record: pbRec},
+			expErr:     &system.ErrNotReplica{},
+		},
+		"nil record": {
+			req:    &mgmtpb.SystemAddAuditReq{},
+			expErr: errors.New("nil audit record"),
+		},
+		"bad timestamp": {
+			req: &mgmtpb.SystemAddAuditReq{
+				Record: &mgmtpb.AuditRecord{Time: "yesterday"},
+			},
+			expErr: errors.New("invalid audit record timestamp"),
+		},
+		"success": {
+			req: &mgmtpb.SystemAddAuditReq{Record: pbRec},
+			expRecords: []*system.AuditRecord{
+				{
+					ID:        1,
+					Time:      ts,
+					Operation: "StorageFormat",
+					Component: "admin",
+					Role:      "admin",
+					Subject:   "CN=admin",
+					Address:   "10.0.0.1:4321",
+					Request:   `{"reformat":true}`,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+
+			tc.req.Sys = build.DefaultSystemName
+			_, gotErr := svc.SystemAddAudit(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotRecords, err := svc.sysdb.GetAuditRecords(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expRecords, gotRecords); diff != "" {
+				t.Fatalf("unexpected records (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// dbSnapshotChunkSize is the maximum amount of snapshot archive data sent in
+// a single stream message.
+const dbSnapshotChunkSize = 1 << 20
+
+// SystemDBSnapshot creates a point-in-time snapshot of the system database on
+// the MS leader and streams it back to the client as a snapshot archive.
+func (svc *mgmtSvc) SystemDBSnapshot(req *mgmtpb.SystemDBSnapshotReq, stream mgmtpb.MgmtSvc_SystemDBSnapshotServer) error {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+
+	meta, data, err := svc.sysdb.TakeSnapshot()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := raft.WriteSnapshotArchive(&buf, meta, data); err != nil {
+		return errors.Wrap(err, "failed to create snapshot archive")
+	}
+	archive := buf.Bytes()
+	svc.log.Debugf("sending system database snapshot (index %d, term %d, %d bytes)",
+		meta.Index, meta.Term, len(archive))
+
+	resp := &mgmtpb.SystemDBSnapshotResp{
+		Index: meta.Index,
+		Term:  meta.Term,
+		Size:  uint64(len(archive)),
+	}
+	for len(archive) > 0 {
+		n := len(archive)
+		if n > dbSnapshotChunkSize {
+			n = dbSnapshotChunkSize
+		}
+		resp.Data = archive[:n]
+		archive = archive[n:]
+
+		if err := stream.Send(resp); err != nil {
+			return errors.Wrap(err, "failed to send snapshot data")
+		}
+		resp = new(mgmtpb.SystemDBSnapshotResp)
+	}
+
+	return nil
+}
+
+// recvDBRestore receives the snapshot archive chunks that follow the first
+// message of a restore stream.
+func recvDBRestore(stream mgmtpb.MgmtSvc_SystemDBRestoreServer, req *mgmtpb.SystemDBRestoreReq) ([]byte, error) {
+	var archive bytes.Buffer
+	archive.Write(req.GetData())
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to receive snapshot data")
+		}
+
+		if uint64(archive.Len()+len(chunk.GetData())) > req.GetSize() {
+			return nil, errors.Errorf("received more snapshot data than expected (%d bytes)",
+				req.GetSize())
+		}
+		archive.Write(chunk.GetData())
+	}
+
+	if uint64(archive.Len()) != req.GetSize() {
+		return nil, errors.Errorf("incomplete snapshot received (%d/%d bytes)",
+			archive.Len(), req.GetSize())
+	}
+
+	return archive.Bytes(), nil
+}
+
+// SystemDBRestore receives a system database snapshot archive, streamed in
+// chunks, validates it against the running system and, if requested, restores
+// it on the MS leader.
+func (svc *mgmtSvc) SystemDBRestore(stream mgmtpb.MgmtSvc_SystemDBRestoreServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return errors.Wrap(err, "failed to receive restore request")
+	}
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+
+	archive, err := recvDBRestore(stream, req)
+	if err != nil {
+		return err
+	}
+	svc.log.Debugf("received system database snapshot (%d bytes)", len(archive))
+
+	resp, err := svc.restoreDB(stream.Context(), req, archive)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// restoreDB validates the snapshot archive against the running system and, if
+// requested, restores it.
+func (svc *mgmtSvc) restoreDB(ctx context.Context, req *mgmtpb.SystemDBRestoreReq, archive []byte) (*mgmtpb.SystemDBRestoreResp, error) {
+	meta, data, err := raft.ReadSnapshotArchive(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+
+	cmp, err := svc.sysdb.CompareSnapshot(meta, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid snapshot")
+	}
+
+	resp := &mgmtpb.SystemDBRestoreResp{
+		Index:           meta.Index,
+		Term:            meta.Term,
+		Version:         cmp.Version,
+		MapVersion:      cmp.MapVersion,
+		MissingRanks:    cmp.MissingRanks.String(),
+		ExtraRanks:      cmp.ExtraRanks.String(),
+		ChangedRanks:    cmp.ChangedRanks.String(),
+		MissingPools:    cmp.MissingPools,
+		ExtraPools:      cmp.ExtraPools,
+		ChangedPools:    cmp.ChangedPools,
+		MissingFindings: cmp.MissingFindings,
+		ExtraFindings:   cmp.ExtraFindings,
+	}
+	if !req.GetApply() {
+		return resp, nil
+	}
+
+	if cmp.HasDifferences() && !req.GetForce() {
+		return nil, errors.New("snapshot differs from the running system; force is required to restore it")
+	}
+
+	if err := svc.sysdb.RestoreSnapshot(meta, data); err != nil {
+		return nil, err
+	}
+	resp.Applied = true
+
+	// Make sure that the engines pick up any membership changes.
+	svc.reqGroupUpdate(ctx, true)
+
+	return resp, nil
+}
+
+// SystemDBVerify checks the consistency of the system database on the MS
+// leader.
+func (svc *mgmtSvc) SystemDBVerify(ctx context.Context, req *mgmtpb.SystemDBVerifyReq) (*mgmtpb.SystemDBVerifyResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	version, err := svc.sysdb.DataVersion()
+	if err != nil {
+		return nil, err
+	}
+	found, err := svc.sysdb.Verify()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemDBVerifyResp{Version: version}
+	for _, inc := range found {
+		svc.log.Errorf("system database inconsistency: %s", inc)
+		resp.Inconsistencies = append(resp.Inconsistencies, &mgmtpb.SystemDBInconsistency{
+			Check:       inc.Check,
+			Description: inc.Description,
+		})
+	}
+
+	return resp, nil
+}
+
+// SystemDBReplica streams the local copy of the system database held by this
+// MS replica back to the MS leader in chunks.
+func (svc *mgmtSvc) SystemDBReplica(req *mgmtpb.SystemDBReplicaReq, stream mgmtpb.MgmtSvc_SystemDBReplicaServer) error {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return err
+	}
+
+	version, data, err := svc.sysdb.ReplicaData()
+	if err != nil {
+		return err
+	}
+
+	resp := &mgmtpb.SystemDBReplicaResp{
+		Version: version,
+		Size:    uint64(len(data)),
+	}
+	for len(data) > 0 {
+		n := len(data)
+		if n > dbSnapshotChunkSize {
+			n = dbSnapshotChunkSize
+		}
+		resp.Data = data[:n]
+		data = data[n:]
+
+		if err := stream.Send(resp); err != nil {
+			return errors.Wrap(err, "failed to send system database data")
+		}
+		resp = new(mgmtpb.SystemDBReplicaResp)
+	}
+
+	return nil
+}
+
+// replicaDBData returns the copy of the system database held by the MS replica
+// at the supplied control address, which is read locally if the address is
+// that of this server.
+func (svc *mgmtSvc) replicaDBData(ctx context.Context, addr *net.TCPAddr) (uint64, []byte, error) {
+	if localAddr, err := svc.sysdb.ReplicaAddr(); err == nil && localAddr.String() == addr.String() {
+		return svc.sysdb.ReplicaData()
+	}
+
+	req := new(control.SystemDBReplicaReq)
+	req.SetHostList([]string{addr.String()})
+	req.SetSystem(svc.sysdb.SystemName())
+
+	resp, err := control.SystemDBReplica(ctx, svc.rpcClient, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.Version, resp.Data, nil
+}
+
+// SystemDBDiff compares the copies of the system database held by two MS
+// replicas. If only one replica is supplied, it is compared with the leader.
+func (svc *mgmtSvc) SystemDBDiff(ctx context.Context, req *mgmtpb.SystemDBDiffReq) (*mgmtpb.SystemDBDiffResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if len(req.Replicas) < 1 || len(req.Replicas) > 2 {
+		return nil, errors.Errorf("one or two MS replicas must be supplied, got %d", len(req.Replicas))
+	}
+
+	replicas, err := svc.sysdb.ReplicaAddrs()
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []*net.TCPAddr
+	if len(req.Replicas) == 1 {
+		leaderAddr, err := svc.sysdb.ReplicaAddr()
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, leaderAddr)
+	}
+	for _, replica := range req.Replicas {
+		addr, err := resolveReplicaAddr(replica)
+		if err != nil {
+			return nil, err
+		}
+		if !common.Includes(replicaAddrStrings(replicas), addr.String()) {
+			return nil, errors.Errorf("%s is not a MS replica", addr)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	var versions [2]uint64
+	var data [2][]byte
+	for i, addr := range addrs {
+		if versions[i], data[i], err = svc.replicaDBData(ctx, addr); err != nil {
+			return nil, errors.Wrapf(err, "failed to get system database from %s", addr)
+		}
+	}
+
+	cmp, err := raft.DiffReplicaData(data[0], data[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemDBDiffResp{
+		First:           addrs[0].String(),
+		FirstVersion:    versions[0],
+		Second:          addrs[1].String(),
+		SecondVersion:   versions[1],
+		MissingRanks:    cmp.MissingRanks.String(),
+		ExtraRanks:      cmp.ExtraRanks.String(),
+		ChangedRanks:    cmp.ChangedRanks.String(),
+		MissingPools:    cmp.MissingPools,
+		ExtraPools:      cmp.ExtraPools,
+		ChangedPools:    cmp.ChangedPools,
+		MissingFindings: cmp.MissingFindings,
+		ExtraFindings:   cmp.ExtraFindings,
+	}, nil
+}
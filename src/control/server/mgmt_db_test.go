@@ -0,0 +1,475 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+type mockDBSnapshotServerStream struct {
+	grpc.ServerStream
+	sent []*mgmtpb.SystemDBSnapshotResp
+}
+
+func (ms *mockDBSnapshotServerStream) Send(resp *mgmtpb.SystemDBSnapshotResp) error {
+	ms.sent = append(ms.sent, resp)
+	return nil
+}
+
+type mockDBReplicaServerStream struct {
+	grpc.ServerStream
+	sent []*mgmtpb.SystemDBReplicaResp
+}
+
+func (ms *mockDBReplicaServerStream) Send(resp *mgmtpb.SystemDBReplicaResp) error {
+	ms.sent = append(ms.sent, resp)
+	return nil
+}
+
+type mockDBRestoreServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*mgmtpb.SystemDBRestoreReq
+	resp *mgmtpb.SystemDBRestoreResp
+}
+
+func (ms *mockDBRestoreServerStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockDBRestoreServerStream) Recv() (*mgmtpb.SystemDBRestoreReq, error) {
+	if len(ms.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := ms.reqs[0]
+	ms.reqs = ms.reqs[1:]
+	return req, nil
+}
+
+func (ms *mockDBRestoreServerStream) SendAndClose(resp *mgmtpb.SystemDBRestoreResp) error {
+	ms.resp = resp
+	return nil
+}
+
+// mockDBRestoreStream returns a restore stream that sends the archive in
+// chunks of the given size.
+func mockDBRestoreStream(ctx context.Context, req *mgmtpb.SystemDBRestoreReq, archive []byte, chunkSize int) *mockDBRestoreServerStream {
+	stream := &mockDBRestoreServerStream{
+		ctx:  ctx,
+		reqs: []*mgmtpb.SystemDBRestoreReq{req},
+	}
+	for len(archive) > 0 {
+		n := len(archive)
+		if n > chunkSize {
+			n = chunkSize
+		}
+		stream.reqs = append(stream.reqs, &mgmtpb.SystemDBRestoreReq{Data: archive[:n]})
+		archive = archive[n:]
+	}
+	return stream
+}
+
+// mockDBSnapshotArchive returns a snapshot archive of the system database.
+func mockDBSnapshotArchive(t *testing.T, svc *mgmtSvc) []byte {
+	t.Helper()
+
+	meta, data, err := svc.sysdb.TakeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := raft.WriteSnapshotArchive(&buf, meta, data); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestServer_MgmtSvc_SystemDBSnapshot(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemDBSnapshotReq
+		expErr     error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.SystemDBSnapshotReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemDBSnapshotReq{},
+			expErr:     &system.ErrNotReplica{},
+		},
+		"success": {
+			req: &mgmtpb.SystemDBSnapshotReq{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			if !tc.nonReplica {
+				if err := svc.sysdb.AddMember(system.MockMember(t, 1, system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			stream := &mockDBSnapshotServerStream{}
+			gotErr := svc.SystemDBSnapshot(tc.req, stream)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if len(stream.sent) == 0 {
+				t.Fatal("no snapshot data sent")
+			}
+			var archive []byte
+			for _, resp := range stream.sent {
+				archive = append(archive, resp.Data...)
+			}
+			test.AssertEqual(t, stream.sent[0].Size, uint64(len(archive)), "unexpected archive size")
+
+			meta, data, err := raft.ReadSnapshotArchive(bytes.NewReader(archive))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, stream.sent[0].Index, meta.Index, "unexpected snapshot index")
+
+			cmp, err := svc.sysdb.CompareSnapshot(meta, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertFalse(t, cmp.HasDifferences(), "expected snapshot to match system")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemDBRestore(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		badArchive bool
+		sizeDelta  int
+		addMember  bool
+		apply      bool
+		force      bool
+		expResp    *mgmtpb.SystemDBRestoreResp
+		expMember  bool
+		expErr     error
+	}{
+		"not replica": {
+			nonReplica: true,
+			expErr:     &system.ErrNotReplica{},
+		},
+		"bad archive": {
+			badArchive: true,
+			expErr:     errors.New("snapshot archive"),
+		},
+		"incomplete archive": {
+			sizeDelta: 1,
+			expErr:    errors.New("incomplete snapshot"),
+		},
+		"too much data": {
+			sizeDelta: -1,
+			expErr:    errors.New("more snapshot data than expected"),
+		},
+		"validate only": {
+			addMember: true,
+			expResp: &mgmtpb.SystemDBRestoreResp{
+				MissingRanks: "2",
+			},
+			expMember: true,
+		},
+		"apply without differences": {
+			apply:   true,
+			expResp: &mgmtpb.SystemDBRestoreResp{Applied: true},
+		},
+		"apply differences without force": {
+			addMember: true,
+			apply:     true,
+			expErr:    errors.New("force is required"),
+			expMember: true,
+		},
+		"apply differences with force": {
+			addMember: true,
+			apply:     true,
+			force:     true,
+			expResp: &mgmtpb.SystemDBRestoreResp{
+				MissingRanks: "2",
+				Applied:      true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			req := &mgmtpb.SystemDBRestoreReq{
+				Sys:   build.DefaultSystemName,
+				Apply: tc.apply,
+				Force: tc.force,
+			}
+
+			var svc *mgmtSvc
+			var archive []byte
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			} else {
+				svc = newTestMgmtSvc(t, log)
+				if err := svc.sysdb.AddMember(system.MockMember(t, 1, system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+				archive = mockDBSnapshotArchive(t, svc)
+			}
+			if tc.badArchive {
+				archive = []byte("bad archive")
+			}
+			req.Size = uint64(len(archive) + tc.sizeDelta)
+			if tc.addMember {
+				if err := svc.sysdb.AddMember(system.MockMember(t, 2, system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			stream := mockDBRestoreStream(test.Context(t), req, archive, 64)
+			gotErr := svc.SystemDBRestore(stream)
+			test.CmpErr(t, tc.expErr, gotErr)
+			gotResp := stream.resp
+			if tc.expErr == nil {
+				tc.expResp.Index = gotResp.Index
+				tc.expResp.Term = gotResp.Term
+				tc.expResp.Version = gotResp.Version
+				tc.expResp.MapVersion = gotResp.MapVersion
+				if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+					t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+				}
+			}
+			if tc.nonReplica || tc.badArchive || tc.sizeDelta != 0 {
+				return
+			}
+
+			_, err := svc.sysdb.FindMemberByRank(2)
+			test.AssertEqual(t, tc.expMember, err == nil, "unexpected member state after restore")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemDBVerify(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		expErr     error
+	}{
+		"not replica": {
+			nonReplica: true,
+			expErr:     &system.ErrNotReplica{},
+		},
+		"consistent": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var svc *mgmtSvc
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			} else {
+				svc = newTestMgmtSvc(t, log)
+				if err := svc.sysdb.AddMember(system.MockMember(t, 1, system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotResp, gotErr := svc.SystemDBVerify(test.Context(t), &mgmtpb.SystemDBVerifyReq{
+				Sys: build.DefaultSystemName,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			expVersion, err := svc.sysdb.DataVersion()
+			if err != nil {
+				t.Fatal(err)
+			}
+			expResp := &mgmtpb.SystemDBVerifyResp{Version: expVersion}
+			if diff := cmp.Diff(expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemDBReplica(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	req := &mgmtpb.SystemDBReplicaReq{Sys: build.DefaultSystemName}
+
+	err := newTestMgmtSvcNonReplica(t, log).SystemDBReplica(req, &mockDBReplicaServerStream{})
+	test.CmpErr(t, &system.ErrNotReplica{}, err)
+
+	svc := newTestMgmtSvc(t, log)
+	if err := svc.sysdb.AddMember(system.MockMember(t, 1, system.MemberStateJoined)); err != nil {
+		t.Fatal(err)
+	}
+	stream := &mockDBReplicaServerStream{}
+	if err := svc.SystemDBReplica(req, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stream.sent) == 0 {
+		t.Fatal("no system database data sent")
+	}
+	var gotData []byte
+	for _, resp := range stream.sent {
+		gotData = append(gotData, resp.Data...)
+	}
+	test.AssertEqual(t, stream.sent[0].Size, uint64(len(gotData)), "unexpected data size")
+
+	expVersion, expData, err := svc.sysdb.ReplicaData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, expVersion, stream.sent[0].Version, "unexpected version")
+	test.AssertTrue(t, bytes.Equal(expData, gotData), "unexpected data")
+}
+
+func TestServer_MgmtSvc_SystemDBDiff(t *testing.T) {
+	local := "127.0.0.1:10001"
+	remote := "127.0.0.2:10001"
+
+	for name, tc := range map[string]struct {
+		replicas  []string
+		remoteErr error
+		expResp   *mgmtpb.SystemDBDiffResp
+		expErr    error
+	}{
+		"no replicas": {
+			expErr: errors.New("one or two MS replicas"),
+		},
+		"not a replica": {
+			replicas: []string{"127.0.0.3:10001"},
+			expErr:   errors.New("not a MS replica"),
+		},
+		"remote replica fails": {
+			replicas:  []string{remote},
+			remoteErr: errors.New("remote failed"),
+			expErr:    errors.New("failed to get system database from 127.0.0.2:10001"),
+		},
+		"compare with leader": {
+			replicas: []string{remote},
+			expResp: &mgmtpb.SystemDBDiffResp{
+				First:        local,
+				Second:       remote,
+				MissingRanks: "3",
+			},
+		},
+		"compare two replicas": {
+			replicas: []string{remote, local},
+			expResp: &mgmtpb.SystemDBDiffResp{
+				First:      remote,
+				Second:     local,
+				ExtraRanks: "3",
+			},
+		},
+		"compare replica with itself": {
+			replicas: []string{local, local},
+			expResp: &mgmtpb.SystemDBDiffResp{
+				First:  local,
+				Second: local,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			svc := newTestMgmtSvc(t, log)
+			svc.sysdb = raft.MockDatabaseWithCfg(t, log, &raft.DatabaseConfig{
+				SystemName: build.DefaultSystemName,
+				RaftDir:    testDir,
+				Replicas: []*net.TCPAddr{
+					common.LocalhostCtrlAddr(),
+					system.MockControlAddr(t, 2),
+				},
+			})
+			if err := svc.sysdb.AddMember(system.MockMember(t, 1, system.MemberStateJoined)); err != nil {
+				t.Fatal(err)
+			}
+
+			// The remote replica has not yet seen the last member.
+			remoteVer, remoteData, err := svc.sysdb.ReplicaData()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := svc.sysdb.AddMember(system.MockMember(t, 3, system.MemberStateJoined)); err != nil {
+				t.Fatal(err)
+			}
+			localVer, err := svc.sysdb.DataVersion()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hr := &control.HostResponse{Addr: remote, Error: tc.remoteErr}
+			if tc.remoteErr == nil {
+				hr.Message = &mgmtpb.SystemDBReplicaResp{
+					Version: remoteVer,
+					Data:    remoteData,
+				}
+			}
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: &control.UnaryResponse{
+					Responses: []*control.HostResponse{hr},
+				},
+			})
+
+			gotResp, gotErr := svc.SystemDBDiff(test.Context(t), &mgmtpb.SystemDBDiffReq{
+				Sys:      build.DefaultSystemName,
+				Replicas: tc.replicas,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			versions := map[string]uint64{local: localVer, remote: remoteVer}
+			tc.expResp.FirstVersion = versions[tc.expResp.First]
+			tc.expResp.SecondVersion = versions[tc.expResp.Second]
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// evtStreamBufSize is the number of events that may be queued for a stream
+// subscriber before further events are dropped.
+const evtStreamBufSize = 256
+
+// eventSubscriber represents a client subscribed to receive RAS events over
+// a stream.
+type eventSubscriber struct {
+	evtType  events.RASTypeID
+	severity events.RASSeverityID
+	ids      map[events.RASID]struct{}
+	evtCh    chan *events.RASEvent
+	done     chan struct{}
+	dropped  atomic.Uint64
+}
+
+func newEventSubscriber(req *mgmtpb.SystemStreamEventsReq) *eventSubscriber {
+	sub := &eventSubscriber{
+		evtType:  events.RASTypeID(req.GetType()),
+		severity: events.RASSeverityID(req.GetSeverity()),
+		evtCh:    make(chan *events.RASEvent, evtStreamBufSize),
+		done:     make(chan struct{}),
+	}
+	if len(req.GetIds()) > 0 {
+		sub.ids = make(map[events.RASID]struct{})
+		for _, id := range req.GetIds() {
+			sub.ids[events.RASID(id)] = struct{}{}
+		}
+	}
+
+	return sub
+}
+
+// matches returns true if the event satisfies the subscriber's filter.
+func (sub *eventSubscriber) matches(evt *events.RASEvent) bool {
+	if sub.evtType != events.RASTypeAny && evt.Type != sub.evtType {
+		return false
+	}
+	if sub.severity != events.RASSeverityUnknown &&
+		(evt.Severity == events.RASSeverityUnknown || evt.Severity > sub.severity) {
+		return false
+	}
+	if sub.ids != nil {
+		if _, found := sub.ids[evt.ID]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventStreamHub implements the events.Handler interface and fans out
+// received events to any subscribed event streams.
+type eventStreamHub struct {
+	sync.RWMutex
+	log         logging.Logger
+	subscribers map[*eventSubscriber]struct{}
+}
+
+func newEventStreamHub(log logging.Logger) *eventStreamHub {
+	return &eventStreamHub{
+		log:         log,
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// OnEvent implements the events.Handler interface. Events are never blocked
+// on slow subscribers, instead they are dropped if a subscriber's queue is
+// full.
+func (hub *eventStreamHub) OnEvent(_ context.Context, evt *events.RASEvent) {
+	if evt == nil {
+		return
+	}
+
+	hub.RLock()
+	defer hub.RUnlock()
+
+	for sub := range hub.subscribers {
+		if !sub.matches(evt) {
+			continue
+		}
+
+		select {
+		case sub.evtCh <- evt:
+		default:
+			hub.log.Errorf("event stream subscriber queue full, dropped %s event (%d dropped)",
+				evt.ID, sub.dropped.Add(1))
+		}
+	}
+}
+
+// subscribe adds a subscriber to the set that will receive events.
+func (hub *eventStreamHub) subscribe(sub *eventSubscriber) {
+	hub.Lock()
+	defer hub.Unlock()
+
+	hub.subscribers[sub] = struct{}{}
+}
+
+// unsubscribe removes a subscriber so that it no longer receives events.
+func (hub *eventStreamHub) unsubscribe(sub *eventSubscriber) {
+	hub.Lock()
+	defer hub.Unlock()
+
+	delete(hub.subscribers, sub)
+}
+
+// closeAll signals all current subscribers that no further events will be
+// delivered and removes them from the hub.
+func (hub *eventStreamHub) closeAll() {
+	hub.Lock()
+	defer hub.Unlock()
+
+	for sub := range hub.subscribers {
+		close(sub.done)
+		delete(hub.subscribers, sub)
+	}
+}
+
+// SystemStreamEvents sends RAS events matching the request filter to the
+// client as they are received by the MS leader. The stream ends when the
+// client cancels it or when this instance is no longer the leader.
+func (svc *mgmtSvc) SystemStreamEvents(req *mgmtpb.SystemStreamEventsReq, stream mgmtpb.MgmtSvc_SystemStreamEventsServer) error {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+
+	sub := newEventSubscriber(req)
+	svc.evtStreams.subscribe(sub)
+	defer svc.evtStreams.unsubscribe(sub)
+
+	// Let the client know that the subscription is in place before any
+	// events are sent.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return errors.Wrap(err, "failed to send stream header")
+	}
+
+	ctx := stream.Context()
+	svc.log.Debugf("RAS event stream opened (type: %s, severity: %s, ids: %v)",
+		sub.evtType, sub.severity, req.GetIds())
+	defer svc.log.Debug("RAS event stream closed")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.done:
+			if err := svc.sysdb.CheckLeader(); err != nil {
+				return err
+			}
+			return errors.New("RAS event stream closed by MS leader")
+		case evt := <-sub.evtCh:
+			pbEvt, err := evt.ToProto()
+			if err != nil {
+				svc.log.Errorf("failed to convert %s event: %s", evt.ID, err)
+				continue
+			}
+			if err := stream.Send(pbEvt); err != nil {
+				return errors.Wrap(err, "failed to send RAS event")
+			}
+		}
+	}
+}
@@ -0,0 +1,318 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	eventPolicyProp = "event_policy"
+	// eventPolicySyncInterval is the interval at which servers other
+	// than the MS leader check for changes to the RAS event policy.
+	eventPolicySyncInterval = 30 * time.Second
+)
+
+// protectedEventIDs are relied upon by the MS to maintain system membership
+// and so their publication may not be altered at runtime.
+var protectedEventIDs = map[events.RASID]struct{}{
+	events.RASEngineDied:   {},
+	events.RASSwimRankDead: {},
+}
+
+type (
+	// eventIDPolicy describes the publication policy for a single RAS
+	// event ID.
+	eventIDPolicy struct {
+		Disabled bool          `json:"disabled,omitempty"`
+		Debounce bool          `json:"debounce,omitempty"`
+		Cooldown time.Duration `json:"cooldown,omitempty"`
+	}
+
+	// eventPolicy maps RAS event IDs to their publication policy.
+	eventPolicy map[events.RASID]eventIDPolicy
+)
+
+func (p eventIDPolicy) isEmpty() bool {
+	return !p.Disabled && !p.Debounce
+}
+
+func (p eventPolicy) equals(other eventPolicy) bool {
+	if len(p) != len(other) {
+		return false
+	}
+	for id, idPolicy := range p {
+		if otherPolicy, found := other[id]; !found || otherPolicy != idPolicy {
+			return false
+		}
+	}
+	return true
+}
+
+// getEventPolicy returns the RAS event policy stored in the system database.
+func getEventPolicy(db system.SysAttrGetter) (eventPolicy, error) {
+	policy := make(eventPolicy)
+
+	val, err := system.GetMgmtProperty(db, eventPolicyProp)
+	if err != nil {
+		if system.IsErrSystemAttrNotFound(err) {
+			return policy, nil
+		}
+		return nil, err
+	}
+	if val == "" {
+		return policy, nil
+	}
+
+	if err := json.Unmarshal([]byte(val), &policy); err != nil {
+		return nil, errors.Wrapf(err, "invalid value for mgmt prop %q", eventPolicyProp)
+	}
+
+	return policy, nil
+}
+
+// setEventPolicy stores the RAS event policy in the system database.
+func setEventPolicy(db system.SysAttrSetter, policy eventPolicy) error {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	return system.SetMgmtProperty(db, eventPolicyProp, string(data))
+}
+
+// applyEventPolicy updates the supplied PubSub to reflect the change from the
+// old to the new policy.
+func applyEventPolicy(ps *events.PubSub, oldPolicy, newPolicy eventPolicy) {
+	for id, op := range oldPolicy {
+		np := newPolicy[id]
+		if op.Disabled && !np.Disabled {
+			ps.EnableEventIDs(id)
+		}
+		if op.Debounce && !np.Debounce {
+			ps.RemoveDebounce(id)
+		}
+	}
+
+	for id, np := range newPolicy {
+		if np.Disabled {
+			ps.DisableEventIDs(id)
+		}
+		if np.Debounce {
+			ps.Debounce(id, np.Cooldown, events.LocationDebounceKey)
+		}
+	}
+}
+
+// loadEventPolicy applies the RAS event policy stored in the local copy of the
+// system database to the events published by this server. It is called once
+// this instance has become the MS leader.
+func (svc *mgmtSvc) loadEventPolicy() error {
+	svc.evtPolicyLock.Lock()
+	defer svc.evtPolicyLock.Unlock()
+
+	policy, err := getEventPolicy(svc.sysdb)
+	if err != nil {
+		return err
+	}
+
+	applyEventPolicy(svc.events, svc.evtPolicy, policy)
+	svc.evtPolicy = policy
+
+	return nil
+}
+
+// reapplyEventPolicy applies the current RAS event policy again, as event
+// debouncing is removed when the PubSub is reset on a change of leadership.
+func (svc *mgmtSvc) reapplyEventPolicy() {
+	svc.evtPolicyLock.Lock()
+	defer svc.evtPolicyLock.Unlock()
+
+	applyEventPolicy(svc.events, svc.evtPolicy, svc.evtPolicy)
+}
+
+// fetchEventPolicy returns the stored RAS event policy. MS replicas read it
+// from their copy of the system database and other servers request it from
+// the MS leader.
+func (svc *mgmtSvc) fetchEventPolicy(ctx context.Context) (eventPolicy, error) {
+	if svc.sysdb.CheckReplica() == nil {
+		return getEventPolicy(svc.sysdb)
+	}
+
+	resp, err := control.SystemGetEventPolicy(ctx, svc.rpcClient, new(control.SystemGetEventPolicyReq))
+	if err != nil {
+		return nil, err
+	}
+
+	policy := make(eventPolicy)
+	for _, p := range resp.Policies {
+		policy[p.ID] = eventIDPolicy{
+			Disabled: p.Disabled,
+			Debounce: p.Debounce,
+			Cooldown: p.Cooldown,
+		}
+	}
+	return policy, nil
+}
+
+// syncEventPolicy applies any change to the stored RAS event policy to the
+// events published by this server. The MS leader applies changes as they are
+// made, so nothing is done on the leader.
+func (svc *mgmtSvc) syncEventPolicy(ctx context.Context) error {
+	if svc.sysdb.IsLeader() {
+		return nil
+	}
+
+	policy, err := svc.fetchEventPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc.evtPolicyLock.Lock()
+	defer svc.evtPolicyLock.Unlock()
+
+	// Leadership may have been gained while the policy was fetched, in
+	// which case the policy has been loaded from the system database.
+	if svc.sysdb.IsLeader() || policy.equals(svc.evtPolicy) {
+		return nil
+	}
+
+	svc.log.Debugf("RAS event policy updated from system database: %+v", policy)
+	applyEventPolicy(svc.events, svc.evtPolicy, policy)
+	svc.evtPolicy = policy
+
+	return nil
+}
+
+// requestEventPolicySync requests an immediate check for changes to the RAS
+// event policy.
+func (svc *mgmtSvc) requestEventPolicySync() {
+	select {
+	case svc.evtPolicyKick <- struct{}{}:
+	default:
+	}
+}
+
+// eventPolicyLoop keeps the RAS event policy applied to the events published
+// by this server in step with the policy stored in the system database, so that
+// changes made on the MS leader take effect on every server.
+func (svc *mgmtSvc) eventPolicyLoop(ctx context.Context) {
+	ticker := time.NewTicker(eventPolicySyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-svc.evtPolicyKick:
+		case <-ticker.C:
+		}
+
+		if err := svc.syncEventPolicy(ctx); err != nil {
+			svc.log.Debugf("failed to sync RAS event policy: %s", err)
+		}
+	}
+}
+
+func eventIDPolicyFromPB(pbPolicy *mgmtpb.EventPolicy) (events.RASID, eventIDPolicy, error) {
+	id := events.RASID(pbPolicy.GetId())
+	if found, err := events.RASIDFromString(id.String()); err != nil || found != id {
+		return id, eventIDPolicy{}, errors.Errorf("unknown RAS event ID %d", pbPolicy.GetId())
+	}
+	if _, protected := protectedEventIDs[id]; protected {
+		return id, eventIDPolicy{}, errors.Errorf("policy for %s events may not be changed", id)
+	}
+	if pbPolicy.GetCooldown() != 0 && !pbPolicy.GetDebounce() {
+		return id, eventIDPolicy{}, errors.Errorf("cooldown for %s events requires debounce", id)
+	}
+
+	return id, eventIDPolicy{
+		Disabled: pbPolicy.GetDisabled(),
+		Debounce: pbPolicy.GetDebounce(),
+		Cooldown: time.Duration(pbPolicy.GetCooldown()),
+	}, nil
+}
+
+// SystemSetEventPolicy updates the stored RAS event policy and applies it to
+// the events published by the MS leader. Other servers apply the change when
+// they next sync the policy.
+func (svc *mgmtSvc) SystemSetEventPolicy(ctx context.Context, req *mgmtpb.SystemSetEventPolicyReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	if len(req.GetPolicies()) == 0 {
+		return nil, errors.New("no event policies supplied")
+	}
+
+	svc.evtPolicyLock.Lock()
+	defer svc.evtPolicyLock.Unlock()
+
+	policy, err := getEventPolicy(svc.sysdb)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pbPolicy := range req.GetPolicies() {
+		id, idPolicy, err := eventIDPolicyFromPB(pbPolicy)
+		if err != nil {
+			return nil, err
+		}
+
+		if idPolicy.isEmpty() {
+			delete(policy, id)
+			continue
+		}
+		policy[id] = idPolicy
+	}
+
+	if err := setEventPolicy(svc.sysdb, policy); err != nil {
+		return nil, errors.Wrap(err, "failed to store event policy")
+	}
+	svc.log.Debugf("RAS event policy updated: %+v", policy)
+
+	applyEventPolicy(svc.events, svc.evtPolicy, policy)
+	svc.evtPolicy = policy
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+// SystemGetEventPolicy returns the stored RAS event policy.
+func (svc *mgmtSvc) SystemGetEventPolicy(ctx context.Context, req *mgmtpb.SystemGetEventPolicyReq) (*mgmtpb.SystemGetEventPolicyResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	policy, err := getEventPolicy(svc.sysdb)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemGetEventPolicyResp)
+	for id, idPolicy := range policy {
+		resp.Policies = append(resp.Policies, &mgmtpb.EventPolicy{
+			Id:       id.Uint32(),
+			Disabled: idPolicy.Disabled,
+			Debounce: idPolicy.Debounce,
+			Cooldown: uint64(idPolicy.Cooldown),
+		})
+	}
+	sort.Slice(resp.Policies, func(i, j int) bool {
+		return resp.Policies[i].Id < resp.Policies[j].Id
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,312 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_SystemSetEventPolicy(t *testing.T) {
+	fmtReq := events.RASEngineFormatRequired
+	linkSpeed := events.RASNVMeLinkSpeedChanged
+	linkWidth := events.RASNVMeLinkWidthChanged
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		curPolicy  eventPolicy
+		req        *mgmtpb.SystemSetEventPolicyReq
+		expPolicy  eventPolicy
+		expErr     error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.SystemSetEventPolicyReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemSetEventPolicyReq{},
+			expErr:     &system.ErrNotReplica{},
+		},
+		"no policies": {
+			req:    &mgmtpb.SystemSetEventPolicyReq{},
+			expErr: errors.New("no event policies"),
+		},
+		"unknown event": {
+			req: &mgmtpb.SystemSetEventPolicyReq{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: 9999, Disabled: true},
+				},
+			},
+			expErr: errors.New("unknown RAS event ID 9999"),
+		},
+		"protected event": {
+			req: &mgmtpb.SystemSetEventPolicyReq{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: events.RASSwimRankDead.Uint32(), Disabled: true},
+				},
+			},
+			expErr: errors.New("may not be changed"),
+		},
+		"cooldown without debounce": {
+			req: &mgmtpb.SystemSetEventPolicyReq{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: fmtReq.Uint32(), Cooldown: uint64(time.Minute)},
+				},
+			},
+			expErr: errors.New("requires debounce"),
+		},
+		"set policies": {
+			req: &mgmtpb.SystemSetEventPolicyReq{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: fmtReq.Uint32(), Disabled: true},
+					{Id: linkSpeed.Uint32(), Debounce: true, Cooldown: uint64(time.Minute)},
+				},
+			},
+			expPolicy: eventPolicy{
+				fmtReq:    {Disabled: true},
+				linkSpeed: {Debounce: true, Cooldown: time.Minute},
+			},
+		},
+		"update existing policy": {
+			curPolicy: eventPolicy{
+				fmtReq:    {Disabled: true},
+				linkSpeed: {Debounce: true, Cooldown: time.Minute},
+			},
+			req: &mgmtpb.SystemSetEventPolicyReq{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: fmtReq.Uint32()},
+					{Id: linkWidth.Uint32(), Debounce: true},
+				},
+			},
+			expPolicy: eventPolicy{
+				linkSpeed: {Debounce: true, Cooldown: time.Minute},
+				linkWidth: {Debounce: true},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+			if tc.curPolicy != nil {
+				if err := setEventPolicy(svc.sysdb, tc.curPolicy); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			_, gotErr := svc.SystemSetEventPolicy(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotPolicy, err := getEventPolicy(svc.sysdb)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expPolicy, gotPolicy); diff != "" {
+				t.Fatalf("unexpected stored policy (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expPolicy, svc.evtPolicy); diff != "" {
+				t.Fatalf("unexpected applied policy (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemGetEventPolicy(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	req := &mgmtpb.SystemGetEventPolicyReq{Sys: build.DefaultSystemName}
+
+	resp, err := svc.SystemGetEventPolicy(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(resp.Policies), "unexpected policies")
+
+	if err := setEventPolicy(svc.sysdb, eventPolicy{
+		events.RASNVMeLinkSpeedChanged: {Debounce: true, Cooldown: time.Minute},
+		events.RASEngineFormatRequired: {Disabled: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err = svc.SystemGetEventPolicy(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expResp := &mgmtpb.SystemGetEventPolicyResp{
+		Policies: []*mgmtpb.EventPolicy{
+			{Id: events.RASEngineFormatRequired.Uint32(), Disabled: true},
+			{Id: events.RASNVMeLinkSpeedChanged.Uint32(), Debounce: true, Cooldown: uint64(time.Minute)},
+		},
+	}
+	if diff := cmp.Diff(expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestServer_MgmtSvc_loadEventPolicy(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	rxCh := make(chan *events.RASEvent, 16)
+	svc.events.Subscribe(events.RASTypeAny,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			rxCh <- evt
+		}))
+
+	if err := setEventPolicy(svc.sysdb, eventPolicy{
+		events.RASEngineFormatRequired: {Disabled: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Publish a muted event followed by an unmuted one, only the latter
+	// should be received.
+	checkRx := func(t *testing.T, first events.RASID, expFirst bool) {
+		t.Helper()
+
+		svc.events.Publish(mockStreamEvt(first, events.RASTypeInfoOnly, events.RASSeverityNotice))
+		svc.events.Publish(mockStreamEvt(events.RASSystemStartFailed, events.RASTypeInfoOnly, events.RASSeverityError))
+
+		expCount := 1
+		if expFirst {
+			expCount = 2
+		}
+		var gotIDs []events.RASID
+		for len(gotIDs) < expCount {
+			select {
+			case evt := <-rxCh:
+				gotIDs = append(gotIDs, evt.ID)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out waiting for events (received %v)", gotIDs)
+			}
+		}
+
+		var gotFirst bool
+		for _, id := range gotIDs {
+			if id == first {
+				gotFirst = true
+			}
+		}
+		test.AssertEqual(t, expFirst, gotFirst, "unexpected muted event state")
+	}
+
+	checkRx(t, events.RASEngineFormatRequired, true)
+
+	if err := svc.loadEventPolicy(); err != nil {
+		t.Fatal(err)
+	}
+	checkRx(t, events.RASEngineFormatRequired, false)
+
+	if err := setEventPolicy(svc.sysdb, eventPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.loadEventPolicy(); err != nil {
+		t.Fatal(err)
+	}
+	checkRx(t, events.RASEngineFormatRequired, true)
+}
+
+func TestServer_MgmtSvc_syncEventPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		curPolicy eventPolicy
+		resp      *mgmtpb.SystemGetEventPolicyResp
+		respErr   error
+		expPolicy eventPolicy
+		expErr    error
+	}{
+		"leader request fails": {
+			curPolicy: eventPolicy{
+				events.RASEngineFormatRequired: {Disabled: true},
+			},
+			respErr: errors.New("not leader"),
+			expPolicy: eventPolicy{
+				events.RASEngineFormatRequired: {Disabled: true},
+			},
+			expErr: errors.New("not leader"),
+		},
+		"policy added": {
+			resp: &mgmtpb.SystemGetEventPolicyResp{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: events.RASEngineFormatRequired.Uint32(), Disabled: true},
+					{Id: events.RASNVMeLinkSpeedChanged.Uint32(), Debounce: true, Cooldown: uint64(time.Minute)},
+				},
+			},
+			expPolicy: eventPolicy{
+				events.RASEngineFormatRequired: {Disabled: true},
+				events.RASNVMeLinkSpeedChanged: {Debounce: true, Cooldown: time.Minute},
+			},
+		},
+		"policy removed": {
+			curPolicy: eventPolicy{
+				events.RASEngineFormatRequired: {Disabled: true},
+			},
+			resp:      &mgmtpb.SystemGetEventPolicyResp{},
+			expPolicy: eventPolicy{},
+		},
+		"policy unchanged": {
+			curPolicy: eventPolicy{
+				events.RASEngineFormatRequired: {Disabled: true},
+			},
+			resp: &mgmtpb.SystemGetEventPolicyResp{
+				Policies: []*mgmtpb.EventPolicy{
+					{Id: events.RASEngineFormatRequired.Uint32(), Disabled: true},
+				},
+			},
+			expPolicy: eventPolicy{
+				events.RASEngineFormatRequired: {Disabled: true},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvcNonReplica(t, log)
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: []*control.UnaryResponse{
+					control.MockMSResponse("10.0.0.1:10001", tc.respErr, tc.resp),
+				},
+			})
+			applyEventPolicy(svc.events, nil, tc.curPolicy)
+			svc.evtPolicy = tc.curPolicy
+
+			gotErr := svc.syncEventPolicy(test.Context(t))
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expPolicy, svc.evtPolicy); diff != "" {
+				t.Fatalf("unexpected policy (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockStreamEvt(id events.RASID, typ events.RASTypeID, sev events.RASSeverityID) *events.RASEvent {
+	return &events.RASEvent{
+		ID:        id,
+		Type:      typ,
+		Severity:  sev,
+		Msg:       "test event",
+		Hostname:  "foo",
+		Timestamp: "2024-01-02T03:04:05.000+00:00",
+	}
+}
+
+func TestServer_eventSubscriber_matches(t *testing.T) {
+	evtDied := mockStreamEvt(events.RASEngineDied, events.RASTypeStateChange, events.RASSeverityError)
+	evtLink := mockStreamEvt(events.RASNVMeLinkSpeedChanged, events.RASTypeInfoOnly, events.RASSeverityWarning)
+	evtSwim := mockStreamEvt(events.RASSwimRankDead, events.RASTypeStateChange, events.RASSeverityNotice)
+	allEvts := []*events.RASEvent{evtDied, evtLink, evtSwim}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemStreamEventsReq
+		expEvts []*events.RASEvent
+	}{
+		"no filter": {
+			req:     &mgmtpb.SystemStreamEventsReq{},
+			expEvts: allEvts,
+		},
+		"type": {
+			req: &mgmtpb.SystemStreamEventsReq{
+				Type: events.RASTypeStateChange.Uint32(),
+			},
+			expEvts: []*events.RASEvent{evtDied, evtSwim},
+		},
+		"severity": {
+			req: &mgmtpb.SystemStreamEventsReq{
+				Severity: events.RASSeverityWarning.Uint32(),
+			},
+			expEvts: []*events.RASEvent{evtDied, evtLink},
+		},
+		"ids": {
+			req: &mgmtpb.SystemStreamEventsReq{
+				Ids: []uint32{events.RASSwimRankDead.Uint32(), events.RASNVMeLinkSpeedChanged.Uint32()},
+			},
+			expEvts: []*events.RASEvent{evtLink, evtSwim},
+		},
+		"combined": {
+			req: &mgmtpb.SystemStreamEventsReq{
+				Type:     events.RASTypeStateChange.Uint32(),
+				Severity: events.RASSeverityError.Uint32(),
+				Ids:      []uint32{events.RASSwimRankDead.Uint32()},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sub := newEventSubscriber(tc.req)
+
+			var gotEvts []*events.RASEvent
+			for _, evt := range allEvts {
+				if sub.matches(evt) {
+					gotEvts = append(gotEvts, evt)
+				}
+			}
+
+			test.AssertEqual(t, len(tc.expEvts), len(gotEvts), "unexpected number of matches")
+			for i, evt := range tc.expEvts {
+				test.AssertEqual(t, evt.ID, gotEvts[i].ID, "unexpected event")
+			}
+		})
+	}
+}
+
+func TestServer_eventStreamHub(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	hub := newEventStreamHub(log)
+	subAll := newEventSubscriber(&mgmtpb.SystemStreamEventsReq{})
+	subErr := newEventSubscriber(&mgmtpb.SystemStreamEventsReq{
+		Severity: events.RASSeverityError.Uint32(),
+	})
+	hub.subscribe(subAll)
+	hub.subscribe(subErr)
+
+	hub.OnEvent(test.Context(t), mockStreamEvt(events.RASEngineDied, events.RASTypeStateChange, events.RASSeverityError))
+	hub.OnEvent(test.Context(t), mockStreamEvt(events.RASSwimRankDead, events.RASTypeStateChange, events.RASSeverityNotice))
+	test.AssertEqual(t, 2, len(subAll.evtCh), "unexpected number of queued events")
+	test.AssertEqual(t, 1, len(subErr.evtCh), "unexpected number of queued events")
+
+	// Events are dropped rather than blocking on a full queue.
+	for i := 0; i < evtStreamBufSize; i++ {
+		hub.OnEvent(test.Context(t), mockStreamEvt(events.RASEngineDied, events.RASTypeStateChange, events.RASSeverityError))
+	}
+	test.AssertEqual(t, evtStreamBufSize, len(subAll.evtCh), "unexpected number of queued events")
+	test.AssertEqual(t, uint64(2), subAll.dropped.Load(), "unexpected number of dropped events")
+
+	hub.unsubscribe(subErr)
+	hub.closeAll()
+	test.AssertEqual(t, 0, len(hub.subscribers), "unexpected subscribers after close")
+
+	select {
+	case <-subAll.done:
+	default:
+		t.Fatal("subscriber not closed")
+	}
+	select {
+	case <-subErr.done:
+		t.Fatal("unsubscribed subscriber closed")
+	default:
+	}
+}
+
+type mockEventsServerStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	subscribed chan struct{}
+	sent       chan *sharedpb.RASEvent
+}
+
+func (ms *mockEventsServerStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockEventsServerStream) SendHeader(metadata.MD) error {
+	close(ms.subscribed)
+	return nil
+}
+
+func (ms *mockEventsServerStream) Send(evt *sharedpb.RASEvent) error {
+	ms.sent <- evt
+	return nil
+}
+
+func newMockEventsServerStream(ctx context.Context) *mockEventsServerStream {
+	return &mockEventsServerStream{
+		ctx:        ctx,
+		subscribed: make(chan struct{}),
+		sent:       make(chan *sharedpb.RASEvent, 1),
+	}
+}
+
+func TestServer_MgmtSvc_SystemStreamEvents(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemStreamEventsReq
+		closeAll   bool
+		expErr     error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.SystemStreamEventsReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemStreamEventsReq{},
+			expErr:     &system.ErrNotReplica{},
+		},
+		"client cancels": {
+			req: &mgmtpb.SystemStreamEventsReq{
+				Severity: events.RASSeverityError.Uint32(),
+			},
+		},
+		"closed by leader": {
+			req: &mgmtpb.SystemStreamEventsReq{
+				Severity: events.RASSeverityError.Uint32(),
+			},
+			closeAll: true,
+			expErr:   errors.New("closed by MS leader"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+			stream := newMockEventsServerStream(ctx)
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- svc.SystemStreamEvents(tc.req, stream)
+			}()
+
+			select {
+			case <-stream.subscribed:
+			case err := <-errCh:
+				test.CmpErr(t, tc.expErr, err)
+				return
+			}
+
+			// Only the matching event should be sent to the client.
+			svc.evtStreams.OnEvent(ctx, mockStreamEvt(events.RASSwimRankDead,
+				events.RASTypeStateChange, events.RASSeverityNotice))
+			svc.evtStreams.OnEvent(ctx, mockStreamEvt(events.RASEngineDied,
+				events.RASTypeStateChange, events.RASSeverityError))
+			select {
+			case pbEvt := <-stream.sent:
+				test.AssertEqual(t, events.RASEngineDied.Uint32(), pbEvt.Id, "unexpected event sent")
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for event")
+			}
+
+			if tc.closeAll {
+				svc.evtStreams.closeAll()
+			} else {
+				cancel()
+			}
+			test.CmpErr(t, tc.expErr, <-errCh)
+			test.AssertEqual(t, 0, len(svc.evtStreams.subscribers), "unexpected subscribers")
+		})
+	}
+}
@@ -9,6 +9,7 @@ package server
 import (
 	"math/rand"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -17,12 +18,14 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -37,6 +40,12 @@ const (
 	// MaxPoolServiceReps defines the maximum number of pool service
 	// replicas that may be configured when creating a pool.
 	MaxPoolServiceReps = 2*daos.PoolSvcRedunFacMax + 1
+	// PoolAttrCreator is the pool user attribute holding the subject of
+	// the certificate used to create the pool.
+	PoolAttrCreator = "daos.creator"
+	// PoolAttrCreated is the pool user attribute holding the time the
+	// pool was created.
+	PoolAttrCreated = "daos.created"
 )
 
 type poolServiceReq interface {
@@ -249,6 +258,7 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	svc.poolCreateMapOwner(ctx, req)
 
 	msg, err := svc.submitSerialRequest(ctx, req)
 	if err != nil {
@@ -257,6 +267,38 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 	return msg.(*mgmtpb.PoolCreateResp), nil
 }
 
+// toPrincipal converts a user or group name to a principal, e.g. "bob@".
+func toPrincipal(name string) string {
+	if strings.Contains(name, "@") {
+		return name
+	}
+	return name + "@"
+}
+
+// poolCreateMapOwner replaces the owner user and/or group of the pool, if not
+// specified by the caller, with those mapped from the caller's admin
+// certificate by the access control configuration.
+func (svc *mgmtSvc) poolCreateMapOwner(ctx context.Context, req *mgmtpb.PoolCreateReq) {
+	access, found := callerAccessFromContext(ctx)
+	if !found || access.component != security.ComponentAdmin {
+		return
+	}
+
+	im := svc.accessCfg.IdentityForCertificate(access.cert)
+	if im == nil {
+		return
+	}
+
+	if req.UserDefault && im.User != "" {
+		req.User = toPrincipal(im.User)
+	}
+	if req.GroupDefault && im.Group != "" {
+		req.Usergroup = toPrincipal(im.Group)
+	}
+	svc.log.Debugf("pool %s owner %s:%s mapped from certificate %q", req.Uuid, req.User,
+		req.Usergroup, access.cert.Subject.String())
+}
+
 // poolCreate handles the actual pool creation request. This is separated from
 // PoolCreate() so that it can be called from the batch request handler.
 //
@@ -409,8 +451,18 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, err
 	}
 
+	if err := svc.checkPoolQuotas(parent, req); err != nil {
+		return nil, err
+	}
+
 	ps = system.NewPoolService(poolUUID, req.Tierbytes, ranklist.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = poolLabel
+	ps.OwnerUser = req.GetUser()
+	ps.OwnerGroup = req.GetUsergroup()
+	ps.CreatedAt = time.Now()
+	if access, found := callerAccessFromContext(parent); found {
+		ps.Creator = access.cert.Subject.String()
+	}
 	if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
 		return nil, err
 	}
@@ -488,9 +540,49 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, err
 	}
 
+	// The creation details are kept by the MS regardless, so a failure to
+	// copy them to the pool attributes doesn't fail the create.
+	if err := svc.poolCreateSetAttrs(ctx, ps); err != nil {
+		svc.log.Errorf("pool %s: failed to set creation attributes: %s", ps.PoolUUID, err)
+	}
+
 	return resp, nil
 }
 
+// poolCreateSetAttrs records the pool creator and creation time as pool user
+// attributes, so that they are visible to DAOS clients.
+func (svc *mgmtSvc) poolCreateSetAttrs(ctx context.Context, ps *system.PoolService) error {
+	req := &mgmtpb.PoolSetAttrReq{
+		Sys:      svc.sysdb.SystemName(),
+		Id:       ps.PoolUUID.String(),
+		SvcRanks: ranklist.RanksToUint32(ps.Replicas),
+		Attributes: []*mgmtpb.PoolAttribute{
+			{Name: PoolAttrCreated, Value: []byte(common.FormatTime(ps.CreatedAt))},
+		},
+	}
+	if ps.Creator != "" {
+		req.Attributes = append(req.Attributes, &mgmtpb.PoolAttribute{
+			Name:  PoolAttrCreator,
+			Value: []byte(ps.Creator),
+		})
+	}
+
+	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodPoolSetAttr, req)
+	if err != nil {
+		return err
+	}
+
+	resp := new(mgmtpb.DaosResp)
+	if err := proto.Unmarshal(dresp.Body, resp); err != nil {
+		return errors.Wrap(err, "unmarshal PoolSetAttr response")
+	}
+	if resp.GetStatus() != 0 {
+		return daos.Status(resp.GetStatus())
+	}
+
+	return nil
+}
+
 func (svc *mgmtSvc) poolCreateAddSystemProps(req *mgmtpb.PoolCreateReq) error {
 	poolSysProps := make(map[uint32]*daos.PoolProperty)
 	for sp := range svc.systemProps.Iter() {
@@ -819,16 +911,20 @@ func (svc *mgmtSvc) PoolExclude(ctx context.Context, req *mgmtpb.PoolExcludeReq)
 		return nil, err
 	}
 
-	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolExclude, req)
+	op, err := svc.newPoolOp(req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &mgmtpb.PoolExcludeResp{}
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal PoolExclude response")
+	msg, err := svc.waitPoolOp(ctx, op)
+	if err != nil {
+		return nil, err
 	}
 
+	resp, ok := msg.(*mgmtpb.PoolExcludeResp)
+	if !ok {
+		resp = new(mgmtpb.PoolExcludeResp)
+	}
 	return resp, nil
 }
 
@@ -838,52 +934,71 @@ func (svc *mgmtSvc) PoolDrain(ctx context.Context, req *mgmtpb.PoolDrainReq) (*m
 		return nil, err
 	}
 
-	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolDrain, req)
+	op, err := svc.newPoolOp(req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &mgmtpb.PoolDrainResp{}
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal PoolDrain response")
+	msg, err := svc.waitPoolOp(ctx, op)
+	if err != nil {
+		return nil, err
 	}
 
+	resp, ok := msg.(*mgmtpb.PoolDrainResp)
+	if !ok {
+		resp = new(mgmtpb.PoolDrainResp)
+	}
 	return resp, nil
 }
 
-// PoolExtend implements the method defined for the Management Service.
-func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (*mgmtpb.PoolExtendResp, error) {
-	if err := svc.checkLeaderRequest(req); err != nil {
-		return nil, err
-	}
-
+// preparePoolExtend adds the fault domain tree and the per-rank storage
+// allocations used at pool creation to a PoolExtend request, and checks that
+// the extended pool is within its owner's storage quota.
+func (svc *mgmtSvc) preparePoolExtend(ctx context.Context, id string, req *mgmtpb.PoolExtendReq) error {
 	// the IO engine needs the domain tree for placement purposes
 	fdTree, err := svc.membership.CompressedFaultDomainTree(req.Ranks...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	req.FaultDomains = fdTree
 
 	// Look up the pool service record to find the storage allocations
 	// used at creation.
-	ps, err := svc.getPoolService(req.GetId())
+	ps, err := svc.getPoolService(id)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	req.Tierbytes = ps.Storage.PerRankTierStorage
 
+	return svc.checkPoolExtendQuotas(ctx, ps.PoolUUID, req)
+}
+
+// PoolExtend implements the method defined for the Management Service.
+func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (*mgmtpb.PoolExtendResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := svc.preparePoolExtend(ctx, req.GetId(), req); err != nil {
+		return nil, err
+	}
+
 	svc.log.Debugf("MgmtSvc.PoolExtend forwarding modified req:%+v\n", req)
 
-	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolExtend, req)
+	op, err := svc.newPoolOp(req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &mgmtpb.PoolExtendResp{}
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal PoolExtend response")
+	msg, err := svc.waitPoolOp(ctx, op)
+	if err != nil {
+		return nil, err
 	}
 
+	resp, ok := msg.(*mgmtpb.PoolExtendResp)
+	if !ok {
+		resp = new(mgmtpb.PoolExtendResp)
+	}
 	return resp, nil
 }
 
@@ -914,16 +1029,20 @@ func (svc *mgmtSvc) PoolReintegrate(ctx context.Context, req *mgmtpb.PoolReinteg
 
 	req.Tierbytes = ps.Storage.PerRankTierStorage
 
-	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolReintegrate, req)
+	op, err := svc.newPoolOp(req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &mgmtpb.PoolReintegrateResp{}
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal PoolReintegrate response")
+	msg, err := svc.waitPoolOp(ctx, op)
+	if err != nil {
+		return nil, err
 	}
 
+	resp, ok := msg.(*mgmtpb.PoolReintegrateResp)
+	if !ok {
+		resp = new(mgmtpb.PoolReintegrateResp)
+	}
 	return resp, nil
 }
 
@@ -1225,12 +1344,17 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 
 	resp := new(mgmtpb.ListPoolsResp)
 	for _, ps := range psList {
-		resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
+		pool := &mgmtpb.ListPoolsResp_Pool{
 			Uuid:    ps.PoolUUID.String(),
 			Label:   ps.PoolLabel,
 			SvcReps: ranklist.RanksToUint32(ps.Replicas),
 			State:   ps.State.String(),
-		})
+			Creator: ps.Creator,
+		}
+		if !ps.CreatedAt.IsZero() {
+			pool.Created = common.FormatTime(ps.CreatedAt)
+		}
+		resp.Pools = append(resp.Pools, pool)
 	}
 
 	v, err := svc.sysdb.DataVersion()
@@ -0,0 +1,636 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// Rebuild-triggering pool operations (exclude, drain, reintegrate and extend)
+// are recorded in the system database and run by the MS leader's pool
+// operation queue loop, which runs the queued operations on each pool one at a
+// time, in the order in which they were created. An operation is not started
+// while the pool is locked by another request, or while a rebuild triggered by
+// the previous operation on the pool is still in progress. Direct requests wait
+// for their operation to finish and return the engine's response. Operations
+// may instead be scheduled to run later within a time window, in which case
+// the request returns as soon as the operation has been queued, and are failed
+// if the window closes before they can be started.
+
+const (
+	// poolOpQueueInterval is the interval at which the MS leader checks
+	// for queued pool operations that are ready to be started.
+	poolOpQueueInterval = 10 * time.Second
+	// poolOpRetention is how long finished pool operations are kept.
+	poolOpRetention = 24 * time.Hour
+	// poolOpWaitInterval is the interval at which a request waiting for a
+	// pool operation checks whether it has been finished elsewhere, e.g.
+	// canceled or run by another MS leader.
+	poolOpWaitInterval = time.Second
+)
+
+// poolOpResult is the outcome of running a pool operation, passed to the
+// request waiting for it.
+type poolOpResult struct {
+	resp proto.Message
+	err  error
+}
+
+var (
+	errPoolOpWindowClosed  = errors.New("time window closed before the operation could be started")
+	errPoolOpLeaderChanged = errors.New("operation interrupted by MS leadership change")
+)
+
+// newPoolOpMsgs returns the dRPC method and empty request and response
+// messages for the given pool operation type.
+func newPoolOpMsgs(opType system.PoolOpType) (drpc.MgmtMethod, poolServiceReq, proto.Message) {
+	switch opType {
+	case system.PoolOpTypeExclude:
+		return drpc.MethodPoolExclude, new(mgmtpb.PoolExcludeReq), new(mgmtpb.PoolExcludeResp)
+	case system.PoolOpTypeDrain:
+		return drpc.MethodPoolDrain, new(mgmtpb.PoolDrainReq), new(mgmtpb.PoolDrainResp)
+	case system.PoolOpTypeReintegrate:
+		return drpc.MethodPoolReintegrate, new(mgmtpb.PoolReintegrateReq), new(mgmtpb.PoolReintegrateResp)
+	default:
+		return drpc.MethodPoolExtend, new(mgmtpb.PoolExtendReq), new(mgmtpb.PoolExtendResp)
+	}
+}
+
+// newPoolOp resolves the pool in the request and returns a new queued
+// operation that will replay the request when it is run.
+func (svc *mgmtSvc) newPoolOp(req poolServiceReq) (*system.PoolOp, error) {
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	req.SetUUID(ps.PoolUUID)
+
+	var op *system.PoolOp
+	switch r := req.(type) {
+	case *mgmtpb.PoolExcludeReq:
+		op = system.NewPoolOp(ps.PoolUUID, system.PoolOpTypeExclude)
+		op.Ranks = []ranklist.Rank{ranklist.Rank(r.GetRank())}
+		op.TargetIdx = r.GetTargetidx()
+	case *mgmtpb.PoolDrainReq:
+		op = system.NewPoolOp(ps.PoolUUID, system.PoolOpTypeDrain)
+		op.Ranks = []ranklist.Rank{ranklist.Rank(r.GetRank())}
+		op.TargetIdx = r.GetTargetidx()
+	case *mgmtpb.PoolReintegrateReq:
+		op = system.NewPoolOp(ps.PoolUUID, system.PoolOpTypeReintegrate)
+		op.Ranks = []ranklist.Rank{ranklist.Rank(r.GetRank())}
+		op.TargetIdx = r.GetTargetidx()
+	case *mgmtpb.PoolExtendReq:
+		op = system.NewPoolOp(ps.PoolUUID, system.PoolOpTypeExtend)
+		op.Ranks = ranklist.RanksFromUint32(r.GetRanks())
+	default:
+		return nil, errors.Errorf("unexpected pool operation request type %T", req)
+	}
+
+	op.Request, err = proto.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize pool operation request")
+	}
+
+	return op, nil
+}
+
+// queuePoolOp records the operation in the system database and wakes the
+// pool operation queue loop so that it is started as soon as its turn comes.
+func (svc *mgmtSvc) queuePoolOp(op *system.PoolOp) error {
+	if err := svc.sysdb.AddPoolOp(op); err != nil {
+		return err
+	}
+	svc.log.Infof("%s queued", op)
+	svc.kickPoolOps()
+
+	return nil
+}
+
+// waitPoolOp queues the operation and waits for it to finish, returning the
+// engine's response. If the context is canceled while the operation is still
+// queued, the operation is canceled. A nil response is returned for an
+// operation that was completed without its response being available, e.g. by
+// another MS leader.
+func (svc *mgmtSvc) waitPoolOp(ctx context.Context, op *system.PoolOp) (proto.Message, error) {
+	resCh := make(chan *poolOpResult, 1)
+	svc.poolOpsLock.Lock()
+	if svc.poolOpWaiters == nil {
+		svc.poolOpWaiters = make(map[uuid.UUID]chan *poolOpResult)
+	}
+	svc.poolOpWaiters[op.ID] = resCh
+	svc.poolOpsLock.Unlock()
+
+	defer func() {
+		svc.poolOpsLock.Lock()
+		delete(svc.poolOpWaiters, op.ID)
+		svc.poolOpsLock.Unlock()
+	}()
+
+	if err := svc.queuePoolOp(op); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(poolOpWaitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			svc.abandonPoolOp(op.ID)
+			return nil, errors.Wrapf(ctx.Err(), "waiting for %s", op)
+		case res := <-resCh:
+			return res.resp, res.err
+		case <-ticker.C:
+		}
+
+		cur, err := svc.sysdb.FindPoolOp(op.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !cur.IsFinished() {
+			continue
+		}
+
+		// The result is passed on before the operation is recorded as
+		// finished, so check for it before falling back to the record.
+		select {
+		case res := <-resCh:
+			return res.resp, res.err
+		default:
+		}
+		switch {
+		case cur.State == system.PoolOpStateCompleted:
+			return nil, nil
+		case cur.Error != "":
+			return nil, errors.New(cur.Error)
+		default:
+			return nil, errors.Errorf("%s was %s", cur, cur.State)
+		}
+	}
+}
+
+// abandonPoolOp cancels an operation that is still queued after the request
+// waiting for it has gone away.
+func (svc *mgmtSvc) abandonPoolOp(opID uuid.UUID) {
+	svc.poolOpsLock.Lock()
+	defer svc.poolOpsLock.Unlock()
+
+	op, err := svc.sysdb.FindPoolOp(opID)
+	if err != nil || op.State != system.PoolOpStateQueued {
+		return
+	}
+
+	if err := op.Cancel(); err != nil {
+		return
+	}
+	if err := svc.sysdb.UpdatePoolOp(op); err != nil {
+		svc.log.Errorf("%s: failed to cancel: %s", op, err)
+		return
+	}
+	svc.log.Infof("%s canceled as request was abandoned", op)
+}
+
+// kickPoolOps prompts the pool operation queue loop to process the queue
+// without waiting for the next tick.
+func (svc *mgmtSvc) kickPoolOps() {
+	select {
+	case svc.poolOpsKick <- struct{}{}:
+	default:
+	}
+}
+
+// poolRebuildBusy returns true if the operation most recently started on the
+// pool completed and the pool is still rebuilding.
+func (svc *mgmtSvc) poolRebuildBusy(ctx context.Context, op *system.PoolOp) (bool, error) {
+	ops, err := svc.sysdb.PoolOps(op.PoolUUID)
+	if err != nil {
+		return false, err
+	}
+
+	var prev *system.PoolOp
+	for _, cur := range ops {
+		if cur.ID == op.ID || cur.Started.IsZero() {
+			continue
+		}
+		if prev == nil || cur.Started.After(prev.Started) {
+			prev = cur
+		}
+	}
+	if prev == nil || prev.State != system.PoolOpStateCompleted {
+		return false, nil
+	}
+
+	req := &mgmtpb.PoolQueryReq{
+		Id:        op.PoolUUID.String(),
+		QueryMask: uint64(daos.DefaultPoolQueryMask),
+	}
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQuery, req)
+	if err != nil {
+		return false, err
+	}
+
+	resp := new(mgmtpb.PoolQueryResp)
+	if err := proto.Unmarshal(dresp.Body, resp); err != nil {
+		return false, errors.Wrap(err, "unmarshal PoolQuery response")
+	}
+	if resp.GetStatus() != 0 {
+		return false, daos.Status(resp.GetStatus())
+	}
+
+	return resp.GetRebuild().GetState() == mgmtpb.PoolRebuildStatus_BUSY, nil
+}
+
+// startPoolOp marks a queued operation as running, unless it has been
+// canceled in the meantime.
+func (svc *mgmtSvc) startPoolOp(op *system.PoolOp) error {
+	svc.poolOpsLock.Lock()
+	defer svc.poolOpsLock.Unlock()
+
+	cur, err := svc.sysdb.FindPoolOp(op.ID)
+	if err != nil {
+		return err
+	}
+	if cur.State != system.PoolOpStateQueued {
+		return errors.Errorf("%s is %s and cannot be started", op, cur.State)
+	}
+
+	op.Start()
+	if err := svc.sysdb.UpdatePoolOp(op); err != nil {
+		return err
+	}
+	svc.log.Infof("%s started", op)
+
+	return nil
+}
+
+// finishPoolOp records the result of an operation and passes it on to the
+// request waiting for the operation, if any. A non-zero status in the
+// engine's response fails the operation.
+func (svc *mgmtSvc) finishPoolOp(op *system.PoolOp, resp proto.Message, err error) {
+	svc.poolOpsLock.Lock()
+	if resCh, found := svc.poolOpWaiters[op.ID]; found {
+		resCh <- &poolOpResult{resp: resp, err: err}
+	}
+	svc.poolOpsLock.Unlock()
+
+	opErr := err
+	if sr, ok := resp.(interface{ GetStatus() int32 }); ok && opErr == nil && sr.GetStatus() != 0 {
+		opErr = daos.Status(sr.GetStatus())
+	}
+
+	op.Finish(opErr)
+	if err := svc.sysdb.UpdatePoolOp(op); err != nil {
+		svc.log.Errorf("%s: failed to record result: %s", op, err)
+	}
+
+	if opErr != nil {
+		svc.log.Errorf("%s failed: %s", op, opErr)
+		return
+	}
+	svc.log.Infof("%s completed", op)
+}
+
+// claimPool returns true if no operation is already being run on the pool by
+// this leader, and marks the pool as busy.
+func (svc *mgmtSvc) claimPool(poolUUID uuid.UUID) bool {
+	svc.poolOpsLock.Lock()
+	defer svc.poolOpsLock.Unlock()
+
+	if svc.poolOpsActive == nil {
+		svc.poolOpsActive = make(map[uuid.UUID]struct{})
+	}
+	if _, active := svc.poolOpsActive[poolUUID]; active {
+		return false
+	}
+	svc.poolOpsActive[poolUUID] = struct{}{}
+
+	return true
+}
+
+func (svc *mgmtSvc) releasePool(poolUUID uuid.UUID) {
+	svc.poolOpsLock.Lock()
+	defer svc.poolOpsLock.Unlock()
+
+	delete(svc.poolOpsActive, poolUUID)
+}
+
+// runPoolOp replays the request recorded with a queued operation and records
+// the result, returning true once the operation has finished. The operation is
+// left queued to be retried on a later pass if the pool is locked by another
+// request or is still rebuilding.
+func (svc *mgmtSvc) runPoolOp(ctx context.Context, op *system.PoolOp) bool {
+	defer svc.releasePool(op.PoolUUID)
+
+	method, req, resp := newPoolOpMsgs(op.Type)
+	if err := proto.Unmarshal(op.Request, req); err != nil {
+		svc.finishPoolOp(op, nil, errors.Wrap(err, "failed to decode pool operation request"))
+		return true
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(ctx, op.PoolUUID)
+	if err != nil {
+		svc.log.Debugf("%s: not started: %s", op, err)
+		return false
+	}
+	defer lock.Release()
+	ctx = lock.InContext(ctx)
+
+	busy, err := svc.poolRebuildBusy(ctx, op)
+	if err != nil {
+		svc.log.Noticef("%s: unable to check rebuild state: %s", op, err)
+	}
+	if busy {
+		svc.log.Debugf("%s: waiting for pool rebuild to finish", op)
+		return false
+	}
+
+	if err := svc.startPoolOp(op); err != nil {
+		svc.log.Debugf("%s: not started: %s", op, err)
+		return false
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, method, req)
+	if err != nil {
+		svc.finishPoolOp(op, nil, err)
+		return true
+	}
+
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		svc.finishPoolOp(op, nil, errors.Wrapf(err, "unmarshal %s response", method))
+		return true
+	}
+	svc.finishPoolOp(op, resp, nil)
+
+	return true
+}
+
+// recoverPoolOps fails any operations that were running on a previous MS
+// leader, as their outcome is unknown. Queued operations are left to be run
+// by this leader.
+func (svc *mgmtSvc) recoverPoolOps() {
+	ops, err := svc.sysdb.PoolOps(uuid.Nil)
+	if err != nil {
+		svc.log.Errorf("failed to list pool operations: %s", err)
+		return
+	}
+
+	for _, op := range ops {
+		if op.State == system.PoolOpStateRunning {
+			svc.finishPoolOp(op, nil, errPoolOpLeaderChanged)
+		}
+	}
+}
+
+// processPoolOps removes finished operations once they have been retained
+// for long enough, fails queued operations whose time window has closed and,
+// for each pool without an operation in progress, starts the earliest queued
+// operation whose window has opened.
+func (svc *mgmtSvc) processPoolOps(ctx context.Context, now time.Time) {
+	ops, err := svc.sysdb.PoolOps(uuid.Nil)
+	if err != nil {
+		svc.log.Errorf("failed to list pool operations: %s", err)
+		return
+	}
+
+	// Operations are listed in creation order, so the first candidate
+	// found for a pool is the one whose turn it is.
+	considered := make(map[uuid.UUID]struct{})
+	for _, op := range ops {
+		switch {
+		case op.IsFinished():
+			if now.Sub(op.Finished) > poolOpRetention {
+				if err := svc.sysdb.RemovePoolOp(op.ID); err != nil {
+					svc.log.Errorf("%s: failed to remove: %s", op, err)
+				}
+			}
+			continue
+		case op.State != system.PoolOpStateQueued, op.IsScheduled(now):
+			continue
+		}
+
+		if _, found := considered[op.PoolUUID]; found {
+			continue
+		}
+		if !svc.claimPool(op.PoolUUID) {
+			considered[op.PoolUUID] = struct{}{}
+			continue
+		}
+
+		if op.IsExpired(now) {
+			svc.finishPoolOp(op, nil, errPoolOpWindowClosed)
+			svc.releasePool(op.PoolUUID)
+			continue
+		}
+
+		considered[op.PoolUUID] = struct{}{}
+		svc.log.Debugf("%s: starting queued operation", op)
+		go func(op *system.PoolOp) {
+			if svc.runPoolOp(ctx, op) {
+				svc.kickPoolOps()
+			}
+		}(op)
+	}
+}
+
+// poolOpQueueLoop is the MS leader loop for running queued pool operations.
+func (svc *mgmtSvc) poolOpQueueLoop(parent context.Context) {
+	svc.log.Debug("starting poolOpQueueLoop")
+	svc.recoverPoolOps()
+
+	ticker := time.NewTicker(poolOpQueueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped poolOpQueueLoop")
+			return
+		case <-ticker.C:
+		case <-svc.poolOpsKick:
+		}
+		svc.processPoolOps(parent, time.Now())
+	}
+}
+
+func unixTime(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func poolOpToPB(op *system.PoolOp) *mgmtpb.PoolOp {
+	return &mgmtpb.PoolOp{
+		Id:        op.ID.String(),
+		PoolUuid:  op.PoolUUID.String(),
+		Type:      op.Type.String(),
+		State:     op.State.String(),
+		Ranks:     ranklist.RanksToUint32(op.Ranks),
+		TargetIdx: op.TargetIdx,
+		NotBefore: toUnix(op.NotBefore),
+		NotAfter:  toUnix(op.NotAfter),
+		Created:   toUnix(op.Created),
+		Started:   toUnix(op.Started),
+		Finished:  toUnix(op.Finished),
+		Error:     op.Error,
+	}
+}
+
+// PoolOpSchedule queues a rebuild-triggering pool operation to be run within
+// a time window. Other servers may only schedule drains, as the NVMe health
+// monitor does.
+func (svc *mgmtSvc) PoolOpSchedule(ctx context.Context, req *mgmtpb.PoolOpScheduleReq) (*mgmtpb.PoolOpScheduleResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if access, found := callerAccessFromContext(ctx); found && access.component == security.ComponentServer {
+		if _, isDrain := req.GetOp().(*mgmtpb.PoolOpScheduleReq_Drain); !isDrain {
+			return nil, errors.Errorf("%s may only schedule pool drain operations", access.component)
+		}
+	}
+
+	var opReq poolServiceReq
+	switch op := req.GetOp().(type) {
+	case *mgmtpb.PoolOpScheduleReq_Exclude:
+		opReq = op.Exclude
+	case *mgmtpb.PoolOpScheduleReq_Drain:
+		opReq = op.Drain
+	case *mgmtpb.PoolOpScheduleReq_Reintegrate:
+		if op.Reintegrate != nil {
+			ps, err := svc.getPoolService(req.GetId())
+			if err != nil {
+				return nil, err
+			}
+			op.Reintegrate.Tierbytes = ps.Storage.PerRankTierStorage
+		}
+		opReq = op.Reintegrate
+	case *mgmtpb.PoolOpScheduleReq_Extend:
+		if op.Extend != nil {
+			if err := svc.preparePoolExtend(ctx, req.GetId(), op.Extend); err != nil {
+				return nil, err
+			}
+		}
+		opReq = op.Extend
+	default:
+		return nil, errors.New("no pool operation in schedule request")
+	}
+	if common.InterfaceIsNil(opReq) {
+		return nil, errors.New("nil pool operation in schedule request")
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	opReq.SetUUID(poolUUID)
+
+	op, err := svc.newPoolOp(opReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := op.SetWindow(unixTime(req.GetNotBefore()), unixTime(req.GetNotAfter())); err != nil {
+		return nil, err
+	}
+
+	if err := svc.queuePoolOp(op); err != nil {
+		return nil, err
+	}
+	if !op.NotBefore.IsZero() {
+		svc.log.Infof("%s scheduled to start after %s", op, op.NotBefore.Format(time.RFC3339))
+	}
+
+	return &mgmtpb.PoolOpScheduleResp{OpId: op.ID.String()}, nil
+}
+
+// PoolOpList returns the operations queued for a pool, or for all pools if
+// no pool is specified.
+func (svc *mgmtSvc) PoolOpList(ctx context.Context, req *mgmtpb.PoolOpListReq) (*mgmtpb.PoolOpListResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	poolUUID := uuid.Nil
+	if req.GetId() != "" {
+		var err error
+		if poolUUID, err = svc.resolvePoolID(req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	ops, err := svc.sysdb.PoolOps(poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.PoolOpListResp)
+	for _, op := range ops {
+		resp.Ops = append(resp.Ops, poolOpToPB(op))
+	}
+
+	return resp, nil
+}
+
+// PoolOpCancel cancels an operation that is queued on a pool and has not yet
+// been started.
+func (svc *mgmtSvc) PoolOpCancel(ctx context.Context, req *mgmtpb.PoolOpCancelReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	opID, err := uuid.Parse(req.GetOpId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pool operation ID %q", req.GetOpId())
+	}
+
+	svc.poolOpsLock.Lock()
+	defer svc.poolOpsLock.Unlock()
+
+	op, err := svc.sysdb.FindPoolOp(opID)
+	if err != nil {
+		return nil, err
+	}
+	if op.PoolUUID != poolUUID {
+		return nil, system.ErrPoolOpNotFound(opID)
+	}
+
+	if err := op.Cancel(); err != nil {
+		return nil, err
+	}
+	if err := svc.sysdb.UpdatePoolOp(op); err != nil {
+		return nil, err
+	}
+	svc.log.Infof("%s canceled", op)
+	svc.kickPoolOps()
+
+	return &mgmtpb.DaosResp{}, nil
+}
@@ -0,0 +1,637 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func newTestPoolOpSvc(t *testing.T, log logging.Logger, drpcResp proto.Message) *mgmtSvc {
+	t.Helper()
+
+	svc := newTestMgmtSvc(t, log)
+	addTestPoolService(t, svc.sysdb, &system.PoolService{
+		PoolUUID: uuid.MustParse(mockUUID),
+		State:    system.PoolServiceStateReady,
+		Replicas: []ranklist.Rank{0},
+		Storage: &system.PoolServiceStorage{
+			CreationRankStr:    "0",
+			CurrentRankStr:     "0",
+			PerRankTierStorage: []uint64{1, 2},
+		},
+	})
+	setupSvcDrpcClient(svc, 0, getMockDrpcClient(drpcResp, nil))
+
+	return svc
+}
+
+func addTestPoolOp(t *testing.T, svc *mgmtSvc, op *system.PoolOp) {
+	t.Helper()
+
+	if err := svc.sysdb.AddPoolOp(op); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getTestPoolOp(t *testing.T, svc *mgmtSvc, id uuid.UUID) *system.PoolOp {
+	t.Helper()
+
+	op, err := svc.sysdb.FindPoolOp(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return op
+}
+
+func waitTestPoolOpState(t *testing.T, svc *mgmtSvc, id uuid.UUID, state system.PoolOpState) *system.PoolOp {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		op := getTestPoolOp(t, svc, id)
+		if op.State == state {
+			return op
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("op not %s: %+v", state, op)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitTestPoolReleased waits for any operation being run on the pool to
+// return.
+func waitTestPoolReleased(t *testing.T, svc *mgmtSvc, poolUUID uuid.UUID) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !svc.claimPool(poolUUID) {
+		if time.Now().After(deadline) {
+			t.Fatal("pool not released")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	svc.releasePool(poolUUID)
+}
+
+// waitTestPoolOpQueued waits for a single operation to be queued on the pool
+// and returns its ID.
+func waitTestPoolOpQueued(t *testing.T, svc *mgmtSvc, poolUUID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		ops, err := svc.sysdb.PoolOps(poolUUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ops) == 1 {
+			return ops[0].ID
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected 1 queued op, got %d", len(ops))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServer_MgmtSvc_PoolDrain_Queued(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+	poolUUID := uuid.MustParse(mockUUID)
+	lock, err := svc.sysdb.TakePoolLock(test.Context(t), poolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type drainResult struct {
+		resp *mgmtpb.PoolDrainResp
+		err  error
+	}
+	resCh := make(chan drainResult, 1)
+	go func() {
+		resp, err := svc.PoolDrain(test.Context(t), &mgmtpb.PoolDrainReq{
+			Sys:  build.DefaultSystemName,
+			Id:   mockUUID,
+			Rank: 0,
+		})
+		resCh <- drainResult{resp: resp, err: err}
+	}()
+	opID := waitTestPoolOpQueued(t, svc, poolUUID)
+
+	// The request waits for the operation, which stays queued while the
+	// pool is locked.
+	svc.processPoolOps(test.Context(t), time.Now())
+	waitTestPoolReleased(t, svc, poolUUID)
+	test.AssertEqual(t, system.PoolOpStateQueued, getTestPoolOp(t, svc, opID).State,
+		"op should stay queued while the pool is locked")
+	select {
+	case res := <-resCh:
+		t.Fatalf("request returned before op was run: %+v", res)
+	default:
+	}
+
+	lock.Release()
+	svc.processPoolOps(test.Context(t), time.Now())
+
+	select {
+	case res := <-resCh:
+		if res.err != nil {
+			t.Fatal(res.err)
+		}
+		if diff := cmp.Diff(&mgmtpb.PoolDrainResp{}, res.resp, test.DefaultCmpOpts()...); diff != "" {
+			t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request did not return after op was run")
+	}
+
+	op := getTestPoolOp(t, svc, opID)
+	test.AssertEqual(t, system.PoolOpStateCompleted, op.State, "unexpected op state")
+	test.AssertEqual(t, []ranklist.Rank{0}, op.Ranks, "unexpected op ranks")
+}
+
+func TestServer_MgmtSvc_PoolDrain_Abandoned(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+	poolUUID := uuid.MustParse(mockUUID)
+	lock, err := svc.sysdb.TakePoolLock(test.Context(t), poolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := svc.PoolDrain(ctx, &mgmtpb.PoolDrainReq{
+			Sys:  build.DefaultSystemName,
+			Id:   mockUUID,
+			Rank: 0,
+		})
+		errCh <- err
+	}()
+	opID := waitTestPoolOpQueued(t, svc, poolUUID)
+
+	cancel()
+	select {
+	case err := <-errCh:
+		test.CmpErr(t, context.Canceled, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request did not return after context was canceled")
+	}
+
+	test.AssertEqual(t, system.PoolOpStateCanceled, getTestPoolOp(t, svc, opID).State,
+		"abandoned op should be canceled")
+}
+
+func TestServer_MgmtSvc_runPoolOp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setupMockDrpc func(*mgmtSvc)
+		expState      system.PoolOpState
+		expErr        string
+	}{
+		"dRPC send fails": {
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("send failure")))
+			},
+			expState: system.PoolOpStateFailed,
+			expErr:   "send failure",
+		},
+		"garbage resp": {
+			setupMockDrpc: func(svc *mgmtSvc) {
+				// dRPC call returns junk in the message body
+				setupSvcDrpcClient(svc, 0, getMockDrpcClientBytes(makeBadBytes(42), nil))
+			},
+			expState: system.PoolOpStateFailed,
+			expErr:   "unmarshal",
+		},
+		"DAOS error": {
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.PoolDrainResp{
+					Status: int32(daos.Busy),
+				}, nil))
+			},
+			expState: system.PoolOpStateFailed,
+			expErr:   daos.Busy.Error(),
+		},
+		"success": {
+			expState: system.PoolOpStateCompleted,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+			if tc.setupMockDrpc != nil {
+				tc.setupMockDrpc(svc)
+			}
+
+			op, err := svc.newPoolOp(&mgmtpb.PoolDrainReq{Id: mockUUID, Rank: 0})
+			if err != nil {
+				t.Fatal(err)
+			}
+			addTestPoolOp(t, svc, op)
+
+			test.AssertTrue(t, svc.claimPool(op.PoolUUID), "pool should not be claimed")
+			test.AssertTrue(t, svc.runPoolOp(test.Context(t), op), "op should have finished")
+
+			got := getTestPoolOp(t, svc, op.ID)
+			test.AssertEqual(t, tc.expState, got.State, "unexpected op state")
+			if !strings.Contains(got.Error, tc.expErr) {
+				t.Fatalf("expected op error to contain %q, got %q", tc.expErr, got.Error)
+			}
+			test.AssertTrue(t, svc.claimPool(op.PoolUUID), "pool should have been released")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolOpSchedule(t *testing.T) {
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		caller    string
+		req       *mgmtpb.PoolOpScheduleReq
+		expType   system.PoolOpType
+		expWindow bool
+		expErr    error
+	}{
+		"server scheduling exclude": {
+			caller: "server",
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: mockUUID,
+				Op: &mgmtpb.PoolOpScheduleReq_Exclude{
+					Exclude: &mgmtpb.PoolExcludeReq{Rank: 1},
+				},
+			},
+			expErr: errors.New("may only schedule pool drain"),
+		},
+		"server scheduling drain": {
+			caller: "server",
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: mockUUID,
+				Op: &mgmtpb.PoolOpScheduleReq_Drain{
+					Drain: &mgmtpb.PoolDrainReq{Rank: 1},
+				},
+			},
+			expType: system.PoolOpTypeDrain,
+		},
+		"no operation": {
+			req:    &mgmtpb.PoolOpScheduleReq{Id: mockUUID},
+			expErr: errors.New("no pool operation"),
+		},
+		"unknown pool": {
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: test.MockUUID(9),
+				Op: &mgmtpb.PoolOpScheduleReq_Drain{
+					Drain: &mgmtpb.PoolDrainReq{Rank: 1},
+				},
+			},
+			expErr: errors.New("unable to find pool"),
+		},
+		"window closes before it opens": {
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: mockUUID,
+				Op: &mgmtpb.PoolOpScheduleReq_Drain{
+					Drain: &mgmtpb.PoolDrainReq{Rank: 1},
+				},
+				NotBefore: now.Add(2 * time.Hour).Unix(),
+				NotAfter:  now.Add(time.Hour).Unix(),
+			},
+			expErr: errors.New("before window start"),
+		},
+		"window in the past": {
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: mockUUID,
+				Op: &mgmtpb.PoolOpScheduleReq_Exclude{
+					Exclude: &mgmtpb.PoolExcludeReq{Rank: 1},
+				},
+				NotAfter: now.Add(-time.Hour).Unix(),
+			},
+			expErr: errors.New("in the past"),
+		},
+		"drain": {
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: mockUUID,
+				Op: &mgmtpb.PoolOpScheduleReq_Drain{
+					Drain: &mgmtpb.PoolDrainReq{Rank: 1},
+				},
+				NotBefore: now.Add(time.Hour).Unix(),
+				NotAfter:  now.Add(2 * time.Hour).Unix(),
+			},
+			expType:   system.PoolOpTypeDrain,
+			expWindow: true,
+		},
+		"reintegrate now": {
+			req: &mgmtpb.PoolOpScheduleReq{
+				Id: mockUUID,
+				Op: &mgmtpb.PoolOpScheduleReq_Reintegrate{
+					Reintegrate: &mgmtpb.PoolReintegrateReq{Rank: 1},
+				},
+			},
+			expType: system.PoolOpTypeReintegrate,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+
+			ctx := test.Context(t)
+			if tc.caller != "" {
+				var err error
+				ctx, err = checkAccess(newTestAuthCtx(ctx, tc.caller),
+					newAuditLog(log, &mockAuditDB{leader: true}, nil), nil,
+					"/mgmt.MgmtSvc/PoolOpSchedule")
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tc.req.Sys = build.DefaultSystemName
+			resp, gotErr := svc.PoolOpSchedule(ctx, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			op := getTestPoolOp(t, svc, uuid.MustParse(resp.OpId))
+			test.AssertEqual(t, tc.expType, op.Type, "unexpected op type")
+			test.AssertEqual(t, system.PoolOpStateQueued, op.State, "unexpected op state")
+			test.AssertEqual(t, []ranklist.Rank{1}, op.Ranks, "unexpected op ranks")
+			if tc.expWindow {
+				test.AssertEqual(t, tc.req.NotBefore, op.NotBefore.Unix(), "unexpected window start")
+				test.AssertEqual(t, tc.req.NotAfter, op.NotAfter.Unix(), "unexpected window end")
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolOpList(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+	op := system.NewPoolOp(uuid.MustParse(mockUUID), system.PoolOpTypeExclude)
+	op.Ranks = []ranklist.Rank{3}
+	op.TargetIdx = []uint32{0, 1}
+	addTestPoolOp(t, svc, op)
+
+	for name, tc := range map[string]struct {
+		id      string
+		expResp *mgmtpb.PoolOpListResp
+		expErr  error
+	}{
+		"all pools": {
+			expResp: &mgmtpb.PoolOpListResp{
+				Ops: []*mgmtpb.PoolOp{
+					{
+						Id:        op.ID.String(),
+						PoolUuid:  mockUUID,
+						Type:      "exclude",
+						State:     "queued",
+						Ranks:     []uint32{3},
+						TargetIdx: []uint32{0, 1},
+						Created:   op.Created.Unix(),
+					},
+				},
+			},
+		},
+		"other pool": {
+			id:      test.MockUUID(9),
+			expResp: &mgmtpb.PoolOpListResp{},
+		},
+		"unknown label": {
+			id:     "foo",
+			expErr: errors.New("unable to find pool"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotResp, gotErr := svc.PoolOpList(test.Context(t), &mgmtpb.PoolOpListReq{
+				Sys: build.DefaultSystemName,
+				Id:  tc.id,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolOpCancel(t *testing.T) {
+	for name, tc := range map[string]struct {
+		opState  system.PoolOpState
+		poolID   string
+		opID     string
+		expState system.PoolOpState
+		expErr   error
+	}{
+		"invalid op ID": {
+			opID:   "foo",
+			expErr: errors.New("invalid pool operation ID"),
+		},
+		"unknown op": {
+			opID:   test.MockUUID(8),
+			expErr: system.ErrPoolOpNotFound(uuid.MustParse(test.MockUUID(8))),
+		},
+		"op on other pool": {
+			poolID: test.MockUUID(9),
+			expErr: errors.New("unable to find pool operation"),
+		},
+		"running op": {
+			opState: system.PoolOpStateRunning,
+			expErr:  errors.New("cannot be canceled"),
+		},
+		"queued op": {
+			expState: system.PoolOpStateCanceled,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+			op := system.NewPoolOp(uuid.MustParse(mockUUID), system.PoolOpTypeDrain)
+			op.State = tc.opState
+			addTestPoolOp(t, svc, op)
+
+			if tc.poolID == "" {
+				tc.poolID = mockUUID
+			}
+			if tc.opID == "" {
+				tc.opID = op.ID.String()
+			}
+
+			_, gotErr := svc.PoolOpCancel(test.Context(t), &mgmtpb.PoolOpCancelReq{
+				Sys:  build.DefaultSystemName,
+				Id:   tc.poolID,
+				OpId: tc.opID,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expState, getTestPoolOp(t, svc, op.ID).State, "unexpected op state")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_processPoolOps(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+	poolUUID := uuid.MustParse(mockUUID)
+	now := time.Now()
+
+	newOp := func(notBefore, notAfter time.Time) *system.PoolOp {
+		op, err := svc.newPoolOp(&mgmtpb.PoolDrainReq{Id: mockUUID, Rank: 0})
+		if err != nil {
+			t.Fatal(err)
+		}
+		op.NotBefore = notBefore
+		op.NotAfter = notAfter
+		addTestPoolOp(t, svc, op)
+		return op
+	}
+
+	expired := newOp(now.Add(-time.Hour), now.Add(-time.Minute))
+	due := newOp(now.Add(-time.Minute), now.Add(time.Hour))
+	later := newOp(now.Add(time.Hour), time.Time{})
+	next := newOp(time.Time{}, time.Time{})
+	stale := system.NewPoolOp(poolUUID, system.PoolOpTypeExclude)
+	stale.Finish(nil)
+	stale.Finished = now.Add(-2 * poolOpRetention)
+	addTestPoolOp(t, svc, stale)
+
+	svc.processPoolOps(test.Context(t), now)
+
+	test.AssertEqual(t, system.PoolOpStateQueued, getTestPoolOp(t, svc, later.ID).State,
+		"op scheduled for later should still be queued")
+	gotExpired := getTestPoolOp(t, svc, expired.ID)
+	test.AssertEqual(t, system.PoolOpStateFailed, gotExpired.State, "expired op should have failed")
+	test.AssertEqual(t, errPoolOpWindowClosed.Error(), gotExpired.Error, "unexpected error")
+	if _, err := svc.sysdb.FindPoolOp(stale.ID); !system.IsPoolOpNotFound(err) {
+		t.Fatalf("expected stale op to be removed, got %v", err)
+	}
+
+	// Only the earliest due op on the pool is run, asynchronously.
+	waitTestPoolOpState(t, svc, due.ID, system.PoolOpStateCompleted)
+	test.AssertEqual(t, system.PoolOpStateQueued, getTestPoolOp(t, svc, next.ID).State,
+		"op queued after the due op should not have been started")
+
+	waitTestPoolReleased(t, svc, poolUUID)
+	svc.processPoolOps(test.Context(t), now)
+	waitTestPoolOpState(t, svc, next.ID, system.PoolOpStateCompleted)
+}
+
+func TestServer_MgmtSvc_recoverPoolOps(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestPoolOpSvc(t, log, &mgmtpb.PoolDrainResp{})
+	poolUUID := uuid.MustParse(mockUUID)
+
+	running := system.NewPoolOp(poolUUID, system.PoolOpTypeDrain)
+	running.Start()
+	addTestPoolOp(t, svc, running)
+	waiting := system.NewPoolOp(poolUUID, system.PoolOpTypeDrain)
+	addTestPoolOp(t, svc, waiting)
+	scheduled := system.NewPoolOp(poolUUID, system.PoolOpTypeDrain)
+	scheduled.NotBefore = time.Now().Add(time.Hour)
+	addTestPoolOp(t, svc, scheduled)
+
+	svc.recoverPoolOps()
+
+	got := getTestPoolOp(t, svc, running.ID)
+	test.AssertEqual(t, system.PoolOpStateFailed, got.State, "unexpected op state")
+	test.AssertEqual(t, errPoolOpLeaderChanged.Error(), got.Error, "unexpected error")
+	for _, op := range []*system.PoolOp{waiting, scheduled} {
+		test.AssertEqual(t, system.PoolOpStateQueued, getTestPoolOp(t, svc, op.ID).State,
+			"queued op should still be queued")
+	}
+}
+
+func TestServer_MgmtSvc_poolRebuildBusy(t *testing.T) {
+	busyResp := &mgmtpb.PoolQueryResp{
+		Rebuild: &mgmtpb.PoolRebuildStatus{State: mgmtpb.PoolRebuildStatus_BUSY},
+	}
+
+	for name, tc := range map[string]struct {
+		prevState system.PoolOpState
+		queryResp *mgmtpb.PoolQueryResp
+		expBusy   bool
+	}{
+		"previous op failed": {
+			prevState: system.PoolOpStateFailed,
+			queryResp: busyResp,
+		},
+		"rebuild idle": {
+			prevState: system.PoolOpStateCompleted,
+			queryResp: &mgmtpb.PoolQueryResp{Rebuild: &mgmtpb.PoolRebuildStatus{}},
+		},
+		"rebuild busy": {
+			prevState: system.PoolOpStateCompleted,
+			queryResp: busyResp,
+			expBusy:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestPoolOpSvc(t, log, tc.queryResp)
+			poolUUID := uuid.MustParse(mockUUID)
+
+			older := system.NewPoolOp(poolUUID, system.PoolOpTypeExclude)
+			older.Start()
+			older.Finish(nil)
+			older.Started = older.Started.Add(-time.Hour)
+			addTestPoolOp(t, svc, older)
+			prev := system.NewPoolOp(poolUUID, system.PoolOpTypeExclude)
+			prev.Start()
+			prev.State = tc.prevState
+			addTestPoolOp(t, svc, prev)
+			op := system.NewPoolOp(poolUUID, system.PoolOpTypeDrain)
+			addTestPoolOp(t, svc, op)
+
+			gotBusy, err := svc.poolRebuildBusy(test.Context(t), op)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expBusy, gotBusy, "unexpected rebuild busy state")
+		})
+	}
+}
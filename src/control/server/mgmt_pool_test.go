@@ -8,6 +8,8 @@ package server
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"testing"
 	"time"
@@ -21,6 +23,7 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/drpc"
@@ -28,6 +31,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
 	"github.com/daos-stack/daos/src/control/system"
@@ -623,6 +627,179 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_poolCreateMapOwner(t *testing.T) {
+	aliceCert := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "admin",
+			OrganizationalUnit: []string{"alice"},
+		},
+	}
+	acCfg := &security.AccessControlConfig{
+		IdentityMappings: []*security.IdentityMapping{
+			{User: "alice", Group: "storage@", Subjects: []string{"OU=alice"}},
+		},
+	}
+	defaultReq := func() *mgmtpb.PoolCreateReq {
+		return &mgmtpb.PoolCreateReq{
+			User:         "root@",
+			Usergroup:    "root@",
+			UserDefault:  true,
+			GroupDefault: true,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		acCfg    *security.AccessControlConfig
+		access   *callerAccess
+		req      *mgmtpb.PoolCreateReq
+		expUser  string
+		expGroup string
+	}{
+		"insecure": {
+			acCfg:    acCfg,
+			req:      defaultReq(),
+			expUser:  "root@",
+			expGroup: "root@",
+		},
+		"no mappings": {
+			access: &callerAccess{
+				component: security.ComponentAdmin,
+				cert:      aliceCert,
+			},
+			req:      defaultReq(),
+			expUser:  "root@",
+			expGroup: "root@",
+		},
+		"no match": {
+			acCfg: acCfg,
+			access: &callerAccess{
+				component: security.ComponentAdmin,
+				cert:      &x509.Certificate{Subject: pkix.Name{CommonName: "admin"}},
+			},
+			req:      defaultReq(),
+			expUser:  "root@",
+			expGroup: "root@",
+		},
+		"mapped": {
+			acCfg: acCfg,
+			access: &callerAccess{
+				component: security.ComponentAdmin,
+				cert:      aliceCert,
+			},
+			req:      defaultReq(),
+			expUser:  "alice@",
+			expGroup: "storage@",
+		},
+		"explicit owner": {
+			acCfg: acCfg,
+			access: &callerAccess{
+				component: security.ComponentAdmin,
+				cert:      aliceCert,
+			},
+			req: &mgmtpb.PoolCreateReq{
+				User:         "bob@",
+				Usergroup:    "root@",
+				GroupDefault: true,
+			},
+			expUser:  "bob@",
+			expGroup: "storage@",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			svc.accessCfg = tc.acCfg
+			ctx := test.Context(t)
+			if tc.access != nil {
+				ctx = context.WithValue(ctx, callerAccessKey{}, tc.access)
+			}
+
+			svc.poolCreateMapOwner(ctx, tc.req)
+
+			test.AssertEqual(t, tc.expUser, tc.req.User, "unexpected user")
+			test.AssertEqual(t, tc.expGroup, tc.req.Usergroup, "unexpected group")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_poolCreateSetAttrs(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	createdAttr := &mgmtpb.PoolAttribute{
+		Name:  PoolAttrCreated,
+		Value: []byte(common.FormatTime(created)),
+	}
+
+	for name, tc := range map[string]struct {
+		creator  string
+		drpcResp *mgmtpb.DaosResp
+		drpcErr  error
+		expAttrs []*mgmtpb.PoolAttribute
+		expErr   error
+	}{
+		"creator unknown": {
+			drpcResp: &mgmtpb.DaosResp{},
+			expAttrs: []*mgmtpb.PoolAttribute{createdAttr},
+		},
+		"creator recorded": {
+			creator:  "CN=admin,OU=alice",
+			drpcResp: &mgmtpb.DaosResp{},
+			expAttrs: []*mgmtpb.PoolAttribute{
+				createdAttr,
+				{Name: PoolAttrCreator, Value: []byte("CN=admin,OU=alice")},
+			},
+		},
+		"dRPC fails": {
+			drpcErr:  errors.New("remote failed"),
+			expAttrs: []*mgmtpb.PoolAttribute{createdAttr},
+			expErr:   errors.New("remote failed"),
+		},
+		"engine fails": {
+			drpcResp: &mgmtpb.DaosResp{Status: int32(daos.NoPermission)},
+			expAttrs: []*mgmtpb.PoolAttribute{createdAttr},
+			expErr:   daos.NoPermission,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			mdc := getMockDrpcClient(tc.drpcResp, tc.drpcErr)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			ps := system.NewPoolService(test.MockPoolUUID(1), nil, nil)
+			ps.Replicas = []ranklist.Rank{0, 1}
+			ps.Creator = tc.creator
+			ps.CreatedAt = created
+
+			gotErr := svc.poolCreateSetAttrs(test.Context(t), ps)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			calls := mdc.calls.get()
+			if len(calls) != 1 {
+				t.Fatalf("expected 1 dRPC call, got %d", len(calls))
+			}
+			test.AssertEqual(t, drpc.MethodPoolSetAttr, calls[0].Method, "unexpected method")
+
+			gotReq := new(mgmtpb.PoolSetAttrReq)
+			if err := proto.Unmarshal(calls[0].Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			expReq := &mgmtpb.PoolSetAttrReq{
+				Sys:        build.DefaultSystemName,
+				Id:         test.MockPoolUUID(1).String(),
+				SvcRanks:   []uint32{0, 1},
+				Attributes: tc.expAttrs,
+			}
+			if diff := cmp.Diff(expReq, gotReq, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected request (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_PoolCreateDownRanks(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -1443,6 +1620,12 @@ func TestServer_MgmtSvc_PoolDrain(t *testing.T) {
 			req:    &mgmtpb.PoolDrainReq{Rank: 2, Targetidx: []uint32{1, 2}},
 			expErr: errors.New("empty pool id"),
 		},
+		"DAOS error": {
+			req: &mgmtpb.PoolDrainReq{Id: mockUUID, Rank: 2, Targetidx: []uint32{1, 2}},
+			expResp: &mgmtpb.PoolDrainResp{
+				Status: int32(daos.Busy),
+			},
+		},
 		"successful drained": {
 			req:     &mgmtpb.PoolDrainReq{Id: mockUUID, Rank: 2, Targetidx: []uint32{1, 2}},
 			expResp: &mgmtpb.PoolDrainResp{},
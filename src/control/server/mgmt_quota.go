@@ -0,0 +1,291 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// parseQuotaPrincipals converts the supplied user or group names to ACL
+// principals.
+func parseQuotaPrincipals(names []string) ([]string, error) {
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		principal, err := ui.ParseACLPrincipal(name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, principal)
+	}
+	return out, nil
+}
+
+// SystemSetQuota sets the resource quota for a user or group ACL principal.
+// A quota without any limits is removed.
+func (svc *mgmtSvc) SystemSetQuota(ctx context.Context, req *mgmtpb.SystemSetQuotaReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	q := &system.Quota{
+		MaxPools:  req.GetMaxPools(),
+		TierBytes: req.GetTierBytes(),
+	}
+	var err error
+	if req.GetUser() != "" {
+		if q.User, err = ui.ParseACLPrincipal(req.GetUser()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetGroup() != "" {
+		if q.Group, err = ui.ParseACLPrincipal(req.GetGroup()); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	if q.IsUnlimited() {
+		if err := svc.sysdb.RemoveQuota(q); err != nil && !system.IsQuotaNotFound(err) {
+			return nil, err
+		}
+		svc.log.Infof("removed quota for %s", q)
+		return &mgmtpb.DaosResp{}, nil
+	}
+
+	if err := svc.sysdb.SetQuota(q); err != nil {
+		return nil, err
+	}
+	svc.log.Infof("set quota for %s (max pools: %d, tier bytes: %v)", q, q.MaxPools, q.TierBytes)
+
+	return &mgmtpb.DaosResp{}, nil
+}
+
+// SystemGetQuota returns the resource quotas for the requested principals,
+// or all quotas if none are requested, along with their current usage.
+func (svc *mgmtSvc) SystemGetQuota(ctx context.Context, req *mgmtpb.SystemGetQuotaReq) (*mgmtpb.SystemGetQuotaResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	users, err := parseQuotaPrincipals(req.GetUsers())
+	if err != nil {
+		return nil, err
+	}
+	groups, err := parseQuotaPrincipals(req.GetGroups())
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := svc.sysdb.GetQuotas(users, groups)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := svc.quotaPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemGetQuotaResp)
+	for _, q := range quotas {
+		usage := q.Usage(pools)
+		resp.Quotas = append(resp.Quotas, &mgmtpb.SystemQuota{
+			User:          q.User,
+			Group:         q.Group,
+			MaxPools:      q.MaxPools,
+			TierBytes:     q.TierBytes,
+			UsedPools:     usage.Pools,
+			UsedTierBytes: usage.TierBytes,
+		})
+	}
+
+	return resp, nil
+}
+
+// checkPoolQuotas returns an error if creating the requested pool would
+// exceed the quota for the pool's owner user or group. The request's
+// per-rank tier storage and ranks must already be set.
+func (svc *mgmtSvc) checkPoolQuotas(ctx context.Context, req *mgmtpb.PoolCreateReq) error {
+	quotas, err := svc.sysdb.GetQuotas(nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	pools, err := svc.quotaPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	tierBytes := totalTierBytes(req.GetTierbytes(), len(req.GetRanks()))
+	for _, q := range quotas {
+		if !q.Applies(req.GetUser(), req.GetUsergroup()) {
+			continue
+		}
+		if err := q.Check(q.Usage(pools), tierBytes); err != nil {
+			svc.log.Errorf("pool %s: %s", req.GetUuid(), err)
+			return FaultPoolQuotaExceeded(err)
+		}
+	}
+
+	return nil
+}
+
+// checkPoolExtendQuotas returns an error if extending the pool onto the
+// requested ranks would exceed the storage quota for the pool's owner user or
+// group. The request's per-rank tier storage must already be set.
+func (svc *mgmtSvc) checkPoolExtendQuotas(ctx context.Context, poolUUID uuid.UUID, req *mgmtpb.PoolExtendReq) error {
+	quotas, err := svc.sysdb.GetQuotas(nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	pools, err := svc.quotaPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	var owner *system.PoolService
+	for _, ps := range pools {
+		if ps.PoolUUID == poolUUID {
+			owner = ps
+			break
+		}
+	}
+	if owner == nil {
+		return errors.Errorf("pool %s not found", poolUUID)
+	}
+
+	tierBytes := totalTierBytes(req.GetTierbytes(), len(req.GetRanks()))
+	for _, q := range quotas {
+		if !q.Applies(owner.OwnerUser, owner.OwnerGroup) {
+			continue
+		}
+		if err := q.CheckStorage(q.Usage(pools), tierBytes); err != nil {
+			svc.log.Errorf("pool %s: %s", poolUUID, err)
+			return FaultPoolQuotaExceeded(err)
+		}
+	}
+
+	return nil
+}
+
+// totalTierBytes returns the storage per tier allocated on the given number of
+// ranks.
+func totalTierBytes(perRank []uint64, nRanks int) []uint64 {
+	tierBytes := make([]uint64, len(perRank))
+	for tier, bytes := range perRank {
+		tierBytes[tier] = bytes * uint64(nRanks)
+	}
+	return tierBytes
+}
+
+// quotaPools returns the pools to be counted against quotas. Pools that do not
+// have their owners recorded in the system database (e.g. pools created before
+// owners were recorded) have them filled in from the pool ACL. On the MS
+// leader, the owners that are looked up are also stored in the system
+// database so that subsequent lookups are not needed.
+func (svc *mgmtSvc) quotaPools(ctx context.Context) ([]*system.PoolService, error) {
+	pools, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ps := range pools {
+		if ps.State != system.PoolServiceStateReady || (ps.OwnerUser != "" && ps.OwnerGroup != "") {
+			continue
+		}
+
+		user, group, err := svc.getPoolOwner(ctx, ps)
+		if err != nil {
+			svc.log.Errorf("pool %s: unable to get owner for quota usage: %s", ps.PoolUUID, err)
+			continue
+		}
+		ps.OwnerUser = user
+		ps.OwnerGroup = group
+
+		if svc.sysdb.CheckLeader() != nil {
+			continue
+		}
+		if err := svc.storePoolOwner(ctx, ps); err != nil {
+			svc.log.Debugf("pool %s: unable to store owner: %s", ps.PoolUUID, err)
+		}
+	}
+
+	return pools, nil
+}
+
+// getPoolOwner returns the owner user and group principals of the pool, as
+// recorded in the pool ACL.
+func (svc *mgmtSvc) getPoolOwner(ctx context.Context, ps *system.PoolService) (string, string, error) {
+	svcRanks, err := svc.getPoolServiceRanks(ps)
+	if err != nil {
+		return "", "", err
+	}
+
+	req := &mgmtpb.GetACLReq{
+		Sys:      svc.sysdb.SystemName(),
+		Id:       ps.PoolUUID.String(),
+		SvcRanks: svcRanks,
+	}
+	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodPoolGetACL, req)
+	if err != nil {
+		return "", "", err
+	}
+
+	resp := new(mgmtpb.ACLResp)
+	if err := proto.Unmarshal(dresp.Body, resp); err != nil {
+		return "", "", errors.Wrap(err, "unmarshal PoolGetACL response")
+	}
+	if resp.GetStatus() != 0 {
+		return "", "", daos.Status(resp.GetStatus())
+	}
+
+	return resp.GetAcl().GetOwnerUser(), resp.GetAcl().GetOwnerGroup(), nil
+}
+
+// storePoolOwner records the owner principals of the supplied pool in the
+// system database, if they are not already set.
+func (svc *mgmtSvc) storePoolOwner(parent context.Context, owned *system.PoolService) error {
+	lock, err := svc.sysdb.TakePoolLock(parent, owned.PoolUUID)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(owned.PoolUUID)
+	if err != nil {
+		return err
+	}
+	if ps.OwnerUser != "" && ps.OwnerGroup != "" {
+		return nil
+	}
+	if ps.OwnerUser == "" {
+		ps.OwnerUser = owned.OwnerUser
+	}
+	if ps.OwnerGroup == "" {
+		ps.OwnerGroup = owned.OwnerGroup
+	}
+
+	return svc.sysdb.UpdatePoolService(lock.InContext(parent), ps)
+}
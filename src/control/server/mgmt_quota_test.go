@@ -0,0 +1,409 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockQuotaPool(t *testing.T, svc *mgmtSvc, user, group string, tierBytes ...uint64) *system.PoolService {
+	t.Helper()
+
+	ps := system.NewPoolService(uuid.New(), tierBytes, []ranklist.Rank{0, 1})
+	ps.State = system.PoolServiceStateReady
+	ps.Replicas = []ranklist.Rank{0}
+	ps.OwnerUser = user
+	ps.OwnerGroup = group
+	addTestPoolService(t, svc.sysdb, ps)
+	return ps
+}
+
+func TestServer_MgmtSvc_SystemSetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		startQuota *system.Quota
+		req        *mgmtpb.SystemSetQuotaReq
+		expQuotas  []*system.Quota
+		expErr     error
+	}{
+		"not replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemSetQuotaReq{User: "bob@", MaxPools: 1},
+			expErr:     &system.ErrNotReplica{},
+		},
+		"no principal": {
+			req:    &mgmtpb.SystemSetQuotaReq{MaxPools: 1},
+			expErr: errors.New("must be set for a user or a group"),
+		},
+		"user and group": {
+			req:    &mgmtpb.SystemSetQuotaReq{User: "bob@", Group: "builders@", MaxPools: 1},
+			expErr: errors.New("both a user and a group"),
+		},
+		"invalid principal": {
+			req:    &mgmtpb.SystemSetQuotaReq{User: "@bob", MaxPools: 1},
+			expErr: errors.New("invalid ACL principal"),
+		},
+		"set": {
+			req: &mgmtpb.SystemSetQuotaReq{
+				Group:     "builders@",
+				TierBytes: []uint64{1 << 30, 1 << 40},
+			},
+			expQuotas: []*system.Quota{
+				{
+					Group:     "builders@",
+					TierBytes: []uint64{1 << 30, 1 << 40},
+				},
+			},
+		},
+		"bare name": {
+			req: &mgmtpb.SystemSetQuotaReq{User: "bob", MaxPools: 1},
+			expQuotas: []*system.Quota{
+				{User: "bob@", MaxPools: 1},
+			},
+		},
+		"replace": {
+			startQuota: &system.Quota{User: "bob@", MaxPools: 1},
+			req:        &mgmtpb.SystemSetQuotaReq{User: "bob@", MaxPools: 3},
+			expQuotas: []*system.Quota{
+				{User: "bob@", MaxPools: 3},
+			},
+		},
+		"remove": {
+			startQuota: &system.Quota{User: "bob@", MaxPools: 1},
+			req:        &mgmtpb.SystemSetQuotaReq{User: "bob@", TierBytes: []uint64{0, 0}},
+			expQuotas:  []*system.Quota{},
+		},
+		"remove unknown": {
+			req:       &mgmtpb.SystemSetQuotaReq{User: "bob@"},
+			expQuotas: []*system.Quota{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var svc *mgmtSvc
+			if tc.nonReplica {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			} else {
+				svc = newTestMgmtSvc(t, log)
+			}
+			if tc.startQuota != nil {
+				if err := svc.sysdb.SetQuota(tc.startQuota); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tc.req.Sys = build.DefaultSystemName
+			_, gotErr := svc.SystemSetQuota(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotQuotas, err := svc.sysdb.GetQuotas(nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expQuotas, gotQuotas); diff != "" {
+				t.Fatalf("unexpected quotas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemGetQuota(t *testing.T) {
+	for name, tc := range map[string]struct {
+		users   []string
+		groups  []string
+		expResp *mgmtpb.SystemGetQuotaResp
+		expErr  error
+	}{
+		"all": {
+			expResp: &mgmtpb.SystemGetQuotaResp{
+				Quotas: []*mgmtpb.SystemQuota{
+					{
+						User:          "bob@",
+						MaxPools:      2,
+						UsedPools:     1,
+						UsedTierBytes: []uint64{20, 200},
+					},
+					{
+						Group:         "builders@",
+						TierBytes:     []uint64{100, 1000},
+						UsedPools:     3,
+						UsedTierBytes: []uint64{140, 1400},
+					},
+				},
+			},
+		},
+		"one": {
+			users: []string{"bob"},
+			expResp: &mgmtpb.SystemGetQuotaResp{
+				Quotas: []*mgmtpb.SystemQuota{
+					{
+						User:          "bob@",
+						MaxPools:      2,
+						UsedPools:     1,
+						UsedTierBytes: []uint64{20, 200},
+					},
+				},
+			},
+		},
+		"unknown": {
+			users:  []string{"alice@"},
+			expErr: system.ErrQuotaNotFound("user alice@"),
+		},
+		"invalid principal": {
+			groups: []string{"@builders"},
+			expErr: errors.New("invalid ACL principal"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			setupMockDrpcClient(svc, &mgmtpb.ACLResp{
+				Acl: &mgmtpb.AccessControlList{
+					OwnerUser:  "carol@",
+					OwnerGroup: "builders@",
+				},
+			}, nil)
+			for _, q := range []*system.Quota{
+				{User: "bob@", MaxPools: 2},
+				{Group: "builders@", TierBytes: []uint64{100, 1000}},
+			} {
+				if err := svc.sysdb.SetQuota(q); err != nil {
+					t.Fatal(err)
+				}
+			}
+			mockQuotaPool(t, svc, "bob@", "builders@", 10, 100)
+			mockQuotaPool(t, svc, "alice@", "builders@", 20, 200)
+			mockQuotaPool(t, svc, "alice@", "testers@", 40, 400)
+			// Owner is filled in from the pool ACL.
+			mockQuotaPool(t, svc, "", "", 40, 400)
+
+			gotResp, gotErr := svc.SystemGetQuota(test.Context(t), &mgmtpb.SystemGetQuotaReq{
+				Sys:    build.DefaultSystemName,
+				Users:  tc.users,
+				Groups: tc.groups,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_checkPoolQuotas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quota  *system.Quota
+		req    *mgmtpb.PoolCreateReq
+		expErr error
+	}{
+		"no quotas": {
+			req: &mgmtpb.PoolCreateReq{User: "bob@", Usergroup: "builders@"},
+		},
+		"other principal": {
+			quota: &system.Quota{User: "alice@", MaxPools: 1},
+			req:   &mgmtpb.PoolCreateReq{User: "bob@", Usergroup: "builders@"},
+		},
+		"within limits": {
+			quota: &system.Quota{User: "bob@", MaxPools: 2, TierBytes: []uint64{30, 300}},
+			req: &mgmtpb.PoolCreateReq{
+				User:      "bob@",
+				Usergroup: "builders@",
+				Ranks:     []uint32{0, 1},
+				Tierbytes: []uint64{5, 50},
+			},
+		},
+		"pool count exceeded": {
+			quota:  &system.Quota{User: "bob@", MaxPools: 1},
+			req:    &mgmtpb.PoolCreateReq{User: "bob@", Usergroup: "builders@"},
+			expErr: errors.New("pools quota for user bob@ exceeded"),
+		},
+		"group storage exceeded": {
+			quota: &system.Quota{Group: "builders@", TierBytes: []uint64{0, 300}},
+			req: &mgmtpb.PoolCreateReq{
+				User:      "alice@",
+				Usergroup: "builders@",
+				Ranks:     []uint32{0, 1},
+				Tierbytes: []uint64{5, 51},
+			},
+			expErr: errors.New("NVMe quota for group builders@ exceeded"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.quota != nil {
+				if err := svc.sysdb.SetQuota(tc.quota); err != nil {
+					t.Fatal(err)
+				}
+			}
+			mockQuotaPool(t, svc, "bob@", "builders@", 10, 100)
+
+			gotErr := svc.checkPoolQuotas(test.Context(t), tc.req)
+			if tc.expErr != nil {
+				test.AssertTrue(t, fault.IsFaultCode(gotErr, code.ServerPoolQuotaExceeded),
+					"expected quota exceeded fault")
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_checkPoolExtendQuotas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quota       *system.Quota
+		ranks       []uint32
+		unknownPool bool
+		expErr      error
+	}{
+		"no quotas": {
+			ranks: []uint32{2},
+		},
+		"other principal": {
+			quota: &system.Quota{User: "alice@", TierBytes: []uint64{0, 1}},
+			ranks: []uint32{2},
+		},
+		"pool count not checked": {
+			quota: &system.Quota{User: "bob@", MaxPools: 1, TierBytes: []uint64{30, 300}},
+			ranks: []uint32{2},
+		},
+		"unknown pool": {
+			quota:       &system.Quota{User: "bob@", MaxPools: 1},
+			ranks:       []uint32{2},
+			unknownPool: true,
+			expErr:      errors.New("not found"),
+		},
+		"storage exceeded": {
+			quota:  &system.Quota{Group: "builders@", TierBytes: []uint64{0, 350}},
+			ranks:  []uint32{2, 3},
+			expErr: errors.New("NVMe quota for group builders@ exceeded"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.quota != nil {
+				if err := svc.sysdb.SetQuota(tc.quota); err != nil {
+					t.Fatal(err)
+				}
+			}
+			ps := mockQuotaPool(t, svc, "bob@", "builders@", 10, 100)
+			poolUUID := ps.PoolUUID
+			if tc.unknownPool {
+				poolUUID = uuid.New()
+			}
+
+			req := &mgmtpb.PoolExtendReq{
+				Ranks:     tc.ranks,
+				Tierbytes: ps.Storage.PerRankTierStorage,
+			}
+			gotErr := svc.checkPoolExtendQuotas(test.Context(t), poolUUID, req)
+			if tc.expErr != nil && !tc.unknownPool {
+				test.AssertTrue(t, fault.IsFaultCode(gotErr, code.ServerPoolQuotaExceeded),
+					"expected quota exceeded fault")
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_quotaPools(t *testing.T) {
+	aclResp := &mgmtpb.ACLResp{
+		Acl: &mgmtpb.AccessControlList{
+			OwnerUser:  "carol@",
+			OwnerGroup: "testers@",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		drpcResp  *mgmtpb.ACLResp
+		drpcErr   error
+		expCalls  int
+		expUser   string
+		expGroup  string
+		expStored bool
+	}{
+		"owner from ACL": {
+			drpcResp:  aclResp,
+			expCalls:  1,
+			expUser:   "carol@",
+			expGroup:  "testers@",
+			expStored: true,
+		},
+		"dRPC fails": {
+			drpcErr:  errors.New("remote failed"),
+			expCalls: 1,
+		},
+		"engine fails": {
+			drpcResp: &mgmtpb.ACLResp{Status: int32(daos.NoPermission)},
+			expCalls: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			mdc := getMockDrpcClient(tc.drpcResp, tc.drpcErr)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			owned := mockQuotaPool(t, svc, "bob@", "builders@", 10, 100)
+			unowned := mockQuotaPool(t, svc, "", "", 20, 200)
+
+			pools, err := svc.quotaPools(test.Context(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.expCalls, len(mdc.calls.get()), "unexpected number of dRPC calls")
+			for _, ps := range pools {
+				switch ps.PoolUUID {
+				case owned.PoolUUID:
+					test.AssertEqual(t, "bob@", ps.OwnerUser, "owned pool user changed")
+					test.AssertEqual(t, "builders@", ps.OwnerGroup, "owned pool group changed")
+				case unowned.PoolUUID:
+					test.AssertEqual(t, tc.expUser, ps.OwnerUser, "unexpected owner user")
+					test.AssertEqual(t, tc.expGroup, ps.OwnerGroup, "unexpected owner group")
+				}
+			}
+
+			stored, err := svc.sysdb.FindPoolServiceByUUID(unowned.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expStored, stored.OwnerUser != "", "unexpected stored owner")
+		})
+	}
+}
@@ -0,0 +1,305 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/peer"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// replicaAddrStrings returns the string form of the supplied replica addresses.
+func replicaAddrStrings(replicas []*net.TCPAddr) []string {
+	accessPts := make([]string, 0, len(replicas))
+	for _, rep := range replicas {
+		accessPts = append(accessPts, rep.String())
+	}
+	return accessPts
+}
+
+// resolveReplicaAddr resolves the control address of a server supplied in a
+// replica request.
+func resolveReplicaAddr(addr string) (*net.TCPAddr, error) {
+	if addr == "" {
+		return nil, errors.New("no server address supplied")
+	}
+
+	tcpAddr, err := resolveFirstAddr(addr, net.LookupIP)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid server address %q", addr)
+	}
+	return tcpAddr, nil
+}
+
+// parseAccessPoints resolves the supplied MS access point addresses.
+func parseAccessPoints(accessPts []string) ([]*net.TCPAddr, error) {
+	replicas := make([]*net.TCPAddr, 0, len(accessPts))
+	for _, ap := range accessPts {
+		addr, err := net.ResolveTCPAddr("tcp", ap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid access point %q", ap)
+		}
+		replicas = append(replicas, addr)
+	}
+	return replicas, nil
+}
+
+// sameAccessPoints returns true if the supplied sets of access points contain
+// the same addresses, in any order.
+func sameAccessPoints(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// replicaResp returns a response describing the supplied MS replica set.
+func (svc *mgmtSvc) replicaResp(replicas []string) *mgmtpb.SystemReplicaResp {
+	leader, _, _ := svc.sysdb.LeaderQuery()
+
+	return &mgmtpb.SystemReplicaResp{
+		Replicas: replicas,
+		Leader:   leader,
+	}
+}
+
+// sendAccessPoints sends the supplied MS replica set to the servers at the
+// supplied control addresses.
+func (svc *mgmtSvc) sendAccessPoints(ctx context.Context, hosts []string, replicas []*net.TCPAddr) error {
+	req := &control.SetAccessPointsReq{
+		AccessPoints: replicaAddrStrings(replicas),
+	}
+	req.SetHostList(hosts)
+	req.SetSystem(svc.sysdb.SystemName())
+
+	resp, err := control.SetAccessPoints(ctx, svc.rpcClient, req)
+	if err != nil {
+		return err
+	}
+	return resp.Errors()
+}
+
+// publishAccessPoints sends the supplied MS replica set to every server that
+// hosts system members and is not a MS replica. Replicas track the replica set
+// through raft. Failures are logged but not returned, as the replica set has
+// already been changed; servers that were not updated continue to use their
+// previous access points until they next join the system.
+func (svc *mgmtSvc) publishAccessPoints(ctx context.Context, replicas []*net.TCPAddr) {
+	accessPts := replicaAddrStrings(replicas)
+
+	var hosts []string
+	for _, host := range svc.membership.HostList(nil) {
+		if !common.Includes(accessPts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		return
+	}
+
+	if err := svc.sendAccessPoints(ctx, hosts, replicas); err != nil {
+		svc.log.Errorf("failed to update MS access points on all servers: %s", err)
+	}
+}
+
+// checkReplicaRunning returns an error if the server at the supplied control
+// address is not running a MS replica.
+func (svc *mgmtSvc) checkReplicaRunning(ctx context.Context, addr *net.TCPAddr) error {
+	req := new(control.ReplicaQueryReq)
+	req.SetHostList([]string{addr.String()})
+	req.SetSystem(svc.sysdb.SystemName())
+
+	resp, err := control.ReplicaQuery(ctx, svc.rpcClient, req)
+	if err == nil {
+		err = resp.Errors()
+	}
+	return errors.Wrapf(err, "%s is not running a MS replica; it must be configured as an access point", addr)
+}
+
+// SystemReplicaAdd handles a request to add a server to the MS replica set.
+func (svc *mgmtSvc) SystemReplicaAdd(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	addr, err := resolveReplicaAddr(req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := svc.sysdb.CheckAddReplica(addr, req.Force); err != nil {
+		return nil, err
+	}
+
+	// The raft replica must be running on the new server before it is
+	// added as a voter, otherwise it could not take part in the quorum.
+	if err := svc.checkReplicaRunning(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	replicas, err := svc.sysdb.AddReplica(addr)
+	if err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("added %s to MS replica set: %s", addr,
+		strings.Join(replicaAddrStrings(replicas), ","))
+
+	svc.publishAccessPoints(ctx, replicas)
+
+	return svc.replicaResp(replicaAddrStrings(replicas)), nil
+}
+
+// SystemReplicaRemove handles a request to remove a server from the MS
+// replica set.
+func (svc *mgmtSvc) SystemReplicaRemove(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	addr, err := resolveReplicaAddr(req.Addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := svc.sysdb.CheckRemoveReplica(addr, req.Force); err != nil {
+		return nil, err
+	}
+
+	replicas, err := svc.sysdb.RemoveReplica(addr)
+	if err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("removed %s from MS replica set: %s", addr,
+		strings.Join(replicaAddrStrings(replicas), ","))
+
+	svc.publishAccessPoints(ctx, replicas)
+
+	return svc.replicaResp(replicaAddrStrings(replicas)), nil
+}
+
+// SystemLeaderTransfer handles a request to transfer MS leadership to another
+// replica.
+func (svc *mgmtSvc) SystemLeaderTransfer(ctx context.Context, req *mgmtpb.SystemLeaderTransferReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	var addr *net.TCPAddr
+	if req.Addr != "" {
+		var err error
+		if addr, err = resolveReplicaAddr(req.Addr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := svc.sysdb.TransferLeadership(addr); err != nil {
+		return nil, errors.Wrap(err, "failed to transfer MS leadership")
+	}
+	svc.log.Notice("transferred MS leadership")
+
+	return svc.replicaResp(svc.sysdb.AccessPoints()), nil
+}
+
+// checkFromLeader verifies that a request carrying the supplied MS replica set
+// was sent by the current MS leader and that the replica set matches the one
+// reported by the MS replicas.
+func (svc *mgmtSvc) checkFromLeader(ctx context.Context, accessPts []string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return errors.New("peer details not found in context")
+	}
+	callerAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return errors.Errorf("peer address (%s) not tcp", p.Addr)
+	}
+
+	lqReq := new(control.LeaderQueryReq)
+	lqReq.SetHostList(svc.sysdb.AccessPoints())
+	lqReq.SetSystem(svc.sysdb.SystemName())
+	lqResp, err := control.LeaderQuery(ctx, svc.rpcClient, lqReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to query MS leader")
+	}
+
+	leaderAddr, err := net.ResolveTCPAddr("tcp", lqResp.Leader)
+	if err != nil {
+		return errors.Wrapf(err, "invalid MS leader address %q", lqResp.Leader)
+	}
+	if !leaderAddr.IP.Equal(callerAddr.IP) {
+		return errors.Errorf("request from %s is not from the MS leader %s", callerAddr.IP, lqResp.Leader)
+	}
+	if !sameAccessPoints(accessPts, lqResp.Replicas) {
+		return errors.Errorf("access points %s do not match MS replica set %s",
+			strings.Join(accessPts, ","), strings.Join(lqResp.Replicas, ","))
+	}
+
+	return nil
+}
+
+// updateAccessPoints updates the MS replica set used by this server if it is
+// not a MS replica and the set has changed.
+func (svc *mgmtSvc) updateAccessPoints(accessPts []string) {
+	if len(accessPts) == 0 || svc.sysdb.IsReplica() ||
+		sameAccessPoints(accessPts, svc.sysdb.AccessPoints()) {
+		return
+	}
+
+	replicas, err := parseAccessPoints(accessPts)
+	if err == nil {
+		err = svc.sysdb.SetAccessPoints(replicas)
+	}
+	if err != nil {
+		svc.log.Errorf("failed to update MS access points: %s", err)
+		return
+	}
+	svc.log.Noticef("MS access points set to %s", strings.Join(accessPts, ","))
+}
+
+// SetAccessPoints handles a request from the MS leader to update the MS
+// replica set used by this server. The request is rejected unless it comes
+// from the current MS leader with the replica set reported by the MS. It is
+// ignored on MS replicas, which track the replica set through raft.
+func (svc *mgmtSvc) SetAccessPoints(ctx context.Context, req *mgmtpb.SetAccessPointsReq) (*mgmtpb.SetAccessPointsResp, error) {
+	if err := svc.checkSystemRequest(req); err != nil {
+		return nil, err
+	}
+	if len(req.AccessPoints) == 0 {
+		return nil, errors.New("no access points supplied")
+	}
+
+	if svc.sysdb.IsReplica() {
+		svc.log.Debug("ignoring MS access points update on MS replica")
+		return new(mgmtpb.SetAccessPointsResp), nil
+	}
+
+	if err := svc.checkFromLeader(ctx, req.AccessPoints); err != nil {
+		return nil, errors.Wrap(err, "MS access points update rejected")
+	}
+	if _, err := parseAccessPoints(req.AccessPoints); err != nil {
+		return nil, err
+	}
+	svc.updateAccessPoints(req.AccessPoints)
+
+	return new(mgmtpb.SetAccessPointsResp), nil
+}
@@ -0,0 +1,335 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/peer"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// newTestMgmtSvcReplicas creates a mgmtSvc whose system database is led by
+// the local replica, with any additional replicas supplied. Joined members are
+// hosted at 127.0.0.2 and 127.0.0.3.
+func newTestMgmtSvcReplicas(t *testing.T, log logging.Logger, mic *control.MockInvokerConfig, replicas ...*net.TCPAddr) *mgmtSvc {
+	t.Helper()
+
+	svc := newTestMgmtSvc(t, log)
+	svc.sysdb = raft.MockDatabaseWithReplicas(t, log, replicas...)
+	svc.membership = system.MockMembership(t, log, svc.sysdb, mockTCPResolver)
+	for _, idx := range []uint32{2, 3} {
+		if err := svc.sysdb.AddMember(system.MockMember(t, idx, system.MemberStateJoined)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	svc.rpcClient = control.NewMockInvoker(log, mic)
+
+	return svc
+}
+
+func TestServer_MgmtSvc_SystemReplicaAdd(t *testing.T) {
+	localAddr := "127.0.0.1:10001"
+	newAddr := "127.0.0.2:10001"
+	otherAddr := "127.0.0.3:10001"
+
+	for name, tc := range map[string]struct {
+		req          *mgmtpb.SystemReplicaReq
+		mic          *control.MockInvokerConfig
+		expResp      *mgmtpb.SystemReplicaResp
+		expAccessPts []string
+		expErr       error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: "bad", Addr: newAddr},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"missing address": {
+			req:    &mgmtpb.SystemReplicaReq{},
+			expErr: errors.New("no server address"),
+		},
+		"not a member": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.4:10001"},
+			expErr: errors.New("not a system member"),
+		},
+		"replica not running": {
+			req: &mgmtpb.SystemReplicaReq{Addr: newAddr},
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: &control.UnaryResponse{
+					Responses: []*control.HostResponse{
+						{Addr: newAddr, Error: &system.ErrNotReplica{}},
+					},
+				},
+			},
+			expErr: errors.New("not running a MS replica"),
+		},
+		"access points update fails": {
+			req: &mgmtpb.SystemReplicaReq{Addr: newAddr},
+			mic: &control.MockInvokerConfig{
+				UnaryResponseSet: []*control.UnaryResponse{
+					{
+						Responses: []*control.HostResponse{
+							{Addr: newAddr, Message: &mgmtpb.LeaderQueryResp{}},
+						},
+					},
+					{
+						Responses: []*control.HostResponse{
+							{Addr: otherAddr, Error: errors.New("remote failed")},
+						},
+					},
+				},
+			},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{localAddr, newAddr},
+			},
+			expAccessPts: []string{localAddr, newAddr},
+		},
+		"success": {
+			req: &mgmtpb.SystemReplicaReq{Addr: newAddr},
+			mic: &control.MockInvokerConfig{
+				UnaryResponseSet: []*control.UnaryResponse{
+					{
+						Responses: []*control.HostResponse{
+							{Addr: newAddr, Message: &mgmtpb.LeaderQueryResp{}},
+						},
+					},
+					{
+						Responses: []*control.HostResponse{
+							{Addr: otherAddr, Message: &mgmtpb.SetAccessPointsResp{}},
+						},
+					},
+				},
+			},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{localAddr, newAddr},
+			},
+			expAccessPts: []string{localAddr, newAddr},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvcReplicas(t, log, tc.mic)
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemReplicaAdd(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expAccessPts, svc.sysdb.AccessPoints()); diff != "" {
+				t.Fatalf("unexpected access points (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemReplicaRemove(t *testing.T) {
+	localAddr := "127.0.0.1:10001"
+
+	for name, tc := range map[string]struct {
+		req          *mgmtpb.SystemReplicaReq
+		expResp      *mgmtpb.SystemReplicaResp
+		expAccessPts []string
+		expErr       error
+	}{
+		"not a replica": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.3:10001"},
+			expErr: errors.New("not a MS replica"),
+		},
+		"current leader": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: localAddr},
+			expErr: errors.New("current MS leader"),
+		},
+		"quorum lost": {
+			req:    &mgmtpb.SystemReplicaReq{Addr: "127.0.0.2:10001"},
+			expErr: errors.New("required for quorum"),
+		},
+		"quorum lost; forced": {
+			req: &mgmtpb.SystemReplicaReq{Addr: "127.0.0.2:10001", Force: true},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{localAddr, "127.0.0.4:10001"},
+			},
+			expAccessPts: []string{localAddr, "127.0.0.4:10001"},
+		},
+		"success": {
+			req: &mgmtpb.SystemReplicaReq{Addr: "127.0.0.4:10001"},
+			expResp: &mgmtpb.SystemReplicaResp{
+				Replicas: []string{localAddr, "127.0.0.2:10001"},
+			},
+			expAccessPts: []string{localAddr, "127.0.0.2:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			// The replica at 127.0.0.4 hosts no joined members.
+			svc := newTestMgmtSvcReplicas(t, log, nil,
+				system.MockControlAddr(t, 2), system.MockControlAddr(t, 4))
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemReplicaRemove(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expAccessPts, svc.sysdb.AccessPoints()); diff != "" {
+				t.Fatalf("unexpected access points (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SetAccessPoints(t *testing.T) {
+	leaderAddr := "127.0.0.1:10001"
+	replicaAddr := "127.0.0.2:10001"
+	accessPts := []string{leaderAddr, replicaAddr}
+
+	peerCtx := func(t *testing.T, ip string) context.Context {
+		return peer.NewContext(test.Context(t), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 43210},
+		})
+	}
+	leaderQueryMIC := func(leader string, replicas ...string) *control.MockInvokerConfig {
+		return &control.MockInvokerConfig{
+			UnaryResponseSet: []*control.UnaryResponse{
+				control.MockMSResponse(leader, nil, &mgmtpb.LeaderQueryResp{
+					CurrentLeader: leader,
+					Replicas:      replicas,
+				}),
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		replica      bool
+		ctx          context.Context
+		mic          *control.MockInvokerConfig
+		req          *mgmtpb.SetAccessPointsReq
+		expAccessPts []string
+		expErr       error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.SetAccessPointsReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"empty replica set": {
+			req:    &mgmtpb.SetAccessPointsReq{},
+			expErr: errors.New("no access points"),
+		},
+		"ignored on replica": {
+			replica: true,
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: []string{"127.0.0.9:10001"},
+			},
+			expAccessPts: []string{leaderAddr},
+		},
+		"no peer": {
+			ctx: test.Context(t),
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: accessPts,
+			},
+			expErr: errors.New("peer details not found"),
+		},
+		"leader query fails": {
+			ctx: peerCtx(t, "127.0.0.1"),
+			mic: &control.MockInvokerConfig{
+				UnaryError: errors.New("query failed"),
+			},
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: accessPts,
+			},
+			expErr: errors.New("query failed"),
+		},
+		"not from leader": {
+			ctx: peerCtx(t, "127.0.0.9"),
+			mic: leaderQueryMIC(leaderAddr, accessPts...),
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: accessPts,
+			},
+			expErr: errors.New("not from the MS leader"),
+		},
+		"replica set mismatch": {
+			ctx: peerCtx(t, "127.0.0.1"),
+			mic: leaderQueryMIC(leaderAddr, leaderAddr),
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: accessPts,
+			},
+			expErr: errors.New("do not match MS replica set"),
+		},
+		"invalid access point": {
+			ctx: peerCtx(t, "127.0.0.1"),
+			mic: leaderQueryMIC(leaderAddr, "127.0.0.1"),
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: []string{"127.0.0.1"},
+			},
+			expErr: errors.New("invalid access point"),
+		},
+		"success": {
+			ctx: peerCtx(t, "127.0.0.1"),
+			mic: leaderQueryMIC(leaderAddr, replicaAddr, leaderAddr),
+			req: &mgmtpb.SetAccessPointsReq{
+				AccessPoints: accessPts,
+			},
+			expAccessPts: accessPts,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var svc *mgmtSvc
+			if tc.replica {
+				svc = newTestMgmtSvcReplicas(t, log, tc.mic)
+			} else {
+				svc = newTestMgmtSvcNonReplica(t, log)
+				svc.rpcClient = control.NewMockInvoker(log, tc.mic)
+			}
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			if tc.ctx == nil {
+				tc.ctx = peerCtx(t, "127.0.0.1")
+			}
+
+			_, gotErr := svc.SetAccessPoints(tc.ctx, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAccessPts, svc.sysdb.AccessPoints()); diff != "" {
+				t.Fatalf("unexpected access points (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/raft"
 )
@@ -81,6 +84,15 @@ type mgmtSvc struct {
 	serialReqs        batchReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	evtStreams        *eventStreamHub // if MS leader, fans out RAS events to client streams
+	evtPolicyLock     sync.Mutex
+	evtPolicy         eventPolicy // RAS event policy applied to published events
+	evtPolicyKick     chan struct{}
+	poolOpsLock       sync.Mutex
+	poolOpsActive     map[uuid.UUID]struct{} // if MS leader, pools with an operation being run
+	poolOpsKick       chan struct{}
+	poolOpWaiters     map[uuid.UUID]chan *poolOpResult // requests waiting for pool operations to finish
+	accessCfg         *security.AccessControlConfig
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
@@ -97,6 +109,9 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		batchReqs:         make(batchReqChan),
 		serialReqs:        make(batchReqChan),
 		groupUpdateReqs:   make(chan bool),
+		poolOpsKick:       make(chan struct{}, 1),
+		evtPolicyKick:     make(chan struct{}, 1),
+		evtStreams:        newEventStreamHub(h.log),
 	}
 }
 
@@ -162,6 +177,7 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 // that will be canceled on leadership loss.
 func (svc *mgmtSvc) startLeaderLoops(ctx context.Context) {
 	go svc.leaderTaskLoop(ctx)
+	go svc.poolOpQueueLoop(ctx)
 }
 
 // startAsyncLoops kicks off the asynchronous processing loops.
@@ -310,6 +326,11 @@ func (svc *mgmtSvc) processSerialRequest(ctx context.Context, req *batchRequest)
 	svc.log.Debugf("invoking serial handler for %T", req.msg)
 	switch msg := req.msg.(type) {
 	case *mgmtpb.PoolCreateReq:
+		// Carry the caller's access over so that the pool creator can be
+		// recorded.
+		if access, found := callerAccessFromContext(req.ctx); found {
+			ctx = context.WithValue(ctx, callerAccessKey{}, access)
+		}
 		resp, err := svc.poolCreate(ctx, msg)
 		req.sendResponse(ctx, resp, err)
 	default:
@@ -106,6 +106,7 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 	}
 
 	resp.MsRanks = ranklist.RanksToUint32(groupMap.MSRanks)
+	resp.AccessPoints = svc.sysdb.AccessPoints()
 
 	v, err := svc.sysdb.DataVersion()
 	if err != nil {
@@ -224,9 +225,10 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 		joinState = mgmtpb.JoinResp_CHECK
 	}
 	resp := &mgmtpb.JoinResp{
-		State:      joinState,
-		Rank:       member.Rank.Uint32(),
-		MapVersion: joinResponse.MapVersion,
+		State:        joinState,
+		Rank:         member.Rank.Uint32(),
+		MapVersion:   joinResponse.MapVersion,
+		AccessPoints: svc.sysdb.AccessPoints(),
 	}
 
 	if svc.isGroupUpdatePaused() && svc.allRanksJoined() {
@@ -505,12 +507,17 @@ type (
 	// function that executes across ranks on different hosts.
 	systemRanksFunc func(context.Context, control.UnaryInvoker, *control.RanksReq) (*control.RanksResp, error)
 
+	// fanoutReportFunc is called with the rank results from each host as
+	// they are received during a fan-out.
+	fanoutReportFunc func([]*sharedpb.RankResult)
+
 	fanoutRequest struct {
 		Method     systemRanksFunc
 		Ranks      *ranklist.RankSet
 		Force      bool
 		FullSystem bool
 		CheckMode  bool
+		Report     fanoutReportFunc
 	}
 
 	fanoutResponse struct {
@@ -646,6 +653,9 @@ func (svc *mgmtSvc) rpcFanout(ctx context.Context, req *fanoutRequest, resp *fan
 			waiting.Delete(ranklist.Rank(rr.Rank))
 			finished.Add(ranklist.Rank(rr.Rank))
 		}
+		if req.Report != nil {
+			req.Report(rs.GetResults())
+		}
 
 		msg := fmt.Sprintf("%s: ", funcName(req.Method))
 		if finished.Count() != 0 {
@@ -821,20 +831,65 @@ func (svc *mgmtSvc) getFanout(req systemReq) (*fanoutRequest, *fanoutResponse, e
 // This control service method is triggered from the control API method of the
 // same name in lib/control/system.go and returns results from all selected ranks.
 func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (*mgmtpb.SystemStopResp, error) {
+	svc.log.Debug("Received SystemStop RPC")
+
+	return svc.systemStop(ctx, req, nil)
+}
+
+// SystemStopStream performs the same two-phase stop as SystemStop but sends
+// the rank results of each phase as they arrive from each host before sending
+// the final response on the stream.
+func (svc *mgmtSvc) SystemStopStream(req *mgmtpb.SystemStopReq, stream mgmtpb.MgmtSvc_SystemStopStreamServer) error {
+	svc.log.Debug("Received SystemStopStream RPC")
+
+	report := func(action string, results []*sharedpb.RankResult) {
+		msg := &mgmtpb.SystemStopStreamResp{
+			Msg: &mgmtpb.SystemStopStreamResp_Progress{
+				Progress: &mgmtpb.SystemStopProgress{
+					Action:  action,
+					Results: results,
+				},
+			},
+		}
+		if err := stream.Send(msg); err != nil {
+			svc.log.Errorf("failed to send system stop progress: %s", err)
+		}
+	}
+
+	resp, err := svc.systemStop(stream.Context(), req, report)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&mgmtpb.SystemStopStreamResp{
+		Msg: &mgmtpb.SystemStopStreamResp_Resp{Resp: resp},
+	})
+}
+
+func (svc *mgmtSvc) systemStop(ctx context.Context, req *mgmtpb.SystemStopReq, report func(string, []*sharedpb.RankResult)) (*mgmtpb.SystemStopResp, error) {
 	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
 		return nil, err
 	}
-	svc.log.Debug("Received SystemStop RPC")
 
 	fReq, fResp, err := svc.getFanout(req)
 	if err != nil {
 		return nil, err
 	}
 
+	reportAction := func(action string) fanoutReportFunc {
+		if report == nil {
+			return nil
+		}
+		return func(results []*sharedpb.RankResult) {
+			report(action, results)
+		}
+	}
+
 	// First phase: Prepare the ranks for shutdown, but only if the request
 	// is for an unforced full system stop.
 	if fReq.FullSystem && !fReq.Force {
 		fReq.Method = control.PrepShutdownRanks
+		fReq.Report = reportAction("prep shutdown")
 		fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
 		if err != nil {
 			return nil, err
@@ -848,6 +903,7 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 	// Second phase: Stop the ranks. If the request is forced, we will
 	// kill the ranks immediately without a graceful shutdown.
 	fReq.Method = control.StopRanks
+	fReq.Report = reportAction("stop")
 	fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
 	if err != nil {
 		return nil, err
@@ -1313,3 +1369,67 @@ func (svc *mgmtSvc) SystemGetProp(ctx context.Context, req *mgmtpb.SystemGetProp
 	resp = &mgmtpb.SystemGetPropResp{Properties: props}
 	return
 }
+
+func eventFilterFromReq(req *mgmtpb.SystemGetEventsReq) (*events.HistoryFilter, error) {
+	filter := &events.HistoryFilter{
+		Severity: events.RASSeverityID(req.GetSeverity()),
+		PoolUUID: req.GetPoolUuid(),
+		Limit:    int(req.GetLimit()),
+	}
+
+	if req.GetRanks() != "" {
+		rs, err := ranklist.CreateRankSet(req.GetRanks())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid ranks")
+		}
+		filter.Ranks = rs
+	}
+	for _, id := range req.GetIds() {
+		filter.IDs = append(filter.IDs, events.RASID(id))
+	}
+	if req.GetSince() != "" {
+		ts, err := common.ParseTime(req.GetSince())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid since timestamp")
+		}
+		filter.Since = ts
+	}
+	if req.GetUntil() != "" {
+		ts, err := common.ParseTime(req.GetUntil())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid until timestamp")
+		}
+		filter.Until = ts
+	}
+
+	return filter, nil
+}
+
+// SystemGetEvents retrieves RAS events from the event history stored in the
+// system database.
+func (svc *mgmtSvc) SystemGetEvents(ctx context.Context, req *mgmtpb.SystemGetEventsReq) (*mgmtpb.SystemGetEventsResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	filter, err := eventFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+
+	evts, err := svc.sysdb.GetEvents(filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query RAS event history")
+	}
+
+	resp := new(mgmtpb.SystemGetEventsResp)
+	for _, evt := range evts {
+		pbEvt, err := evt.ToProto()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert %s event", evt.ID)
+		}
+		resp.Events = append(resp.Events, pbEvt)
+	}
+
+	return resp, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -1664,6 +1665,124 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 	}
 }
 
+type mockSystemStopServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*mgmtpb.SystemStopStreamResp
+}
+
+func (ms *mockSystemStopServerStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockSystemStopServerStream) Send(resp *mgmtpb.SystemStopStreamResp) error {
+	ms.sent = append(ms.sent, resp)
+	return nil
+}
+
+func TestServer_MgmtSvc_SystemStopStream(t *testing.T) {
+	hr := func(a int32, rrs ...*sharedpb.RankResult) *control.HostResponse {
+		return &control.HostResponse{
+			Addr:    test.MockHostAddr(a).String(),
+			Message: &mgmtpb.SystemStopResp{Results: rrs},
+		}
+	}
+	hrps := []*control.HostResponse{
+		hr(1, mockRankSuccess("prep shutdown", 0), mockRankSuccess("prep shutdown", 1)),
+		hr(2, mockRankSuccess("prep shutdown", 3)),
+	}
+	hrss := []*control.HostResponse{
+		hr(1, mockRankSuccess("stop", 0), mockRankSuccess("stop", 1)),
+		hr(2, mockRankSuccess("stop", 3)),
+	}
+
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.SystemStopReq
+		mResps      [][]*control.HostResponse
+		expProgress []string
+		expResults  []*sharedpb.RankResult
+		expAPIErr   error
+	}{
+		"not system leader": {
+			req: &mgmtpb.SystemStopReq{
+				Sys: "quack",
+			},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"full system stop": {
+			req:    &mgmtpb.SystemStopReq{},
+			mResps: [][]*control.HostResponse{hrps, hrss},
+			expProgress: []string{
+				"prep shutdown: 0,1", "prep shutdown: 3",
+				"stop: 0,1", "stop: 3",
+			},
+			expResults: []*sharedpb.RankResult{
+				mockRankSuccess("stop", 0, 1), mockRankSuccess("stop", 1, 1), mockRankSuccess("stop", 3, 2),
+			},
+		},
+		"full system stop (forced)": {
+			req:         &mgmtpb.SystemStopReq{Force: true},
+			mResps:      [][]*control.HostResponse{hrss},
+			expProgress: []string{"stop: 0,1", "stop: 3"},
+			expResults: []*sharedpb.RankResult{
+				mockRankSuccess("stop", 0, 1), mockRankSuccess("stop", 1, 1), mockRankSuccess("stop", 3, 2),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.mResps == nil {
+				tc.mResps = [][]*control.HostResponse{{}}
+			}
+			members := system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 3, 2, "joined"),
+			}
+			svc := mgmtSystemTestSetup(t, log, members, tc.mResps...)
+
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			stream := &mockSystemStopServerStream{ctx: test.Context(t)}
+			gotAPIErr := svc.SystemStopStream(tc.req, stream)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				test.AssertEqual(t, 0, len(stream.sent), "messages sent")
+				return
+			}
+
+			if len(stream.sent) == 0 {
+				t.Fatal("no messages sent on stream")
+			}
+			gotResp := stream.sent[len(stream.sent)-1].GetResp()
+			if gotResp == nil {
+				t.Fatal("final message on stream is not a response")
+			}
+			checkRankResults(t, tc.expResults, gotResp.Results)
+
+			var gotProgress []string
+			for _, msg := range stream.sent[:len(stream.sent)-1] {
+				prog := msg.GetProgress()
+				if prog == nil {
+					t.Fatalf("unexpected message on stream: %+v", msg)
+				}
+				var ranks []string
+				for _, rr := range prog.Results {
+					ranks = append(ranks, fmt.Sprintf("%d", rr.Rank))
+				}
+				gotProgress = append(gotProgress, prog.Action+": "+strings.Join(ranks, ","))
+			}
+			sort.Strings(gotProgress)
+			if diff := cmp.Diff(tc.expProgress, gotProgress); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_SystemExclude(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req        *mgmtpb.SystemExcludeReq
@@ -1923,6 +2042,118 @@ func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_SystemGetEvents(t *testing.T) {
+	poolUUID := test.MockUUID(1)
+	evtDied := events.NewEngineDiedEvent("foo", 0, 1, common.ExitStatus("test"), 1234)
+	evtPSR := events.NewPoolSvcReplicasUpdateEvent("foo", 2, poolUUID, []uint32{2}, 1)
+	evtSpeed := events.NewGenericEvent(events.RASNVMeLinkSpeedChanged, events.RASSeverityWarning,
+		"link speed changed", "").WithRank(3)
+
+	toPB := func(evts ...*events.RASEvent) []*sharedpb.RASEvent {
+		var pbEvts []*sharedpb.RASEvent
+		for _, evt := range evts {
+			pbEvt, err := evt.ToProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+			pbEvts = append(pbEvts, pbEvt)
+		}
+		return pbEvts
+	}
+
+	for name, tc := range map[string]struct {
+		nilReq  bool
+		noEvts  bool
+		req     *mgmtpb.SystemGetEventsReq
+		expResp *mgmtpb.SystemGetEventsResp
+		expErr  error
+	}{
+		"nil req": {
+			nilReq: true,
+			expErr: errors.New("nil request"),
+		},
+		"empty history": {
+			noEvts:  true,
+			req:     &mgmtpb.SystemGetEventsReq{},
+			expResp: &mgmtpb.SystemGetEventsResp{},
+		},
+		"invalid ranks": {
+			req:    &mgmtpb.SystemGetEventsReq{Ranks: "foo"},
+			expErr: errors.New("invalid ranks"),
+		},
+		"invalid since": {
+			req:    &mgmtpb.SystemGetEventsReq{Since: "yesterday"},
+			expErr: errors.New("invalid since"),
+		},
+		"unfiltered": {
+			req: &mgmtpb.SystemGetEventsReq{},
+			expResp: &mgmtpb.SystemGetEventsResp{
+				Events: toPB(evtDied, evtPSR, evtSpeed),
+			},
+		},
+		"filtered by rank": {
+			req: &mgmtpb.SystemGetEventsReq{Ranks: "1,3"},
+			expResp: &mgmtpb.SystemGetEventsResp{
+				Events: toPB(evtDied, evtSpeed),
+			},
+		},
+		"filtered by severity": {
+			req: &mgmtpb.SystemGetEventsReq{
+				Severity: events.RASSeverityError.Uint32(),
+			},
+			expResp: &mgmtpb.SystemGetEventsResp{
+				Events: toPB(evtDied, evtPSR),
+			},
+		},
+		"filtered by id and pool": {
+			req: &mgmtpb.SystemGetEventsReq{
+				Ids:      []uint32{events.RASPoolRepsUpdate.Uint32()},
+				PoolUuid: poolUUID,
+			},
+			expResp: &mgmtpb.SystemGetEventsResp{
+				Events: toPB(evtPSR),
+			},
+		},
+		"limited": {
+			req: &mgmtpb.SystemGetEventsReq{Limit: 1},
+			expResp: &mgmtpb.SystemGetEventsResp{
+				Events: toPB(evtSpeed),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if !tc.noEvts {
+				for _, evt := range []*events.RASEvent{evtDied, evtPSR, evtSpeed} {
+					if err := svc.sysdb.AddEvent(evt); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			req := tc.req
+			if tc.nilReq {
+				req = nil
+			} else {
+				req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemGetEvents(test.Context(t), req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_Join(t *testing.T) {
 	curMember := mockMember(t, 0, 0, "excluded")
 	newMember := mockMember(t, 1, 1, "joined")
@@ -1998,10 +2229,11 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				},
 			},
 			expResp: &mgmtpb.JoinResp{
-				Status:     0,
-				Rank:       curMember.Rank.Uint32(),
-				State:      mgmtpb.JoinResp_IN,
-				MapVersion: 2,
+				Status:       0,
+				Rank:         curMember.Rank.Uint32(),
+				State:        mgmtpb.JoinResp_IN,
+				MapVersion:   2,
+				AccessPoints: []string{"127.0.0.1:10001"},
 			},
 		},
 		"rejoining host; NilRank": {
@@ -2023,10 +2255,11 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				},
 			},
 			expResp: &mgmtpb.JoinResp{
-				Status:     0,
-				Rank:       curMember.Rank.Uint32(),
-				State:      mgmtpb.JoinResp_IN,
-				MapVersion: 2,
+				Status:       0,
+				Rank:         curMember.Rank.Uint32(),
+				State:        mgmtpb.JoinResp_IN,
+				MapVersion:   2,
+				AccessPoints: []string{"127.0.0.1:10001"},
 			},
 		},
 		"provider doesn't match": {
@@ -2070,10 +2303,11 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				},
 			},
 			expResp: &mgmtpb.JoinResp{
-				Status:     0,
-				Rank:       curMember.Rank.Uint32(),
-				State:      mgmtpb.JoinResp_IN,
-				MapVersion: 2,
+				Status:       0,
+				Rank:         curMember.Rank.Uint32(),
+				State:        mgmtpb.JoinResp_IN,
+				MapVersion:   2,
+				AccessPoints: []string{"127.0.0.1:10001"},
 			},
 		},
 		"new host (non local)": {
@@ -2094,11 +2328,12 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				},
 			},
 			expResp: &mgmtpb.JoinResp{
-				Status:     0,
-				Rank:       newMember.Rank.Uint32(),
-				State:      mgmtpb.JoinResp_IN,
-				LocalJoin:  false,
-				MapVersion: 2,
+				Status:       0,
+				Rank:         newMember.Rank.Uint32(),
+				State:        mgmtpb.JoinResp_IN,
+				LocalJoin:    false,
+				MapVersion:   2,
+				AccessPoints: []string{"127.0.0.1:10001"},
 			},
 		},
 		"new host (local)": {
@@ -2120,11 +2355,12 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				},
 			},
 			expResp: &mgmtpb.JoinResp{
-				Status:     0,
-				Rank:       newMember.Rank.Uint32(),
-				State:      mgmtpb.JoinResp_IN,
-				LocalJoin:  true,
-				MapVersion: 2,
+				Status:       0,
+				Rank:         newMember.Rank.Uint32(),
+				State:        mgmtpb.JoinResp_IN,
+				LocalJoin:    true,
+				MapVersion:   2,
+				AccessPoints: []string{"127.0.0.1:10001"},
 			},
 		},
 	} {
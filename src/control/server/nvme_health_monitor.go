@@ -0,0 +1,363 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	// nvmeHealthSampleInterval is the interval at which the health of the
+	// NVMe devices assigned to the engines is sampled.
+	nvmeHealthSampleInterval = time.Hour
+	// nvmeHealthHistoryLen is the number of samples kept for each device.
+	nvmeHealthHistoryLen = 30 * 24
+	// nvmeWearOutWarnPeriod is the estimated time remaining before a device
+	// reaches its rated endurance at which warnings start to be raised.
+	nvmeWearOutWarnPeriod = 30 * 24 * time.Hour
+	// nvmeWearOutWarnInterval limits how often the warning is repeated for
+	// a device.
+	nvmeWearOutWarnInterval = 24 * time.Hour
+)
+
+var nvmeWearDaysKey = []string{"nvme", "wear", "days_remaining"}
+
+type nvmeDevHistory struct {
+	trAddr string
+	rank   ranklist.Rank
+	hist   *storage.NvmeHealthHistory
+}
+
+// policyMatch records a health policy rule that matched a device history.
+type policyMatch struct {
+	rule   *storage.BdevHealthRule
+	reason string
+}
+
+// nvmeHealthMonitor periodically samples the health of the NVMe devices used
+// by the engines, keeps a bounded history for each device, raises RAS warnings
+// for devices that are estimated to wear out soon and applies the configured
+// health policy.
+type nvmeHealthMonitor struct {
+	sync.RWMutex
+	log       logging.Logger
+	harness   *EngineHarness
+	pub       events.Publisher
+	policy    *storage.BdevHealthPolicy
+	rpcClient control.UnaryInvoker
+	devices   map[string]*nvmeDevHistory
+	warned    map[string]time.Time
+	matched   map[string]map[string]bool
+	getNow    func() time.Time
+}
+
+func newNvmeHealthMonitor(log logging.Logger, harness *EngineHarness, pub events.Publisher, policy *storage.BdevHealthPolicy, rpcClient control.UnaryInvoker) *nvmeHealthMonitor {
+	if policy == nil {
+		policy = &storage.BdevHealthPolicy{}
+	}
+
+	return &nvmeHealthMonitor{
+		log:       log,
+		harness:   harness,
+		pub:       pub,
+		policy:    policy,
+		rpcClient: rpcClient,
+		devices:   make(map[string]*nvmeDevHistory),
+		warned:    make(map[string]time.Time),
+		matched:   make(map[string]map[string]bool),
+		getNow:    time.Now,
+	}
+}
+
+func newNvmeWearOutEvent(devUUID, trAddr string, rank ranklist.Rank, est *storage.NvmeWearEstimate) *events.RASEvent {
+	msg := fmt.Sprintf("NVMe device %s estimated to reach rated endurance in %.1f day(s)",
+		trAddr, est.DaysRemaining)
+	info := fmt.Sprintf("uuid: %s, wear: %.1f%%, new media errors: %d", devUUID,
+		est.Wear*100, est.NewMediaErrors)
+
+	return events.NewGenericEvent(events.RASNVMeWearOut, events.RASSeverityWarning, msg, info).
+		WithRank(rank.Uint32())
+}
+
+func newNvmeHealthPolicyEvent(devUUID, trAddr string, rank ranklist.Rank, msg string) *events.RASEvent {
+	return events.NewGenericEvent(events.RASNVMeHealthPolicy, events.RASSeverityWarning, msg,
+		fmt.Sprintf("uuid: %s, traddr: %s", devUUID, trAddr)).
+		WithRank(rank.Uint32())
+}
+
+// evaluatePolicy returns the policy rules that newly match the history of a
+// device. A rule that has matched doesn't match again until its condition has
+// cleared.
+func (m *nvmeHealthMonitor) evaluatePolicy(devUUID string, hist *storage.NvmeHealthHistory) []*policyMatch {
+	var matches []*policyMatch
+	for _, rule := range m.policy.Rules {
+		matched, reason := rule.Evaluate(hist)
+		if !matched {
+			delete(m.matched[devUUID], rule.Name)
+			continue
+		}
+		if m.matched[devUUID][rule.Name] {
+			continue
+		}
+
+		if m.matched[devUUID] == nil {
+			m.matched[devUUID] = make(map[string]bool)
+		}
+		m.matched[devUUID][rule.Name] = true
+		matches = append(matches, &policyMatch{rule: rule, reason: reason})
+	}
+
+	return matches
+}
+
+// record adds a health sample to the history of a device, raises a warning
+// if the device is estimated to wear out within the warning period and returns
+// the health policy rules that newly match the device.
+func (m *nvmeHealthMonitor) record(devUUID, trAddr string, rank ranklist.Rank, health *storage.NvmeHealth) []*policyMatch {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.getNow()
+	dev, found := m.devices[devUUID]
+	if !found {
+		dev = &nvmeDevHistory{hist: storage.NewNvmeHealthHistory(nvmeHealthHistoryLen)}
+		m.devices[devUUID] = dev
+	}
+	dev.trAddr = trAddr
+	dev.rank = rank
+	dev.hist.Add(storage.NewNvmeHealthSample(now, health))
+
+	est := dev.hist.Estimate()
+	if est.DaysRemaining >= 0 {
+		metrics.SetGaugeWithLabels(nvmeWearDaysKey, float32(est.DaysRemaining),
+			[]metrics.Label{{Name: "device", Value: devUUID}})
+	}
+
+	if est.WearsOutWithin(nvmeWearOutWarnPeriod) {
+		if last, found := m.warned[devUUID]; !found || now.Sub(last) >= nvmeWearOutWarnInterval {
+			m.warned[devUUID] = now
+
+			evt := newNvmeWearOutEvent(devUUID, trAddr, rank, est)
+			m.log.Notice(evt.Msg)
+			m.pub.Publish(evt)
+		}
+	}
+
+	return m.evaluatePolicy(devUUID, dev.hist)
+}
+
+// drainDevice queues a drain of the targets on the device for each pool with
+// targets on the device. The drains are run by the MS pool operation queue so
+// that health sampling isn't held up waiting for rebuilds to finish.
+func (m *nvmeHealthMonitor) drainDevice(ctx context.Context, ei Engine, rank ranklist.Rank, dev *ctlpb.SmdDevice) error {
+	resp, err := listSmdPools(ctx, ei)
+	if err != nil {
+		return err
+	}
+
+	devTgts := make(map[int32]bool)
+	for _, tgt := range dev.TgtIds {
+		devTgts[tgt] = true
+	}
+
+	for _, pool := range resp.Pools {
+		var tgts []uint32
+		for _, tgt := range pool.TgtIds {
+			if devTgts[tgt] {
+				tgts = append(tgts, uint32(tgt))
+			}
+		}
+		if len(tgts) == 0 {
+			continue
+		}
+
+		req := &control.PoolOpScheduleReq{
+			ID:    pool.Uuid,
+			Drain: &control.PoolDrainReq{ID: pool.Uuid, Rank: rank, Targetidx: tgts},
+		}
+		resp, err := control.PoolOpSchedule(ctx, m.rpcClient, req)
+		if err != nil {
+			return errors.Wrapf(err, "pool %s", pool.Uuid)
+		}
+		m.log.Debugf("queued drain of targets %v on rank %d in pool %s (op %s)", tgts, rank,
+			pool.Uuid, resp.OpID)
+	}
+
+	return nil
+}
+
+func manageReqStatus(res *ctlpb.SmdManageResp_Result, err error) error {
+	if err != nil {
+		return err
+	}
+	if res.Status != 0 {
+		return daos.Status(res.Status)
+	}
+	return nil
+}
+
+// takeAction takes the given policy action on the device.
+func (m *nvmeHealthMonitor) takeAction(ctx context.Context, ei Engine, rank ranklist.Rank, dev *ctlpb.SmdDevice, action storage.BdevPolicyAction) error {
+	switch action {
+	case storage.BdevActionDrain:
+		return m.drainDevice(ctx, ei, rank, dev)
+	case storage.BdevActionSetFaulty:
+		return manageReqStatus(sendManageReq(ctx, ei, drpc.MethodSetFaultyState,
+			&ctlpb.SetFaultyReq{Uuid: dev.Uuid}))
+	case storage.BdevActionLED:
+		return manageReqStatus(sendManageReq(ctx, ei, drpc.MethodLedManage,
+			&ctlpb.LedManageReq{
+				Ids:       dev.Ctrlr.PciAddr,
+				LedAction: ctlpb.LedAction_SET,
+				LedState:  ctlpb.LedState_QUICK_BLINK,
+			}))
+	default:
+		return errors.Errorf("unsupported action %q", action)
+	}
+}
+
+// applyPolicy takes the actions of the matched policy rules on the device, or
+// only reports them in dry-run mode.
+func (m *nvmeHealthMonitor) applyPolicy(ctx context.Context, ei Engine, rank ranklist.Rank, dev *ctlpb.SmdDevice, matches []*policyMatch) {
+	for _, match := range matches {
+		var taken []string
+		for _, action := range storage.BdevPolicyActions {
+			if action == storage.BdevActionEvent || !match.rule.HasAction(action) {
+				continue
+			}
+			if !m.policy.DryRun {
+				if err := m.takeAction(ctx, ei, rank, dev, action); err != nil {
+					m.log.Errorf("nvme health policy rule %q: %s on device %s failed: %s",
+						match.rule.Name, action, dev.Uuid, err)
+					continue
+				}
+			}
+			taken = append(taken, string(action))
+		}
+
+		actions := "none"
+		if len(taken) > 0 {
+			actions = strings.Join(taken, ", ")
+		}
+		msg := fmt.Sprintf("NVMe device %s matched health policy rule %q (%s), actions taken: %s",
+			dev.Ctrlr.PciAddr, match.rule.Name, match.reason, actions)
+		if m.policy.DryRun {
+			msg = fmt.Sprintf("dry-run: NVMe device %s matched health policy rule %q (%s), "+
+				"actions that would be taken: %s", dev.Ctrlr.PciAddr, match.rule.Name,
+				match.reason, actions)
+		}
+
+		m.log.Notice(msg)
+		if m.policy.DryRun || match.rule.HasAction(storage.BdevActionEvent) {
+			m.pub.Publish(newNvmeHealthPolicyEvent(dev.Uuid, dev.Ctrlr.PciAddr, rank, msg))
+		}
+	}
+}
+
+// sample retrieves the health of the devices used by each ready engine and
+// records it in the device histories.
+func (m *nvmeHealthMonitor) sample(ctx context.Context) {
+	for _, ei := range m.harness.Instances() {
+		if !ei.IsReady() {
+			continue
+		}
+
+		rResp, err := smdQueryEngine(ctx, ei, &ctlpb.SmdQueryReq{IncludeBioHealth: true})
+		if err != nil {
+			m.log.Errorf("nvme health sample: %s", err)
+			continue
+		}
+
+		for _, dev := range rResp.Devices {
+			if dev.Ctrlr == nil || dev.Ctrlr.HealthStats == nil {
+				continue
+			}
+			health := new(storage.NvmeHealth)
+			if err := convert.Types(dev.Ctrlr.HealthStats, health); err != nil {
+				m.log.Errorf("nvme health sample: convert health of %s: %s", dev.Uuid, err)
+				continue
+			}
+			rank := ranklist.Rank(rResp.Rank)
+			if matches := m.record(dev.Uuid, dev.Ctrlr.PciAddr, rank, health); len(matches) > 0 {
+				m.applyPolicy(ctx, ei, rank, dev, matches)
+			}
+		}
+	}
+}
+
+func (m *nvmeHealthMonitor) start(ctx context.Context) {
+	interval := nvmeHealthSampleInterval
+	if m.policy.SampleInterval > 0 {
+		interval = m.policy.SampleInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.sample(ctx)
+			}
+		}
+	}()
+}
+
+// trends returns the wear estimate of each device with a sampled history,
+// ordered by rank and device UUID.
+func (m *nvmeHealthMonitor) trends(includeSamples bool) ([]*ctlpb.NvmeHealthTrend, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	trends := make([]*ctlpb.NvmeHealthTrend, 0, len(m.devices))
+	for devUUID, dev := range m.devices {
+		trend := new(ctlpb.NvmeHealthTrend)
+		if err := convert.Types(dev.hist.Estimate(), trend); err != nil {
+			return nil, errors.Wrapf(err, "convert wear estimate of %s", devUUID)
+		}
+		trend.Uuid = devUUID
+		trend.TrAddr = dev.trAddr
+		trend.Rank = dev.rank.Uint32()
+
+		if includeSamples {
+			if err := convert.Types(dev.hist.Samples, &trend.Samples); err != nil {
+				return nil, errors.Wrapf(err, "convert health samples of %s", devUUID)
+			}
+		}
+		trends = append(trends, trend)
+	}
+
+	sort.Slice(trends, func(i, j int) bool {
+		if trends[i].Rank != trends[j].Rank {
+			return trends[i].Rank < trends[j].Rank
+		}
+		return trends[i].Uuid < trends[j].Uuid
+	})
+
+	return trends, nil
+}
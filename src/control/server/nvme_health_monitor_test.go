@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServer_nvmeHealthMonitor_record(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	pub := &mockPublisher{}
+	mon := newNvmeHealthMonitor(log, nil, pub, nil, nil)
+	now := time.Now()
+	tib := uint64(1 << 40)
+	wornUUID := test.MockUUID(1)
+	healthyUUID := test.MockUUID(2)
+
+	for _, step := range []struct {
+		name      string
+		elapsed   time.Duration
+		wear      uint64
+		written   uint64
+		expEvents int
+	}{
+		{
+			name:    "first sample",
+			wear:    90,
+			written: 9 * tib,
+		},
+		{
+			name:      "wears out within a day",
+			elapsed:   24 * time.Hour,
+			wear:      91,
+			written:   10 * tib,
+			expEvents: 1,
+		},
+		{
+			name:      "already warned",
+			elapsed:   25 * time.Hour,
+			wear:      91,
+			written:   10 * tib,
+			expEvents: 1,
+		},
+		{
+			name:      "repeated after a day",
+			elapsed:   49 * time.Hour,
+			wear:      92,
+			written:   11 * tib,
+			expEvents: 2,
+		},
+	} {
+		mon.getNow = func() time.Time { return now.Add(step.elapsed) }
+		mon.record(wornUUID, "0000:81:00.0", ranklist.Rank(1), &storage.NvmeHealth{
+			MediaWearRaw:     step.wear * 1024,
+			NandBytesWritten: step.written,
+		})
+		mon.record(healthyUUID, "0000:01:00.0", ranklist.Rank(0), &storage.NvmeHealth{
+			WearLevelingCntNorm: 99,
+			NandBytesWritten:    step.written,
+		})
+
+		test.AssertEqual(t, step.expEvents, len(pub.published), step.name+": unexpected number of events")
+		if step.expEvents == 0 {
+			continue
+		}
+		evt := pub.published[len(pub.published)-1]
+		test.AssertEqual(t, events.RASNVMeWearOut, evt.ID, step.name+": unexpected event ID")
+		test.AssertEqual(t, events.RASSeverityWarning, evt.Severity, step.name+": unexpected severity")
+		test.AssertEqual(t, uint32(1), evt.Rank, step.name+": unexpected rank")
+		test.AssertTrue(t, strings.Contains(evt.Msg, "0000:81:00.0"),
+			step.name+": unexpected message: "+evt.Msg)
+	}
+
+	trends, err := mon.trends(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, len(trends), "unexpected number of trends")
+	test.AssertEqual(t, healthyUUID, trends[0].Uuid, "trends not sorted by rank")
+	test.AssertEqual(t, 0, len(trends[0].Samples), "samples unexpectedly included")
+	test.AssertEqual(t, 0.92, trends[1].Wear, "unexpected wear")
+	test.AssertTrue(t, trends[1].DaysRemaining > 0 && trends[1].DaysRemaining < 1,
+		"unexpected days remaining")
+
+	trends, err = mon.trends(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 4, len(trends[1].Samples), "unexpected number of samples")
+}
+
+func TestServer_nvmeHealthMonitor_policy(t *testing.T) {
+	devUUID := test.MockUUID(1)
+	dev := &ctlpb.SmdDevice{
+		Uuid:  devUUID,
+		Ctrlr: &ctlpb.NvmeController{PciAddr: "0000:81:00.0"},
+	}
+
+	for name, tc := range map[string]struct {
+		dryRun     bool
+		actions    []storage.BdevPolicyAction
+		spareWarns []bool
+		expMatches []int
+		expEvents  int
+		expMsg     string
+	}{
+		"dry run": {
+			dryRun: true,
+			actions: []storage.BdevPolicyAction{
+				storage.BdevActionSetFaulty, storage.BdevActionDrain,
+			},
+			spareWarns: []bool{false, true, true, false, true},
+			expMatches: []int{0, 1, 0, 0, 1},
+			expEvents:  2,
+			expMsg:     "dry-run: NVMe device 0000:81:00.0 matched health policy rule \"spare\" (available spare warning for 0s), actions that would be taken: drain, set_faulty",
+		},
+		"event only": {
+			actions:    []storage.BdevPolicyAction{storage.BdevActionEvent},
+			spareWarns: []bool{true, true},
+			expMatches: []int{1, 0},
+			expEvents:  1,
+			expMsg:     "NVMe device 0000:81:00.0 matched health policy rule \"spare\" (available spare warning for 0s), actions taken: none",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			pub := &mockPublisher{}
+			mon := newNvmeHealthMonitor(log, nil, pub, &storage.BdevHealthPolicy{
+				DryRun: tc.dryRun,
+				Rules: []*storage.BdevHealthRule{
+					{
+						Name:      "spare",
+						Condition: storage.BdevConditionSpareWarning,
+						Actions:   tc.actions,
+					},
+				},
+			}, nil)
+			now := time.Now()
+
+			for i, warn := range tc.spareWarns {
+				mon.getNow = func() time.Time { return now.Add(time.Duration(i) * time.Hour) }
+				matches := mon.record(devUUID, dev.Ctrlr.PciAddr, ranklist.Rank(1),
+					&storage.NvmeHealth{AvailSpareWarn: warn})
+				test.AssertEqual(t, tc.expMatches[i], len(matches),
+					fmt.Sprintf("sample %d: unexpected number of matches", i))
+				mon.applyPolicy(test.Context(t), nil, ranklist.Rank(1), dev, matches)
+			}
+
+			test.AssertEqual(t, tc.expEvents, len(pub.published), "unexpected number of events")
+			for _, evt := range pub.published {
+				test.AssertEqual(t, events.RASNVMeHealthPolicy, evt.ID, "unexpected event ID")
+				test.AssertEqual(t, tc.expMsg, evt.Msg, "unexpected event message")
+			}
+		})
+	}
+}
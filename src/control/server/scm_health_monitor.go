@@ -0,0 +1,177 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// scmHealthCheckInterval is the interval at which the PMem namespaces backing
+// the engines are checked for bad blocks.
+const scmHealthCheckInterval = time.Hour
+
+// scmHealthMonitor reports the health and usage of the SCM assigned to the
+// engines and raises RAS events when bad blocks are found on PMem namespaces.
+type scmHealthMonitor struct {
+	sync.Mutex
+	log      logging.Logger
+	harness  *EngineHarness
+	pub      events.Publisher
+	reported map[string]uint64 // bad-block sector counts already reported
+}
+
+func newScmHealthMonitor(log logging.Logger, harness *EngineHarness, pub events.Publisher) *scmHealthMonitor {
+	return &scmHealthMonitor{
+		log:      log,
+		harness:  harness,
+		pub:      pub,
+		reported: make(map[string]uint64),
+	}
+}
+
+func newScmBadBlocksEvent(ns *storage.ScmNamespaceHealth, rank ranklist.Rank) *events.RASEvent {
+	msg := fmt.Sprintf("PMem namespace %s has %d bad sector(s) in %d range(s)",
+		ns.BlockDevice, ns.BadBlockCount(), len(ns.BadBlocks))
+	info := fmt.Sprintf("uuid: %s, health: %s, dirty shutdowns: %d", ns.UUID,
+		ns.HealthState, ns.DirtyShutdowns)
+
+	return events.NewGenericEvent(events.RASScmBadBlocks, events.RASSeverityError, msg, info).
+		WithRank(rank.Uint32())
+}
+
+// checkBadBlocks raises an event if the number of bad blocks on a namespace
+// has grown since the last report.
+func (m *scmHealthMonitor) checkBadBlocks(ns *storage.ScmNamespaceHealth, rank ranklist.Rank) {
+	m.Lock()
+	defer m.Unlock()
+
+	count := ns.BadBlockCount()
+	if count <= m.reported[ns.UUID] {
+		m.reported[ns.UUID] = count
+		return
+	}
+	m.reported[ns.UUID] = count
+
+	m.log.Errorf("pmem namespace %s (rank %s): %d bad sector(s)", ns.BlockDevice, rank, count)
+	if m.pub != nil {
+		m.pub.Publish(newScmBadBlocksEvent(ns, rank))
+	}
+}
+
+// findNamespaces returns the PMem namespaces whose block devices are in the
+// given device list.
+func findNamespaces(nss []*storage.ScmNamespaceHealth, devList []string) []*storage.ScmNamespaceHealth {
+	var found []*storage.ScmNamespaceHealth
+	for _, ns := range nss {
+		for _, path := range devList {
+			if filepath.Base(path) == ns.BlockDevice {
+				found = append(found, ns)
+				break
+			}
+		}
+	}
+
+	return found
+}
+
+// query reports the SCM health and usage of each engine. Namespace health is
+// only retrieved for engines configured with PMem.
+func (m *scmHealthMonitor) query() ([]*ctlpb.EngineScmHealth, error) {
+	var nsHealth []*storage.ScmNamespaceHealth
+	var healthQueried bool
+
+	instances := m.harness.Instances()
+	resp := make([]*ctlpb.EngineScmHealth, 0, len(instances))
+	for _, ei := range instances {
+		cfg, err := ei.GetStorage().GetScmConfig()
+		if err != nil {
+			return nil, errors.Wrapf(err, "instance %d", ei.Index())
+		}
+
+		rank, err := ei.GetRank()
+		if err != nil {
+			rank = ranklist.NilRank
+		}
+
+		esh := &ctlpb.EngineScmHealth{
+			InstanceIdx: ei.Index(),
+			Rank:        rank.Uint32(),
+			Class:       cfg.Class.String(),
+			Path:        cfg.Scm.MountPoint,
+			ScmSize:     uint64(humanize.GiByte * cfg.Scm.RamdiskSize),
+		}
+
+		mounted, err := ei.GetStorage().ScmIsMounted()
+		if err != nil {
+			m.log.Errorf("instance %d: check scm mount: %s", ei.Index(), err)
+		}
+		if mounted {
+			usage, err := ei.GetStorage().GetScmUsage()
+			if err != nil {
+				return nil, errors.Wrapf(err, "instance %d", ei.Index())
+			}
+			esh.TotalBytes = usage.TotalBytes
+			esh.AvailBytes = usage.AvailBytes
+		}
+
+		if cfg.Class == storage.ClassDcpm {
+			if !healthQueried {
+				hResp, err := ei.GetStorage().QueryScmHealth(storage.ScmHealthRequest{})
+				if err != nil {
+					return nil, errors.Wrap(err, "query pmem namespace health")
+				}
+				nsHealth = hResp.Namespaces
+				healthQueried = true
+			}
+
+			nss := findNamespaces(nsHealth, cfg.Scm.DeviceList)
+			for _, ns := range nss {
+				m.checkBadBlocks(ns, rank)
+			}
+			if err := convert.Types(nss, &esh.Namespaces); err != nil {
+				return nil, errors.Wrapf(err, "instance %d: convert namespace health",
+					ei.Index())
+			}
+		}
+
+		resp = append(resp, esh)
+	}
+
+	return resp, nil
+}
+
+func (m *scmHealthMonitor) start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(scmHealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := m.query(); err != nil {
+					m.log.Errorf("scm health check: %s", err)
+				}
+			}
+		}
+	}()
+}
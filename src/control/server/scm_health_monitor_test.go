@@ -0,0 +1,189 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/provider/system"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/scm"
+)
+
+func TestServer_scmHealthMonitor_query(t *testing.T) {
+	pmem0 := &storage.ScmNamespaceHealth{
+		UUID:        test.MockUUID(0),
+		BlockDevice: "pmem0",
+		Name:        "namespace0.0",
+		Size:        3 << 40,
+		HealthState: "non-critical",
+		BadBlocks: []*storage.ScmBadBlock{
+			{Offset: 8, Length: 2, Dimms: []string{"nmem0"}},
+		},
+	}
+	pmem1 := &storage.ScmNamespaceHealth{
+		UUID:        test.MockUUID(1),
+		BlockDevice: "pmem1",
+		Name:        "namespace1.0",
+		NumaNode:    1,
+		Size:        3 << 40,
+		HealthState: "ok",
+	}
+	dcpmTier := func(mnt, dev string) *storage.TierConfig {
+		return storage.NewTierConfig().
+			WithStorageClass(storage.ClassDcpm.String()).
+			WithScmMountPoint(mnt).
+			WithScmDeviceList(dev)
+	}
+
+	for name, tc := range map[string]struct {
+		scmTiers  []*storage.TierConfig
+		smbc      *scm.MockBackendConfig
+		smsc      *system.MockSysConfig
+		expResp   []*ctlpb.EngineScmHealth
+		expEvents int
+		expErr    error
+	}{
+		"ram; not mounted": {
+			scmTiers: []*storage.TierConfig{
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassRam.String()).
+					WithScmMountPoint("/mnt/daos0").
+					WithScmRamdiskSize(16),
+			},
+			expResp: []*ctlpb.EngineScmHealth{
+				{Class: "ram", Path: "/mnt/daos0", ScmSize: 16 << 30},
+			},
+		},
+		"ram; mounted": {
+			scmTiers: []*storage.TierConfig{
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassRam.String()).
+					WithScmMountPoint("/mnt/daos0").
+					WithScmRamdiskSize(16),
+			},
+			smsc: &system.MockSysConfig{
+				IsMountedBool: true,
+				GetfsUsageResps: []system.GetfsUsageRetval{
+					{Total: 16 << 30, Avail: 4 << 30},
+					{Total: 16 << 30, Avail: 4 << 30},
+				},
+			},
+			expResp: []*ctlpb.EngineScmHealth{
+				{
+					Class:      "ram",
+					Path:       "/mnt/daos0",
+					ScmSize:    16 << 30,
+					TotalBytes: 16 << 30,
+					AvailBytes: 4 << 30,
+				},
+			},
+		},
+		"dcpm; health query fails": {
+			scmTiers: []*storage.TierConfig{
+				dcpmTier("/mnt/daos0", "/dev/pmem0"),
+			},
+			smbc: &scm.MockBackendConfig{
+				GetNamespaceHealthErr: errors.New("ndctl failed"),
+			},
+			expErr: errors.New("ndctl failed"),
+		},
+		"dcpm; bad blocks on one engine": {
+			scmTiers: []*storage.TierConfig{
+				dcpmTier("/mnt/daos0", "/dev/pmem0"),
+				dcpmTier("/mnt/daos1", "/dev/pmem1"),
+			},
+			smbc: &scm.MockBackendConfig{
+				GetNamespaceHealthRes: []*storage.ScmNamespaceHealth{pmem0, pmem1},
+			},
+			expResp: []*ctlpb.EngineScmHealth{
+				{
+					Class: "dcpm",
+					Path:  "/mnt/daos0",
+					Namespaces: []*ctlpb.ScmNamespaceHealth{
+						{
+							Uuid:        pmem0.UUID,
+							Blockdev:    "pmem0",
+							Dev:         "namespace0.0",
+							Size:        3 << 40,
+							HealthState: "non-critical",
+							Badblocks: []*ctlpb.ScmBadBlock{
+								{Offset: 8, Length: 2, Dimms: []string{"nmem0"}},
+							},
+						},
+					},
+				},
+				{
+					InstanceIdx: 1,
+					Rank:        1,
+					Class:       "dcpm",
+					Path:        "/mnt/daos1",
+					Namespaces: []*ctlpb.ScmNamespaceHealth{
+						{
+							Uuid:        pmem1.UUID,
+							Blockdev:    "pmem1",
+							Dev:         "namespace1.0",
+							NumaNode:    1,
+							Size:        3 << 40,
+							HealthState: "ok",
+						},
+					},
+				},
+			},
+			expEvents: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var engineCfgs []*engine.Config
+			for _, sc := range tc.scmTiers {
+				engineCfgs = append(engineCfgs, engine.MockConfig().WithStorage(sc))
+			}
+			cs := mockControlService(t, log, config.DefaultServer().WithEngines(engineCfgs...),
+				nil, tc.smbc, tc.smsc)
+
+			pub := &mockPublisher{}
+			mon := newScmHealthMonitor(log, cs.harness, pub)
+
+			// Repeated queries only raise events for new bad blocks.
+			for i := 0; i < 2; i++ {
+				gotResp, gotErr := mon.query()
+				test.CmpErr(t, tc.expErr, gotErr)
+				if tc.expErr != nil {
+					return
+				}
+
+				if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+					t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+				}
+				test.AssertEqual(t, tc.expEvents, len(pub.published),
+					"unexpected number of events")
+			}
+
+			if tc.expEvents == 0 {
+				return
+			}
+			evt := pub.published[0]
+			test.AssertEqual(t, events.RASScmBadBlocks, evt.ID, "unexpected event ID")
+			test.AssertEqual(t, events.RASSeverityError, evt.Severity, "unexpected severity")
+			test.AssertTrue(t, strings.Contains(evt.Msg, "pmem0 has 2 bad sector(s)"),
+				"unexpected message: "+evt.Msg)
+		})
+	}
+}
@@ -143,6 +143,7 @@ type server struct {
 	pubSub       *events.PubSub
 	evtForwarder *control.EventForwarder
 	evtLogger    *control.EventLogger
+	evtSinks     []*events.Sink
 	ctlSvc       *ControlService
 	mgmtSvc      *mgmtSvc
 	grpcServer   *grpc.Server
@@ -196,9 +197,10 @@ func CreateDatabaseConfig(cfg *config.Server) (*raft.DatabaseConfig, error) {
 	}
 
 	return &raft.DatabaseConfig{
-		Replicas:   dbReplicas,
-		RaftDir:    raftDir,
-		SystemName: cfg.SystemName,
+		Replicas:        dbReplicas,
+		RaftDir:         raftDir,
+		SystemName:      cfg.SystemName,
+		AuditMaxRecords: cfg.AuditMaxRecords,
 	}, nil
 }
 
@@ -233,12 +235,24 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 	// Create event distribution primitives.
 	srv.pubSub = events.NewPubSub(ctx, srv.log)
 	srv.OnShutdown(srv.pubSub.Close)
-	srv.evtForwarder = control.NewEventForwarder(rpcClient, srv.cfg.AccessPoints)
+	srv.evtForwarder = control.NewEventForwarder(rpcClient, srv.sysdb.AccessPoints())
+	srv.sysdb.OnReplicasChanged(srv.evtForwarder.SetAccessPoints)
 	srv.evtLogger = control.NewEventLogger(srv.log)
+	srv.evtSinks, err = events.NewSinks(ctx, srv.log, srv.cfg.RASSinks)
+	if err != nil {
+		return
+	}
+	for _, sink := range srv.evtSinks {
+		srv.OnShutdown(sink.Close)
+	}
 
 	srv.ctlSvc = NewControlService(srv.log, srv.harness, srv.cfg, srv.pubSub,
 		hwprov.DefaultFabricScanner(srv.log))
+	srv.ctlSvc.healthMon = newNvmeHealthMonitor(srv.log, srv.harness, srv.pubSub,
+		srv.cfg.NvmeHealthPolicy, rpcClient)
+	srv.ctlSvc.scmHealthMon = newScmHealthMonitor(srv.log, srv.harness, srv.pubSub)
 	srv.mgmtSvc = newMgmtSvc(srv.harness, srv.membership, srv.sysdb, rpcClient, srv.pubSub)
+	srv.mgmtSvc.accessCfg = srv.cfg.AccessControl
 
 	if err := srv.mgmtSvc.systemProps.UpdateCompPropVal(daos.SystemPropertyDaosSystem, func() string {
 		return srv.cfg.SystemName
@@ -314,11 +328,17 @@ func (srv *server) initNetwork() error {
 func (srv *server) createEngine(ctx context.Context, idx int, cfg *engine.Config) (*EngineInstance, error) {
 	// Closure to join an engine instance to a system using control API.
 	joinFn := func(ctxIn context.Context, req *control.SystemJoinReq) (*control.SystemJoinResp, error) {
-		req.SetHostList(srv.cfg.AccessPoints)
+		req.SetHostList(srv.sysdb.AccessPoints())
 		req.SetSystem(srv.cfg.SystemName)
 		req.ControlAddr = srv.ctlAddr
 
-		return control.SystemJoin(ctxIn, srv.mgmtSvc.rpcClient, req)
+		resp, err := control.SystemJoin(ctxIn, srv.mgmtSvc.rpcClient, req)
+		if err != nil {
+			return nil, err
+		}
+		srv.mgmtSvc.updateAccessPoints(resp.AccessPoints)
+
+		return resp, nil
 	}
 
 	sp := storage.DefaultProvider(srv.log, idx, &cfg.Storage).
@@ -389,7 +409,8 @@ func (srv *server) addEngines(ctx context.Context) error {
 
 // setupGrpc creates a new grpc server and registers services.
 func (srv *server) setupGrpc() error {
-	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader)
+	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.cfg.AccessControl,
+		newAuditLog(srv.log, srv.sysdb, srv.mgmtSvc.forwardAuditRecord))
 	if err != nil {
 		return err
 	}
@@ -476,6 +497,7 @@ func (srv *server) registerEvents() {
 	srv.sysdb.OnLeadershipLost(func() error {
 		srv.log.Infof("MS leader no longer running on %s", srv.hostname)
 		registerFollowerSubscriptions(srv)
+		srv.mgmtSvc.evtStreams.closeAll()
 		return nil
 	})
 }
@@ -513,6 +535,10 @@ func (srv *server) start(ctx context.Context) error {
 	}()
 
 	srv.mgmtSvc.startAsyncLoops(ctx)
+	go srv.mgmtSvc.eventPolicyLoop(ctx)
+	startCertMonitor(ctx, srv.log, srv.cfg.TransportConfig, srv.pubSub)
+	srv.ctlSvc.healthMon.start(ctx)
+	srv.ctlSvc.scmHealthMon.start(ctx)
 
 	if srv.cfg.AutoFormat {
 		srv.log.Notice("--auto flag set on server start so formatting storage now")
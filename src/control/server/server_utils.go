@@ -675,10 +675,24 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 // to local) events and starts forwarding events to the new MS leader.
 // Log events on the host that they were raised (and first published) on.
 // This is the initial behavior before leadership has been determined.
+// The RAS event policy continues to be applied to the events published on
+// this host.
 func registerFollowerSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	subscribeEventSinks(srv)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.evtForwarder)
+
+	srv.mgmtSvc.reapplyEventPolicy()
+	srv.mgmtSvc.requestEventPolicySync()
+}
+
+// subscribeEventSinks subscribes any configured RAS event sinks to receive
+// events raised on this host.
+func subscribeEventSinks(srv *server) {
+	for _, sink := range srv.evtSinks {
+		srv.pubSub.Subscribe(events.RASTypeAny, sink)
+	}
 }
 
 // registerLeaderSubscriptions stops forwarding events to MS and instead starts
@@ -686,6 +700,14 @@ func registerFollowerSubscriptions(srv *server) {
 func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	subscribeEventSinks(srv)
+	srv.pubSub.Subscribe(events.RASTypeAny,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			if err := srv.sysdb.AddEvent(evt); err != nil {
+				srv.log.Errorf("failed to record %s event in history: %s", evt.ID, err)
+			}
+		}))
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.mgmtSvc.evtStreams)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.membership)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.sysdb)
 	srv.pubSub.Subscribe(events.RASTypeStateChange,
@@ -719,12 +741,16 @@ func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Debounce(events.RASSwimRankDead, 0, func(ev *events.RASEvent) string {
 		return strconv.FormatUint(uint64(ev.Rank), 10) + ":" + strconv.FormatUint(ev.Incarnation, 10)
 	})
+
+	if err := srv.mgmtSvc.loadEventPolicy(); err != nil {
+		srv.log.Errorf("failed to apply RAS event policy: %s", err)
+	}
 }
 
 // getGrpcOpts generates a set of gRPC options for the server based on the supplied configuration.
-func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool) ([]grpc.ServerOption, error) {
+func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, acCfg *security.AccessControlConfig, al *auditLog) ([]grpc.ServerOption, error) {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		unaryLoggingInterceptor(log, ldrChk), // must be first in order to properly log errors
+		unaryLoggingInterceptor(log, al.db.IsLeader), // must be first in order to properly log errors
 		unaryErrorInterceptor,
 		unaryStatusInterceptor,
 		unaryVersionInterceptor(log),
@@ -738,14 +764,14 @@ func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldr
 	}
 	srvOpts := []grpc.ServerOption{tcOpt}
 
-	uintOpt, err := unaryInterceptorForTransportConfig(cfgTransport)
+	uintOpt, err := unaryInterceptorForTransportConfig(al, cfgTransport, acCfg)
 	if err != nil {
 		return nil, err
 	}
 	if uintOpt != nil {
 		unaryInterceptors = append(unaryInterceptors, uintOpt)
 	}
-	sintOpt, err := streamInterceptorForTransportConfig(cfgTransport)
+	sintOpt, err := streamInterceptorForTransportConfig(al, cfgTransport, acCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -753,6 +779,11 @@ func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldr
 		streamInterceptors = append(streamInterceptors, sintOpt)
 	}
 
+	// The audit interceptors must follow the access interceptors in order to
+	// identify the caller.
+	unaryInterceptors = append(unaryInterceptors, unaryAuditInterceptor(al))
+	streamInterceptors = append(streamInterceptors, streamAuditInterceptor(al))
+
 	return append(srvOpts, []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(streamInterceptors...),
@@ -0,0 +1,158 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"time"
+)
+
+const (
+	// mediaWearRawPerPercent is the MediaWearRaw value corresponding to 1%
+	// of the rated endurance of a device.
+	mediaWearRawPerPercent = 1024
+	secondsPerDay          = 24 * 60 * 60
+)
+
+// NvmeHealthSample records the health attributes of an NVMe device that are
+// tracked over time.
+type NvmeHealthSample struct {
+	Time                    time.Time `json:"time"`
+	MediaErrors             uint64    `json:"media_errs"`
+	MediaWearRaw            uint64    `json:"media_wear_raw"`
+	WearLevelingCntNorm     uint32    `json:"wear_leveling_cnt_norm"`
+	NandBytesWritten        uint64    `json:"nand_bytes_written"`
+	HostBytesWritten        uint64    `json:"host_bytes_written"`
+	ThermalThrottleEventCnt uint64    `json:"thermal_throttle_event_cnt"`
+	Temperature             uint32    `json:"temperature"`
+	AvailSpareWarn          bool      `json:"avail_spare_warn"`
+}
+
+// NewNvmeHealthSample returns a sample of the given health stats taken at the
+// given time.
+func NewNvmeHealthSample(ts time.Time, health *NvmeHealth) *NvmeHealthSample {
+	return &NvmeHealthSample{
+		Time:                    ts,
+		MediaErrors:             health.MediaErrors,
+		MediaWearRaw:            health.MediaWearRaw,
+		WearLevelingCntNorm:     uint32(health.WearLevelingCntNorm),
+		NandBytesWritten:        health.NandBytesWritten,
+		HostBytesWritten:        health.HostBytesWritten,
+		ThermalThrottleEventCnt: health.ThermalThrottleEventCnt,
+		Temperature:             health.Temperature,
+		AvailSpareWarn:          health.AvailSpareWarn,
+	}
+}
+
+// Wear returns the fraction of the rated endurance of the device that has
+// been used, or a negative value if the device doesn't report its wear.
+func (s *NvmeHealthSample) Wear() float64 {
+	switch {
+	case s.MediaWearRaw > 0:
+		return float64(s.MediaWearRaw) / mediaWearRawPerPercent / 100
+	case s.WearLevelingCntNorm > 0 && s.WearLevelingCntNorm <= 100:
+		// The normalized wear leveling count decreases from 100 as
+		// the device wears.
+		return float64(100-s.WearLevelingCntNorm) / 100
+	default:
+		return -1
+	}
+}
+
+// NvmeHealthHistory holds a bounded history of health samples for an NVMe
+// device, oldest first.
+type NvmeHealthHistory struct {
+	maxLen  int
+	Samples []*NvmeHealthSample
+}
+
+// NewNvmeHealthHistory returns a history that keeps up to maxLen samples.
+func NewNvmeHealthHistory(maxLen int) *NvmeHealthHistory {
+	if maxLen < 2 {
+		maxLen = 2
+	}
+	return &NvmeHealthHistory{maxLen: maxLen}
+}
+
+// Add appends a sample to the history, discarding the oldest sample if the
+// history is full.
+func (h *NvmeHealthHistory) Add(s *NvmeHealthSample) {
+	if len(h.Samples) >= h.maxLen {
+		h.Samples = append(h.Samples[:0], h.Samples[len(h.Samples)-h.maxLen+1:]...)
+	}
+	h.Samples = append(h.Samples, s)
+}
+
+// NvmeWearEstimate describes the wear of an NVMe device over its sampled
+// history.
+type NvmeWearEstimate struct {
+	// Wear is the fraction of the rated endurance used, negative if unknown.
+	Wear float64 `json:"wear"`
+	// WriteRate is the rate at which the NAND is written, in bytes/second.
+	WriteRate float64 `json:"write_rate"`
+	// DaysRemaining is the estimated number of days until the rated
+	// endurance is reached, negative if it can't be estimated.
+	DaysRemaining float64 `json:"days_remaining"`
+	// NewMediaErrors is the number of media errors seen over the history.
+	NewMediaErrors uint64 `json:"new_media_errs"`
+	// NewThrottleEvents is the number of thermal throttle events seen over
+	// the history.
+	NewThrottleEvents uint64 `json:"new_thermal_throttle_events"`
+}
+
+// WearsOutWithin returns true if the device is estimated to reach its rated
+// endurance within the given period.
+func (e *NvmeWearEstimate) WearsOutWithin(period time.Duration) bool {
+	return e.DaysRemaining >= 0 && e.DaysRemaining*secondsPerDay <= period.Seconds()
+}
+
+func counterDelta(first, last uint64) uint64 {
+	// Counters may be reset, e.g. if the device is replaced.
+	if last < first {
+		return last
+	}
+	return last - first
+}
+
+// Estimate returns an estimate of the remaining endurance of the device. The
+// remaining endurance is extrapolated from the rate at which the NAND has been
+// written over the history if the device reports it, or otherwise from the
+// rate at which the reported wear has increased.
+func (h *NvmeHealthHistory) Estimate() *NvmeWearEstimate {
+	est := &NvmeWearEstimate{Wear: -1, DaysRemaining: -1}
+	if len(h.Samples) == 0 {
+		return est
+	}
+
+	first, last := h.Samples[0], h.Samples[len(h.Samples)-1]
+	est.Wear = last.Wear()
+	est.NewMediaErrors = counterDelta(first.MediaErrors, last.MediaErrors)
+	est.NewThrottleEvents = counterDelta(first.ThermalThrottleEventCnt, last.ThermalThrottleEventCnt)
+
+	elapsed := last.Time.Sub(first.Time).Seconds()
+	if elapsed <= 0 {
+		return est
+	}
+	if last.NandBytesWritten >= first.NandBytesWritten {
+		est.WriteRate = float64(last.NandBytesWritten-first.NandBytesWritten) / elapsed
+	}
+
+	switch {
+	case est.Wear < 0:
+	case est.Wear >= 1:
+		est.DaysRemaining = 0
+	case est.WriteRate > 0 && est.Wear > 0 && last.NandBytesWritten > 0:
+		rated := float64(last.NandBytesWritten) / est.Wear
+		est.DaysRemaining = (rated - float64(last.NandBytesWritten)) / est.WriteRate / secondsPerDay
+	default:
+		if firstWear := first.Wear(); firstWear >= 0 && est.Wear > firstWear {
+			wearRate := (est.Wear - firstWear) / elapsed
+			est.DaysRemaining = (1 - est.Wear) / wearRate / secondsPerDay
+		}
+	}
+
+	return est
+}
@@ -0,0 +1,166 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func Test_NvmeHealthHistory_Add(t *testing.T) {
+	start := time.Now()
+	hist := NewNvmeHealthHistory(3)
+
+	for i := 0; i < 5; i++ {
+		hist.Add(&NvmeHealthSample{Time: start.Add(time.Duration(i) * time.Hour)})
+	}
+
+	test.AssertEqual(t, 3, len(hist.Samples), "unexpected history length")
+	test.AssertEqual(t, start.Add(2*time.Hour), hist.Samples[0].Time, "oldest samples not discarded")
+	test.AssertEqual(t, start.Add(4*time.Hour), hist.Samples[2].Time, "newest sample not last")
+}
+
+func Test_NvmeHealthHistory_Estimate(t *testing.T) {
+	start := time.Now()
+	day := 24 * time.Hour
+	tib := uint64(1 << 40)
+
+	for name, tc := range map[string]struct {
+		samples []*NvmeHealthSample
+		expEst  *NvmeWearEstimate
+	}{
+		"no samples": {
+			expEst: &NvmeWearEstimate{Wear: -1, DaysRemaining: -1},
+		},
+		"single sample": {
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaWearRaw: 10 * mediaWearRawPerPercent},
+			},
+			expEst: &NvmeWearEstimate{Wear: 0.1, DaysRemaining: -1},
+		},
+		"wear not reported": {
+			samples: []*NvmeHealthSample{
+				{Time: start, NandBytesWritten: tib},
+				{Time: start.Add(day), NandBytesWritten: 2 * tib},
+			},
+			expEst: &NvmeWearEstimate{
+				Wear:          -1,
+				WriteRate:     float64(tib) / day.Seconds(),
+				DaysRemaining: -1,
+			},
+		},
+		"estimate from write rate": {
+			// 10 TiB written at 50% wear; 1 TiB/day leaves 10 days.
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaWearRaw: 45 * mediaWearRawPerPercent, NandBytesWritten: 9 * tib},
+				{Time: start.Add(day), MediaWearRaw: 50 * mediaWearRawPerPercent, NandBytesWritten: 10 * tib},
+			},
+			expEst: &NvmeWearEstimate{
+				Wear:          0.5,
+				WriteRate:     float64(tib) / day.Seconds(),
+				DaysRemaining: 10,
+			},
+		},
+		"estimate from wear leveling count": {
+			// 2% wear per day with 20% remaining leaves 10 days.
+			samples: []*NvmeHealthSample{
+				{Time: start, WearLevelingCntNorm: 24},
+				{Time: start.Add(2 * day), WearLevelingCntNorm: 20},
+			},
+			expEst: &NvmeWearEstimate{
+				Wear:          0.8,
+				DaysRemaining: 10,
+			},
+		},
+		"no wear over history": {
+			samples: []*NvmeHealthSample{
+				{Time: start, WearLevelingCntNorm: 90},
+				{Time: start.Add(day), WearLevelingCntNorm: 90},
+			},
+			expEst: &NvmeWearEstimate{Wear: 0.1, DaysRemaining: -1},
+		},
+		"worn out": {
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaWearRaw: 100 * mediaWearRawPerPercent},
+				{Time: start.Add(day), MediaWearRaw: 101 * mediaWearRawPerPercent},
+			},
+			expEst: &NvmeWearEstimate{Wear: 1.01, DaysRemaining: 0},
+		},
+		"new errors and throttle events": {
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaErrors: 2, ThermalThrottleEventCnt: 5},
+				{Time: start.Add(day), MediaErrors: 7, ThermalThrottleEventCnt: 6},
+			},
+			expEst: &NvmeWearEstimate{
+				Wear:              -1,
+				DaysRemaining:     -1,
+				NewMediaErrors:    5,
+				NewThrottleEvents: 1,
+			},
+		},
+		"counters reset": {
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaErrors: 7},
+				{Time: start.Add(day), MediaErrors: 1},
+			},
+			expEst: &NvmeWearEstimate{
+				Wear:           -1,
+				DaysRemaining:  -1,
+				NewMediaErrors: 1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hist := NewNvmeHealthHistory(10)
+			for _, s := range tc.samples {
+				hist.Add(s)
+			}
+
+			cmpOpt := cmpopts.EquateApprox(0, 1e-9)
+			if diff := cmp.Diff(tc.expEst, hist.Estimate(), cmpOpt); diff != "" {
+				t.Fatalf("unexpected estimate (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func Test_NvmeWearEstimate_WearsOutWithin(t *testing.T) {
+	for name, tc := range map[string]struct {
+		daysRemaining float64
+		period        time.Duration
+		expResult     bool
+	}{
+		"unknown": {
+			daysRemaining: -1,
+			period:        time.Duration(math.MaxInt64),
+		},
+		"outside period": {
+			daysRemaining: 31,
+			period:        30 * 24 * time.Hour,
+		},
+		"within period": {
+			daysRemaining: 29.5,
+			period:        30 * 24 * time.Hour,
+			expResult:     true,
+		},
+		"worn out": {
+			period:    time.Hour,
+			expResult: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			est := &NvmeWearEstimate{DaysRemaining: tc.daysRemaining}
+			test.AssertEqual(t, tc.expResult, est.WearsOutWithin(tc.period), "unexpected result")
+		})
+	}
+}
@@ -0,0 +1,211 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// BdevHealthCondition identifies the NVMe device health condition evaluated
+// by a policy rule.
+type BdevHealthCondition string
+
+const (
+	// BdevConditionTemperature matches when the device temperature stays
+	// above the threshold (in degrees Celsius) for the rule duration.
+	BdevConditionTemperature BdevHealthCondition = "temperature"
+	// BdevConditionSpareWarning matches when the device reports that its
+	// available spare capacity is below threshold for the rule duration.
+	BdevConditionSpareWarning BdevHealthCondition = "spare_warning"
+	// BdevConditionMediaErrorRate matches when at least threshold new
+	// media errors are reported within the rule duration.
+	BdevConditionMediaErrorRate BdevHealthCondition = "media_error_rate"
+)
+
+// BdevPolicyAction identifies an action taken when a policy rule matches.
+type BdevPolicyAction string
+
+const (
+	// BdevActionDrain drains the pool targets on the device.
+	BdevActionDrain BdevPolicyAction = "drain"
+	// BdevActionSetFaulty sets the device faulty.
+	BdevActionSetFaulty BdevPolicyAction = "set_faulty"
+	// BdevActionLED sets the device LED to the identify state.
+	BdevActionLED BdevPolicyAction = "led"
+	// BdevActionEvent raises a RAS event.
+	BdevActionEvent BdevPolicyAction = "event"
+)
+
+// BdevPolicyActions lists the supported actions in the order in which they are
+// taken.
+var BdevPolicyActions = []BdevPolicyAction{
+	BdevActionDrain, BdevActionSetFaulty, BdevActionLED, BdevActionEvent,
+}
+
+// BdevHealthRule describes a condition on the sampled health of an NVMe device
+// and the actions to take when it matches.
+type BdevHealthRule struct {
+	Name      string              `yaml:"name"`
+	Condition BdevHealthCondition `yaml:"condition"`
+	Threshold uint64              `yaml:"threshold,omitempty"`
+	Duration  time.Duration       `yaml:"duration,omitempty"`
+	Actions   []BdevPolicyAction  `yaml:"actions"`
+}
+
+// HasAction returns true if the rule takes the given action.
+func (r *BdevHealthRule) HasAction(action BdevPolicyAction) bool {
+	for _, a := range r.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the rule is invalid.
+func (r *BdevHealthRule) Validate() error {
+	if r == nil {
+		return errors.New("nil rule")
+	}
+	if r.Name == "" {
+		return errors.New("name must be set")
+	}
+
+	switch r.Condition {
+	case BdevConditionTemperature:
+		if r.Threshold == 0 {
+			return errors.Errorf("%s condition requires a threshold", r.Condition)
+		}
+	case BdevConditionSpareWarning:
+	case BdevConditionMediaErrorRate:
+		if r.Threshold == 0 || r.Duration <= 0 {
+			return errors.Errorf("%s condition requires a threshold and duration", r.Condition)
+		}
+	default:
+		return errors.Errorf("unknown condition %q", r.Condition)
+	}
+
+	if len(r.Actions) == 0 {
+		return errors.New("at least one action must be set")
+	}
+	for _, action := range r.Actions {
+		known := false
+		for _, a := range BdevPolicyActions {
+			if action == a {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return errors.Errorf("unknown action %q", action)
+		}
+	}
+
+	return nil
+}
+
+// sustained returns true if the condition holds for every sample taken over
+// the given duration, ending with the latest sample.
+func (h *NvmeHealthHistory) sustained(d time.Duration, cond func(*NvmeHealthSample) bool) bool {
+	if len(h.Samples) == 0 {
+		return false
+	}
+
+	start := h.Samples[len(h.Samples)-1].Time.Add(-d)
+	for i := len(h.Samples) - 1; i >= 0; i-- {
+		s := h.Samples[i]
+		if !cond(s) {
+			return false
+		}
+		if !s.Time.After(start) {
+			return true
+		}
+	}
+
+	// The history doesn't yet cover the duration.
+	return false
+}
+
+// mediaErrorsWithin returns the number of media errors reported over the given
+// duration, ending with the latest sample.
+func (h *NvmeHealthHistory) mediaErrorsWithin(d time.Duration) uint64 {
+	if len(h.Samples) == 0 {
+		return 0
+	}
+
+	last := h.Samples[len(h.Samples)-1]
+	start := last.Time.Add(-d)
+	base := h.Samples[0]
+	for _, s := range h.Samples {
+		if s.Time.After(start) {
+			break
+		}
+		base = s
+	}
+
+	return counterDelta(base.MediaErrors, last.MediaErrors)
+}
+
+// Evaluate returns true and a description of the match if the rule matches the
+// given health history.
+func (r *BdevHealthRule) Evaluate(h *NvmeHealthHistory) (bool, string) {
+	switch r.Condition {
+	case BdevConditionTemperature:
+		if h.sustained(r.Duration, func(s *NvmeHealthSample) bool {
+			return float64(s.Temperature)-273.15 > float64(r.Threshold)
+		}) {
+			return true, fmt.Sprintf("temperature above %dC for %s", r.Threshold, r.Duration)
+		}
+	case BdevConditionSpareWarning:
+		if h.sustained(r.Duration, func(s *NvmeHealthSample) bool {
+			return s.AvailSpareWarn
+		}) {
+			return true, fmt.Sprintf("available spare warning for %s", r.Duration)
+		}
+	case BdevConditionMediaErrorRate:
+		if n := h.mediaErrorsWithin(r.Duration); n >= r.Threshold {
+			return true, fmt.Sprintf("%d media errors within %s", n, r.Duration)
+		}
+	}
+
+	return false, ""
+}
+
+// BdevHealthPolicy describes the rules evaluated by the control plane against
+// the sampled health of the NVMe devices used by the engines.
+type BdevHealthPolicy struct {
+	// DryRun reports the actions that would be taken without taking them.
+	DryRun         bool              `yaml:"dry_run,omitempty"`
+	SampleInterval time.Duration     `yaml:"sample_interval,omitempty"`
+	Rules          []*BdevHealthRule `yaml:"rules,omitempty"`
+}
+
+// Validate returns an error if the policy is invalid. A nil policy is valid.
+func (p *BdevHealthPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.SampleInterval < 0 {
+		return errors.New("sample_interval must not be negative")
+	}
+
+	names := make(map[string]bool)
+	for idx, rule := range p.Rules {
+		if err := rule.Validate(); err != nil {
+			return errors.Wrapf(err, "rule %d", idx)
+		}
+		if names[rule.Name] {
+			return errors.Errorf("rule %d: duplicate name %q", idx, rule.Name)
+		}
+		names[rule.Name] = true
+	}
+
+	return nil
+}
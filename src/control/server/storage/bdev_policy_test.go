@@ -0,0 +1,240 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestStorage_BdevHealthPolicy_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy *BdevHealthPolicy
+		expErr error
+	}{
+		"nil policy": {},
+		"valid": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "hot",
+						Condition: BdevConditionTemperature,
+						Threshold: 70,
+						Duration:  time.Hour,
+						Actions:   []BdevPolicyAction{BdevActionLED, BdevActionEvent},
+					},
+					{
+						Name:      "spare",
+						Condition: BdevConditionSpareWarning,
+						Actions:   []BdevPolicyAction{BdevActionDrain, BdevActionSetFaulty},
+					},
+					{
+						Name:      "errors",
+						Condition: BdevConditionMediaErrorRate,
+						Threshold: 10,
+						Duration:  24 * time.Hour,
+						Actions:   []BdevPolicyAction{BdevActionEvent},
+					},
+				},
+			},
+		},
+		"negative sample interval": {
+			policy: &BdevHealthPolicy{SampleInterval: -time.Second},
+			expErr: errors.New("sample_interval"),
+		},
+		"missing name": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Condition: BdevConditionSpareWarning,
+						Actions:   []BdevPolicyAction{BdevActionEvent},
+					},
+				},
+			},
+			expErr: errors.New("rule 0: name must be set"),
+		},
+		"unknown condition": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "bad",
+						Condition: "humidity",
+						Actions:   []BdevPolicyAction{BdevActionEvent},
+					},
+				},
+			},
+			expErr: errors.New("unknown condition"),
+		},
+		"temperature without threshold": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "hot",
+						Condition: BdevConditionTemperature,
+						Actions:   []BdevPolicyAction{BdevActionEvent},
+					},
+				},
+			},
+			expErr: errors.New("requires a threshold"),
+		},
+		"error rate without duration": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "errors",
+						Condition: BdevConditionMediaErrorRate,
+						Threshold: 10,
+						Actions:   []BdevPolicyAction{BdevActionEvent},
+					},
+				},
+			},
+			expErr: errors.New("requires a threshold and duration"),
+		},
+		"no actions": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "spare",
+						Condition: BdevConditionSpareWarning,
+					},
+				},
+			},
+			expErr: errors.New("at least one action"),
+		},
+		"unknown action": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "spare",
+						Condition: BdevConditionSpareWarning,
+						Actions:   []BdevPolicyAction{"reboot"},
+					},
+				},
+			},
+			expErr: errors.New("unknown action"),
+		},
+		"duplicate names": {
+			policy: &BdevHealthPolicy{
+				Rules: []*BdevHealthRule{
+					{
+						Name:      "spare",
+						Condition: BdevConditionSpareWarning,
+						Actions:   []BdevPolicyAction{BdevActionEvent},
+					},
+					{
+						Name:      "spare",
+						Condition: BdevConditionSpareWarning,
+						Actions:   []BdevPolicyAction{BdevActionLED},
+					},
+				},
+			},
+			expErr: errors.New("rule 1: duplicate name"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.policy.Validate())
+		})
+	}
+}
+
+func TestStorage_BdevHealthRule_Evaluate(t *testing.T) {
+	start := time.Now()
+	tempK := func(c uint32) uint32 { return c + 273 }
+
+	for name, tc := range map[string]struct {
+		rule      *BdevHealthRule
+		samples   []*NvmeHealthSample
+		expMatch  bool
+		expReason string
+	}{
+		"no samples": {
+			rule: &BdevHealthRule{Condition: BdevConditionSpareWarning},
+		},
+		"temperature latest sample": {
+			rule: &BdevHealthRule{Condition: BdevConditionTemperature, Threshold: 70},
+			samples: []*NvmeHealthSample{
+				{Time: start, Temperature: tempK(75)},
+			},
+			expMatch:  true,
+			expReason: "temperature above 70C for 0s",
+		},
+		"temperature sustained": {
+			rule: &BdevHealthRule{Condition: BdevConditionTemperature, Threshold: 70, Duration: time.Hour},
+			samples: []*NvmeHealthSample{
+				{Time: start, Temperature: tempK(60)},
+				{Time: start.Add(time.Hour), Temperature: tempK(72)},
+				{Time: start.Add(2 * time.Hour), Temperature: tempK(75)},
+			},
+			expMatch:  true,
+			expReason: "temperature above 70C for 1h0m0s",
+		},
+		"temperature not sustained": {
+			rule: &BdevHealthRule{Condition: BdevConditionTemperature, Threshold: 70, Duration: time.Hour},
+			samples: []*NvmeHealthSample{
+				{Time: start, Temperature: tempK(75)},
+				{Time: start.Add(time.Hour), Temperature: tempK(65)},
+				{Time: start.Add(2 * time.Hour), Temperature: tempK(75)},
+			},
+		},
+		"temperature history too short": {
+			rule: &BdevHealthRule{Condition: BdevConditionTemperature, Threshold: 70, Duration: 2 * time.Hour},
+			samples: []*NvmeHealthSample{
+				{Time: start, Temperature: tempK(75)},
+				{Time: start.Add(time.Hour), Temperature: tempK(75)},
+			},
+		},
+		"spare warning": {
+			rule: &BdevHealthRule{Condition: BdevConditionSpareWarning},
+			samples: []*NvmeHealthSample{
+				{Time: start, AvailSpareWarn: true},
+			},
+			expMatch:  true,
+			expReason: "available spare warning for 0s",
+		},
+		"spare warning cleared": {
+			rule: &BdevHealthRule{Condition: BdevConditionSpareWarning},
+			samples: []*NvmeHealthSample{
+				{Time: start, AvailSpareWarn: true},
+				{Time: start.Add(time.Hour)},
+			},
+		},
+		"media error rate exceeded": {
+			rule: &BdevHealthRule{Condition: BdevConditionMediaErrorRate, Threshold: 10, Duration: 2 * time.Hour},
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaErrors: 0},
+				{Time: start.Add(time.Hour), MediaErrors: 5},
+				{Time: start.Add(2 * time.Hour), MediaErrors: 6},
+				{Time: start.Add(3 * time.Hour), MediaErrors: 16},
+			},
+			expMatch:  true,
+			expReason: "11 media errors within 2h0m0s",
+		},
+		"media error rate below threshold": {
+			rule: &BdevHealthRule{Condition: BdevConditionMediaErrorRate, Threshold: 10, Duration: time.Hour},
+			samples: []*NvmeHealthSample{
+				{Time: start, MediaErrors: 0},
+				{Time: start.Add(time.Hour), MediaErrors: 9},
+				{Time: start.Add(2 * time.Hour), MediaErrors: 18},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hist := NewNvmeHealthHistory(10)
+			for _, s := range tc.samples {
+				hist.Add(s)
+			}
+
+			match, reason := tc.rule.Evaluate(hist)
+			test.AssertEqual(t, tc.expMatch, match, "unexpected match")
+			test.AssertEqual(t, tc.expReason, reason, "unexpected reason")
+		})
+	}
+}
@@ -421,6 +421,8 @@ type MockScmProvider struct {
 	FirmwareQueryErr  error
 	FirmwareUpdateRes *ScmFirmwareUpdateResponse
 	FirmwareUpdateErr error
+	QueryHealthRes    *ScmHealthResponse
+	QueryHealthErr    error
 }
 
 func (m *MockScmProvider) Mount(ScmMountRequest) (*MountResponse, error) {
@@ -454,3 +456,7 @@ func (m *MockScmProvider) QueryFirmware(ScmFirmwareQueryRequest) (*ScmFirmwareQu
 func (m *MockScmProvider) UpdateFirmware(ScmFirmwareUpdateRequest) (*ScmFirmwareUpdateResponse, error) {
 	return m.FirmwareUpdateRes, m.FirmwareUpdateErr
 }
+
+func (m *MockScmProvider) QueryHealth(ScmHealthRequest) (*ScmHealthResponse, error) {
+	return m.QueryHealthRes, m.QueryHealthErr
+}
@@ -211,6 +211,12 @@ func (p *Provider) ScanScm(req ScmScanRequest) (*ScmScanResponse, error) {
 	return p.scm.Scan(req)
 }
 
+// QueryScmHealth calls into the SCM storage provider to query the health of PMem namespaces.
+func (p *Provider) QueryScmHealth(req ScmHealthRequest) (*ScmHealthResponse, error) {
+	p.log.Debugf("calling scm storage provider health query: %+v", req)
+	return p.scm.QueryHealth(req)
+}
+
 // GetScmConfig returns the only SCM tier config.
 func (p *Provider) GetScmConfig() (*TierConfig, error) {
 	// NB: A bit wary of building in assumptions again about the number of
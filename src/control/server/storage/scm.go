@@ -127,6 +127,29 @@ type (
 	// ScmNamespaces is a type alias for a slice of ScmNamespace references.
 	ScmNamespaces []*ScmNamespace
 
+	// ScmBadBlock is a range of PMem media reported as bad, offset and length are in units
+	// of 512-byte sectors.
+	ScmBadBlock struct {
+		Offset uint64   `json:"offset"`
+		Length uint64   `json:"length"`
+		Dimms  []string `json:"dimms,omitempty"`
+	}
+
+	// ScmNamespaceHealth describes the media health of a PMem namespace and the modules
+	// backing it.
+	ScmNamespaceHealth struct {
+		UUID        string `json:"uuid"`
+		BlockDevice string `json:"blockdev"`
+		Name        string `json:"dev"`
+		NumaNode    uint32 `json:"numa_node"`
+		Size        uint64 `json:"size"`
+		// Worst health state reported for the backing modules.
+		HealthState string `json:"health_state"`
+		// Sum of the dirty shutdown counts of the backing modules.
+		DirtyShutdowns uint64         `json:"dirty_shutdowns"`
+		BadBlocks      []*ScmBadBlock `json:"badblocks"`
+	}
+
 	// ScmFirmwareUpdateStatus represents the status of a firmware update on the module.
 	ScmFirmwareUpdateStatus uint32
 
@@ -207,6 +230,14 @@ func (sms ScmModules) Summary() string {
 		common.Pluralise("module", len(sms)))
 }
 
+// BadBlockCount returns the number of sectors reported as bad on the namespace.
+func (snh *ScmNamespaceHealth) BadBlockCount() (count uint64) {
+	for _, bb := range snh.BadBlocks {
+		count += bb.Length
+	}
+	return
+}
+
 // Capacity reports total storage capacity (bytes) of PMem namespace (pmem block device).
 func (sn ScmNamespace) Capacity() uint64 {
 	return sn.Size
@@ -326,6 +357,7 @@ type (
 		Prepare(ScmPrepareRequest) (*ScmPrepareResponse, error)
 		QueryFirmware(ScmFirmwareQueryRequest) (*ScmFirmwareQueryResponse, error)
 		UpdateFirmware(ScmFirmwareUpdateRequest) (*ScmFirmwareUpdateResponse, error)
+		QueryHealth(ScmHealthRequest) (*ScmHealthResponse, error)
 	}
 
 	// ScmPrepareRequest defines the parameters for a Prepare operation.
@@ -355,6 +387,17 @@ type (
 		Namespaces ScmNamespaces
 	}
 
+	// ScmHealthRequest defines the parameters for a PMem namespace health query.
+	ScmHealthRequest struct {
+		pbin.ForwardableRequest
+		SocketID *uint // Only process PMem attached to this socket.
+	}
+
+	// ScmHealthResponse contains the health of each PMem namespace.
+	ScmHealthResponse struct {
+		Namespaces []*ScmNamespaceHealth
+	}
+
 	// RamdiskParams defines the sub-parameters of a Format or Mount operation that
 	// will use tmpfs-based ramdisk
 	RamdiskParams struct {
@@ -532,6 +575,18 @@ func (f *ScmAdminForwarder) Prepare(req ScmPrepareRequest) (*ScmPrepareResponse,
 	return res, nil
 }
 
+// QueryHealth forwards a request to query the health of PMem namespaces.
+func (f *ScmAdminForwarder) QueryHealth(req ScmHealthRequest) (*ScmHealthResponse, error) {
+	req.Forwarded = true
+
+	res := new(ScmHealthResponse)
+	if err := f.SendReq("ScmQueryHealth", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 const (
 	// ScmFirmwareQueryMethod is the method name used when forwarding the request
 	// to query SCM firmware.
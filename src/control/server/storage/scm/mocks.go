@@ -341,17 +341,19 @@ func mockModule(uid string, pi, si, ci, chi, chp uint32) *storage.ScmModule {
 // implementation providing capability to access and configure
 // SCM modules and namespaces.
 type MockBackendConfig struct {
-	GetModulesRes        storage.ScmModules
-	GetModulesErr        error
-	GetNamespacesRes     storage.ScmNamespaces
-	GetNamespacesErr     error
-	PrepRes              *storage.ScmPrepareResponse
-	PrepErr              error
-	PrepResetRes         *storage.ScmPrepareResponse
-	PrepResetErr         error
-	GetFirmwareStatusErr error
-	GetFirmwareStatusRes *storage.ScmFirmwareInfo
-	UpdateFirmwareErr    error
+	GetModulesRes         storage.ScmModules
+	GetModulesErr         error
+	GetNamespacesRes      storage.ScmNamespaces
+	GetNamespacesErr      error
+	GetNamespaceHealthRes []*storage.ScmNamespaceHealth
+	GetNamespaceHealthErr error
+	PrepRes               *storage.ScmPrepareResponse
+	PrepErr               error
+	PrepResetRes          *storage.ScmPrepareResponse
+	PrepResetErr          error
+	GetFirmwareStatusErr  error
+	GetFirmwareStatusRes  *storage.ScmFirmwareInfo
+	UpdateFirmwareErr     error
 }
 
 type MockBackend struct {
@@ -377,6 +379,10 @@ func (mb *MockBackend) getNamespaces(sockID int) (storage.ScmNamespaces, error)
 	return mb.cfg.GetNamespacesRes, mb.cfg.GetNamespacesErr
 }
 
+func (mb *MockBackend) getNamespaceHealth(sockID int) ([]*storage.ScmNamespaceHealth, error) {
+	return mb.cfg.GetNamespaceHealthRes, mb.cfg.GetNamespaceHealthErr
+}
+
 func (mb *MockBackend) prep(req storage.ScmPrepareRequest, _ *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error) {
 	mb.Lock()
 	mb.PrepareCalls = append(mb.PrepareCalls, req)
@@ -53,8 +53,21 @@ var (
 		BinaryName: ndctlName,
 		Args:       []string{"list", "-R", "-v"},
 	}
+	// returns ns info including media errors in json
+	cmdListNamespaceErrors = pmemCmd{
+		BinaryName: ndctlName,
+		Args:       []string{"list", "-N", "-M", "-v"},
+	}
+	// expects namespace device name param, returns health of the dimms backing it in json
+	cmdListNamespaceDimms = pmemCmd{
+		BinaryName: ndctlName,
+		Args:       []string{"list", "-D", "-H", "--namespace"},
+	}
 )
 
+// ndctl health states in increasing order of severity.
+var ndctlHealthStates = []string{"ok", "unknown", "non-critical", "critical", "fatal"}
+
 func (cr *cmdRunner) checkNdctl() (errOut error) {
 	cr.checkOnce.Do(func() {
 		if _, err := cr.lookPath("ndctl"); err != nil {
@@ -281,3 +294,116 @@ func (cr *cmdRunner) getNdctlRegions(sockID int) (NdctlRegions, error) {
 
 	return nrs, nil
 }
+
+type (
+	// NdctlDimmHealth is the health information reported by ndctl for a PMem module.
+	NdctlDimmHealth struct {
+		HealthState   string `json:"health_state"`
+		ShutdownState string `json:"shutdown_state"`
+		ShutdownCount uint64 `json:"shutdown_count"`
+	}
+
+	// NdctlDimm is a PMem module as reported by ndctl.
+	NdctlDimm struct {
+		Dev    string           `json:"dev"`
+		ID     string           `json:"id"`
+		Health *NdctlDimmHealth `json:"health"`
+	}
+
+	NdctlDimms []*NdctlDimm
+)
+
+func parseNdctlDimms(jsonData string) (NdctlDimms, error) {
+	nds := NdctlDimms{}
+
+	// turn single entries into arrays
+	if !strings.HasPrefix(jsonData, "[") {
+		jsonData = "[" + jsonData + "]"
+	}
+
+	if err := json.Unmarshal([]byte(jsonData), &nds); err != nil {
+		return nil, err
+	}
+
+	return nds, nil
+}
+
+func parseNamespaceHealth(jsonData string) ([]*storage.ScmNamespaceHealth, error) {
+	nss := []*storage.ScmNamespaceHealth{}
+
+	// turn single entries into arrays
+	if !strings.HasPrefix(jsonData, "[") {
+		jsonData = "[" + jsonData + "]"
+	}
+
+	if err := json.Unmarshal([]byte(jsonData), &nss); err != nil {
+		return nil, err
+	}
+
+	return nss, nil
+}
+
+// worseHealthState returns the more severe of two ndctl health states.
+func worseHealthState(a, b string) string {
+	rank := func(state string) int {
+		for i, s := range ndctlHealthStates {
+			if s == state {
+				return i
+			}
+		}
+		return 1 // unrecognized states are treated as unknown
+	}
+
+	if a == "" || rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
+
+// getNamespaceHealth calls ndctl to list pmem namespaces with their bad blocks and the health of
+// the modules backing each of them.
+func (cr *cmdRunner) getNamespaceHealth(numaID int) ([]*storage.ScmNamespaceHealth, error) {
+	if err := cr.checkNdctl(); err != nil {
+		return nil, err
+	}
+
+	cmd := cmdListNamespaceErrors
+	if numaID != sockAny {
+		cmd.Args = append(cmd.Args, "--numa-node", fmt.Sprintf("%d", numaID))
+	}
+	out, err := cr.runCmd(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	nss, err := parseNamespaceHealth(out)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ns := range nss {
+		cmd := cmdListNamespaceDimms
+		cmd.Args = append(cmd.Args, ns.Name)
+		out, err := cr.runCmd(cmd)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "%s", ns.Name)
+		}
+
+		dimms, err := parseNdctlDimms(out)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "%s", ns.Name)
+		}
+
+		for _, dimm := range dimms {
+			if dimm.Health == nil {
+				ns.HealthState = worseHealthState(ns.HealthState, "unknown")
+				continue
+			}
+			ns.HealthState = worseHealthState(ns.HealthState, dimm.Health.HealthState)
+			ns.DirtyShutdowns += dimm.Health.ShutdownCount
+		}
+	}
+	cr.log.Debugf("queried health of %d pmem namespaces", len(nss))
+
+	return nss, nil
+}
@@ -251,3 +251,143 @@ func TestNdctl_getNdctlRegions(t *testing.T) {
 		})
 	}
 }
+
+func TestNdctl_getNamespaceHealth(t *testing.T) {
+	// `ndctl list -N -M -v` output for a namespace with media errors
+	nsOut := `[{
+   "dev":"namespace0.0",
+   "mode":"fsdax",
+   "map":"dev",
+   "size":3183575302144,
+   "uuid":"842fc847-28e0-4bb6-8dfc-d24afdba1528",
+   "sector_size":512,
+   "blockdev":"pmem0",
+   "numa_node":0,
+   "badblock_count":9,
+   "badblocks":[
+      {"offset":1024,"length":8,"dimms":["nmem1"]},
+      {"offset":4096,"length":1,"dimms":["nmem0","nmem1"]}
+   ]
+},{
+   "dev":"namespace1.0",
+   "mode":"fsdax",
+   "map":"dev",
+   "size":3183575302144,
+   "uuid":"842fc847-28e0-4bb6-8dfc-d24afdba1529",
+   "sector_size":512,
+   "blockdev":"pmem1",
+   "numa_node":1
+}]`
+	// `ndctl list -D -H --namespace` output template
+	dimmTmpl := `{
+   "dev":"nmem%d",
+   "id":"8089-a2-1838-00000c5f",
+   "handle":1,
+   "phys_id":28,
+   "health":{
+      "health_state":"%s",
+      "temperature_celsius":28.0,
+      "spares_percentage":100,
+      "shutdown_state":"clean",
+      "shutdown_count":%d
+   }
+}`
+
+	for name, tc := range map[string]struct {
+		lookPathErr error
+		dimmOut     map[string]string
+		dimmErr     error
+		expHealth   []*storage.ScmNamespaceHealth
+		expCommands []pmemCmd
+		expErr      error
+	}{
+		"ndctl not installed": {
+			lookPathErr: FaultMissingNdctl,
+			expErr:      FaultMissingNdctl,
+		},
+		"dimm query fails": {
+			dimmErr: errors.New("bad namespace"),
+			expErr:  errors.New("namespace0.0: bad namespace"),
+		},
+		"bad blocks and degraded module": {
+			dimmOut: map[string]string{
+				"namespace0.0": "[" + fmt.Sprintf(dimmTmpl, 0, "ok", 2) + "," +
+					fmt.Sprintf(dimmTmpl, 1, "non-critical", 3) + "]",
+				"namespace1.0": fmt.Sprintf(dimmTmpl, 2, "ok", 0),
+			},
+			expHealth: []*storage.ScmNamespaceHealth{
+				{
+					UUID:           "842fc847-28e0-4bb6-8dfc-d24afdba1528",
+					BlockDevice:    "pmem0",
+					Name:           "namespace0.0",
+					Size:           3183575302144,
+					HealthState:    "non-critical",
+					DirtyShutdowns: 5,
+					BadBlocks: []*storage.ScmBadBlock{
+						{Offset: 1024, Length: 8, Dimms: []string{"nmem1"}},
+						{Offset: 4096, Length: 1, Dimms: []string{"nmem0", "nmem1"}},
+					},
+				},
+				{
+					UUID:        "842fc847-28e0-4bb6-8dfc-d24afdba1529",
+					BlockDevice: "pmem1",
+					Name:        "namespace1.0",
+					NumaNode:    1,
+					Size:        3183575302144,
+					HealthState: "ok",
+				},
+			},
+			expCommands: []pmemCmd{
+				cmdListNamespaceErrors,
+				{
+					BinaryName: ndctlName,
+					Args:       []string{"list", "-D", "-H", "--namespace", "namespace0.0"},
+				},
+				{
+					BinaryName: ndctlName,
+					Args:       []string{"list", "-D", "-H", "--namespace", "namespace1.0"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var commands []pmemCmd
+			mockLookPath := func(string) (string, error) {
+				return "", tc.lookPathErr
+			}
+			mockRun := func(_ logging.Logger, cmd pmemCmd) (string, error) {
+				commands = append(commands, cmd)
+				if cmd.Args[1] == "-N" {
+					return nsOut, nil
+				}
+				if tc.dimmErr != nil {
+					return "", tc.dimmErr
+				}
+				return tc.dimmOut[cmd.Args[len(cmd.Args)-1]], nil
+			}
+
+			cr, err := newCmdRunner(log, mockRun, mockLookPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotHealth, gotErr := cr.getNamespaceHealth(sockAny)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expHealth, gotHealth); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expCommands, commands); diff != "" {
+				t.Fatalf("unexpected commands (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, uint64(9), gotHealth[0].BadBlockCount(),
+				"unexpected bad block count")
+		})
+	}
+}
@@ -37,6 +37,7 @@ type (
 	Backend interface {
 		getModules(int) (storage.ScmModules, error)
 		getNamespaces(int) (storage.ScmNamespaces, error)
+		getNamespaceHealth(int) ([]*storage.ScmNamespaceHealth, error)
 		prep(storage.ScmPrepareRequest, *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error)
 		prepReset(storage.ScmPrepareRequest, *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error)
 		GetFirmwareStatus(deviceUID string) (*storage.ScmFirmwareInfo, error)
@@ -150,6 +151,21 @@ func (p *Provider) Scan(req storage.ScmScanRequest) (_ *storage.ScmScanResponse,
 	return resp, nil
 }
 
+// QueryHealth reports the media health of PMem namespaces.
+func (p *Provider) QueryHealth(req storage.ScmHealthRequest) (*storage.ScmHealthResponse, error) {
+	sockSelector := sockAny
+	if req.SocketID != nil {
+		sockSelector = int(*req.SocketID)
+	}
+
+	nss, err := p.backend.getNamespaceHealth(sockSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.ScmHealthResponse{Namespaces: nss}, nil
+}
+
 type scanFn func(storage.ScmScanRequest) (*storage.ScmScanResponse, error)
 
 func (p *Provider) prepare(req storage.ScmPrepareRequest, scan scanFn) (*storage.ScmPrepareResponse, error) {
@@ -9,6 +9,7 @@ package server
 import (
 	"context"
 
+	metrics "github.com/armon/go-metrics"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -68,11 +69,43 @@ func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Eng
 	return nil
 }
 
+// legacyRaftMetricPrefixes lists the raft library metrics that embed the peer
+// ID in the metric name. They are also emitted with the peer ID as a label, so
+// are blocked in order to avoid creating a set of metrics per peer.
+var legacyRaftMetricPrefixes = []string{
+	"raft.replication.appendEntries.rpc.",
+	"raft.replication.appendEntries.logs.",
+	"raft.replication.heartbeat.",
+	"raft.replication.installSnapshot.",
+}
+
+// regPromRaftMetrics bridges the metrics emitted by the raft library and the
+// MS database into the Prometheus exporter.
+func regPromRaftMetrics(log logging.Logger) error {
+	c := promexp.NewGoMetricsCollector(log, "server")
+	if err := prometheus.Register(c); err != nil {
+		return errors.Wrap(err, "failed to register raft metrics collector")
+	}
+
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	cfg.BlockedPrefixes = legacyRaftMetricPrefixes
+	if _, err := metrics.NewGlobal(cfg, c); err != nil {
+		return errors.Wrap(err, "failed to set up raft metrics")
+	}
+
+	return nil
+}
+
 func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine) (func(), error) {
 	expCfg := &promexp.ExporterConfig{
 		Port:  port,
 		Title: "DAOS Engine Telemetry",
 		Register: func(ctx context.Context, log logging.Logger) error {
+			if err := regPromRaftMetrics(log); err != nil {
+				return err
+			}
 			return regPromEngineSources(ctx, log, engines)
 		},
 	}
@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// AuditRecord describes a mutating control-plane operation or a call
+	// denied by access control, the caller that requested it and its result.
+	AuditRecord struct {
+		ID        uint64    `json:"id"`
+		Time      time.Time `json:"time"`
+		Operation string    `json:"operation"`
+		Component string    `json:"component"`
+		Role      string    `json:"role"`
+		Subject   string    `json:"subject"`
+		Address   string    `json:"address"`
+		Request   string    `json:"request"`
+		Error     string    `json:"error"`
+		Denied    bool      `json:"denied"`
+	}
+
+	// AuditFilter selects audit records. Unset fields match all records.
+	AuditFilter struct {
+		Operation string // operation name, e.g. PoolCreate
+		Subject   string // substring of the caller's certificate subject
+		Since     time.Time
+		Until     time.Time
+		Limit     int // maximum number of most recent matching records
+	}
+)
+
+// Result returns a short description of the result of the operation.
+func (ar *AuditRecord) Result() string {
+	if ar.Denied {
+		return "denied"
+	}
+	if ar.Error != "" {
+		return "failed"
+	}
+	return "ok"
+}
+
+func (ar *AuditRecord) String() string {
+	subject := ar.Subject
+	if subject == "" {
+		subject = "unknown"
+	}
+	msg := fmt.Sprintf("%s by %s (subject=%q role=%s) from %s: %s", ar.Operation, ar.Component,
+		subject, ar.Role, ar.Address, ar.Result())
+	if ar.Error != "" {
+		msg += ": " + ar.Error
+	}
+	return msg
+}
+
+// Matches returns true if the filter matches the audit record.
+func (af *AuditFilter) Matches(ar *AuditRecord) bool {
+	if af == nil {
+		return true
+	}
+
+	if af.Operation != "" && !strings.EqualFold(af.Operation, ar.Operation) {
+		return false
+	}
+	if af.Subject != "" && !strings.Contains(ar.Subject, af.Subject) {
+		return false
+	}
+	if !af.Since.IsZero() && ar.Time.Before(af.Since) {
+		return false
+	}
+	if !af.Until.IsZero() && ar.Time.After(af.Until) {
+		return false
+	}
+
+	return true
+}
@@ -42,6 +42,10 @@ type (
 		State      PoolServiceState
 		Replicas   []ranklist.Rank
 		Storage    *PoolServiceStorage
+		OwnerUser  string // owner principal set at creation, e.g. "bob@"
+		OwnerGroup string // owner group principal set at creation
+		Creator    string // subject of the certificate used to create the pool
+		CreatedAt  time.Time
 		LastUpdate time.Time
 	}
 )
@@ -0,0 +1,178 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+type (
+	// PoolOpType identifies a rebuild-triggering pool operation.
+	PoolOpType uint8
+
+	// PoolOpState is the state of a queued pool operation.
+	PoolOpState uint8
+
+	// PoolOp describes a rebuild-triggering pool operation that has been
+	// queued by the MS. Operations on the same pool are run one at a time,
+	// optionally within a time window.
+	PoolOp struct {
+		ID        uuid.UUID
+		PoolUUID  uuid.UUID
+		Type      PoolOpType
+		State     PoolOpState
+		Ranks     []ranklist.Rank
+		TargetIdx []uint32
+		Request   []byte    // serialized request, replayed when the operation runs
+		NotBefore time.Time // operation will not be started before this time, if set
+		NotAfter  time.Time // operation will not be started after this time, if set
+		Created   time.Time
+		Started   time.Time
+		Finished  time.Time
+		Error     string
+	}
+)
+
+const (
+	PoolOpTypeExclude PoolOpType = iota
+	PoolOpTypeDrain
+	PoolOpTypeReintegrate
+	PoolOpTypeExtend
+)
+
+func (t PoolOpType) String() string {
+	return [...]string{
+		"exclude",
+		"drain",
+		"reintegrate",
+		"extend",
+	}[t]
+}
+
+const (
+	PoolOpStateQueued PoolOpState = iota
+	PoolOpStateRunning
+	PoolOpStateCompleted
+	PoolOpStateFailed
+	PoolOpStateCanceled
+)
+
+func (s PoolOpState) String() string {
+	return [...]string{
+		"queued",
+		"running",
+		"completed",
+		"failed",
+		"canceled",
+	}[s]
+}
+
+// NewPoolOp returns a queued operation of the given type on the given pool.
+func NewPoolOp(poolUUID uuid.UUID, opType PoolOpType) *PoolOp {
+	return &PoolOp{
+		ID:       uuid.New(),
+		PoolUUID: poolUUID,
+		Type:     opType,
+		State:    PoolOpStateQueued,
+		Created:  time.Now(),
+	}
+}
+
+func (op *PoolOp) String() string {
+	return fmt.Sprintf("pool %s %s operation %s", op.PoolUUID, op.Type, op.ID)
+}
+
+// SetWindow sets the time window within which the operation may be started.
+// A zero time leaves that end of the window open.
+func (op *PoolOp) SetWindow(notBefore, notAfter time.Time) error {
+	if !notAfter.IsZero() {
+		if notAfter.Before(notBefore) {
+			return errors.Errorf("window end %s is before window start %s",
+				notAfter.Format(time.RFC3339), notBefore.Format(time.RFC3339))
+		}
+		if notAfter.Before(time.Now()) {
+			return errors.Errorf("window end %s is in the past", notAfter.Format(time.RFC3339))
+		}
+	}
+
+	op.NotBefore = notBefore
+	op.NotAfter = notAfter
+	return nil
+}
+
+// IsScheduled returns true if the operation may not be started until a
+// later time.
+func (op *PoolOp) IsScheduled(now time.Time) bool {
+	return op.NotBefore.After(now)
+}
+
+// IsExpired returns true if the operation's window closed before it could
+// be started.
+func (op *PoolOp) IsExpired(now time.Time) bool {
+	return !op.NotAfter.IsZero() && now.After(op.NotAfter)
+}
+
+// IsFinished returns true if the operation has completed, failed or been
+// canceled.
+func (op *PoolOp) IsFinished() bool {
+	return op.State >= PoolOpStateCompleted
+}
+
+// Start marks the operation as running.
+func (op *PoolOp) Start() {
+	op.State = PoolOpStateRunning
+	op.Started = time.Now()
+}
+
+// Finish marks the operation as completed, or as failed if an error is
+// supplied.
+func (op *PoolOp) Finish(err error) {
+	op.State = PoolOpStateCompleted
+	if err != nil {
+		op.State = PoolOpStateFailed
+		op.Error = err.Error()
+	}
+	op.Finished = time.Now()
+}
+
+// Cancel marks a queued operation as canceled.
+func (op *PoolOp) Cancel() error {
+	if op.State != PoolOpStateQueued {
+		return errors.Errorf("%s is %s and cannot be canceled", op, op.State)
+	}
+
+	op.State = PoolOpStateCanceled
+	op.Finished = time.Now()
+	return nil
+}
+
+type errPoolOpNotFound struct {
+	id uuid.UUID
+}
+
+func (err *errPoolOpNotFound) Error() string {
+	return fmt.Sprintf("unable to find pool operation %s", err.id)
+}
+
+// ErrPoolOpNotFound returns an error indicating that no pool operation with
+// the given ID exists.
+func ErrPoolOpNotFound(id uuid.UUID) error {
+	return &errPoolOpNotFound{id: id}
+}
+
+// IsPoolOpNotFound returns a boolean indicating whether or not the supplied
+// error indicates that a pool operation was not found.
+func IsPoolOpNotFound(err error) bool {
+	_, ok := errors.Cause(err).(*errPoolOpNotFound)
+	return ok
+}
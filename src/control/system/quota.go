@@ -0,0 +1,221 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+type (
+	// Quota limits the resources that may be consumed by the pools owned by
+	// a user or group ACL principal. Exactly one of User or Group is set.
+	// A limit of zero is unlimited.
+	Quota struct {
+		User      string   `json:"user,omitempty"`
+		Group     string   `json:"group,omitempty"`
+		MaxPools  uint32   `json:"max_pools"`
+		TierBytes []uint64 `json:"tier_bytes"`
+	}
+
+	// QuotaUsage describes the resources consumed by the pools that are
+	// counted against a quota.
+	QuotaUsage struct {
+		Pools     uint32   `json:"pools"`
+		TierBytes []uint64 `json:"tier_bytes"`
+	}
+)
+
+// QuotaTierName returns a name for the storage tier at the given index.
+func QuotaTierName(tier int) string {
+	switch tier {
+	case 0:
+		return "SCM"
+	case 1:
+		return "NVMe"
+	default:
+		return fmt.Sprintf("tier %d", tier)
+	}
+}
+
+func (q *Quota) String() string {
+	if q.User != "" {
+		return "user " + q.User
+	}
+	return "group " + q.Group
+}
+
+// Validate checks that the quota applies to exactly one valid user or group
+// ACL principal.
+func (q *Quota) Validate() error {
+	if q == nil {
+		return errors.New("nil quota")
+	}
+
+	var principal string
+	switch {
+	case q.User != "" && q.Group != "":
+		return errors.New("quota may not be set for both a user and a group")
+	case q.User != "":
+		principal = q.User
+	case q.Group != "":
+		principal = q.Group
+	default:
+		return errors.New("quota must be set for a user or a group")
+	}
+	if !daos.ACLPrincipalIsValid(principal) {
+		return errors.Errorf("invalid ACL principal %q", principal)
+	}
+
+	return nil
+}
+
+// IsUnlimited returns true if the quota does not set any limits.
+func (q *Quota) IsUnlimited() bool {
+	if q.MaxPools != 0 {
+		return false
+	}
+	for _, limit := range q.TierBytes {
+		if limit != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Applies returns true if the quota applies to a pool with the given owner
+// user and group principals.
+func (q *Quota) Applies(user, group string) bool {
+	if q.User != "" {
+		return q.User == user
+	}
+	return q.Group != "" && q.Group == group
+}
+
+// Usage returns the resources consumed by the supplied pools that are
+// counted against the quota. Pools are matched on their owner principals, so
+// callers must ensure that the owners of the supplied pools are populated.
+func (q *Quota) Usage(pools []*PoolService) *QuotaUsage {
+	usage := &QuotaUsage{
+		TierBytes: make([]uint64, len(q.TierBytes)),
+	}
+
+	for _, ps := range pools {
+		if !q.Applies(ps.OwnerUser, ps.OwnerGroup) {
+			continue
+		}
+		usage.Pools++
+
+		if ps.Storage == nil {
+			continue
+		}
+		nRanks := uint64(len(ps.Storage.CurrentRanks()))
+		for tier, bytes := range ps.Storage.PerRankTierStorage {
+			for len(usage.TierBytes) <= tier {
+				usage.TierBytes = append(usage.TierBytes, 0)
+			}
+			usage.TierBytes[tier] += nRanks * bytes
+		}
+	}
+
+	return usage
+}
+
+// Check returns an error if adding a pool with the given total storage per
+// tier to the current usage would exceed any of the quota limits.
+func (q *Quota) Check(usage *QuotaUsage, tierBytes []uint64) error {
+	if q.MaxPools != 0 && usage.Pools+1 > q.MaxPools {
+		return &ErrQuotaExceeded{
+			Principal: q.String(),
+			Resource:  "pools",
+			Requested: 1,
+			Used:      uint64(usage.Pools),
+			Limit:     uint64(q.MaxPools),
+		}
+	}
+
+	return q.CheckStorage(usage, tierBytes)
+}
+
+// CheckStorage returns an error if adding the given total storage per tier to
+// the current usage would exceed any of the quota's storage limits, e.g. when
+// an existing pool is extended.
+func (q *Quota) CheckStorage(usage *QuotaUsage, tierBytes []uint64) error {
+	for tier, limit := range q.TierBytes {
+		if limit == 0 || tier >= len(tierBytes) {
+			continue
+		}
+		var used uint64
+		if tier < len(usage.TierBytes) {
+			used = usage.TierBytes[tier]
+		}
+		if used+tierBytes[tier] > limit {
+			return &ErrQuotaExceeded{
+				Principal: q.String(),
+				Resource:  QuotaTierName(tier),
+				Requested: tierBytes[tier],
+				Used:      used,
+				Limit:     limit,
+				IsBytes:   true,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ErrQuotaExceeded indicates that a request would exceed a quota limit.
+type ErrQuotaExceeded struct {
+	Principal string // user or group the quota applies to, e.g. "user bob@"
+	Resource  string
+	Requested uint64
+	Used      uint64
+	Limit     uint64
+	IsBytes   bool
+}
+
+func (err *ErrQuotaExceeded) Error() string {
+	if err.IsBytes {
+		return fmt.Sprintf("%s quota for %s exceeded (requested: %s, used: %s, limit: %s)",
+			err.Resource, err.Principal, humanize.Bytes(err.Requested),
+			humanize.Bytes(err.Used), humanize.Bytes(err.Limit))
+	}
+	return fmt.Sprintf("%s quota for %s exceeded (requested: %d, used: %d, limit: %d)",
+		err.Resource, err.Principal, err.Requested, err.Used, err.Limit)
+}
+
+// IsQuotaExceeded returns a boolean indicating whether or not the supplied
+// error is an instance of ErrQuotaExceeded.
+func IsQuotaExceeded(err error) bool {
+	_, ok := errors.Cause(err).(*ErrQuotaExceeded)
+	return ok
+}
+
+type errQuotaNotFound struct {
+	principal string
+}
+
+func (err *errQuotaNotFound) Error() string {
+	return fmt.Sprintf("unable to find quota for %q", err.principal)
+}
+
+// ErrQuotaNotFound returns an error indicating that no quota is set for the
+// given principal.
+func ErrQuotaNotFound(principal string) error {
+	return &errQuotaNotFound{principal: principal}
+}
+
+// IsQuotaNotFound returns a boolean indicating whether or not the supplied
+// error indicates that a quota was not found.
+func IsQuotaNotFound(err error) bool {
+	_, ok := errors.Cause(err).(*errQuotaNotFound)
+	return ok
+}
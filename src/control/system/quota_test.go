@@ -0,0 +1,143 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestSystem_Quota_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		quota  *Quota
+		expErr error
+	}{
+		"nil": {
+			expErr: errors.New("nil quota"),
+		},
+		"user": {
+			quota: &Quota{User: "bob@"},
+		},
+		"group": {
+			quota: &Quota{Group: "builders@"},
+		},
+		"no principal": {
+			quota:  &Quota{MaxPools: 1},
+			expErr: errors.New("must be set for a user or a group"),
+		},
+		"user and group": {
+			quota:  &Quota{User: "bob@", Group: "builders@"},
+			expErr: errors.New("both a user and a group"),
+		},
+		"invalid principal": {
+			quota:  &Quota{User: "bob"},
+			expErr: errors.New("invalid ACL principal"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.quota.Validate())
+		})
+	}
+}
+
+func TestSystem_Quota_Check(t *testing.T) {
+	mockPool := func(user, group string, ranks []ranklist.Rank, tierBytes ...uint64) *PoolService {
+		ps := NewPoolService(uuid.New(), tierBytes, ranks)
+		ps.OwnerUser = user
+		ps.OwnerGroup = group
+		return ps
+	}
+	pools := []*PoolService{
+		mockPool("bob@", "builders@", []ranklist.Rank{0, 1}, 10, 100),
+		mockPool("alice@", "builders@", []ranklist.Rank{0, 1, 2}, 5, 50),
+		mockPool("bob@", "testers@", []ranklist.Rank{2}, 1, 10),
+		mockPool("", "", []ranklist.Rank{0}, 1000, 1000),
+	}
+
+	for name, tc := range map[string]struct {
+		quota     *Quota
+		tierBytes []uint64
+		expUsage  *QuotaUsage
+		expErr    error
+	}{
+		"user within limits": {
+			quota: &Quota{
+				User:      "bob@",
+				MaxPools:  3,
+				TierBytes: []uint64{25, 250},
+			},
+			tierBytes: []uint64{4, 40},
+			expUsage: &QuotaUsage{
+				Pools:     2,
+				TierBytes: []uint64{21, 210},
+			},
+		},
+		"user pool count exceeded": {
+			quota: &Quota{
+				User:     "bob@",
+				MaxPools: 2,
+			},
+			expUsage: &QuotaUsage{
+				Pools:     2,
+				TierBytes: []uint64{21, 210},
+			},
+			expErr: &ErrQuotaExceeded{
+				Principal: "user bob@",
+				Resource:  "pools",
+				Requested: 1,
+				Used:      2,
+				Limit:     2,
+			},
+		},
+		"group NVMe exceeded": {
+			quota: &Quota{
+				Group:     "builders@",
+				TierBytes: []uint64{0, 400},
+			},
+			tierBytes: []uint64{1, 51},
+			expUsage: &QuotaUsage{
+				Pools:     2,
+				TierBytes: []uint64{35, 350},
+			},
+			expErr: &ErrQuotaExceeded{
+				Principal: "group builders@",
+				Resource:  "NVMe",
+				Requested: 51,
+				Used:      350,
+				Limit:     400,
+				IsBytes:   true,
+			},
+		},
+		"unlimited": {
+			quota:     &Quota{Group: "testers@"},
+			tierBytes: []uint64{1 << 40, 1 << 50},
+			expUsage: &QuotaUsage{
+				Pools:     1,
+				TierBytes: []uint64{1, 10},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotUsage := tc.quota.Usage(pools)
+			if diff := cmp.Diff(tc.expUsage, gotUsage); diff != "" {
+				t.Fatalf("unexpected usage (-want, +got):\n%s\n", diff)
+			}
+
+			gotErr := tc.quota.Check(gotUsage, tc.tierBytes)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				test.AssertTrue(t, IsQuotaExceeded(gotErr), "expected quota exceeded error")
+			}
+		})
+	}
+}
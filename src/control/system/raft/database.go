@@ -8,6 +8,7 @@ package raft
 
 import (
 	"context"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -41,6 +42,7 @@ type (
 	onLeadershipGainedFn func(context.Context) error
 	onLeadershipLostFn   func() error
 	onRaftShutdownFn     func() error
+	onReplicasChangedFn  func(accessPts []string)
 
 	raftService interface {
 		Apply([]byte, time.Duration) raft.ApplyFuture
@@ -50,9 +52,13 @@ type (
 		Leader() raft.ServerAddress
 		LeaderCh() <-chan bool
 		LeadershipTransfer() raft.Future
+		LeadershipTransferToServer(raft.ServerID, raft.ServerAddress) raft.Future
+		GetConfiguration() raft.ConfigurationFuture
 		Barrier(time.Duration) raft.Future
 		Shutdown() raft.Future
 		State() raft.RaftState
+		Snapshot() raft.SnapshotFuture
+		Restore(*raft.SnapshotMeta, io.Reader, time.Duration) error
 	}
 
 	// syncRaft provides a wrapper for synchronized access to the
@@ -77,6 +83,11 @@ type (
 		Pools         *PoolDatabase
 		Checker       *CheckerDatabase
 		System        *SystemDatabase
+		Quotas        *QuotaDatabase
+		Audit         *AuditDatabase
+		Events        *EventDatabase
+		PoolOps       *PoolOpDatabase
+		Replicas      *ReplicaDatabase
 		SchemaVersion uint
 	}
 
@@ -96,9 +107,12 @@ type (
 		onLeadershipGained []onLeadershipGainedFn
 		onLeadershipLost   []onLeadershipLostFn
 		onRaftShutdown     []onRaftShutdownFn
+		onReplicasChanged  []onReplicasChangedFn
 		shutdownCb         context.CancelFunc
 		shutdownErrCh      chan error
 		poolLocks          poolLockMap
+		accessPtsLock      sync.RWMutex
+		accessPts          []*net.TCPAddr // replica set known to a non-replica
 
 		data *dbData // raft-backed system data
 	}
@@ -111,6 +125,7 @@ type (
 		RaftSnapshotInterval  time.Duration
 		SystemName            string
 		ReadOnly              bool
+		AuditMaxRecords       int // number of audit records retained, 0 for default
 	}
 
 	// GroupMap represents a version of the system membership map.
@@ -172,7 +187,7 @@ func (sr *syncRaft) withReadLock(fn func(raftService) error) error {
 	return fn(svc)
 }
 
-func (cfg *DatabaseConfig) stringReplicas(excludeAddrs ...*net.TCPAddr) (replicas []string) {
+func stringAddrs(addrs []*net.TCPAddr, excludeAddrs ...*net.TCPAddr) (strAddrs []string) {
 	isExcluded := func(addr *net.TCPAddr) bool {
 		for _, e := range excludeAddrs {
 			if common.CmpTCPAddr(addr, e) {
@@ -181,15 +196,19 @@ func (cfg *DatabaseConfig) stringReplicas(excludeAddrs ...*net.TCPAddr) (replica
 		}
 		return false
 	}
-	for _, r := range cfg.Replicas {
-		if isExcluded(r) {
+	for _, a := range addrs {
+		if isExcluded(a) {
 			continue
 		}
-		replicas = append(replicas, r.String())
+		strAddrs = append(strAddrs, a.String())
 	}
 	return
 }
 
+func (cfg *DatabaseConfig) stringReplicas(excludeAddrs ...*net.TCPAddr) []string {
+	return stringAddrs(cfg.Replicas, excludeAddrs...)
+}
+
 func (cfg *DatabaseConfig) PeerReplicaAddrs() (peers []*net.TCPAddr) {
 	localAddr, _ := cfg.LocalReplicaAddr()
 
@@ -270,6 +289,16 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 			System: &SystemDatabase{
 				Attributes: make(map[string]string),
 			},
+			Quotas: &QuotaDatabase{
+				Users:  make(QuotaPrincipalMap),
+				Groups: make(QuotaPrincipalMap),
+			},
+			Audit:  &AuditDatabase{},
+			Events: &EventDatabase{},
+			PoolOps: &PoolOpDatabase{
+				Ops: make(PoolOpMap),
+			},
+			Replicas:      &ReplicaDatabase{},
 			SchemaVersion: CurrentSchemaVersion,
 		},
 	}
@@ -282,7 +311,7 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 // isReplica returns true if the supplied address matches
 // a known replica address.
 func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
-	for _, candidate := range db.cfg.Replicas {
+	for _, candidate := range db.replicas() {
 		if common.CmpTCPAddr(ctrlAddr, candidate) {
 			return true
 		}
@@ -299,17 +328,17 @@ func (db *Database) SystemName() string {
 // LeaderQuery returns the system leader, if known.
 func (db *Database) LeaderQuery() (leader string, replicas []string, err error) {
 	if !db.IsReplica() {
-		return "", nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return "", nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
 
-	return db.leaderHint(), db.cfg.stringReplicas(), nil
+	return db.leaderHint(), db.stringReplicas(), nil
 }
 
 // ReplicaAddr returns the system's replica address if
 // the system is configured as a MS replica.
 func (db *Database) ReplicaAddr() (*net.TCPAddr, error) {
 	if !db.IsReplica() {
-		return nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
 	return db.replicaAddr, nil
 }
@@ -322,7 +351,7 @@ func (db *Database) PeerAddrs() ([]*net.TCPAddr, error) {
 	}
 
 	var peers []*net.TCPAddr
-	for _, rep := range db.cfg.Replicas {
+	for _, rep := range db.replicas() {
 		if !common.CmpTCPAddr(myAddr, rep) {
 			peers = append(peers, rep)
 		}
@@ -351,7 +380,7 @@ func (db *Database) IsBootstrap() bool {
 // replica or the service is not running.
 func (db *Database) CheckReplica() error {
 	if !db.IsReplica() {
-		return &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return &system.ErrNotReplica{db.stringReplicas()}
 	}
 
 	if db.initialized.IsFalse() {
@@ -366,7 +395,7 @@ func (db *Database) CheckReplica() error {
 func errNotSysLeader(svc raftService, db *Database) error {
 	return &system.ErrNotLeader{
 		LeaderHint: string(svc.Leader()),
-		Replicas:   db.cfg.stringReplicas(db.replicaAddr),
+		Replicas:   db.stringReplicas(db.replicaAddr),
 	}
 }
 
@@ -429,6 +458,12 @@ func (db *Database) OnRaftShutdown(fns ...onRaftShutdownFn) {
 	db.onRaftShutdown = append(db.onRaftShutdown, fns...)
 }
 
+// OnReplicasChanged registers callbacks to be run when the MS replica set
+// known to this server is changed at runtime.
+func (db *Database) OnReplicasChanged(fns ...onReplicasChangedFn) {
+	db.onReplicasChanged = append(db.onReplicasChanged, fns...)
+}
+
 // Start checks to see if the system is configured as a MS replica. If
 // not, it returns early without an error. If it is, the persistent storage
 // is initialized if necessary, and the replica is started to begin the
@@ -0,0 +1,105 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// DefaultAuditMaxRecords is the number of audit records retained in the
+// database if no limit is configured. Once reached, the oldest records are
+// discarded to make room for new ones.
+const DefaultAuditMaxRecords = 10000
+
+type (
+	// AuditDatabase contains the audit log of mutating control-plane
+	// operations.
+	AuditDatabase struct {
+		Records []*system.AuditRecord
+		NextID  uint64
+		Dropped uint64 // number of records discarded due to the retention limit
+	}
+
+	// auditUpdate contains a record to be added to the audit log along with
+	// the retention limit of the leader that added it, so that all replicas
+	// discard the same records.
+	auditUpdate struct {
+		Record     *system.AuditRecord
+		MaxRecords int
+	}
+)
+
+// addRecord appends a record to the audit log, assigning it the next ID, and
+// discards the oldest records in excess of maxRecords.
+func (ad *AuditDatabase) addRecord(rec *system.AuditRecord, maxRecords int) {
+	ad.NextID++
+	rec.ID = ad.NextID
+	ad.Records = append(ad.Records, rec)
+	if maxRecords > 0 && len(ad.Records) > maxRecords {
+		ad.Dropped += uint64(len(ad.Records) - maxRecords)
+		ad.Records = append([]*system.AuditRecord{}, ad.Records[len(ad.Records)-maxRecords:]...)
+	}
+}
+
+// AddAuditRecord appends a record to the audit log.
+func (db *Database) AddAuditRecord(rec *system.AuditRecord) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	maxRecords := db.cfg.AuditMaxRecords
+	if maxRecords <= 0 {
+		maxRecords = DefaultAuditMaxRecords
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitAuditUpdate(raftOpAddAuditRecord, &auditUpdate{
+		Record:     rec,
+		MaxRecords: maxRecords,
+	})
+}
+
+// GetAuditRecords returns copies of the audit records matching the filter, in
+// the order in which they were added.
+func (db *Database) GetAuditRecords(filter *system.AuditFilter) ([]*system.AuditRecord, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := []*system.AuditRecord{}
+	for _, rec := range db.data.Audit.Records {
+		if !filter.Matches(rec) {
+			continue
+		}
+		cp := new(system.AuditRecord)
+		*cp = *rec
+		out = append(out, cp)
+	}
+
+	if filter != nil && filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[len(out)-filter.Limit:]
+	}
+	return out, nil
+}
+
+// GetAuditRecordsDropped returns the number of records that have been discarded
+// from the audit log due to the retention limit.
+func (db *Database) GetAuditRecordsDropped() (uint64, error) {
+	if err := db.CheckReplica(); err != nil {
+		return 0, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	return db.data.Audit.Dropped, nil
+}
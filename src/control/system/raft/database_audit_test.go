@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_AuditDatabase_addRecord(t *testing.T) {
+	ad := &AuditDatabase{}
+	for i := 0; i < DefaultAuditMaxRecords+2; i++ {
+		ad.addRecord(&system.AuditRecord{Operation: "PoolCreate"}, DefaultAuditMaxRecords)
+	}
+
+	test.AssertEqual(t, DefaultAuditMaxRecords, len(ad.Records), "unexpected number of records")
+	test.AssertEqual(t, uint64(3), ad.Records[0].ID, "unexpected oldest record")
+	test.AssertEqual(t, uint64(DefaultAuditMaxRecords+2), ad.NextID, "unexpected next ID")
+	test.AssertEqual(t, uint64(2), ad.Dropped, "unexpected number of dropped records")
+
+	// A lower limit discards all records in excess of it.
+	ad.addRecord(&system.AuditRecord{Operation: "PoolCreate"}, 10)
+	test.AssertEqual(t, 10, len(ad.Records), "unexpected number of records")
+	test.AssertEqual(t, uint64(DefaultAuditMaxRecords+3), ad.Records[9].ID, "unexpected newest record")
+	test.AssertEqual(t, uint64(DefaultAuditMaxRecords-7), ad.Dropped, "unexpected number of dropped records")
+}
+
+func TestSystem_Database_AuditMaxRecords(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	db.cfg.AuditMaxRecords = 2
+	for _, op := range []string{"PoolCreate", "PoolDestroy", "SystemStop"} {
+		if err := db.AddAuditRecord(&system.AuditRecord{Operation: op}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gotRecords, err := db.GetAuditRecords(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, len(gotRecords), "unexpected number of records")
+	test.AssertEqual(t, "PoolDestroy", gotRecords[0].Operation, "unexpected oldest record")
+
+	gotDropped, err := db.GetAuditRecordsDropped()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(1), gotDropped, "unexpected number of dropped records")
+}
+
+func TestSystem_Database_AuditRecords(t *testing.T) {
+	start := time.Unix(1700000000, 0).UTC()
+	records := []*system.AuditRecord{
+		{
+			Time:      start,
+			Operation: "PoolCreate",
+			Subject:   "CN=admin,OU=ops",
+		},
+		{
+			Time:      start.Add(time.Minute),
+			Operation: "PoolDestroy",
+			Subject:   "CN=admin,OU=ops",
+			Error:     "pool busy",
+		},
+		{
+			Time:      start.Add(2 * time.Minute),
+			Operation: "SystemStop",
+			Subject:   "CN=admin,OU=storage admins",
+		},
+	}
+	withID := func(idx int) *system.AuditRecord {
+		rec := new(system.AuditRecord)
+		*rec = *records[idx]
+		rec.ID = uint64(idx + 1)
+		return rec
+	}
+
+	for name, tc := range map[string]struct {
+		filter     *system.AuditFilter
+		expRecords []*system.AuditRecord
+	}{
+		"all": {
+			expRecords: []*system.AuditRecord{withID(0), withID(1), withID(2)},
+		},
+		"operation": {
+			filter:     &system.AuditFilter{Operation: "pooldestroy"},
+			expRecords: []*system.AuditRecord{withID(1)},
+		},
+		"subject": {
+			filter:     &system.AuditFilter{Subject: "OU=ops"},
+			expRecords: []*system.AuditRecord{withID(0), withID(1)},
+		},
+		"time range": {
+			filter: &system.AuditFilter{
+				Since: start.Add(30 * time.Second),
+				Until: start.Add(90 * time.Second),
+			},
+			expRecords: []*system.AuditRecord{withID(1)},
+		},
+		"limit": {
+			filter:     &system.AuditFilter{Limit: 2},
+			expRecords: []*system.AuditRecord{withID(1), withID(2)},
+		},
+		"no match": {
+			filter:     &system.AuditFilter{Operation: "StorageFormat"},
+			expRecords: []*system.AuditRecord{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, rec := range records {
+				cp := new(system.AuditRecord)
+				*cp = *rec
+				if err := db.AddAuditRecord(cp); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotRecords, err := db.GetAuditRecords(tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expRecords, gotRecords); diff != "" {
+				t.Fatalf("unexpected records (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
+)
+
+// maxEventRecords is the number of RAS events retained in the event history.
+// Once reached, the oldest events are discarded to make room for new ones.
+const maxEventRecords = 10000
+
+// EventDatabase contains the history of RAS events published by the MS leader.
+// Events are stored in their protobuf encoding, in the order in which they were
+// recorded, as the extended info of native events cannot be decoded from JSON.
+// Events published concurrently may be recorded out of order, so they are
+// sorted by timestamp when read.
+type EventDatabase struct {
+	Events [][]byte
+}
+
+// addEvent appends an encoded event to the history.
+func (ed *EventDatabase) addEvent(data []byte) {
+	ed.Events = append(ed.Events, data)
+	if len(ed.Events) > maxEventRecords {
+		ed.Events = append([][]byte{}, ed.Events[len(ed.Events)-maxEventRecords:]...)
+	}
+}
+
+// AddEvent appends a RAS event to the event history.
+func (db *Database) AddEvent(evt *events.RASEvent) error {
+	if evt == nil {
+		return errors.New("nil event")
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(pbEvt)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitEventUpdate(raftOpAddEvent, data)
+}
+
+// GetEvents returns the events in the event history that match the filter, in
+// chronological order.
+func (db *Database) GetEvents(filter *events.HistoryFilter) ([]*events.RASEvent, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	evts := make([]*events.RASEvent, 0, len(db.data.Events.Events))
+	times := make(map[*events.RASEvent]time.Time, len(db.data.Events.Events))
+	for _, data := range db.data.Events.Events {
+		pbEvt := new(sharedpb.RASEvent)
+		if err := proto.Unmarshal(data, pbEvt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal event")
+		}
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			return nil, err
+		}
+		evts = append(evts, evt)
+		times[evt], _ = evt.GetTimestamp()
+	}
+	sort.SliceStable(evts, func(i, j int) bool {
+		return times[evts[i]].Before(times[evts[j]])
+	})
+
+	return filter.Filter(evts), nil
+}
@@ -0,0 +1,124 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSystem_EventDatabase_addEvent(t *testing.T) {
+	ed := &EventDatabase{}
+	for i := 0; i < maxEventRecords+2; i++ {
+		ed.addEvent([]byte{byte(i)})
+	}
+
+	test.AssertEqual(t, maxEventRecords, len(ed.Events), "unexpected number of events")
+	test.AssertEqual(t, byte(2), ed.Events[0][0], "unexpected oldest event")
+}
+
+func TestSystem_Database_Events(t *testing.T) {
+	evts := []*events.RASEvent{
+		events.NewEngineDiedEvent("host1", 0, 0, common.NormalExit, 1234),
+		events.NewEngineFormatRequiredEvent("host2", 1, "metadata"),
+		events.NewEngineDiedEvent("host2", 1, 2, common.NormalExit, 5678),
+	}
+	evtMsgs := func(evts []*events.RASEvent) []string {
+		msgs := []string{}
+		for _, evt := range evts {
+			msgs = append(msgs, evt.String())
+		}
+		return msgs
+	}
+
+	for name, tc := range map[string]struct {
+		filter  *events.HistoryFilter
+		expEvts []*events.RASEvent
+	}{
+		"all": {
+			expEvts: evts,
+		},
+		"ranks": {
+			filter:  &events.HistoryFilter{Ranks: ranklist.MustCreateRankSet("2")},
+			expEvts: []*events.RASEvent{evts[2]},
+		},
+		"ids": {
+			filter:  &events.HistoryFilter{IDs: []events.RASID{events.RASEngineDied}},
+			expEvts: []*events.RASEvent{evts[0], evts[2]},
+		},
+		"limit": {
+			filter:  &events.HistoryFilter{Limit: 2},
+			expEvts: []*events.RASEvent{evts[1], evts[2]},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, evt := range evts {
+				if err := db.AddEvent(evt); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotEvts, err := db.GetEvents(tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(evtMsgs(tc.expEvts), evtMsgs(gotEvts)); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_Events_OutOfOrder(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	now := time.Now()
+	var evts []*events.RASEvent
+	for i := 0; i < 3; i++ {
+		evt := events.NewEngineDiedEvent("host1", 0, uint32(i), common.NormalExit, 1234)
+		evt.Timestamp = common.FormatTime(now.Add(time.Duration(i) * time.Second))
+		evts = append(evts, evt)
+	}
+
+	db := MockDatabase(t, log)
+	for _, i := range []int{1, 2, 0} {
+		if err := db.AddEvent(evts[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gotEvts, err := db.GetEvents(&events.HistoryFilter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, len(gotEvts), "unexpected number of events")
+	test.AssertEqual(t, evts[1].Timestamp, gotEvts[0].Timestamp, "unexpected first event")
+	test.AssertEqual(t, evts[2].Timestamp, gotEvts[1].Timestamp, "unexpected second event")
+}
+
+func TestSystem_Database_AddEvent_Nil(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	test.CmpErr(t, errors.New("nil event"), db.AddEvent(nil))
+}
@@ -0,0 +1,142 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// PoolOpMap allows the lookup of a PoolOp by its ID.
+	PoolOpMap map[uuid.UUID]*system.PoolOp
+
+	// PoolOpDatabase contains the rebuild-triggering pool operations
+	// queued by the MS.
+	PoolOpDatabase struct {
+		Ops PoolOpMap
+	}
+)
+
+func copyPoolOp(in *system.PoolOp) *system.PoolOp {
+	out := new(system.PoolOp)
+	*out = *in
+	if in.Ranks != nil {
+		out.Ranks = append([]ranklist.Rank{}, in.Ranks...)
+	}
+	if in.TargetIdx != nil {
+		out.TargetIdx = append([]uint32{}, in.TargetIdx...)
+	}
+	if in.Request != nil {
+		out.Request = append([]byte{}, in.Request...)
+	}
+	return out
+}
+
+// AddPoolOp adds a new pool operation to the database.
+func (db *Database) AddPoolOp(op *system.PoolOp) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if op == nil {
+		return errors.New("nil pool operation")
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	if _, err := db.FindPoolOp(op.ID); err == nil {
+		return errors.Errorf("pool operation %s already exists", op.ID)
+	}
+
+	return db.submitPoolOpUpdate(raftOpUpdatePoolOp, op)
+}
+
+// UpdatePoolOp updates an existing pool operation.
+func (db *Database) UpdatePoolOp(op *system.PoolOp) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if op == nil {
+		return errors.New("nil pool operation")
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	if _, err := db.FindPoolOp(op.ID); err != nil {
+		return err
+	}
+
+	return db.submitPoolOpUpdate(raftOpUpdatePoolOp, op)
+}
+
+// RemovePoolOp removes the pool operation with the given ID.
+func (db *Database) RemovePoolOp(id uuid.UUID) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	op, err := db.FindPoolOp(id)
+	if err != nil {
+		return err
+	}
+
+	return db.submitPoolOpUpdate(raftOpRemovePoolOp, op)
+}
+
+// FindPoolOp returns a copy of the pool operation with the given ID.
+func (db *Database) FindPoolOp(id uuid.UUID) (*system.PoolOp, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	op, found := db.data.PoolOps.Ops[id]
+	if !found {
+		return nil, system.ErrPoolOpNotFound(id)
+	}
+	return copyPoolOp(op), nil
+}
+
+// PoolOps returns copies of the operations queued for the pool with the
+// given UUID, or for all pools if the UUID is nil, in the order in which
+// they were created.
+func (db *Database) PoolOps(poolUUID uuid.UUID) ([]*system.PoolOp, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := make([]*system.PoolOp, 0, len(db.data.PoolOps.Ops))
+	for _, op := range db.data.PoolOps.Ops {
+		if poolUUID != uuid.Nil && op.PoolUUID != poolUUID {
+			continue
+		}
+		out = append(out, copyPoolOp(op))
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Created.Equal(out[j].Created) {
+			return out[i].ID.String() < out[j].ID.String()
+		}
+		return out[i].Created.Before(out[j].Created)
+	})
+	return out, nil
+}
@@ -0,0 +1,127 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_Database_PoolOps(t *testing.T) {
+	pool1 := uuid.MustParse(test.MockUUID(1))
+	pool2 := uuid.MustParse(test.MockUUID(2))
+	created := time.Now()
+
+	mockOp := func(idx int32, poolUUID uuid.UUID, opType system.PoolOpType) *system.PoolOp {
+		return &system.PoolOp{
+			ID:        uuid.MustParse(test.MockUUID(100 + idx)),
+			PoolUUID:  poolUUID,
+			Type:      opType,
+			Ranks:     []ranklist.Rank{ranklist.Rank(idx)},
+			TargetIdx: []uint32{0, 1},
+			Created:   created.Add(time.Duration(idx) * time.Second),
+		}
+	}
+	op1 := mockOp(1, pool1, system.PoolOpTypeDrain)
+	op2 := mockOp(2, pool2, system.PoolOpTypeExclude)
+	op3 := mockOp(3, pool1, system.PoolOpTypeReintegrate)
+	op3Running := mockOp(3, pool1, system.PoolOpTypeReintegrate)
+	op3Running.Start()
+
+	for name, tc := range map[string]struct {
+		add      []*system.PoolOp
+		update   []*system.PoolOp
+		remove   []uuid.UUID
+		poolUUID uuid.UUID
+		expOps   []*system.PoolOp
+		expErr   error
+	}{
+		"no ops": {
+			expOps: []*system.PoolOp{},
+		},
+		"all pools": {
+			add:    []*system.PoolOp{op3, op1, op2},
+			expOps: []*system.PoolOp{op1, op2, op3},
+		},
+		"one pool": {
+			add:      []*system.PoolOp{op3, op1, op2},
+			poolUUID: pool1,
+			expOps:   []*system.PoolOp{op1, op3},
+		},
+		"duplicate": {
+			add:    []*system.PoolOp{op1, op1},
+			expErr: errors.New("already exists"),
+		},
+		"update": {
+			add:      []*system.PoolOp{op1, op3},
+			update:   []*system.PoolOp{op3Running},
+			poolUUID: pool1,
+			expOps:   []*system.PoolOp{op1, op3Running},
+		},
+		"update unknown": {
+			update: []*system.PoolOp{op1},
+			expErr: system.ErrPoolOpNotFound(op1.ID),
+		},
+		"remove": {
+			add:    []*system.PoolOp{op1, op2},
+			remove: []uuid.UUID{op1.ID},
+			expOps: []*system.PoolOp{op2},
+		},
+		"remove unknown": {
+			remove: []uuid.UUID{op1.ID},
+			expErr: system.ErrPoolOpNotFound(op1.ID),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+
+			var gotErr error
+			for _, op := range tc.add {
+				if gotErr = db.AddPoolOp(op); gotErr != nil {
+					break
+				}
+			}
+			for _, op := range tc.update {
+				if gotErr != nil {
+					break
+				}
+				gotErr = db.UpdatePoolOp(op)
+			}
+			for _, id := range tc.remove {
+				if gotErr != nil {
+					break
+				}
+				gotErr = db.RemovePoolOp(id)
+			}
+
+			var gotOps []*system.PoolOp
+			if gotErr == nil {
+				gotOps, gotErr = db.PoolOps(tc.poolUUID)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expOps, gotOps); diff != "" {
+				t.Fatalf("unexpected pool ops (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"sort"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// QuotaPrincipalMap allows the lookup of a Quota by its ACL principal.
+	QuotaPrincipalMap map[string]*system.Quota
+
+	// QuotaDatabase contains the resource quotas set for users and groups.
+	QuotaDatabase struct {
+		Users  QuotaPrincipalMap
+		Groups QuotaPrincipalMap
+	}
+)
+
+// principalMap returns the map that holds quotas of the same kind (user or
+// group) as the supplied quota, and the key for the quota within it.
+func (qdb *QuotaDatabase) principalMap(q *system.Quota) (QuotaPrincipalMap, string) {
+	if q.User != "" {
+		return qdb.Users, q.User
+	}
+	return qdb.Groups, q.Group
+}
+
+func copyQuota(in *system.Quota) *system.Quota {
+	out := new(system.Quota)
+	*out = *in
+	if in.TierBytes != nil {
+		out.TierBytes = append([]uint64{}, in.TierBytes...)
+	}
+	return out
+}
+
+// SetQuota adds or replaces the quota for the quota's user or group.
+func (db *Database) SetQuota(q *system.Quota) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if err := q.Validate(); err != nil {
+		return err
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitQuotaUpdate(raftOpSetQuota, q)
+}
+
+// RemoveQuota removes the quota set for the quota's user or group.
+func (db *Database) RemoveQuota(q *system.Quota) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if err := q.Validate(); err != nil {
+		return err
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	if err := db.findQuota(q); err != nil {
+		return err
+	}
+	return db.submitQuotaUpdate(raftOpRemoveQuota, &system.Quota{User: q.User, Group: q.Group})
+}
+
+func (db *Database) findQuota(q *system.Quota) error {
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	pm, key := db.data.Quotas.principalMap(q)
+	if _, found := pm[key]; !found {
+		return system.ErrQuotaNotFound(q.String())
+	}
+	return nil
+}
+
+// GetQuotas returns copies of the quotas set for the given user and group
+// principals, or all quotas if no principals are supplied. User quotas are
+// returned before group quotas, each sorted by principal.
+func (db *Database) GetQuotas(users, groups []string) ([]*system.Quota, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	qdb := db.data.Quotas
+	if len(users) == 0 && len(groups) == 0 {
+		out := make([]*system.Quota, 0, len(qdb.Users)+len(qdb.Groups))
+		for _, pm := range []QuotaPrincipalMap{qdb.Users, qdb.Groups} {
+			for _, q := range sortedQuotas(pm) {
+				out = append(out, copyQuota(q))
+			}
+		}
+		return out, nil
+	}
+
+	out := make([]*system.Quota, 0, len(users)+len(groups))
+	for _, user := range users {
+		q, found := qdb.Users[user]
+		if !found {
+			return nil, system.ErrQuotaNotFound("user " + user)
+		}
+		out = append(out, copyQuota(q))
+	}
+	for _, group := range groups {
+		q, found := qdb.Groups[group]
+		if !found {
+			return nil, system.ErrQuotaNotFound("group " + group)
+		}
+		out = append(out, copyQuota(q))
+	}
+	return out, nil
+}
+
+func sortedQuotas(pm QuotaPrincipalMap) []*system.Quota {
+	keys := make([]string, 0, len(pm))
+	for key := range pm {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	out := make([]*system.Quota, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, pm[key])
+	}
+	return out
+}
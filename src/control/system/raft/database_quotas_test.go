@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_Database_Quotas(t *testing.T) {
+	bobQuota := &system.Quota{
+		User:     "bob@",
+		MaxPools: 2,
+	}
+	aliceQuota := &system.Quota{
+		User:     "alice@",
+		MaxPools: 1,
+	}
+	grpQuota := &system.Quota{
+		Group:     "builders@",
+		TierBytes: []uint64{1 << 30, 1 << 40},
+	}
+
+	for name, tc := range map[string]struct {
+		set       []*system.Quota
+		remove    []*system.Quota
+		users     []string
+		groups    []string
+		expQuotas []*system.Quota
+		expErr    error
+	}{
+		"no quotas": {
+			expQuotas: []*system.Quota{},
+		},
+		"list all": {
+			set:       []*system.Quota{grpQuota, bobQuota, aliceQuota},
+			expQuotas: []*system.Quota{aliceQuota, bobQuota, grpQuota},
+		},
+		"get one": {
+			set:       []*system.Quota{grpQuota, bobQuota},
+			users:     []string{bobQuota.User},
+			expQuotas: []*system.Quota{bobQuota},
+		},
+		"get user and group": {
+			set:       []*system.Quota{grpQuota, bobQuota, aliceQuota},
+			users:     []string{bobQuota.User},
+			groups:    []string{grpQuota.Group},
+			expQuotas: []*system.Quota{bobQuota, grpQuota},
+		},
+		"same name for user and group": {
+			set: []*system.Quota{bobQuota, {Group: "bob@", MaxPools: 7}},
+			expQuotas: []*system.Quota{
+				bobQuota,
+				{Group: "bob@", MaxPools: 7},
+			},
+		},
+		"replace": {
+			set: []*system.Quota{bobQuota, {
+				User:     bobQuota.User,
+				MaxPools: 5,
+			}},
+			expQuotas: []*system.Quota{
+				{
+					User:     bobQuota.User,
+					MaxPools: 5,
+				},
+			},
+		},
+		"remove": {
+			set:       []*system.Quota{grpQuota, bobQuota},
+			remove:    []*system.Quota{{Group: grpQuota.Group}},
+			expQuotas: []*system.Quota{bobQuota},
+		},
+		"remove unknown": {
+			remove: []*system.Quota{{User: "alice@"}},
+			expErr: system.ErrQuotaNotFound("user alice@"),
+		},
+		"get unknown": {
+			set:    []*system.Quota{bobQuota},
+			groups: []string{"bob@"},
+			expErr: system.ErrQuotaNotFound("group bob@"),
+		},
+		"invalid principal": {
+			set:    []*system.Quota{{User: "bob", MaxPools: 1}},
+			expErr: errors.New("invalid ACL principal"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+
+			var gotErr error
+			for _, q := range tc.set {
+				if gotErr = db.SetQuota(q); gotErr != nil {
+					break
+				}
+			}
+			for _, q := range tc.remove {
+				if gotErr != nil {
+					break
+				}
+				gotErr = db.RemoveQuota(q)
+			}
+
+			var gotQuotas []*system.Quota
+			if gotErr == nil {
+				gotQuotas, gotErr = db.GetQuotas(tc.users, tc.groups)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expQuotas, gotQuotas); diff != "" {
+				t.Fatalf("unexpected quotas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// ReplicaDatabase contains the MS replica set once it has been changed at
+// runtime. Until then, the replica set is taken from the configured access
+// points.
+type ReplicaDatabase struct {
+	Replicas []*net.TCPAddr
+}
+
+// replicas returns a copy of the current set of MS replica addresses. On a
+// running replica, a replica set changed at runtime is distributed through
+// raft. Other servers use the replica set last sent to them by the MS leader,
+// if any, or the configured access points.
+func (db *Database) replicas() []*net.TCPAddr {
+	if db.IsReplica() && db.initialized.IsTrue() {
+		db.data.RLock()
+		replicas := db.data.Replicas.Replicas
+		db.data.RUnlock()
+
+		if len(replicas) > 0 {
+			return append([]*net.TCPAddr{}, replicas...)
+		}
+	}
+
+	db.accessPtsLock.RLock()
+	defer db.accessPtsLock.RUnlock()
+
+	if len(db.accessPts) > 0 {
+		return append([]*net.TCPAddr{}, db.accessPts...)
+	}
+	return append([]*net.TCPAddr{}, db.cfg.Replicas...)
+}
+
+func (db *Database) stringReplicas(excludeAddrs ...*net.TCPAddr) []string {
+	return stringAddrs(db.replicas(), excludeAddrs...)
+}
+
+// AccessPoints returns the current set of MS replica addresses.
+func (db *Database) AccessPoints() []string {
+	return db.stringReplicas()
+}
+
+// ReplicaAddrs returns the current set of MS replica addresses.
+func (db *Database) ReplicaAddrs() ([]*net.TCPAddr, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	return db.replicas(), nil
+}
+
+// runOnReplicasChanged runs the callbacks registered with OnReplicasChanged.
+func (db *Database) runOnReplicasChanged() {
+	accessPts := db.AccessPoints()
+	for _, fn := range db.onReplicasChanged {
+		fn(accessPts)
+	}
+}
+
+// SetAccessPoints updates the MS replica set known to a server that is not a
+// MS replica, after it has been changed at runtime. The set is not persisted,
+// so the configured access points are used again after a restart until the
+// server next joins the system. MS replicas track the replica set through
+// raft and may not be updated this way.
+func (db *Database) SetAccessPoints(replicas []*net.TCPAddr) error {
+	if len(replicas) == 0 {
+		return errors.New("empty MS replica set")
+	}
+	if db.IsReplica() {
+		return errors.New("MS replica set can't be updated on a MS replica")
+	}
+
+	db.accessPtsLock.Lock()
+	db.accessPts = append([]*net.TCPAddr{}, replicas...)
+	db.accessPtsLock.Unlock()
+
+	db.runOnReplicasChanged()
+	return nil
+}
+
+// replicaQuorum returns the number of replicas required for quorum in a
+// replica set of the given size.
+func replicaQuorum(numReplicas int) int {
+	return numReplicas/2 + 1
+}
+
+// raftVoters returns the addresses of the voters in the current raft
+// configuration.
+func (db *Database) raftVoters() (map[string]bool, error) {
+	voters := make(map[string]bool)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		future := svc.GetConfiguration()
+		if err := future.Error(); err != nil {
+			return err
+		}
+		for _, srv := range future.Configuration().Servers {
+			if srv.Suffrage == raft.Voter {
+				voters[string(srv.Address)] = true
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to get raft configuration")
+	}
+
+	return voters, nil
+}
+
+// hostsJoinedMember returns true if the server at the supplied control address
+// hosts at least one joined system member.
+func (db *Database) hostsJoinedMember(addr *net.TCPAddr) bool {
+	members, err := db.FindMembersByAddr(addr)
+	if err != nil {
+		return false
+	}
+	for _, m := range members {
+		if m.State == system.MemberStateJoined {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAvailableReplica returns true if the supplied replica is expected to be
+// able to participate in the raft quorum. The local replica is always
+// available; remote replicas are considered to be available if they are
+// voters in the current raft configuration and host at least one joined
+// system member.
+func (db *Database) isAvailableReplica(addr *net.TCPAddr, voters map[string]bool) bool {
+	if common.CmpTCPAddr(db.replicaAddr, addr) {
+		return true
+	}
+
+	return voters[addr.String()] && db.hostsJoinedMember(addr)
+}
+
+// checkReplicaQuorum returns an error if fewer than a quorum of the supplied
+// replica set are available. A candidate that is about to be added as a voter
+// is considered to be available if it hosts a joined member.
+func (db *Database) checkReplicaQuorum(replicas []*net.TCPAddr, candidate *net.TCPAddr) error {
+	voters, err := db.raftVoters()
+	if err != nil {
+		return err
+	}
+
+	var numAvail int
+	for _, rep := range replicas {
+		if common.CmpTCPAddr(rep, candidate) {
+			if db.hostsJoinedMember(rep) {
+				numAvail++
+			}
+			continue
+		}
+		if db.isAvailableReplica(rep, voters) {
+			numAvail++
+		}
+	}
+
+	if numAvail < replicaQuorum(len(replicas)) {
+		return errors.Errorf("only %d of %d replicas would be available, %d are required for quorum",
+			numAvail, len(replicas), replicaQuorum(len(replicas)))
+	}
+
+	return nil
+}
+
+// CheckAddReplica verifies that the server at the supplied control address
+// may be added to the MS replica set and returns the resulting set. Unless
+// force is set, the server must host a joined member and a quorum of the
+// resulting set must be available.
+func (db *Database) CheckAddReplica(addr *net.TCPAddr, force bool) ([]*net.TCPAddr, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+	if addr == nil {
+		return nil, errors.New("nil replica address")
+	}
+
+	if db.isReplica(addr) {
+		return nil, errors.Errorf("%s is already a MS replica", addr)
+	}
+	if _, err := db.FindMembersByAddr(addr); err != nil {
+		return nil, errors.Wrapf(err, "%s is not a system member", addr)
+	}
+
+	replicas := append(db.replicas(), addr)
+	if force {
+		return replicas, nil
+	}
+
+	if !db.hostsJoinedMember(addr) {
+		return nil, errors.Errorf("%s has no joined members", addr)
+	}
+	if err := db.checkReplicaQuorum(replicas, addr); err != nil {
+		return nil, errors.Wrapf(err, "adding %s", addr)
+	}
+
+	return replicas, nil
+}
+
+// AddReplica adds the server at the supplied control address to the MS replica
+// set and as a raft voter, and returns the resulting set. The server must be
+// running a raft replica, i.e. it must be configured as an access point.
+func (db *Database) AddReplica(addr *net.TCPAddr) ([]*net.TCPAddr, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if db.isReplica(addr) {
+		return nil, errors.Errorf("%s is already a MS replica", addr)
+	}
+
+	// Record the new replica set before adding the voter, so that the new
+	// replica is re-added by any leader if its member rejoins.
+	prevReplicas := db.replicas()
+	replicas := append(db.replicas(), addr)
+	if err := db.submitReplicasUpdate(replicas); err != nil {
+		return nil, err
+	}
+
+	db.log.Debugf("adding %s as a new raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.AddVoter(raft.ServerID(addr.String()), raft.ServerAddress(addr.String()), 0, 0).Error()
+	}); err != nil {
+		if rbErr := db.submitReplicasUpdate(prevReplicas); rbErr != nil {
+			db.log.Errorf("failed to restore MS replica set: %s", rbErr)
+		}
+		return nil, errors.Wrapf(err, "failed to add %q as raft replica", addr)
+	}
+
+	return replicas, nil
+}
+
+// CheckRemoveReplica verifies that the server at the supplied control address
+// may be removed from the MS replica set and returns the resulting set. The
+// current leader may not be removed. Unless force is set, a quorum of the
+// resulting set must be available.
+func (db *Database) CheckRemoveReplica(addr *net.TCPAddr, force bool) ([]*net.TCPAddr, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+	if addr == nil {
+		return nil, errors.New("nil replica address")
+	}
+
+	if !db.isReplica(addr) {
+		return nil, errors.Errorf("%s is not a MS replica", addr)
+	}
+	if common.CmpTCPAddr(db.replicaAddr, addr) {
+		return nil, errors.Errorf("%s is the current MS leader; transfer leadership before removing it", addr)
+	}
+
+	replicas := db.replicas()
+	for i, rep := range replicas {
+		if common.CmpTCPAddr(rep, addr) {
+			replicas = append(replicas[:i], replicas[i+1:]...)
+			break
+		}
+	}
+	if force {
+		return replicas, nil
+	}
+
+	if err := db.checkReplicaQuorum(replicas, nil); err != nil {
+		return nil, errors.Wrapf(err, "removing %s", addr)
+	}
+
+	return replicas, nil
+}
+
+// RemoveReplica removes the server at the supplied control address from the MS
+// replica set and as a raft voter, and returns the resulting set.
+func (db *Database) RemoveReplica(addr *net.TCPAddr) ([]*net.TCPAddr, error) {
+	replicas, err := db.CheckRemoveReplica(addr, true)
+	if err != nil {
+		return nil, err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	// Record the new replica set before removing the voter, so that the
+	// removed replica is not re-added by any leader if its member rejoins.
+	prevReplicas := db.replicas()
+	if err := db.submitReplicasUpdate(replicas); err != nil {
+		return nil, err
+	}
+
+	db.log.Debugf("removing %s as a raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.RemoveServer(raft.ServerID(addr.String()), 0, 0).Error()
+	}); err != nil {
+		if rbErr := db.submitReplicasUpdate(prevReplicas); rbErr != nil {
+			db.log.Errorf("failed to restore MS replica set: %s", rbErr)
+		}
+		return nil, errors.Wrapf(err, "failed to remove %q as a raft replica", addr)
+	}
+
+	return replicas, nil
+}
+
+// TransferLeadership transfers MS leadership to the replica at the supplied
+// control address, or to any other replica if the address is nil.
+func (db *Database) TransferLeadership(addr *net.TCPAddr) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	if addr != nil {
+		if !db.isReplica(addr) {
+			return errors.Errorf("%s is not a MS replica", addr)
+		}
+		if common.CmpTCPAddr(db.replicaAddr, addr) {
+			return errors.Errorf("%s is already the MS leader", addr)
+		}
+	} else if len(db.replicas()) < 2 {
+		return errors.New("no other MS replicas to transfer leadership to")
+	}
+
+	return db.raft.withReadLock(func(svc raftService) error {
+		if addr == nil {
+			return svc.LeadershipTransfer().Error()
+		}
+		return svc.LeadershipTransferToServer(raft.ServerID(addr.String()),
+			raft.ServerAddress(addr.String())).Error()
+	})
+}
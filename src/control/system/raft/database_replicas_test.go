@@ -0,0 +1,393 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// mockReplicaDB returns a database led by the local replica, with one member
+// hosted at each of the supplied control address indices in the given state.
+func mockReplicaDB(t *testing.T, log logging.Logger, replicaIdxs []uint32, memberStates map[uint32]system.MemberState) *Database {
+	t.Helper()
+
+	var replicas []*net.TCPAddr
+	for _, idx := range replicaIdxs {
+		replicas = append(replicas, system.MockControlAddr(t, idx))
+	}
+	db := MockDatabaseWithReplicas(t, log, replicas...)
+
+	for idx, state := range memberStates {
+		if err := db.AddMember(system.MockMember(t, idx, state)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+func cmpReplicas(t *testing.T, exp []*net.TCPAddr, got []*net.TCPAddr) {
+	t.Helper()
+
+	expStr := make([]string, 0, len(exp))
+	for _, addr := range exp {
+		expStr = append(expStr, addr.String())
+	}
+	gotStr := make([]string, 0, len(got))
+	for _, addr := range got {
+		gotStr = append(gotStr, addr.String())
+	}
+	if diff := cmp.Diff(expStr, gotStr); diff != "" {
+		t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestRaft_Database_CheckAddReplica(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+
+	for name, tc := range map[string]struct {
+		replicaIdxs  []uint32
+		memberStates map[uint32]system.MemberState
+		notLeader    bool
+		addIdx       uint32
+		force        bool
+		expReplicas  []uint32
+		expErr       error
+	}{
+		"not leader": {
+			notLeader: true,
+			addIdx:    2,
+			expErr:    errors.New("not the DAOS Management Service leader"),
+		},
+		"already a replica": {
+			replicaIdxs: []uint32{2},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+			},
+			addIdx: 2,
+			expErr: errors.New("already a MS replica"),
+		},
+		"not a member": {
+			addIdx: 2,
+			expErr: errors.New("not a system member"),
+		},
+		"no joined members": {
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateStopped,
+			},
+			addIdx: 2,
+			expErr: errors.New("no joined members"),
+		},
+		"no joined members; forced": {
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateStopped,
+			},
+			addIdx:      2,
+			force:       true,
+			expReplicas: []uint32{2},
+		},
+		"quorum lost": {
+			replicaIdxs: []uint32{3, 4},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+				3: system.MemberStateStopped,
+				4: system.MemberStateStopped,
+			},
+			addIdx: 2,
+			expErr: errors.New("required for quorum"),
+		},
+		"success": {
+			replicaIdxs: []uint32{3},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+				3: system.MemberStateStopped,
+			},
+			addIdx:      2,
+			expReplicas: []uint32{3, 2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockReplicaDB(t, log, tc.replicaIdxs, tc.memberStates)
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			gotReplicas, gotErr := db.CheckAddReplica(system.MockControlAddr(t, tc.addIdx), tc.force)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			expReplicas := []*net.TCPAddr{local}
+			for _, idx := range tc.expReplicas {
+				expReplicas = append(expReplicas, system.MockControlAddr(t, idx))
+			}
+			cmpReplicas(t, expReplicas, gotReplicas)
+		})
+	}
+}
+
+func TestRaft_Database_CheckRemoveReplica(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+
+	for name, tc := range map[string]struct {
+		replicaIdxs  []uint32
+		memberStates map[uint32]system.MemberState
+		nonVoterIdxs []uint32
+		removeAddr   *net.TCPAddr
+		force        bool
+		expReplicas  []uint32
+		expErr       error
+	}{
+		"not a replica": {
+			removeAddr: system.MockControlAddr(t, 2),
+			expErr:     errors.New("not a MS replica"),
+		},
+		"current leader": {
+			removeAddr: local,
+			expErr:     errors.New("current MS leader"),
+		},
+		"quorum lost": {
+			replicaIdxs: []uint32{2, 3},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+				3: system.MemberStateStopped,
+			},
+			removeAddr: system.MockControlAddr(t, 2),
+			expErr:     errors.New("required for quorum"),
+		},
+		"quorum lost; replica not a raft voter": {
+			replicaIdxs: []uint32{2, 3},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+				3: system.MemberStateJoined,
+			},
+			nonVoterIdxs: []uint32{3},
+			removeAddr:   system.MockControlAddr(t, 2),
+			expErr:       errors.New("required for quorum"),
+		},
+		"quorum lost; forced": {
+			replicaIdxs: []uint32{2, 3},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+				3: system.MemberStateStopped,
+			},
+			removeAddr:  system.MockControlAddr(t, 2),
+			force:       true,
+			expReplicas: []uint32{3},
+		},
+		"success": {
+			replicaIdxs: []uint32{2, 3},
+			memberStates: map[uint32]system.MemberState{
+				2: system.MemberStateJoined,
+				3: system.MemberStateJoined,
+			},
+			removeAddr:  system.MockControlAddr(t, 2),
+			expReplicas: []uint32{3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockReplicaDB(t, log, tc.replicaIdxs, tc.memberStates)
+			for _, idx := range tc.nonVoterIdxs {
+				addr := system.MockControlAddr(t, idx)
+				db.raft.svc.RemoveServer(raft.ServerID(addr.String()), 0, 0)
+			}
+
+			gotReplicas, gotErr := db.CheckRemoveReplica(tc.removeAddr, tc.force)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			expReplicas := []*net.TCPAddr{local}
+			for _, idx := range tc.expReplicas {
+				expReplicas = append(expReplicas, system.MockControlAddr(t, idx))
+			}
+			cmpReplicas(t, expReplicas, gotReplicas)
+		})
+	}
+}
+
+func TestRaft_Database_AddRemoveReplica(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	local := common.LocalhostCtrlAddr()
+	newAddr := system.MockControlAddr(t, 2)
+	db := mockReplicaDB(t, log, nil, map[uint32]system.MemberState{
+		2: system.MemberStateJoined,
+	})
+
+	var notified []string
+	db.OnReplicasChanged(func(accessPts []string) {
+		notified = accessPts
+	})
+
+	isVoter := func(addr *net.TCPAddr) bool {
+		t.Helper()
+
+		voters, err := db.raftVoters()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return voters[addr.String()]
+	}
+
+	replicas, err := db.AddReplica(newAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmpReplicas(t, []*net.TCPAddr{local, newAddr}, replicas)
+	cmpReplicas(t, []*net.TCPAddr{local, newAddr}, db.data.Replicas.Replicas)
+	test.AssertTrue(t, db.isReplica(newAddr), "expected new replica")
+	test.AssertTrue(t, isVoter(newAddr), "expected new raft voter")
+	if diff := cmp.Diff([]string{local.String(), newAddr.String()}, notified); diff != "" {
+		t.Fatalf("unexpected notification (-want, +got):\n%s\n", diff)
+	}
+
+	if _, err := db.AddReplica(newAddr); err == nil {
+		t.Fatal("expected error adding existing replica")
+	}
+
+	replicas, err = db.RemoveReplica(newAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmpReplicas(t, []*net.TCPAddr{local}, replicas)
+	cmpReplicas(t, []*net.TCPAddr{local}, db.data.Replicas.Replicas)
+	test.AssertFalse(t, db.isReplica(newAddr), "expected replica to be removed")
+	test.AssertFalse(t, isVoter(newAddr), "expected raft voter to be removed")
+	if diff := cmp.Diff([]string{local.String()}, notified); diff != "" {
+		t.Fatalf("unexpected notification (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestRaft_Database_SetAccessPoints(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	replicaAddr := system.MockControlAddr(t, 2)
+	newAddr := system.MockControlAddr(t, 3)
+
+	for name, tc := range map[string]struct {
+		isReplica   bool
+		accessPts   []*net.TCPAddr
+		expReplicas []*net.TCPAddr
+		expErr      error
+	}{
+		"empty set": {
+			expErr: errors.New("empty MS replica set"),
+		},
+		"replica": {
+			isReplica: true,
+			accessPts: []*net.TCPAddr{local, newAddr},
+			expErr:    errors.New("can't be updated on a MS replica"),
+		},
+		"success": {
+			accessPts:   []*net.TCPAddr{replicaAddr, newAddr},
+			expReplicas: []*net.TCPAddr{replicaAddr, newAddr},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var db *Database
+			if tc.isReplica {
+				db = mockReplicaDB(t, log, nil, nil)
+			} else {
+				db = MockDatabaseWithCfg(t, log, &DatabaseConfig{
+					SystemName: build.DefaultSystemName,
+					Replicas:   []*net.TCPAddr{replicaAddr},
+				})
+			}
+
+			var notified []string
+			db.OnReplicasChanged(func(accessPts []string) {
+				notified = accessPts
+			})
+
+			gotErr := db.SetAccessPoints(tc.accessPts)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				test.AssertEqual(t, 0, len(notified), "unexpected notification")
+				return
+			}
+
+			cmpReplicas(t, tc.expReplicas, db.replicas())
+			if diff := cmp.Diff(stringAddrs(tc.expReplicas), notified); diff != "" {
+				t.Fatalf("unexpected notification (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRaft_Database_TransferLeadership(t *testing.T) {
+	for name, tc := range map[string]struct {
+		replicaIdxs []uint32
+		addr        *net.TCPAddr
+		transferErr error
+		expErr      error
+	}{
+		"no other replicas": {
+			expErr: errors.New("no other MS replicas"),
+		},
+		"not a replica": {
+			replicaIdxs: []uint32{2},
+			addr:        system.MockControlAddr(t, 3),
+			expErr:      errors.New("not a MS replica"),
+		},
+		"already leader": {
+			replicaIdxs: []uint32{2},
+			addr:        common.LocalhostCtrlAddr(),
+			expErr:      errors.New("already the MS leader"),
+		},
+		"transfer fails": {
+			replicaIdxs: []uint32{2},
+			transferErr: errors.New("transfer failed"),
+			expErr:      errors.New("transfer failed"),
+		},
+		"any replica": {
+			replicaIdxs: []uint32{2},
+		},
+		"specific replica": {
+			replicaIdxs: []uint32{2, 3},
+			addr:        system.MockControlAddr(t, 3),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockReplicaDB(t, log, tc.replicaIdxs, nil)
+			db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+				State:                 raft.Leader,
+				LeadershipTransferErr: tc.transferErr,
+			}, (*fsm)(db)))
+
+			gotErr := db.TransferLeadership(tc.addr)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
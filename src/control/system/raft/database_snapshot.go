@@ -0,0 +1,273 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// SnapshotComparison describes how the system state in a snapshot differs
+// from that of the running system.
+type SnapshotComparison struct {
+	Metadata        *raft.SnapshotMeta
+	Version         uint64
+	MapVersion      uint32
+	MissingRanks    *ranklist.RankSet // in the running system but not the snapshot
+	ExtraRanks      *ranklist.RankSet // in the snapshot but not the running system
+	ChangedRanks    *ranklist.RankSet // UUID or address differs in the snapshot
+	MissingPools    []string          // in the running system but not the snapshot
+	ExtraPools      []string          // in the snapshot but not the running system
+	ChangedPools    []string          // service replicas differ in the snapshot
+	MissingFindings []uint64          // in the running system but not the snapshot
+	ExtraFindings   []uint64          // in the snapshot but not the running system
+}
+
+// HasDifferences returns true if the snapshot differs from the running system.
+func (sc *SnapshotComparison) HasDifferences() bool {
+	if sc == nil {
+		return false
+	}
+
+	return sc.MissingRanks.Count() > 0 || sc.ExtraRanks.Count() > 0 ||
+		sc.ChangedRanks.Count() > 0 || len(sc.MissingPools) > 0 ||
+		len(sc.ExtraPools) > 0 || len(sc.ChangedPools) > 0 ||
+		len(sc.MissingFindings) > 0 || len(sc.ExtraFindings) > 0
+}
+
+// TakeSnapshot creates a point-in-time snapshot of the system database on the
+// current leader and returns its metadata and data.
+func (db *Database) TakeSnapshot() (*raft.SnapshotMeta, []byte, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, nil, err
+	}
+
+	var meta *raft.SnapshotMeta
+	var data []byte
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		future := svc.Snapshot()
+		err := future.Error()
+		if errors.Is(err, raft.ErrNothingNewToSnapshot) {
+			// A snapshot was taken after the last log was applied, so
+			// apply a barrier to allow a new one to be created.
+			if err := svc.Barrier(0).Error(); err != nil {
+				return err
+			}
+			future = svc.Snapshot()
+			err = future.Error()
+		}
+		if IsRaftLeadershipError(err) {
+			return errNotSysLeader(svc, db)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to create snapshot")
+		}
+
+		var rc io.ReadCloser
+		meta, rc, err = future.Open()
+		if err != nil {
+			return errors.Wrap(err, "failed to open snapshot")
+		}
+		defer rc.Close()
+
+		data, err = io.ReadAll(rc)
+		return errors.Wrap(err, "failed to read snapshot")
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	db.log.Debugf("created system database snapshot (index %d, term %d, %d bytes)",
+		meta.Index, meta.Term, len(data))
+	return meta, data, nil
+}
+
+// decodeSnapshot validates the snapshot metadata and decodes the data into
+// a standalone copy of the system database.
+func decodeSnapshot(meta *raft.SnapshotMeta, data []byte) (*dbData, error) {
+	if meta == nil {
+		return nil, errors.New("nil snapshot metadata")
+	}
+	if meta.Version < raft.SnapshotVersionMin || meta.Version > raft.SnapshotVersionMax {
+		return nil, errors.Errorf("unsupported snapshot version %d", meta.Version)
+	}
+	if meta.Size != int64(len(data)) {
+		return nil, errors.Errorf("snapshot data size %d does not match metadata (%d)",
+			len(data), meta.Size)
+	}
+
+	return decodeData(data)
+}
+
+// decodeData decodes snapshot-format data into a standalone copy of the
+// system database.
+func decodeData(data []byte) (*dbData, error) {
+	snapDB, _ := NewDatabase(nil, nil)
+	if err := json.Unmarshal(data, snapDB.data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode snapshot data")
+	}
+	if snapDB.data.SchemaVersion != CurrentSchemaVersion {
+		return nil, errors.Errorf("snapshot schema version %d != %d",
+			snapDB.data.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	return snapDB.data, nil
+}
+
+// mergeRestoreData replaces the state in snap that is not restored from a
+// snapshot with that of the running system.
+func mergeRestoreData(snap, cur *dbData) {
+	snap.System = cur.System
+	snap.Quotas = cur.Quotas
+	snap.Audit = cur.Audit
+	snap.Events = cur.Events
+	snap.PoolOps = cur.PoolOps
+	snap.Replicas = cur.Replicas
+
+	if snap.Version < cur.Version {
+		snap.Version = cur.Version
+	}
+	if snap.MapVersion < cur.MapVersion {
+		snap.MapVersion = cur.MapVersion
+	}
+	if snap.NextRank < cur.NextRank {
+		snap.NextRank = cur.NextRank
+	}
+}
+
+func poolServiceName(ps *system.PoolService) string {
+	if ps.PoolLabel != "" {
+		return ps.PoolLabel
+	}
+	return ps.PoolUUID.String()
+}
+
+// compareData compares the members, pool services and checker findings in
+// two copies of the system database. Items in cur but not snap are reported
+// as missing, and items only in snap are reported as extra.
+func compareData(cur, snap *dbData) *SnapshotComparison {
+	cmp := &SnapshotComparison{
+		Version:      snap.Version,
+		MapVersion:   snap.MapVersion,
+		MissingRanks: ranklist.NewRankSet(),
+		ExtraRanks:   ranklist.NewRankSet(),
+		ChangedRanks: ranklist.NewRankSet(),
+	}
+
+	for rank, curMember := range cur.Members.Ranks {
+		old, found := snap.Members.Ranks[rank]
+		switch {
+		case !found:
+			cmp.MissingRanks.Add(rank)
+		case old.UUID != curMember.UUID || old.Addr.String() != curMember.Addr.String():
+			cmp.ChangedRanks.Add(rank)
+		}
+	}
+	for rank := range snap.Members.Ranks {
+		if _, found := cur.Members.Ranks[rank]; !found {
+			cmp.ExtraRanks.Add(rank)
+		}
+	}
+
+	for id, curPool := range cur.Pools.Uuids {
+		old, found := snap.Pools.Uuids[id]
+		switch {
+		case !found:
+			cmp.MissingPools = append(cmp.MissingPools, poolServiceName(curPool))
+		case ranklist.RankSetFromRanks(old.Replicas).String() != ranklist.RankSetFromRanks(curPool.Replicas).String():
+			cmp.ChangedPools = append(cmp.ChangedPools, poolServiceName(curPool))
+		}
+	}
+	for id, old := range snap.Pools.Uuids {
+		if _, found := cur.Pools.Uuids[id]; !found {
+			cmp.ExtraPools = append(cmp.ExtraPools, poolServiceName(old))
+		}
+	}
+	sort.Strings(cmp.MissingPools)
+	sort.Strings(cmp.ExtraPools)
+	sort.Strings(cmp.ChangedPools)
+
+	for seq := range cur.Checker.Findings {
+		if _, found := snap.Checker.Findings[seq]; !found {
+			cmp.MissingFindings = append(cmp.MissingFindings, seq)
+		}
+	}
+	for seq := range snap.Checker.Findings {
+		if _, found := cur.Checker.Findings[seq]; !found {
+			cmp.ExtraFindings = append(cmp.ExtraFindings, seq)
+		}
+	}
+	sort.Slice(cmp.MissingFindings, func(i, j int) bool { return cmp.MissingFindings[i] < cmp.MissingFindings[j] })
+	sort.Slice(cmp.ExtraFindings, func(i, j int) bool { return cmp.ExtraFindings[i] < cmp.ExtraFindings[j] })
+
+	return cmp
+}
+
+// CompareSnapshot validates the supplied snapshot and compares its members,
+// pool services and checker findings with those of the running system.
+func (db *Database) CompareSnapshot(meta *raft.SnapshotMeta, data []byte) (*SnapshotComparison, error) {
+	snap, err := decodeSnapshot(meta, data)
+	if err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	cmp := compareData(db.data, snap)
+	cmp.Metadata = meta
+	return cmp, nil
+}
+
+// RestoreSnapshot restores the members, pool services and checker state in
+// the supplied snapshot on the current leader, which replicates them to the
+// other replicas. All other state, such as the audit log, the MS replica set
+// and queued pool operations, is kept from the running system. The system map
+// version and the next rank to be assigned are never rolled back, so that
+// engines will accept the group update that follows the restore and ranks
+// are not reused.
+func (db *Database) RestoreSnapshot(meta *raft.SnapshotMeta, data []byte) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	snap, err := decodeSnapshot(meta, data)
+	if err != nil {
+		return err
+	}
+
+	db.data.RLock()
+	mergeRestoreData(snap, db.data)
+	data, err = json.Marshal(snap)
+	db.data.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot data")
+	}
+	restoreMeta := *meta
+	restoreMeta.Size = int64(len(data))
+	meta = &restoreMeta
+
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		err := svc.Restore(meta, bytes.NewReader(data), 0)
+		if IsRaftLeadershipError(err) {
+			return errNotSysLeader(svc, db)
+		}
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to restore snapshot")
+	}
+
+	db.log.Noticef("restored system database snapshot (index %d, term %d)", meta.Index, meta.Term)
+	return nil
+}
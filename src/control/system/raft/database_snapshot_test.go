@@ -0,0 +1,316 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/checker"
+)
+
+func updateSnapshotPool(t *testing.T, db *Database, ps *system.PoolService, add bool) {
+	t.Helper()
+
+	ctx := test.Context(t)
+	lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+
+	if add {
+		err = db.AddPoolService(lock.InContext(ctx), ps)
+	} else {
+		err = db.UpdatePoolService(lock.InContext(ctx), ps)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mockSnapshotDB(t *testing.T, log logging.Logger) *Database {
+	t.Helper()
+
+	db := MockDatabase(t, log)
+	for i := uint32(0); i < 3; i++ {
+		if err := db.AddMember(system.MockMember(t, i, system.MemberStateJoined)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	updateSnapshotPool(t, db, &system.PoolService{
+		PoolUUID:  uuid.MustParse(test.MockUUID(1)),
+		PoolLabel: "pool1",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []Rank{0, 1},
+	}, true)
+	for i := 1; i <= 2; i++ {
+		if err := db.AddCheckerFinding(checker.MockFinding(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+func TestSystem_Database_TakeSnapshot(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := mockSnapshotDB(t, log)
+	meta, data, err := db.TakeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, int64(len(data)), meta.Size, "unexpected snapshot size")
+
+	snap, err := decodeSnapshot(meta, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 3, len(snap.Members.Ranks), "unexpected member count")
+	test.AssertEqual(t, 1, len(snap.Pools.Uuids), "unexpected pool count")
+	test.AssertEqual(t, 2, len(snap.Checker.Findings), "unexpected finding count")
+
+	db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State: raft.Follower,
+	}, (*fsm)(db)))
+	_, _, err = db.TakeSnapshot()
+	test.CmpErr(t, &system.ErrNotLeader{}, err)
+}
+
+func TestSystem_Database_CompareSnapshot(t *testing.T) {
+	pool2 := &system.PoolService{
+		PoolUUID:  uuid.MustParse(test.MockUUID(2)),
+		PoolLabel: "pool2",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []Rank{2},
+	}
+
+	for name, tc := range map[string]struct {
+		modifySnap func(t *testing.T, meta *raft.SnapshotMeta, data []byte) []byte
+		modifyDB   func(t *testing.T, db *Database)
+		expCmp     *SnapshotComparison
+		expErr     error
+	}{
+		"size mismatch": {
+			modifySnap: func(t *testing.T, meta *raft.SnapshotMeta, data []byte) []byte {
+				return data[1:]
+			},
+			expErr: errors.New("does not match metadata"),
+		},
+		"bad version": {
+			modifySnap: func(t *testing.T, meta *raft.SnapshotMeta, data []byte) []byte {
+				meta.Version = raft.SnapshotVersionMax + 1
+				return data
+			},
+			expErr: errors.New("unsupported snapshot version"),
+		},
+		"bad data": {
+			modifySnap: func(t *testing.T, meta *raft.SnapshotMeta, data []byte) []byte {
+				data = []byte("bad data")
+				meta.Size = int64(len(data))
+				return data
+			},
+			expErr: errors.New("failed to decode"),
+		},
+		"no differences": {
+			expCmp: &SnapshotComparison{
+				MissingRanks: MustCreateRankSet(""),
+				ExtraRanks:   MustCreateRankSet(""),
+				ChangedRanks: MustCreateRankSet(""),
+			},
+		},
+		"differences": {
+			modifyDB: func(t *testing.T, db *Database) {
+				for _, rank := range []Rank{1, 2} {
+					m, err := db.FindMemberByRank(rank)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := db.RemoveMember(m); err != nil {
+						t.Fatal(err)
+					}
+				}
+				changed := system.MockMember(t, 1, system.MemberStateJoined)
+				changed.UUID = uuid.MustParse(test.MockUUID(11))
+				for _, m := range []*system.Member{changed, system.MockMember(t, 3, system.MemberStateJoined)} {
+					if err := db.AddMember(m); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				ps, err := db.FindPoolServiceByLabel("pool1")
+				if err != nil {
+					t.Fatal(err)
+				}
+				ps.Replicas = []Rank{0, 3}
+				updateSnapshotPool(t, db, ps, false)
+				updateSnapshotPool(t, db, pool2, true)
+
+				if err := db.RemoveCheckerFinding(checker.MockFinding(1)); err != nil {
+					t.Fatal(err)
+				}
+				if err := db.AddCheckerFinding(checker.MockFinding(3)); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expCmp: &SnapshotComparison{
+				MissingRanks:    MustCreateRankSet("3"),
+				ExtraRanks:      MustCreateRankSet("2"),
+				ChangedRanks:    MustCreateRankSet("1"),
+				MissingPools:    []string{"pool2"},
+				ChangedPools:    []string{"pool1"},
+				MissingFindings: []uint64{3},
+				ExtraFindings:   []uint64{1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockSnapshotDB(t, log)
+			meta, data, err := db.TakeSnapshot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.modifySnap != nil {
+				data = tc.modifySnap(t, meta, data)
+			}
+			if tc.modifyDB != nil {
+				tc.modifyDB(t, db)
+			}
+
+			gotCmp, gotErr := db.CompareSnapshot(meta, data)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			tc.expCmp.Metadata = meta
+			tc.expCmp.Version = gotCmp.Version
+			tc.expCmp.MapVersion = gotCmp.MapVersion
+			if diff := cmp.Diff(tc.expCmp, gotCmp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected comparison (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.modifyDB != nil, gotCmp.HasDifferences(), "unexpected differences")
+		})
+	}
+}
+
+func TestSystem_Database_RestoreSnapshot(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := mockSnapshotDB(t, log)
+	meta, data, err := db.TakeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapMapVer := db.data.MapVersion
+
+	if err := db.AddMember(system.MockMember(t, 3, system.MemberStateJoined)); err != nil {
+		t.Fatal(err)
+	}
+	curMapVer := db.data.MapVersion
+	if curMapVer <= snapMapVer {
+		t.Fatalf("expected map version to increase (%d <= %d)", curMapVer, snapMapVer)
+	}
+
+	if err := db.RestoreSnapshot(meta, data); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.FindMemberByRank(3); !system.IsMemberNotFound(err) {
+		t.Fatalf("expected member to be removed by restore, got %v", err)
+	}
+	test.AssertEqual(t, curMapVer, db.data.MapVersion, "map version rolled back")
+
+	cmp, err := db.CompareSnapshot(meta, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertFalse(t, cmp.HasDifferences(), "expected no differences after restore")
+}
+
+func TestSystem_Database_RestoreSnapshot_KeepsState(t *testing.T) {
+	for name, tc := range map[string]struct {
+		modifyDB func(*testing.T, *Database)
+		checkDB  func(*testing.T, *Database)
+	}{
+		"audit log": {
+			modifyDB: func(t *testing.T, db *Database) {
+				if err := db.AddAuditRecord(&system.AuditRecord{Operation: "PoolCreate"}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			checkDB: func(t *testing.T, db *Database) {
+				recs, err := db.GetAuditRecords(&system.AuditFilter{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, 1, len(recs), "audit record removed by restore")
+			},
+		},
+		"replicas": {
+			modifyDB: func(t *testing.T, db *Database) {
+				newReplica := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 10001}
+				if err := db.submitReplicasUpdate(append(db.replicas(), newReplica)); err != nil {
+					t.Fatal(err)
+				}
+			},
+			checkDB: func(t *testing.T, db *Database) {
+				test.AssertEqual(t, []string{"127.0.0.1:10001", "127.0.0.2:10001"}, db.AccessPoints(),
+					"replica set rolled back by restore")
+			},
+		},
+		"pool ops": {
+			modifyDB: func(t *testing.T, db *Database) {
+				op := system.NewPoolOp(uuid.MustParse(test.MockUUID(1)), system.PoolOpTypeDrain)
+				if err := db.AddPoolOp(op); err != nil {
+					t.Fatal(err)
+				}
+			},
+			checkDB: func(t *testing.T, db *Database) {
+				test.AssertEqual(t, 1, len(db.data.PoolOps.Ops), "pool operation removed by restore")
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockSnapshotDB(t, log)
+			meta, data, err := db.TakeSnapshot()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tc.modifyDB(t, db)
+			if err := db.RestoreSnapshot(meta, data); err != nil {
+				t.Fatal(err)
+			}
+
+			tc.checkDB(t, db)
+		})
+	}
+}
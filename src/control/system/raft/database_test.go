@@ -315,6 +315,56 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	}
 	(*fsm)(db0).Apply(rl)
 
+	data, err = createRaftUpdate(raftOpSetQuota, &system.Quota{
+		User:      "bob@",
+		MaxPools:  4,
+		TierBytes: []uint64{1, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
+	data, err = createRaftUpdate(raftOpAddAuditRecord, &auditUpdate{
+		Record: &system.AuditRecord{
+			Time:      time.Unix(1700000000, 0).UTC(),
+			Operation: "PoolCreate",
+			Component: "admin",
+			Role:      "admin",
+			Subject:   "CN=admin",
+			Address:   "127.0.0.1:1234",
+		},
+		MaxRecords: DefaultAuditMaxRecords,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
+	data, err = createRaftUpdate(raftOpAddEvent, []byte("encoded event"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
+	data, err = createRaftUpdate(raftOpUpdateReplicas, []*net.TCPAddr{
+		common.LocalhostCtrlAddr(),
+		system.MockControlAddr(t, 2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
+	po := system.NewPoolOp(uuid.New(), system.PoolOpTypeDrain)
+	po.Ranks = []Rank{1}
+	po.TargetIdx = []uint32{0, 1}
+	data, err = createRaftUpdate(raftOpUpdatePoolOp, po)
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db0).Apply(&raft.Log{Data: data})
+
 	snap, err := (*fsm)(db0).Snapshot()
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,288 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// Names of the consistency checks run against the system database.
+const (
+	VerifyMemberRanks  = "member-ranks"
+	VerifyMemberUUIDs  = "member-uuids"
+	VerifyMemberAddrs  = "member-addrs"
+	VerifyFabricURIs   = "fabric-uris"
+	VerifyMapVersion   = "map-version"
+	VerifyPoolUUIDs    = "pool-uuids"
+	VerifyPoolReplicas = "pool-replicas"
+	VerifyPoolRanks    = "pool-ranks"
+	VerifyPoolLabels   = "pool-labels"
+)
+
+// DatabaseInconsistency describes an inconsistency found in the system
+// database by one of the consistency checks.
+type DatabaseInconsistency struct {
+	Check       string `json:"check"`
+	Description string `json:"description"`
+}
+
+func (di *DatabaseInconsistency) String() string {
+	return fmt.Sprintf("%s: %s", di.Check, di.Description)
+}
+
+// dbVerifier accumulates the inconsistencies found while verifying a copy of
+// the system database.
+type dbVerifier []*DatabaseInconsistency
+
+func (dv *dbVerifier) addf(check, format string, args ...interface{}) {
+	*dv = append(*dv, &DatabaseInconsistency{
+		Check:       check,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (dv *dbVerifier) verifyMembers(d *dbData) {
+	for rank, m := range d.Members.Ranks {
+		if m.Rank != rank {
+			dv.addf(VerifyMemberRanks, "rank %d maps to member %s with rank %d", rank, m.UUID, m.Rank)
+		}
+		if _, found := d.Members.Uuids[m.UUID]; !found {
+			dv.addf(VerifyMemberUUIDs, "rank %d maps to member %s which is not in the UUID index", rank, m.UUID)
+		}
+	}
+
+	fabricURIs := make(map[string]*ranklist.RankSet)
+	for id, m := range d.Members.Uuids {
+		if m.UUID != id {
+			dv.addf(VerifyMemberUUIDs, "UUID %s maps to member %s", id, m.UUID)
+		}
+		if cur, found := d.Members.Ranks[m.Rank]; !found || cur.UUID != m.UUID {
+			dv.addf(VerifyMemberRanks, "member %s with rank %d is not in the rank index", m.UUID, m.Rank)
+		}
+
+		if m.PrimaryFabricURI == "" {
+			dv.addf(VerifyFabricURIs, "rank %d has no fabric URI", m.Rank)
+		} else {
+			if _, found := fabricURIs[m.PrimaryFabricURI]; !found {
+				fabricURIs[m.PrimaryFabricURI] = ranklist.NewRankSet()
+			}
+			fabricURIs[m.PrimaryFabricURI].Add(m.Rank)
+		}
+
+		if m.Addr == nil {
+			dv.addf(VerifyMemberAddrs, "rank %d has no control address", m.Rank)
+			continue
+		}
+		// Engines on the same server share a control address, but each
+		// member should be listed under its own address exactly once.
+		var listed int
+		for _, am := range d.Members.Addrs[m.Addr.String()] {
+			if am.UUID == m.UUID {
+				listed++
+			}
+		}
+		if listed != 1 {
+			dv.addf(VerifyMemberAddrs, "rank %d is listed %d times under control address %s",
+				m.Rank, listed, m.Addr)
+		}
+	}
+	for uri, ranks := range fabricURIs {
+		if ranks.Count() > 1 {
+			dv.addf(VerifyFabricURIs, "fabric URI %s is shared by ranks %s", uri, ranks)
+		}
+	}
+
+	for addr, members := range d.Members.Addrs {
+		for _, m := range members {
+			if _, found := d.Members.Uuids[m.UUID]; !found {
+				dv.addf(VerifyMemberAddrs, "control address %s lists member %s which is not in the UUID index",
+					addr, m.UUID)
+				continue
+			}
+			if m.Addr == nil || m.Addr.String() != addr {
+				dv.addf(VerifyMemberAddrs, "control address %s lists rank %d with control address %s",
+					addr, m.Rank, m.Addr)
+			}
+		}
+	}
+
+	// Every membership change increments the map version, so it can never
+	// be lower than the number of members.
+	if int(d.MapVersion) < len(d.Members.Uuids) {
+		dv.addf(VerifyMapVersion, "map version %d is lower than the member count %d",
+			d.MapVersion, len(d.Members.Uuids))
+	}
+}
+
+func (dv *dbVerifier) verifyPools(d *dbData) {
+	hasReplica := func(ps *system.PoolService, rank ranklist.Rank) bool {
+		for _, r := range ps.Replicas {
+			if r == rank {
+				return true
+			}
+		}
+		return false
+	}
+	inRankIndex := func(id uuid.UUID, rank ranklist.Rank) bool {
+		for _, ps := range d.Pools.Ranks[rank] {
+			if ps.PoolUUID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	for id, ps := range d.Pools.Uuids {
+		name := poolServiceName(ps)
+		if ps.PoolUUID != id {
+			dv.addf(VerifyPoolUUIDs, "UUID %s maps to pool %s", id, name)
+		}
+
+		for _, rank := range ps.Replicas {
+			if _, found := d.Members.Ranks[rank]; !found {
+				dv.addf(VerifyPoolReplicas, "pool %s has a service replica on rank %d which is not a system member",
+					name, rank)
+			}
+			if !inRankIndex(ps.PoolUUID, rank) {
+				dv.addf(VerifyPoolRanks, "pool %s service replica rank %d is not in the rank index", name, rank)
+			}
+		}
+
+		if ps.PoolLabel == "" {
+			continue
+		}
+		if cur, found := d.Pools.Labels[ps.PoolLabel]; !found || cur.PoolUUID != ps.PoolUUID {
+			dv.addf(VerifyPoolLabels, "pool %s label %q is not in the label index", ps.PoolUUID, ps.PoolLabel)
+		}
+	}
+
+	for rank, services := range d.Pools.Ranks {
+		for _, ps := range services {
+			if _, found := d.Pools.Uuids[ps.PoolUUID]; !found {
+				dv.addf(VerifyPoolRanks, "rank %d lists pool %s which is not in the UUID index", rank, ps.PoolUUID)
+				continue
+			}
+			if !hasReplica(ps, rank) {
+				dv.addf(VerifyPoolRanks, "rank %d lists pool %s which has no service replica on it",
+					rank, poolServiceName(ps))
+			}
+		}
+	}
+
+	for label, ps := range d.Pools.Labels {
+		if _, found := d.Pools.Uuids[ps.PoolUUID]; !found {
+			dv.addf(VerifyPoolLabels, "label %q maps to pool %s which is not in the UUID index", label, ps.PoolUUID)
+			continue
+		}
+		if ps.PoolLabel != label {
+			dv.addf(VerifyPoolLabels, "label %q maps to pool %s with label %q", label, ps.PoolUUID, ps.PoolLabel)
+		}
+	}
+}
+
+// verifyData cross-checks the member and pool indexes held in the supplied
+// copy of the system database and returns any inconsistencies found, sorted
+// by check name. The caller must hold the data lock.
+func verifyData(d *dbData) []*DatabaseInconsistency {
+	dv := make(dbVerifier, 0)
+	dv.verifyMembers(d)
+	dv.verifyPools(d)
+
+	sort.Slice(dv, func(i, j int) bool {
+		if dv[i].Check != dv[j].Check {
+			return dv[i].Check < dv[j].Check
+		}
+		return dv[i].Description < dv[j].Description
+	})
+
+	return dv
+}
+
+// Verify checks the consistency of the local replica's copy of the system
+// database and returns any inconsistencies found.
+func (db *Database) Verify() ([]*DatabaseInconsistency, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	return verifyData(db.data), nil
+}
+
+// VerifySnapshot validates the supplied snapshot and checks the consistency of
+// the system database that it contains.
+func VerifySnapshot(meta *raft.SnapshotMeta, data []byte) ([]*DatabaseInconsistency, error) {
+	snap, err := decodeSnapshot(meta, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyData(snap), nil
+}
+
+// ReplicaData returns the local replica's copy of the system database in the
+// format used for snapshots, along with its version, so that it may be
+// compared with the copy held by another replica.
+func (db *Database) ReplicaData() (uint64, []byte, error) {
+	if err := db.CheckReplica(); err != nil {
+		return 0, nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	data, err := json.Marshal(db.data)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to encode system database")
+	}
+	return db.data.Version, data, nil
+}
+
+// DiffReplicaData compares two copies of the system database returned by
+// ReplicaData. Items in the first copy but not the second are reported as
+// missing, and items only in the second copy are reported as extra.
+func DiffReplicaData(first, second []byte) (*SnapshotComparison, error) {
+	a, err := decodeData(first)
+	if err != nil {
+		return nil, errors.Wrap(err, "first copy")
+	}
+	b, err := decodeData(second)
+	if err != nil {
+		return nil, errors.Wrap(err, "second copy")
+	}
+
+	return compareData(a, b), nil
+}
+
+// DiffSnapshots validates and compares two snapshots. Items in the first
+// snapshot but not the second are reported as missing, and items only in the
+// second snapshot are reported as extra.
+func DiffSnapshots(firstMeta *raft.SnapshotMeta, firstData []byte, secondMeta *raft.SnapshotMeta, secondData []byte) (*SnapshotComparison, error) {
+	a, err := decodeSnapshot(firstMeta, firstData)
+	if err != nil {
+		return nil, errors.Wrap(err, "first snapshot")
+	}
+	b, err := decodeSnapshot(secondMeta, secondData)
+	if err != nil {
+		return nil, errors.Wrap(err, "second snapshot")
+	}
+
+	cmp := compareData(a, b)
+	cmp.Metadata = secondMeta
+	return cmp, nil
+}
@@ -0,0 +1,268 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_Database_Verify(t *testing.T) {
+	pool1UUID := test.MockUUID(1)
+
+	for name, tc := range map[string]struct {
+		corrupt    func(t *testing.T, d *dbData)
+		expFound   []*DatabaseInconsistency
+		expSnapErr error
+	}{
+		"consistent": {
+			expFound: []*DatabaseInconsistency{},
+		},
+		"rank maps to wrong member": {
+			corrupt: func(t *testing.T, d *dbData) {
+				d.Members.Ranks[5] = d.Members.Ranks[1]
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyMemberRanks,
+					Description: fmt.Sprintf("rank 5 maps to member %s with rank 1", test.MockUUID(1)),
+				},
+			},
+		},
+		"member missing from UUID index": {
+			corrupt: func(t *testing.T, d *dbData) {
+				delete(d.Members.Uuids, uuid.MustParse(test.MockUUID(2)))
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check: VerifyMemberAddrs,
+					Description: fmt.Sprintf("control address 127.0.0.2:10001 lists member %s which is not in the UUID index",
+						test.MockUUID(2)),
+				},
+				{
+					Check: VerifyMemberUUIDs,
+					Description: fmt.Sprintf("rank 2 maps to member %s which is not in the UUID index",
+						test.MockUUID(2)),
+				},
+			},
+			// The snapshot can't be decoded without the member.
+			expSnapErr: errors.New("rank 2 missing UUID"),
+		},
+		"member missing from address index": {
+			corrupt: func(t *testing.T, d *dbData) {
+				delete(d.Members.Addrs, "127.0.0.1:10001")
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyMemberAddrs,
+					Description: "rank 1 is listed 0 times under control address 127.0.0.1:10001",
+				},
+			},
+		},
+		"missing fabric URI": {
+			corrupt: func(t *testing.T, d *dbData) {
+				d.Members.Ranks[0].PrimaryFabricURI = ""
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyFabricURIs,
+					Description: "rank 0 has no fabric URI",
+				},
+			},
+		},
+		"duplicate fabric URI": {
+			corrupt: func(t *testing.T, d *dbData) {
+				d.Members.Ranks[2].PrimaryFabricURI = d.Members.Ranks[1].PrimaryFabricURI
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyFabricURIs,
+					Description: "fabric URI 127.0.0.1:10001 is shared by ranks 1-2",
+				},
+			},
+		},
+		"map version lower than member count": {
+			corrupt: func(t *testing.T, d *dbData) {
+				d.MapVersion = 1
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyMapVersion,
+					Description: "map version 1 is lower than the member count 3",
+				},
+			},
+		},
+		"pool replica not a member": {
+			corrupt: func(t *testing.T, d *dbData) {
+				ps := d.Pools.Labels["pool1"]
+				ps.Replicas = append(ps.Replicas, 7)
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyPoolRanks,
+					Description: "pool pool1 service replica rank 7 is not in the rank index",
+				},
+				{
+					Check:       VerifyPoolReplicas,
+					Description: "pool pool1 has a service replica on rank 7 which is not a system member",
+				},
+			},
+		},
+		"stale pool rank index": {
+			corrupt: func(t *testing.T, d *dbData) {
+				d.Pools.Ranks[2] = append(d.Pools.Ranks[2], d.Pools.Labels["pool1"])
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyPoolRanks,
+					Description: "rank 2 lists pool pool1 which has no service replica on it",
+				},
+			},
+		},
+		"pool label index mismatch": {
+			corrupt: func(t *testing.T, d *dbData) {
+				d.Pools.Labels["other"] = d.Pools.Labels["pool1"]
+				delete(d.Pools.Labels, "pool1")
+			},
+			expFound: []*DatabaseInconsistency{
+				{
+					Check:       VerifyPoolLabels,
+					Description: fmt.Sprintf("label \"other\" maps to pool %s with label \"pool1\"", pool1UUID),
+				},
+				{
+					Check:       VerifyPoolLabels,
+					Description: fmt.Sprintf("pool %s label \"pool1\" is not in the label index", pool1UUID),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockSnapshotDB(t, log)
+			if tc.corrupt != nil {
+				tc.corrupt(t, db.data)
+			}
+
+			gotFound, err := db.Verify()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expFound, gotFound); diff != "" {
+				t.Fatalf("unexpected inconsistencies (-want, +got):\n%s\n", diff)
+			}
+
+			// The same inconsistencies should be found in a snapshot.
+			meta, data, err := db.TakeSnapshot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotFound, err = VerifySnapshot(meta, data)
+			test.CmpErr(t, tc.expSnapErr, err)
+			if tc.expSnapErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expFound, gotFound); diff != "" {
+				t.Fatalf("unexpected snapshot inconsistencies (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_VerifySnapshot_Invalid(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := mockSnapshotDB(t, log)
+	meta, data, err := db.TakeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = VerifySnapshot(meta, data[1:])
+	test.CmpErr(t, errors.New("does not match metadata"), err)
+}
+
+func TestSystem_DiffReplicaData(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	first := mockSnapshotDB(t, log)
+	second := mockSnapshotDB(t, log)
+	if err := second.AddMember(system.MockMember(t, 3, system.MemberStateJoined)); err != nil {
+		t.Fatal(err)
+	}
+	ps, err := second.FindPoolServiceByLabel("pool1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.Replicas = []Rank{0, 3}
+	updateSnapshotPool(t, second, ps, false)
+
+	firstVer, firstData, err := first.ReplicaData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondVer, secondData, err := second.ReplicaData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, secondVer > firstVer, "expected second copy to have a higher version")
+
+	cmpOpts := []cmp.Option{
+		cmp.Comparer(func(x, y *RankSet) bool {
+			return x.String() == y.String()
+		}),
+	}
+	expCmp := &SnapshotComparison{
+		MissingRanks: MustCreateRankSet(""),
+		ExtraRanks:   MustCreateRankSet("3"),
+		ChangedRanks: MustCreateRankSet(""),
+		ChangedPools: []string{"pool1"},
+	}
+
+	gotCmp, err := DiffReplicaData(firstData, secondData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expCmp.Version = gotCmp.Version
+	expCmp.MapVersion = gotCmp.MapVersion
+	if diff := cmp.Diff(expCmp, gotCmp, cmpOpts...); diff != "" {
+		t.Fatalf("unexpected replica comparison (-want, +got):\n%s\n", diff)
+	}
+
+	firstMeta, firstSnap, err := first.TakeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondMeta, secondSnap, err := second.TakeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotCmp, err = DiffSnapshots(firstMeta, firstSnap, secondMeta, secondSnap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expCmp.Metadata = secondMeta
+	if diff := cmp.Diff(expCmp, gotCmp, cmpOpts...); diff != "" {
+		t.Fatalf("unexpected snapshot comparison (-want, +got):\n%s\n", diff)
+	}
+
+	_, err = DiffReplicaData(firstData, []byte("bad data"))
+	test.CmpErr(t, errors.New("second copy"), err)
+}
@@ -7,6 +7,7 @@
 package raft
 
 import (
+	"io"
 	"net"
 	"testing"
 	"time"
@@ -34,11 +35,29 @@ type (
 		BarrierReturn         raft.Future
 	}
 	mockRaftService struct {
-		cfg mockRaftServiceConfig
-		fsm raft.FSM
+		cfg    mockRaftServiceConfig
+		fsm    raft.FSM
+		snaps  *raft.InmemSnapshotStore
+		voters []raft.Server
+	}
+	mockConfigurationFuture struct {
+		mockRaftFuture
+		cfg raft.Configuration
+	}
+	mockSnapshotFuture struct {
+		mockRaftFuture
+		snaps *raft.InmemSnapshotStore
+		id    string
 	}
 )
 
+func (msf *mockSnapshotFuture) Open() (*raft.SnapshotMeta, io.ReadCloser, error) {
+	if msf.err != nil {
+		return nil, nil, msf.err
+	}
+	return msf.snaps.Open(msf.id)
+}
+
 // mockRaftFuture implements raft.Future, raft.IndexFuture, and raft.ApplyFuture
 func (mrf *mockRaftFuture) Error() error          { return mrf.err }
 func (mrf *mockRaftFuture) Index() uint64         { return mrf.index }
@@ -49,14 +68,30 @@ func (mrs *mockRaftService) Apply(cmd []byte, timeout time.Duration) raft.ApplyF
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) AddVoter(_ raft.ServerID, _ raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mcf *mockConfigurationFuture) Configuration() raft.Configuration { return mcf.cfg }
+
+func (mrs *mockRaftService) AddVoter(id raft.ServerID, addr raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+	mrs.RemoveServer(id, 0, 0)
+	mrs.voters = append(mrs.voters, raft.Server{Suffrage: raft.Voter, ID: id, Address: addr})
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) RemoveServer(_ raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mrs *mockRaftService) RemoveServer(id raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+	for i, srv := range mrs.voters {
+		if srv.ID == id {
+			mrs.voters = append(mrs.voters[:i], mrs.voters[i+1:]...)
+			break
+		}
+	}
 	return &mockRaftFuture{}
 }
 
+func (mrs *mockRaftService) GetConfiguration() raft.ConfigurationFuture {
+	return &mockConfigurationFuture{
+		cfg: raft.Configuration{Servers: append([]raft.Server{}, mrs.voters...)},
+	}
+}
+
 func (mrs *mockRaftService) BootstrapCluster(cfg raft.Configuration) raft.Future {
 	return &mockRaftFuture{}
 }
@@ -76,6 +111,10 @@ func (mrs *mockRaftService) LeadershipTransfer() raft.Future {
 	return &mockRaftFuture{err: mrs.cfg.LeadershipTransferErr}
 }
 
+func (mrs *mockRaftService) LeadershipTransferToServer(_ raft.ServerID, _ raft.ServerAddress) raft.Future {
+	return mrs.LeadershipTransfer()
+}
+
 func (mrs *mockRaftService) Shutdown() raft.Future {
 	mrs.cfg.State = raft.Shutdown
 	return &mockRaftFuture{}
@@ -92,6 +131,27 @@ func (mrs *mockRaftService) Barrier(time.Duration) raft.Future {
 	return mrs.cfg.BarrierReturn
 }
 
+func (mrs *mockRaftService) Snapshot() raft.SnapshotFuture {
+	snap, err := mrs.fsm.Snapshot()
+	if err != nil {
+		return &mockSnapshotFuture{mockRaftFuture: mockRaftFuture{err: err}}
+	}
+
+	sink, err := mrs.snaps.Create(raft.SnapshotVersionMax, 1, 1, raft.Configuration{}, 1, nil)
+	if err == nil {
+		err = snap.Persist(sink)
+	}
+	if err != nil {
+		return &mockSnapshotFuture{mockRaftFuture: mockRaftFuture{err: err}}
+	}
+
+	return &mockSnapshotFuture{snaps: mrs.snaps, id: sink.ID()}
+}
+
+func (mrs *mockRaftService) Restore(_ *raft.SnapshotMeta, r io.Reader, _ time.Duration) error {
+	return mrs.fsm.Restore(io.NopCloser(r))
+}
+
 func newMockRaftService(cfg *mockRaftServiceConfig, fsm raft.FSM) *mockRaftService {
 	if cfg == nil {
 		cfg = &mockRaftServiceConfig{
@@ -102,8 +162,9 @@ func newMockRaftService(cfg *mockRaftServiceConfig, fsm raft.FSM) *mockRaftServi
 		cfg.LeaderCh = make(<-chan bool)
 	}
 	return &mockRaftService{
-		cfg: *cfg,
-		fsm: fsm,
+		cfg:   *cfg,
+		fsm:   fsm,
+		snaps: raft.NewInmemSnapshotStore(),
 	}
 }
 
@@ -122,6 +183,18 @@ func MockDatabaseWithAddr(t *testing.T, log logging.Logger, addr *net.TCPAddr) *
 	return db
 }
 
+// MockDatabaseWithReplicas is similar to MockDatabase but includes the
+// supplied replicas in the MS replica set, after the local replica.
+func MockDatabaseWithReplicas(t *testing.T, log logging.Logger, replicas ...*net.TCPAddr) *Database {
+	localAddr := common.LocalhostCtrlAddr()
+	db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+		SystemName: build.DefaultSystemName,
+		Replicas:   append([]*net.TCPAddr{localAddr}, replicas...),
+	})
+	db.replicaAddr = localAddr
+	return db
+}
+
 // MockDatabaseWithCfg is similar to MockDatabase but allows a custom
 // DatabaseConfig to be supplied.
 func MockDatabaseWithCfg(t *testing.T, log logging.Logger, dbCfg *DatabaseConfig) *Database {
@@ -130,9 +203,14 @@ func MockDatabaseWithCfg(t *testing.T, log logging.Logger, dbCfg *DatabaseConfig
 		t.Fatal(err)
 	}
 
-	db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+	svc := newMockRaftService(&mockRaftServiceConfig{
 		State: raft.Leader,
-	}, (*fsm)(db)))
+	}, (*fsm)(db))
+	// The configured replicas are the voters in the bootstrapped cluster.
+	for _, rep := range dbCfg.Replicas {
+		svc.AddVoter(raft.ServerID(rep.String()), raft.ServerAddress(rep.String()), 0, 0)
+	}
+	db.raft.setSvc(svc)
 	db.initialized.SetTrue()
 
 	return db
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	transport "github.com/Jille/raft-grpc-transport"
+	metrics "github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/raft"
 	boltdb "github.com/hashicorp/raft-boltdb/v2"
@@ -51,10 +52,24 @@ const (
 	raftOpUpdateCheckerFinding
 	raftOpRemoveCheckerFinding
 	raftOpClearCheckerFindings
+	raftOpSetQuota
+	raftOpRemoveQuota
+	raftOpUpdatePoolOp
+	raftOpRemovePoolOp
+	raftOpAddAuditRecord
+	raftOpAddEvent
+	raftOpUpdateReplicas
 
 	sysDBFile = "daos_system.db"
 )
 
+var (
+	// Keys for the metrics emitted alongside those emitted by the raft
+	// library.
+	fsmApplyKey       = []string{"ms", "fsm", "apply"}
+	submitFailuresKey = []string{"ms", "submit", "failures"}
+)
+
 type (
 	raftOp uint32
 
@@ -90,6 +105,13 @@ func (ro raftOp) String() string {
 		"updateCheckerFinding",
 		"removeCheckerFinding",
 		"clearCheckerFindings",
+		"setQuota",
+		"removeQuota",
+		"updatePoolOp",
+		"removePoolOp",
+		"addAuditRecord",
+		"addEvent",
+		"updateReplicas",
 	}[ro]
 }
 
@@ -489,6 +511,51 @@ func (db *Database) submitCheckerUpdate(op raftOp, f *checker.Finding) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitQuotaUpdate submits the given quota update.
+func (db *Database) submitQuotaUpdate(op raftOp, q *system.Quota) error {
+	data, err := createRaftUpdate(op, q)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// submitPoolOpUpdate submits the given pool operation update.
+func (db *Database) submitPoolOpUpdate(op raftOp, po *system.PoolOp) error {
+	data, err := createRaftUpdate(op, po)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// submitAuditUpdate submits the given audit log update.
+func (db *Database) submitAuditUpdate(op raftOp, au *auditUpdate) error {
+	data, err := createRaftUpdate(op, au)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// submitEventUpdate submits the given event history update.
+func (db *Database) submitEventUpdate(op raftOp, evtData []byte) error {
+	data, err := createRaftUpdate(op, evtData)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// submitReplicasUpdate submits the given MS replica set update.
+func (db *Database) submitReplicasUpdate(replicas []*net.TCPAddr) error {
+	data, err := createRaftUpdate(raftOpUpdateReplicas, replicas)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
@@ -499,8 +566,14 @@ func (db *Database) submitRaftUpdate(data []byte) error {
 		// signal some callers to retry the operation on the
 		// new leader.
 		if IsRaftLeadershipError(err) {
+			metrics.IncrCounterWithLabels(submitFailuresKey, 1,
+				[]metrics.Label{{Name: "reason", Value: "leadership"}})
 			return errNotSysLeader(svc, db)
 		}
+		if err != nil {
+			metrics.IncrCounterWithLabels(submitFailuresKey, 1,
+				[]metrics.Label{{Name: "reason", Value: "error"}})
+		}
 
 		return err
 	})
@@ -548,6 +621,17 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpAddCheckerFinding, raftOpUpdateCheckerFinding, raftOpRemoveCheckerFinding, raftOpClearCheckerFindings:
 		f.data.applyCheckerUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpSetQuota, raftOpRemoveQuota:
+		f.data.applyQuotaUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpUpdatePoolOp, raftOpRemovePoolOp:
+		f.data.applyPoolOpUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpAddAuditRecord:
+		f.data.applyAuditUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpAddEvent:
+		f.data.applyEventUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpUpdateReplicas:
+		f.data.applyReplicasUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		(*Database)(f).runOnReplicasChanged()
 	default:
 		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
 		return nil
@@ -557,6 +641,8 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 	f.data.Version++ // Successful updates should increment this value.
 	f.data.Unlock()
 
+	metrics.IncrCounterWithLabels(fsmApplyKey, 1, []metrics.Label{{Name: "op", Value: c.Op.String()}})
+
 	return nil
 }
 
@@ -654,6 +740,120 @@ func (d *dbData) applySystemUpdate(op raftOp, data []byte, panicFn func(error))
 	}
 }
 
+// applyQuotaUpdate is responsible for applying the quota update operation to
+// the database.
+func (d *dbData) applyQuotaUpdate(op raftOp, data []byte, panicFn func(error)) {
+	q := new(system.Quota)
+	if err := json.Unmarshal(data, q); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode quota update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	pm, key := d.Quotas.principalMap(q)
+	switch op {
+	case raftOpSetQuota:
+		pm[key] = q
+	case raftOpRemoveQuota:
+		delete(pm, key)
+	default:
+		panicFn(errors.Errorf("unhandled Quota Apply operation: %d", op))
+		return
+	}
+}
+
+// applyPoolOpUpdate is responsible for applying the pool operation update
+// to the database.
+func (d *dbData) applyPoolOpUpdate(op raftOp, data []byte, panicFn func(error)) {
+	po := new(system.PoolOp)
+	if err := json.Unmarshal(data, po); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode pool operation update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpUpdatePoolOp:
+		d.PoolOps.Ops[po.ID] = po
+	case raftOpRemovePoolOp:
+		delete(d.PoolOps.Ops, po.ID)
+	default:
+		panicFn(errors.Errorf("unhandled Pool Operation Apply operation: %d", op))
+		return
+	}
+}
+
+// applyAuditUpdate is responsible for applying the audit log update operation
+// to the database.
+func (d *dbData) applyAuditUpdate(op raftOp, data []byte, panicFn func(error)) {
+	au := new(auditUpdate)
+	if err := json.Unmarshal(data, au); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode audit update"))
+		return
+	}
+	if au.Record == nil {
+		panicFn(errors.New("audit update has no record"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddAuditRecord:
+		d.Audit.addRecord(au.Record, au.MaxRecords)
+	default:
+		panicFn(errors.Errorf("unhandled Audit Apply operation: %d", op))
+		return
+	}
+}
+
+// applyEventUpdate is responsible for applying the event history update
+// operation to the database.
+func (d *dbData) applyEventUpdate(op raftOp, data []byte, panicFn func(error)) {
+	var evtData []byte
+	if err := json.Unmarshal(data, &evtData); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode event update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddEvent:
+		d.Events.addEvent(evtData)
+	default:
+		panicFn(errors.Errorf("unhandled Event Apply operation: %d", op))
+		return
+	}
+}
+
+// applyReplicasUpdate is responsible for applying the MS replica set update
+// operation to the database.
+func (d *dbData) applyReplicasUpdate(op raftOp, data []byte, panicFn func(error)) {
+	var replicas []*net.TCPAddr
+	if err := json.Unmarshal(data, &replicas); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode replicas update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpUpdateReplicas:
+		d.Replicas.Replicas = replicas
+	default:
+		panicFn(errors.Errorf("unhandled Replicas Apply operation: %d", op))
+		return
+	}
+}
+
 // applyCheckerUpdate is responsible for applying the checker update
 // operation to the database.
 func (d *dbData) applyCheckerUpdate(op raftOp, data []byte, panicFn func(error)) {
@@ -714,6 +914,8 @@ func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
 }
 
 // Restore is called to force the FSM to read in a snapshot, discarding any previous state.
+// Snapshots restored by an administrator are merged with the running state by
+// RestoreSnapshot before they get here.
 func (f *fsm) Restore(rc io.ReadCloser) error {
 	db, _ := NewDatabase(nil, nil)
 	if err := json.NewDecoder(rc).Decode(db.data); err != nil {
@@ -732,8 +934,14 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 	f.data.MapVersion = db.data.MapVersion
 	f.data.System = db.data.System
 	f.data.Checker = db.data.Checker
+	f.data.Quotas = db.data.Quotas
+	f.data.Audit = db.data.Audit
+	f.data.Events = db.data.Events
+	f.data.PoolOps = db.data.PoolOps
+	f.data.Replicas = db.data.Replicas
 	f.data.Version = db.data.Version
 	f.data.Unlock()
+	(*Database)(f).runOnReplicasChanged()
 	f.log.Debugf("db snapshot loaded (map version %d; data version %d)", db.data.MapVersion, db.data.Version)
 	return nil
 }
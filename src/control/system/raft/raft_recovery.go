@@ -7,7 +7,9 @@
 package raft
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -138,23 +140,54 @@ func copySnapshot(src, dst string) error {
 	return nil
 }
 
-// RestoreLocalReplica restores the MS from the snapshot at the supplied path.
+// extractSnapshotArchive writes the contents of the snapshot archive at the
+// supplied path to the destination directory in snapshot store format.
+func extractSnapshotArchive(src, dst string) error {
+	meta, data, err := readSnapshotArchiveFile(src)
+	if err != nil {
+		return err
+	}
+
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dst, snapshotMetaFile), metaData, 0600); err != nil {
+		return errors.Wrap(err, "failed to write snapshot metadata")
+	}
+	if err := os.WriteFile(filepath.Join(dst, snapshotDataFile), data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write snapshot data")
+	}
+
+	return nil
+}
+
+// RestoreLocalReplica restores the MS from the snapshot at the supplied path,
+// which may be either a snapshot directory or a snapshot archive.
 func RestoreLocalReplica(log logging.Logger, cfg *DatabaseConfig, snapPath string) error {
 	sInfo, err := ReadSnapshotInfo(snapPath)
 	if err != nil {
 		return errors.Wrapf(err, "failed to verify snapshot at %q", snapPath)
 	}
 
-	if strings.HasPrefix(snapPath, cfg.RaftDir) {
+	isArchive := isSnapshotArchive(snapPath)
+	if isArchive || strings.HasPrefix(snapPath, cfg.RaftDir) {
 		tmpDir, err := ioutil.TempDir("", "daos-raft-restore")
 		if err != nil {
 			return errors.Wrap(err, "failed to create temporary directory")
 		}
 		defer os.RemoveAll(tmpDir)
 
-		log.Info("Copying snapshot to intermediate temporary directory")
-		if err := copySnapshot(snapPath, tmpDir); err != nil {
-			return errors.Wrap(err, "failed to copy snapshot")
+		if isArchive {
+			log.Info("Extracting snapshot archive to intermediate temporary directory")
+			if err := extractSnapshotArchive(snapPath, tmpDir); err != nil {
+				return errors.Wrap(err, "failed to extract snapshot archive")
+			}
+		} else {
+			log.Info("Copying snapshot to intermediate temporary directory")
+			if err := copySnapshot(snapPath, tmpDir); err != nil {
+				return errors.Wrap(err, "failed to copy snapshot")
+			}
 		}
 		snapPath = tmpDir
 	}
@@ -473,20 +506,145 @@ func readSnapshotData(path string) ([]byte, error) {
 	return data, nil
 }
 
-// ReadSnapshotInfo reads the snapshot metadata and data from the given path.
-func ReadSnapshotInfo(path string) (*SnapshotDetails, error) {
-	details := &SnapshotDetails{
-		Path:     path,
-		Metadata: new(raft.SnapshotMeta),
+// isSnapshotArchive returns true if the path refers to a snapshot archive
+// rather than a snapshot directory.
+func isSnapshotArchive(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && fi.Mode().IsRegular()
+}
+
+// WriteSnapshotArchive writes the snapshot metadata and data to the supplied
+// writer as a compressed archive that can be restored on another system.
+func WriteSnapshotArchive(w io.Writer, meta *raft.SnapshotMeta, data []byte) error {
+	if meta == nil {
+		return errors.New("nil snapshot metadata")
 	}
-	if err := readSnapshotMeta(path, details.Metadata); err != nil {
-		return nil, err
+
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal snapshot metadata")
+	}
+
+	zw := gzip.NewWriter(w)
+	tw := tar.NewWriter(zw)
+	for _, file := range []struct {
+		name string
+		data []byte
+	}{
+		{name: snapshotMetaFile, data: metaData},
+		{name: snapshotDataFile, data: data},
+	} {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    file.name,
+			Mode:    0600,
+			Size:    int64(len(file.data)),
+			ModTime: time.Now(),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to write archive header for %s", file.name)
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			return errors.Wrapf(err, "failed to write %s to archive", file.name)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, "failed to close snapshot archive")
+	}
+	return errors.Wrap(zw.Close(), "failed to close snapshot archive")
+}
+
+// ReadSnapshotArchive reads the snapshot metadata and data from a compressed
+// archive created by WriteSnapshotArchive.
+func ReadSnapshotArchive(r io.Reader) (*raft.SnapshotMeta, []byte, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read snapshot archive")
+	}
+	defer zr.Close()
+
+	var meta *raft.SnapshotMeta
+	var data []byte
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read snapshot archive")
+		}
+
+		switch hdr.Name {
+		case snapshotMetaFile:
+			meta = new(raft.SnapshotMeta)
+			if err := json.NewDecoder(tr).Decode(meta); err != nil {
+				return nil, nil, errors.Wrap(err, "failed to parse snapshot metadata")
+			}
+		case snapshotDataFile:
+			if data, err = io.ReadAll(tr); err != nil {
+				return nil, nil, errors.Wrap(err, "failed to read snapshot data")
+			}
+		default:
+			return nil, nil, errors.Errorf("unexpected file %q in snapshot archive", hdr.Name)
+		}
+	}
+
+	if meta == nil {
+		return nil, nil, errors.Errorf("snapshot archive is missing %s", snapshotMetaFile)
+	}
+	if data == nil {
+		return nil, nil, errors.Errorf("snapshot archive is missing %s", snapshotDataFile)
+	}
+	if meta.Size != int64(len(data)) {
+		return nil, nil, errors.Errorf("snapshot data size %d does not match metadata (%d)",
+			len(data), meta.Size)
+	}
+
+	return meta, data, nil
+}
+
+func readSnapshotArchiveFile(path string) (*raft.SnapshotMeta, []byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	meta, data, err := ReadSnapshotArchive(f)
+	return meta, data, errors.Wrapf(err, "failed to read snapshot archive %q", path)
+}
+
+// ReadSnapshot reads the snapshot metadata and data from the given path,
+// which may be either a snapshot directory or a snapshot archive.
+func ReadSnapshot(path string) (*raft.SnapshotMeta, []byte, error) {
+	if isSnapshotArchive(path) {
+		return readSnapshotArchiveFile(path)
+	}
+
+	meta := new(raft.SnapshotMeta)
+	if err := readSnapshotMeta(path, meta); err != nil {
+		return nil, nil, err
 	}
 
 	data, err := readSnapshotData(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return meta, data, nil
+}
+
+// ReadSnapshotInfo reads the snapshot metadata and data from the given path,
+// which may be either a snapshot directory or a snapshot archive.
+func ReadSnapshotInfo(path string) (*SnapshotDetails, error) {
+	meta, data, err := ReadSnapshot(path)
 	if err != nil {
 		return nil, err
 	}
 
+	details := &SnapshotDetails{
+		Path:     path,
+		Metadata: meta,
+	}
 	return details, errors.Wrapf(details.DecodeSnapshot(data), "failed to decode snapshot data in %s", path)
 }
@@ -7,9 +7,14 @@
 package raft
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -236,10 +241,131 @@ func Test_Raft_RecoverLocalReplica(t *testing.T) {
 	}
 }
 
+// writeTestArchive creates a snapshot archive from the snapshot directory.
+func writeTestArchive(t *testing.T, snapDir string) string {
+	t.Helper()
+
+	meta := new(raft.SnapshotMeta)
+	if err := readSnapshotMeta(snapDir, meta); err != nil {
+		t.Fatal(err)
+	}
+	data, err := readSnapshotData(snapDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archivePath := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	f, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := WriteSnapshotArchive(f, meta, data); err != nil {
+		t.Fatal(err)
+	}
+
+	return archivePath
+}
+
+func Test_Raft_SnapshotArchive(t *testing.T) {
+	meta := &raft.SnapshotMeta{
+		Version: raft.SnapshotVersionMax,
+		ID:      "1-2-3",
+		Index:   2,
+		Term:    1,
+		Size:    int64(len("snapshot data")),
+	}
+	data := []byte("snapshot data")
+
+	writeArchive := func(t *testing.T, files map[string][]byte) *bytes.Buffer {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		zw := gzip.NewWriter(buf)
+		tw := tar.NewWriter(zw)
+		for name, content := range files {
+			if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := tw.Write(content); err != nil {
+				t.Fatal(err)
+			}
+		}
+		tw.Close()
+		zw.Close()
+		return buf
+	}
+
+	for name, tc := range map[string]struct {
+		archive func(t *testing.T) io.Reader
+		expErr  error
+	}{
+		"not an archive": {
+			archive: func(t *testing.T) io.Reader {
+				return bytes.NewBufferString("bad archive")
+			},
+			expErr: errors.New("invalid header"),
+		},
+		"missing metadata": {
+			archive: func(t *testing.T) io.Reader {
+				return writeArchive(t, map[string][]byte{snapshotDataFile: data})
+			},
+			expErr: errors.New("missing meta.json"),
+		},
+		"missing data": {
+			archive: func(t *testing.T) io.Reader {
+				metaData, _ := json.Marshal(meta)
+				return writeArchive(t, map[string][]byte{snapshotMetaFile: metaData})
+			},
+			expErr: errors.New("missing state.bin"),
+		},
+		"unexpected file": {
+			archive: func(t *testing.T) io.Reader {
+				return writeArchive(t, map[string][]byte{"other": data})
+			},
+			expErr: errors.New("unexpected file"),
+		},
+		"size mismatch": {
+			archive: func(t *testing.T) io.Reader {
+				metaData, _ := json.Marshal(meta)
+				return writeArchive(t, map[string][]byte{
+					snapshotMetaFile: metaData,
+					snapshotDataFile: []byte("short"),
+				})
+			},
+			expErr: errors.New("does not match metadata"),
+		},
+		"success": {
+			archive: func(t *testing.T) io.Reader {
+				buf := new(bytes.Buffer)
+				if err := WriteSnapshotArchive(buf, meta, data); err != nil {
+					t.Fatal(err)
+				}
+				return buf
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotMeta, gotData, gotErr := ReadSnapshotArchive(tc.archive(t))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(meta, gotMeta); diff != "" {
+				t.Fatalf("unexpected metadata (-want +got):\n%s", diff)
+			}
+			test.AssertEqual(t, string(data), string(gotData), "unexpected data")
+		})
+	}
+}
+
 func Test_Raft_RestoreLocalReplica(t *testing.T) {
 	for name, tc := range map[string]struct {
-		setup  func(t *testing.T) (*DatabaseConfig, string)
-		expErr error
+		setup   func(t *testing.T) (*DatabaseConfig, string)
+		archive bool
+		expErr  error
 	}{
 		"unwritable restore dir": {
 			setup: func(t *testing.T) (*DatabaseConfig, string) {
@@ -281,6 +407,19 @@ func Test_Raft_RestoreLocalReplica(t *testing.T) {
 				return dbCfg, filepath.Join(t.TempDir(), filepath.Base(srcDir))
 			},
 		},
+		"successful restore from snapshot archive": {
+			setup: func(t *testing.T) (*DatabaseConfig, string) {
+				dbCfg := testDbCfg()
+				srcDir := dbCfg.RaftDir
+				dbCfg.RaftDir = filepath.Join(t.TempDir(), filepath.Base(srcDir))
+				test.CopyDir(t, srcDir, dbCfg.RaftDir)
+				if err := os.Remove(dbCfg.DBFilePath()); err != nil {
+					t.Fatal(err)
+				}
+				return dbCfg, filepath.Join(t.TempDir(), filepath.Base(srcDir))
+			},
+			archive: true,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -292,8 +431,13 @@ func Test_Raft_RestoreLocalReplica(t *testing.T) {
 				t.Fatal(err)
 			}
 
+			snapPath := preSnaps[0].Path
+			if tc.archive {
+				snapPath = writeTestArchive(t, snapPath)
+			}
+
 			dbCfg.RaftDir = restoreDir
-			err = RestoreLocalReplica(log, dbCfg, preSnaps[0].Path)
+			err = RestoreLocalReplica(log, dbCfg, snapPath)
 			test.CmpErr(t, tc.expErr, err)
 			if tc.expErr != nil {
 				return
@@ -455,6 +599,22 @@ func Test_Raft_ReadSnapshotInfo(t *testing.T) {
 			},
 			expErr: errors.New("invalid character"),
 		},
+		"mangled archive": {
+			setup: func(t *testing.T) string {
+				archivePath := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+				if err := os.WriteFile(archivePath, []byte("bad archive"), 0644); err != nil {
+					t.Fatal(err)
+				}
+
+				return archivePath
+			},
+			expErr: errors.New("invalid header"),
+		},
+		"good snapshot archive": {
+			setup: func(t *testing.T) string {
+				return writeTestArchive(t, latest.Path)
+			},
+		},
 		"good snapshot path": {
 			setup: func(t *testing.T) string {
 				testDir := t.TempDir()
@@ -74,6 +74,7 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_CHK_PROP               = 245,
 	DRPC_METHOD_MGMT_CHK_ACT                = 246,
 	DRPC_METHOD_MGMT_SETUP_CLIENT_TELEM     = 247,
+	DRPC_METHOD_MGMT_POOL_SET_ATTR          = 248,
 
 	NUM_DRPC_MGMT_METHODS /* Must be last */
 };
@@ -63,7 +63,11 @@
 	X(RAS_SYSTEM_FABRIC_PROV_CHANGED, "system_fabric_provider_changed")                        \
 	X(RAS_ENGINE_JOIN_FAILED, "engine_join_failed")                                            \
 	X(RAS_DEVICE_LINK_SPEED_CHANGED, "device_link_speed_changed")                              \
-	X(RAS_DEVICE_LINK_WIDTH_CHANGED, "device_link_width_changed")
+	X(RAS_DEVICE_LINK_WIDTH_CHANGED, "device_link_width_changed")                              \
+	X(RAS_CERT_EXPIRING, "certificate_expiring")                                               \
+	X(RAS_DEVICE_WEAR_OUT, "device_wear_out")                                                  \
+	X(RAS_DEVICE_HEALTH_POLICY, "device_health_policy")                                        \
+	X(RAS_SCM_BAD_BLOCKS, "scm_bad_blocks")
 
 /** Define RAS event enum */
 typedef enum {
@@ -62,6 +62,9 @@ ds_mgmt_drpc_pool_overwrite_acl(Drpc__Call *drpc_req,
 void
 ds_mgmt_drpc_pool_upgrade(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_pool_set_attr(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 void
 ds_mgmt_drpc_pool_update_acl(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
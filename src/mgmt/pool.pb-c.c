@@ -1504,7 +1504,97 @@ void   mgmt__pool_query_target_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_query_target_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[14] =
+void   mgmt__pool_attribute__init
+                     (Mgmt__PoolAttribute         *message)
+{
+  static const Mgmt__PoolAttribute init_value = MGMT__POOL_ATTRIBUTE__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_attribute__get_packed_size
+                     (const Mgmt__PoolAttribute *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_attribute__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_attribute__pack
+                     (const Mgmt__PoolAttribute *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_attribute__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_attribute__pack_to_buffer
+                     (const Mgmt__PoolAttribute *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_attribute__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolAttribute *
+       mgmt__pool_attribute__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolAttribute *)
+     protobuf_c_message_unpack (&mgmt__pool_attribute__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_attribute__free_unpacked
+                     (Mgmt__PoolAttribute *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_attribute__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_set_attr_req__init
+                     (Mgmt__PoolSetAttrReq         *message)
+{
+  static const Mgmt__PoolSetAttrReq init_value = MGMT__POOL_SET_ATTR_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_set_attr_req__get_packed_size
+                     (const Mgmt__PoolSetAttrReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_attr_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_set_attr_req__pack
+                     (const Mgmt__PoolSetAttrReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_attr_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_set_attr_req__pack_to_buffer
+                     (const Mgmt__PoolSetAttrReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_attr_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolSetAttrReq *
+       mgmt__pool_set_attr_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolSetAttrReq *)
+     protobuf_c_message_unpack (&mgmt__pool_set_attr_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_set_attr_req__free_unpacked
+                     (Mgmt__PoolSetAttrReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_set_attr_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[16] =
 {
   {
     "uuid",
@@ -1674,10 +1764,35 @@ static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[1
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "user_default",
+    15,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolCreateReq, user_default),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "group_default",
+    16,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolCreateReq, group_default),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
   4,   /* field[4] = acl */
   6,   /* field[6] = faultDomains */
+  15,   /* field[15] = group_default */
   13,   /* field[13] = meta_blob_size */
   10,   /* field[10] = numranks */
   7,   /* field[7] = numsvcreps */
@@ -1688,13 +1803,14 @@ static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
   9,   /* field[9] = tierratio */
   8,   /* field[8] = totalbytes */
   2,   /* field[2] = user */
+  14,   /* field[14] = user_default */
   3,   /* field[3] = usergroup */
   0,   /* field[0] = uuid */
 };
 static const ProtobufCIntRange mgmt__pool_create_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 14 }
+  { 0, 16 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor =
 {
@@ -1704,7 +1820,7 @@ const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor =
   "Mgmt__PoolCreateReq",
   "mgmt",
   sizeof(Mgmt__PoolCreateReq),
-  14,
+  16,
   mgmt__pool_create_req__field_descriptors,
   mgmt__pool_create_req__field_indices_by_name,
   1,  mgmt__pool_create_req__number_ranges,
@@ -2698,7 +2814,7 @@ const ProtobufCMessageDescriptor mgmt__list_pools_req__descriptor =
   (ProtobufCMessageInit) mgmt__list_pools_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descriptors[5] =
+static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descriptors[7] =
 {
   {
     "uuid",
@@ -2760,8 +2876,34 @@ static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descrip
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "creator",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp__Pool, creator),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "created",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp__Pool, created),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__list_pools_resp__pool__field_indices_by_name[] = {
+  6,   /* field[6] = created */
+  5,   /* field[5] = creator */
   1,   /* field[1] = label */
   4,   /* field[4] = rebuild_state */
   3,   /* field[3] = state */
@@ -2771,7 +2913,7 @@ static const unsigned mgmt__list_pools_resp__pool__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__list_pools_resp__pool__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 5 }
+  { 0, 7 }
 };
 const ProtobufCMessageDescriptor mgmt__list_pools_resp__pool__descriptor =
 {
@@ -2781,7 +2923,7 @@ const ProtobufCMessageDescriptor mgmt__list_pools_resp__pool__descriptor =
   "Mgmt__ListPoolsResp__Pool",
   "mgmt",
   sizeof(Mgmt__ListPoolsResp__Pool),
-  5,
+  7,
   mgmt__list_pools_resp__pool__field_descriptors,
   mgmt__list_pools_resp__pool__field_indices_by_name,
   1,  mgmt__list_pools_resp__pool__number_ranges,
@@ -4315,6 +4457,134 @@ const ProtobufCMessageDescriptor mgmt__pool_query_target_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_query_target_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_attribute__field_descriptors[2] =
+{
+  {
+    "name",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */,
+    offsetof(Mgmt__PoolAttribute, name),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "value",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BYTES,
+    0,   /* quantifier_offset */,
+    offsetof(Mgmt__PoolAttribute, value),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_attribute__field_indices_by_name[] = {
+  0,   /* field[0] = name */
+  1,   /* field[1] = value */
+};
+static const ProtobufCIntRange mgmt__pool_attribute__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_attribute__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolAttribute",
+  "PoolAttribute",
+  "Mgmt__PoolAttribute",
+  "mgmt",
+  sizeof(Mgmt__PoolAttribute),
+  2,
+  mgmt__pool_attribute__field_descriptors,
+  mgmt__pool_attribute__field_indices_by_name,
+  1,  mgmt__pool_attribute__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_attribute__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_set_attr_req__field_descriptors[4] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */,
+    offsetof(Mgmt__PoolSetAttrReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */,
+    offsetof(Mgmt__PoolSetAttrReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolSetAttrReq, n_svc_ranks),
+    offsetof(Mgmt__PoolSetAttrReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "attributes",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__PoolSetAttrReq, n_attributes),
+    offsetof(Mgmt__PoolSetAttrReq, attributes),
+    &mgmt__pool_attribute__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_set_attr_req__field_indices_by_name[] = {
+  3,   /* field[3] = attributes */
+  1,   /* field[1] = id */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__pool_set_attr_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_set_attr_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolSetAttrReq",
+  "PoolSetAttrReq",
+  "Mgmt__PoolSetAttrReq",
+  "mgmt",
+  sizeof(Mgmt__PoolSetAttrReq),
+  4,
+  mgmt__pool_set_attr_req__field_descriptors,
+  mgmt__pool_set_attr_req__field_indices_by_name,
+  1,  mgmt__pool_set_attr_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_set_attr_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCEnumValue mgmt__storage_media_type__enum_values_by_number[2] =
 {
   { "SCM", "MGMT__STORAGE_MEDIA_TYPE__SCM", 0 },
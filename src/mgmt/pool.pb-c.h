@@ -50,6 +50,8 @@ typedef struct _Mgmt__PoolQueryTargetReq Mgmt__PoolQueryTargetReq;
 typedef struct _Mgmt__StorageTargetUsage Mgmt__StorageTargetUsage;
 typedef struct _Mgmt__PoolQueryTargetInfo Mgmt__PoolQueryTargetInfo;
 typedef struct _Mgmt__PoolQueryTargetResp Mgmt__PoolQueryTargetResp;
+typedef struct _Mgmt__PoolAttribute Mgmt__PoolAttribute;
+typedef struct _Mgmt__PoolSetAttrReq Mgmt__PoolSetAttrReq;
 
 
 /* --- enums --- */
@@ -213,10 +215,18 @@ struct  _Mgmt__PoolCreateReq
    * Size in bytes of metadata blob on SSD (manual config)
    */
   uint64_t meta_blob_size;
+  /*
+   * user was not specified and defaults to the client's identity
+   */
+  protobuf_c_boolean user_default;
+  /*
+   * usergroup was not specified and defaults to the client's identity
+   */
+  protobuf_c_boolean group_default;
 };
 #define MGMT__POOL_CREATE_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_create_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL, 0, 0, 0,NULL, 0, 0,NULL, 0,NULL, 0 }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL, 0, 0, 0,NULL, 0, 0,NULL, 0,NULL, 0, 0, 0 }
 
 
 /*
@@ -625,10 +635,18 @@ struct  _Mgmt__ListPoolsResp__Pool
    * pool rebuild state
    */
   char *rebuild_state;
+  /*
+   * subject of the certificate used to create the pool
+   */
+  char *creator;
+  /*
+   * time the pool was created, empty if unknown
+   */
+  char *created;
 };
 #define MGMT__LIST_POOLS_RESP__POOL__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_pools_resp__pool__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
 
 
 /*
@@ -1133,6 +1151,56 @@ struct  _Mgmt__PoolQueryTargetResp
     , 0, 0,NULL }
 
 
+/*
+ * PoolAttribute is a pool user attribute.
+ */
+struct  _Mgmt__PoolAttribute
+{
+  ProtobufCMessage base;
+  /*
+   * attribute name
+   */
+  char *name;
+  /*
+   * attribute value
+   */
+  ProtobufCBinaryData value;
+};
+#define MGMT__POOL_ATTRIBUTE__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_attribute__descriptor) \
+    , (char *)protobuf_c_empty_string, {0,NULL} }
+
+
+/*
+ * PoolSetAttrReq represents a request to set pool user attributes.
+ */
+struct  _Mgmt__PoolSetAttrReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid of pool to modify
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+  /*
+   * attributes to set
+   */
+  size_t n_attributes;
+  Mgmt__PoolAttribute **attributes;
+};
+#define MGMT__POOL_SET_ATTR_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_set_attr_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL }
+
+
 /* Mgmt__PoolCreateReq methods */
 void   mgmt__pool_create_req__init
                      (Mgmt__PoolCreateReq         *message);
@@ -1766,6 +1834,44 @@ Mgmt__PoolQueryTargetResp *
 void   mgmt__pool_query_target_resp__free_unpacked
                      (Mgmt__PoolQueryTargetResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolAttribute methods */
+void   mgmt__pool_attribute__init
+                     (Mgmt__PoolAttribute         *message);
+size_t mgmt__pool_attribute__get_packed_size
+                     (const Mgmt__PoolAttribute   *message);
+size_t mgmt__pool_attribute__pack
+                     (const Mgmt__PoolAttribute   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_attribute__pack_to_buffer
+                     (const Mgmt__PoolAttribute   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolAttribute *
+       mgmt__pool_attribute__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_attribute__free_unpacked
+                     (Mgmt__PoolAttribute *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolSetAttrReq methods */
+void   mgmt__pool_set_attr_req__init
+                     (Mgmt__PoolSetAttrReq         *message);
+size_t mgmt__pool_set_attr_req__get_packed_size
+                     (const Mgmt__PoolSetAttrReq   *message);
+size_t mgmt__pool_set_attr_req__pack
+                     (const Mgmt__PoolSetAttrReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_set_attr_req__pack_to_buffer
+                     (const Mgmt__PoolSetAttrReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolSetAttrReq *
+       mgmt__pool_set_attr_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_set_attr_req__free_unpacked
+                     (Mgmt__PoolSetAttrReq *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__PoolCreateReq_Closure)
@@ -1873,6 +1979,12 @@ typedef void (*Mgmt__PoolQueryTargetInfo_Closure)
 typedef void (*Mgmt__PoolQueryTargetResp_Closure)
                  (const Mgmt__PoolQueryTargetResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolAttribute_Closure)
+                 (const Mgmt__PoolAttribute *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolSetAttrReq_Closure)
+                 (const Mgmt__PoolSetAttrReq *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -1919,6 +2031,8 @@ extern const ProtobufCMessageDescriptor mgmt__pool_query_target_info__descriptor
 extern const ProtobufCEnumDescriptor    mgmt__pool_query_target_info__target_type__descriptor;
 extern const ProtobufCEnumDescriptor    mgmt__pool_query_target_info__target_state__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_query_target_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_attribute__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_set_attr_req__descriptor;
 
 PROTOBUF_C__END_DECLS
 
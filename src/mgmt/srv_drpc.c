@@ -1096,6 +1096,88 @@ out:
 	mgmt__pool_upgrade_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_pool_set_attr(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolSetAttrReq	*req = NULL;
+	Mgmt__DaosResp		 resp = MGMT__DAOS_RESP__INIT;
+	uuid_t			 uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	char			**names = NULL;
+	void			**values = NULL;
+	size_t			*sizes = NULL;
+	uint8_t			*body;
+	size_t			 len;
+	int			 i;
+	int			 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_set_attr_req__unpack(&alloc.alloc,
+					      drpc_req->body.len,
+					      drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (set pool attributes)\n");
+		return;
+	}
+
+	D_INFO("Received request to set attributes for pool %s\n", req->id);
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "Pool UUID is invalid");
+		goto out;
+	}
+
+	if (req->n_attributes == 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "No pool attributes to set");
+		goto out;
+	}
+
+	D_ALLOC_ARRAY(names, req->n_attributes);
+	D_ALLOC_ARRAY(values, req->n_attributes);
+	D_ALLOC_ARRAY(sizes, req->n_attributes);
+	if (names == NULL || values == NULL || sizes == NULL)
+		D_GOTO(out_attrs, rc = -DER_NOMEM);
+
+	for (i = 0; i < req->n_attributes; i++) {
+		names[i]  = req->attributes[i]->name;
+		values[i] = req->attributes[i]->value.data;
+		sizes[i]  = req->attributes[i]->value.len;
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out_attrs, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_set_attr(uuid, svc_ranks, req->n_attributes, names, values, sizes);
+	if (rc != 0)
+		DL_ERROR(rc, "Failed to set attributes for pool %s", req->id);
+
+	d_rank_list_free(svc_ranks);
+
+out_attrs:
+	D_FREE(names);
+	D_FREE(values);
+	D_FREE(sizes);
+out:
+	resp.status = rc;
+	len = mgmt__daos_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__daos_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__pool_set_attr_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 free_response_props(Mgmt__PoolProperty **props, size_t n_props)
 {
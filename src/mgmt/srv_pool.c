@@ -621,6 +621,16 @@ int ds_mgmt_pool_upgrade(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
 	return dsc_pool_svc_upgrade(pool_uuid, svc_ranks, mgmt_ps_call_deadline());
 }
 
+int
+ds_mgmt_pool_set_attr(uuid_t pool_uuid, d_rank_list_t *svc_ranks, int n, char *names[],
+		      void *values[], size_t sizes[])
+{
+	D_DEBUG(DB_MGMT, "Setting attributes for pool " DF_UUID "\n", DP_UUID(pool_uuid));
+
+	return dsc_pool_svc_set_attr(pool_uuid, svc_ranks, mgmt_ps_call_deadline(), n, names,
+				     values, sizes);
+}
+
 int
 ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 		      daos_prop_t *prop)
@@ -557,6 +557,13 @@ mock_ds_mgmt_pool_upgrade_setup(void)
 	uuid_clear(ds_mgmt_pool_upgrade_uuid);
 }
 
+int
+ds_mgmt_pool_set_attr(uuid_t pool_uuid, d_rank_list_t *svc_ranks, int n, char *names[],
+		      void *values[], size_t sizes[])
+{
+	return 0;
+}
+
 int	ds_mgmt_dev_manage_led_return;
 uuid_t  ds_mgmt_dev_manage_led_uuid;
 
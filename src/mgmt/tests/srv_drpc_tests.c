@@ -108,6 +108,7 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_get_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_overwrite_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_upgrade);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_set_attr);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_update_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_delete_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_query);
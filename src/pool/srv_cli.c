@@ -1109,3 +1109,108 @@ dsc_pool_svc_upgrade(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline)
 	D_DEBUG(DB_MGMT, DF_UUID ": Upgrading pool prop\n", DP_UUID(pool_uuid));
 	return dsc_pool_svc_call(pool_uuid, ranks, &pool_upgrade_cbs, NULL /* arg */, deadline);
 }
+
+struct pool_set_attr_arg {
+	int          psaa_n;
+	char       **psaa_names;
+	void       **psaa_values;
+	size_t      *psaa_sizes;
+	crt_bulk_t   psaa_bulk;
+};
+
+static int
+pool_set_attr_init(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
+{
+	struct pool_set_attr_arg *arg = varg;
+	struct dss_module_info   *info = dss_get_module_info();
+	d_sg_list_t               sgl;
+	int                       i;
+	int                       j;
+	int                       rc;
+
+	/* Same layout as the client sends: names, sizes, then non-empty values. */
+	sgl.sg_nr_out = 0;
+	sgl.sg_nr     = arg->psaa_n + 1;
+	for (i = 0; i < arg->psaa_n; i++)
+		if (arg->psaa_sizes[i] > 0)
+			sgl.sg_nr++;
+
+	D_ALLOC_ARRAY(sgl.sg_iovs, sgl.sg_nr);
+	if (sgl.sg_iovs == NULL)
+		return -DER_NOMEM;
+
+	for (i = 0, j = 0; i < arg->psaa_n; i++)
+		d_iov_set(&sgl.sg_iovs[j++], arg->psaa_names[i],
+			  strlen(arg->psaa_names[i]) + 1 /* trailing '\0' */);
+	d_iov_set(&sgl.sg_iovs[j++], arg->psaa_sizes, arg->psaa_n * sizeof(*arg->psaa_sizes));
+	for (i = 0; i < arg->psaa_n; i++)
+		if (arg->psaa_sizes[i] > 0)
+			d_iov_set(&sgl.sg_iovs[j++], arg->psaa_values[i], arg->psaa_sizes[i]);
+
+	rc = crt_bulk_create(info->dmi_ctx, &sgl, CRT_BULK_RO, &arg->psaa_bulk);
+	D_FREE(sgl.sg_iovs);
+	if (rc != 0)
+		return rc;
+
+	pool_attr_set_in_set_data(rpc, arg->psaa_n, arg->psaa_bulk);
+	return 0;
+}
+
+static int
+pool_set_attr_consume(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
+{
+	struct pool_op_out *out = crt_reply_get(rpc);
+	int                 rc  = out->po_rc;
+
+	if (rc != 0)
+		DL_ERROR(rc, DF_UUID ": failed to set attributes for pool", DP_UUID(pool_uuid));
+	return rc;
+}
+
+static void
+pool_set_attr_fini(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
+{
+	struct pool_set_attr_arg *arg = varg;
+
+	crt_bulk_free(arg->psaa_bulk);
+	arg->psaa_bulk = CRT_BULK_NULL;
+}
+
+static struct dsc_pool_svc_call_cbs pool_set_attr_cbs = {
+	.pscc_op	= POOL_ATTR_SET,
+	.pscc_init	= pool_set_attr_init,
+	.pscc_consume	= pool_set_attr_consume,
+	.pscc_fini	= pool_set_attr_fini
+};
+
+/**
+ * Set pool user attributes without holding a pool handle.
+ *
+ * \param[in]	pool_uuid	UUID of the pool
+ * \param[in]	ranks		Pool service replicas
+ * \param[in]	deadline	Unix time deadline in milliseconds
+ * \param[in]	n		Number of attributes
+ * \param[in]	names		Attribute names
+ * \param[in]	values		Attribute values
+ * \param[in]	sizes		Sizes of the attribute values
+ *
+ * \return	0		Success
+ */
+int
+dsc_pool_svc_set_attr(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline, int n,
+		      char *names[], void *values[], size_t sizes[])
+{
+	struct pool_set_attr_arg arg = {
+		.psaa_n		= n,
+		.psaa_names	= names,
+		.psaa_values	= values,
+		.psaa_sizes	= sizes,
+		.psaa_bulk	= CRT_BULK_NULL
+	};
+
+	if (n <= 0 || names == NULL || values == NULL || sizes == NULL)
+		return -DER_INVAL;
+
+	D_DEBUG(DB_MGMT, DF_UUID ": Setting %d pool attributes\n", DP_UUID(pool_uuid), n);
+	return dsc_pool_svc_call(pool_uuid, ranks, &pool_set_attr_cbs, &arg, deadline);
+}